@@ -0,0 +1,343 @@
+package elevenlabs
+
+import (
+	"context"
+	"net/url"
+	"sort"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// AgentsService handles conversational AI agent operations.
+type AgentsService struct {
+	client *Client
+}
+
+// EvaluationResult is the outcome of an evaluation criterion or of a whole
+// conversation.
+type EvaluationResult string
+
+// Evaluation outcomes.
+const (
+	EvaluationSuccess EvaluationResult = "success"
+	EvaluationFailure EvaluationResult = "failure"
+	EvaluationUnknown EvaluationResult = "unknown"
+)
+
+// Conversation roles.
+const (
+	ConversationRoleUser  = "user"
+	ConversationRoleAgent = "agent"
+)
+
+// SimulationScenario describes a conversation to simulate against an agent.
+// The simulated user is itself an LLM driven by UserPrompt.
+type SimulationScenario struct {
+	// UserPrompt instructs the simulated user, e.g. "You are a customer who
+	// wants to cancel their subscription but can be convinced to stay."
+	UserPrompt string
+
+	// FirstMessage is the simulated user's opening message. If empty, the
+	// simulated user waits for the agent.
+	FirstMessage string
+
+	// Language is the language of the simulated user (e.g., "en").
+	Language string
+
+	// History is a partial conversation to continue from. If empty, the
+	// simulation starts from the beginning.
+	History []ConversationTurn
+
+	// DynamicVariables are values for the agent's dynamic variables.
+	DynamicVariables map[string]any
+
+	// ToolMocks mocks tool calls by tool name, so simulations do not call
+	// real webhooks.
+	ToolMocks map[string]ToolMock
+
+	// EvaluationCriteria are evaluated in addition to the agent's own
+	// criteria.
+	EvaluationCriteria []EvaluationCriterion
+
+	// TurnsLimit is the maximum number of new turns to simulate.
+	// If zero, the API default is used.
+	TurnsLimit int
+}
+
+// ToolMock is the mocked response of a tool during a simulation.
+type ToolMock struct {
+	// ReturnValue is returned to the agent when the tool is called.
+	ReturnValue string `json:"default_return_value,omitempty"`
+
+	// IsError reports the tool call as failed.
+	IsError bool `json:"default_is_error,omitempty"`
+}
+
+// EvaluationCriterion is a goal the conversation is evaluated against.
+type EvaluationCriterion struct {
+	// ID identifies the criterion in SimulationAnalysis.EvaluationResults.
+	ID string `json:"id"`
+
+	// Name is a human-readable name.
+	Name string `json:"name"`
+
+	// Prompt describes what a successful conversation achieves, e.g.
+	// "The agent offered a discount before processing the cancellation."
+	Prompt string `json:"conversation_goal_prompt"`
+
+	// UseKnowledgeBase evaluates the criterion with the agent's knowledge base.
+	UseKnowledgeBase bool `json:"use_knowledge_base,omitempty"`
+}
+
+// ConversationTurn is a single message in a conversation.
+type ConversationTurn struct {
+	// Role is ConversationRoleUser or ConversationRoleAgent.
+	Role string `json:"role"`
+
+	// Message is the text of the turn.
+	Message string `json:"message,omitempty"`
+
+	// TimeInCallSecs is when the turn happened, relative to the call start.
+	TimeInCallSecs int `json:"time_in_call_secs"`
+
+	// ToolCalls are the tools the agent called during the turn.
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+}
+
+// ToolCall is a tool invocation made by an agent.
+type ToolCall struct {
+	RequestID    string `json:"request_id"`
+	ToolName     string `json:"tool_name"`
+	ParamsAsJSON string `json:"params_as_json"`
+	Called       bool   `json:"tool_has_been_called"`
+}
+
+// SimulationResult is the outcome of a simulated conversation.
+type SimulationResult struct {
+	// Conversation is the simulated transcript, including any History.
+	Conversation []ConversationTurn `json:"simulated_conversation"`
+
+	// Analysis is the evaluation of the conversation.
+	Analysis SimulationAnalysis `json:"analysis"`
+}
+
+// SimulationAnalysis is the evaluation of a simulated conversation.
+type SimulationAnalysis struct {
+	// CallSuccessful is the overall outcome of the conversation.
+	CallSuccessful EvaluationResult `json:"call_successful"`
+
+	// Summary summarizes the transcript.
+	Summary string `json:"transcript_summary"`
+
+	// Title is a short title for the conversation.
+	Title string `json:"call_summary_title,omitempty"`
+
+	// EvaluationResults are keyed by criterion ID.
+	EvaluationResults map[string]CriterionResult `json:"evaluation_criteria_results,omitempty"`
+
+	// DataCollectionResults are the agent's data collection results.
+	DataCollectionResults map[string]any `json:"data_collection_results,omitempty"`
+}
+
+// CriterionResult is the evaluation of a single criterion.
+type CriterionResult struct {
+	CriteriaID string           `json:"criteria_id"`
+	Result     EvaluationResult `json:"result"`
+	Rationale  string           `json:"rationale"`
+}
+
+// Passed reports whether the conversation succeeded and no evaluation
+// criterion failed. Unknown results do not fail the simulation.
+func (r *SimulationResult) Passed() bool {
+	if r.Analysis.CallSuccessful == EvaluationFailure {
+		return false
+	}
+	return len(r.FailedCriteria()) == 0
+}
+
+// FailedCriteria returns the results of criteria that failed.
+func (r *SimulationResult) FailedCriteria() []CriterionResult {
+	var failed []CriterionResult
+	for _, res := range r.Analysis.EvaluationResults {
+		if res.Result == EvaluationFailure {
+			failed = append(failed, res)
+		}
+	}
+	return failed
+}
+
+// simulationRequest is the wire format of a simulation request.
+type simulationRequest struct {
+	Specification      simulationSpecification `json:"simulation_specification"`
+	EvaluationCriteria []EvaluationCriterion   `json:"extra_evaluation_criteria,omitempty"`
+	TurnsLimit         int                     `json:"new_turns_limit,omitempty"`
+}
+
+type simulationSpecification struct {
+	UserConfig       simulatedUserConfig `json:"simulated_user_config"`
+	History          []ConversationTurn  `json:"partial_conversation_history,omitempty"`
+	DynamicVariables map[string]any      `json:"dynamic_variables,omitempty"`
+	ToolMocks        map[string]ToolMock `json:"tool_mock_config,omitempty"`
+}
+
+type simulatedUserConfig struct {
+	FirstMessage string               `json:"first_message,omitempty"`
+	Language     string               `json:"language,omitempty"`
+	Prompt       *simulatedUserPrompt `json:"prompt,omitempty"`
+}
+
+type simulatedUserPrompt struct {
+	Prompt string `json:"prompt"`
+}
+
+// Simulate runs a conversation between an agent and a simulated user and
+// returns the transcript with its evaluation. Use it to regression-test
+// agent prompt changes:
+//
+//	result, err := client.Agents().Simulate(ctx, agentID, scenario)
+//	if err == nil && !result.Passed() {
+//		t.Errorf("criteria failed: %+v", result.FailedCriteria())
+//	}
+func (s *AgentsService) Simulate(ctx context.Context, agentID string, scenario *SimulationScenario) (*SimulationResult, error) {
+	if agentID == "" {
+		return nil, &ValidationError{Field: "agent_id", Message: "cannot be empty"}
+	}
+	if scenario == nil {
+		return nil, &ValidationError{Field: "scenario", Message: "cannot be nil"}
+	}
+	if scenario.TurnsLimit < 0 {
+		return nil, &ValidationError{Field: "turns_limit", Message: "cannot be negative"}
+	}
+	for _, c := range scenario.EvaluationCriteria {
+		if c.ID == "" || c.Name == "" || c.Prompt == "" {
+			return nil, &ValidationError{Field: "evaluation_criteria", Message: "id, name, and prompt are required"}
+		}
+	}
+
+	req := simulationRequest{
+		Specification: simulationSpecification{
+			UserConfig: simulatedUserConfig{
+				FirstMessage: scenario.FirstMessage,
+				Language:     scenario.Language,
+			},
+			History:          scenario.History,
+			DynamicVariables: scenario.DynamicVariables,
+			ToolMocks:        scenario.ToolMocks,
+		},
+		EvaluationCriteria: scenario.EvaluationCriteria,
+		TurnsLimit:         scenario.TurnsLimit,
+	}
+	if scenario.UserPrompt != "" {
+		req.Specification.UserConfig.Prompt = &simulatedUserPrompt{Prompt: scenario.UserPrompt}
+	}
+
+	var result SimulationResult
+	path := "/v1/convai/agents/" + url.PathEscape(agentID) + "/simulate-conversation"
+	if err := s.client.postJSON(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// LLMUsageRequest describes an agent configuration to estimate LLM cost for.
+type LLMUsageRequest struct {
+	// AgentID estimates cost for an existing agent. Fields left at their
+	// zero value are taken from the agent's configuration. If empty, the
+	// estimate is based only on the fields below.
+	AgentID string
+
+	// PromptLength is the length of the system prompt in characters.
+	PromptLength int
+
+	// NumberOfPages is the number of PDF pages or URLs in the agent's
+	// knowledge base.
+	NumberOfPages int
+
+	// RAGEnabled reports whether retrieval-augmented generation is enabled.
+	RAGEnabled bool
+}
+
+// LLMPrice is the expected cost of running an agent on one LLM.
+type LLMPrice struct {
+	// Model is the LLM identifier (e.g., "gpt-4o-mini").
+	Model string
+
+	// PricePerMinute is the expected LLM cost per minute of conversation, in USD.
+	PricePerMinute float64
+}
+
+// LLMUsageEstimate is the expected LLM cost of an agent across models.
+type LLMUsageEstimate struct {
+	// Prices are sorted from cheapest to most expensive.
+	Prices []LLMPrice
+}
+
+// Price returns the expected per-minute cost for a model.
+func (e *LLMUsageEstimate) Price(model string) (float64, bool) {
+	for _, p := range e.Prices {
+		if p.Model == model {
+			return p.PricePerMinute, true
+		}
+	}
+	return 0, false
+}
+
+// CalculateLLMUsage estimates the per-minute LLM cost of an agent for each
+// available model, so model choices can be compared before deploying.
+func (s *AgentsService) CalculateLLMUsage(ctx context.Context, req *LLMUsageRequest) (*LLMUsageEstimate, error) {
+	if req == nil {
+		return nil, &ValidationError{Field: "request", Message: "cannot be nil"}
+	}
+	if req.PromptLength < 0 {
+		return nil, &ValidationError{Field: "prompt_length", Message: "cannot be negative"}
+	}
+	if req.NumberOfPages < 0 {
+		return nil, &ValidationError{Field: "number_of_pages", Message: "cannot be negative"}
+	}
+
+	var resp any
+	var err error
+	if req.AgentID != "" {
+		body := &api.LLMUsageCalculatorRequestModel{}
+		if req.PromptLength > 0 {
+			body.PromptLength = api.NewOptNilInt(req.PromptLength)
+		}
+		if req.NumberOfPages > 0 {
+			body.NumberOfPages = api.NewOptNilInt(req.NumberOfPages)
+		}
+		if req.RAGEnabled {
+			body.RagEnabled = api.NewOptNilBool(true)
+		}
+		resp, err = s.client.apiClient.GetAgentLlmExpectedCostCalculation(ctx, body,
+			api.GetAgentLlmExpectedCostCalculationParams{AgentID: req.AgentID})
+	} else {
+		if req.PromptLength == 0 {
+			return nil, &ValidationError{Field: "prompt_length", Message: "is required without agent_id"}
+		}
+		resp, err = s.client.apiClient.GetPublicLlmExpectedCostCalculation(ctx, &api.LLMUsageCalculatorPublicRequestModel{
+			PromptLength:  req.PromptLength,
+			NumberOfPages: req.NumberOfPages,
+			RagEnabled:    req.RAGEnabled,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := resp.(type) {
+	case *api.LLMUsageCalculatorResponseModel:
+		estimate := &LLMUsageEstimate{Prices: make([]LLMPrice, 0, len(r.LlmPrices))}
+		for _, p := range r.LlmPrices {
+			estimate.Prices = append(estimate.Prices, LLMPrice{
+				Model:          string(p.Llm),
+				PricePerMinute: p.PricePerMinute,
+			})
+		}
+		sort.SliceStable(estimate.Prices, func(i, j int) bool {
+			return estimate.Prices[i].PricePerMinute < estimate.Prices[j].PricePerMinute
+		})
+		return estimate, nil
+	default:
+		return nil, &APIError{Message: "unexpected response type"}
+	}
+}
@@ -0,0 +1,159 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAgentsSimulateValidation(t *testing.T) {
+	client, err := NewClient(WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		agentID  string
+		scenario *SimulationScenario
+	}{
+		{"empty agent ID", "", &SimulationScenario{}},
+		{"nil scenario", "agent1", nil},
+		{"negative turns", "agent1", &SimulationScenario{TurnsLimit: -1}},
+		{"incomplete criterion", "agent1", &SimulationScenario{EvaluationCriteria: []EvaluationCriterion{{ID: "c1"}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := client.Agents().Simulate(ctx, tt.agentID, tt.scenario); !isValidationError(err, nil) {
+				t.Errorf("Simulate() error = %v, want ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestAgentsSimulate(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/convai/agents/agent1/simulate-conversation" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"simulated_conversation": [
+				{"role": "user", "message": "I want to cancel.", "time_in_call_secs": 0},
+				{"role": "agent", "message": "I can offer 20% off.", "time_in_call_secs": 3,
+				 "tool_calls": [{"request_id": "r1", "tool_name": "lookup", "params_as_json": "{}", "tool_has_been_called": true}]}
+			],
+			"analysis": {
+				"call_successful": "success",
+				"transcript_summary": "The agent offered a discount.",
+				"evaluation_criteria_results": {
+					"discount": {"criteria_id": "discount", "result": "success", "rationale": "Offered 20%."},
+					"polite": {"criteria_id": "polite", "result": "failure", "rationale": "Interrupted."}
+				}
+			}
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	result, err := client.Agents().Simulate(context.Background(), "agent1", &SimulationScenario{
+		UserPrompt:   "You want to cancel your subscription.",
+		FirstMessage: "I want to cancel.",
+		ToolMocks:    map[string]ToolMock{"lookup": {ReturnValue: "plan: pro"}},
+		EvaluationCriteria: []EvaluationCriterion{
+			{ID: "discount", Name: "Discount", Prompt: "The agent offered a discount."},
+		},
+		TurnsLimit: 4,
+	})
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+
+	spec, _ := body["simulation_specification"].(map[string]any)
+	user, _ := spec["simulated_user_config"].(map[string]any)
+	prompt, _ := user["prompt"].(map[string]any)
+	if prompt["prompt"] != "You want to cancel your subscription." || user["first_message"] != "I want to cancel." {
+		t.Errorf("unexpected simulated user config: %v", user)
+	}
+	if body["new_turns_limit"] != float64(4) {
+		t.Errorf("new_turns_limit = %v, want 4", body["new_turns_limit"])
+	}
+	if mocks, _ := spec["tool_mock_config"].(map[string]any); mocks["lookup"] == nil {
+		t.Errorf("missing tool mock: %v", spec)
+	}
+
+	if len(result.Conversation) != 2 || result.Conversation[1].ToolCalls[0].ToolName != "lookup" {
+		t.Errorf("unexpected conversation: %+v", result.Conversation)
+	}
+	if result.Passed() {
+		t.Error("Passed() = true, want false with a failed criterion")
+	}
+	if failed := result.FailedCriteria(); len(failed) != 1 || failed[0].CriteriaID != "polite" {
+		t.Errorf("FailedCriteria() = %+v", failed)
+	}
+}
+
+func TestAgentsCalculateLLMUsage(t *testing.T) {
+	var paths []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"llm_prices": [
+			{"llm": "gpt-4o", "price_per_minute": 0.02},
+			{"llm": "gpt-4o-mini", "price_per_minute": 0.001}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.Agents().CalculateLLMUsage(ctx, &LLMUsageRequest{}); !isValidationError(err, nil) {
+		t.Errorf("CalculateLLMUsage() without prompt length error = %v, want ValidationError", err)
+	}
+
+	estimate, err := client.Agents().CalculateLLMUsage(ctx, &LLMUsageRequest{PromptLength: 2000, NumberOfPages: 10, RAGEnabled: true})
+	if err != nil {
+		t.Fatalf("CalculateLLMUsage() error = %v", err)
+	}
+	if estimate.Prices[0].Model != "gpt-4o-mini" {
+		t.Errorf("Prices not sorted by price: %+v", estimate.Prices)
+	}
+	if price, ok := estimate.Price("gpt-4o"); !ok || price != 0.02 {
+		t.Errorf("Price(gpt-4o) = %v, %v", price, ok)
+	}
+
+	// Agent estimates only send the fields that override the agent config.
+	if _, err := client.Agents().CalculateLLMUsage(ctx, &LLMUsageRequest{AgentID: "agent1", PromptLength: 500}); err != nil {
+		t.Fatalf("CalculateLLMUsage(agent) error = %v", err)
+	}
+
+	if len(paths) != 2 || paths[0] != "/v1/convai/llm-usage/calculate" || paths[1] != "/v1/convai/agent/agent1/llm-usage/calculate" {
+		t.Fatalf("unexpected paths: %v", paths)
+	}
+	if bodies[0]["rag_enabled"] != true || bodies[0]["number_of_pages"] != float64(10) {
+		t.Errorf("unexpected public request: %v", bodies[0])
+	}
+	if bodies[1]["prompt_length"] != float64(500) {
+		t.Errorf("unexpected agent request: %v", bodies[1])
+	}
+	if _, ok := bodies[1]["rag_enabled"]; ok {
+		t.Errorf("agent request should not override rag_enabled: %v", bodies[1])
+	}
+}
@@ -0,0 +1,235 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// schemaResponse is a generated response type that APIHealth can check.
+type schemaResponse interface {
+	UnmarshalJSON(data []byte) error
+	Validate() error
+}
+
+// healthProbes are read-only requests whose responses APIHealth compares
+// with the generated schema.
+var healthProbes = []struct {
+	path string
+	new  func() schemaResponse
+}{
+	{"/v1/models", func() schemaResponse { return new(api.GetModelsOKApplicationJSON) }},
+	{"/v1/user", func() schemaResponse { return new(api.UserResponseModel) }},
+	{"/v1/user/subscription", func() schemaResponse { return new(api.SubscriptionResponseModel) }},
+	{"/v1/voices", func() schemaResponse { return new(api.GetVoicesResponseModel) }},
+}
+
+// APIHealth is the outcome of Client.APIHealth.
+type APIHealth struct {
+	// CheckedAt is when the check ran.
+	CheckedAt time.Time
+
+	// Endpoints reports each endpoint checked.
+	Endpoints []EndpointHealth
+}
+
+// Drifted reports whether any endpoint responded with fields or values
+// that the OpenAPI spec this package was generated from doesn't describe.
+func (h *APIHealth) Drifted() bool {
+	for _, e := range h.Endpoints {
+		if e.Drifted() {
+			return true
+		}
+	}
+	return false
+}
+
+// String summarizes the check, one line per endpoint.
+func (h *APIHealth) String() string {
+	var b strings.Builder
+	for _, e := range h.Endpoints {
+		switch {
+		case e.Err != nil:
+			fmt.Fprintf(&b, "%s: error: %v\n", e.Operation, e.Err)
+		case !e.Drifted():
+			fmt.Fprintf(&b, "%s: ok\n", e.Operation)
+		default:
+			if e.Mismatch != nil {
+				fmt.Fprintf(&b, "%s: mismatch: %v\n", e.Operation, e.Mismatch)
+			}
+			if len(e.UnknownFields) > 0 {
+				fmt.Fprintf(&b, "%s: unknown fields: %s\n", e.Operation, strings.Join(e.UnknownFields, ", "))
+			}
+		}
+	}
+	return b.String()
+}
+
+// EndpointHealth is how one endpoint's response compares with the schema.
+type EndpointHealth struct {
+	// Operation is the request made, such as "GET /v1/voices".
+	Operation string
+
+	// UnknownFields are the response fields the schema doesn't describe,
+	// as dotted paths with "[]" for array elements, such as
+	// "voices[].new_field". They are ignored when decoding.
+	UnknownFields []string
+
+	// Mismatch is the error for a response that doesn't decode or doesn't
+	// validate against the schema, such as a new enum value. Such responses
+	// fail with a DecodeError under DecodeStrict.
+	Mismatch error
+
+	// Err is the error for a request that failed, such as when the API key
+	// may not read the endpoint. The endpoint was not checked.
+	Err error
+}
+
+// Drifted reports whether the response differed from the schema.
+func (e *EndpointHealth) Drifted() bool {
+	return e.Mismatch != nil || len(e.UnknownFields) > 0
+}
+
+// APIHealth checks whether the API still matches the OpenAPI spec this
+// package was generated from, by comparing the responses of read-only
+// endpoints with the generated schema. Responses with new fields decode
+// fine and new enum values are accepted under DecodeLenient and
+// DecodeWarn, but either means the pinned spec is behind the API and the
+// package should be regenerated. Run it in CI or at startup to notice
+// drift before it becomes a failure.
+//
+// Example:
+//
+//	health, err := client.APIHealth(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if health.Drifted() {
+//	    log.Printf("ElevenLabs API has drifted from the pinned spec:\n%s", health)
+//	}
+func (c *Client) APIHealth(ctx context.Context) (*APIHealth, error) {
+	health := &APIHealth{CheckedAt: c.clock.Now()}
+	for _, probe := range healthProbes {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		health.Endpoints = append(health.Endpoints, c.checkEndpoint(ctx, probe.path, probe.new()))
+	}
+	return health, nil
+}
+
+// checkEndpoint compares the response of a GET request with target's
+// schema.
+func (c *Client) checkEndpoint(ctx context.Context, path string, target schemaResponse) EndpointHealth {
+	result := EndpointHealth{Operation: http.MethodGet + " " + path}
+
+	var data json.RawMessage
+	if err := c.getJSON(ctx, path, &data); err != nil {
+		result.Err = err
+		return result
+	}
+	if err := target.UnmarshalJSON(data); err != nil {
+		result.Mismatch = err
+	} else if err := target.Validate(); err != nil {
+		result.Mismatch = err
+	}
+
+	var raw any
+	if err := json.Unmarshal(data, &raw); err == nil {
+		seen := make(map[string]bool)
+		collectUnknownFields(raw, reflect.TypeOf(target).Elem(), "", seen)
+		for field := range seen {
+			result.UnknownFields = append(result.UnknownFields, field)
+		}
+		sort.Strings(result.UnknownFields)
+	}
+	return result
+}
+
+// collectUnknownFields adds to seen the paths of object keys in raw that
+// have no field in t, a generated schema type.
+func collectUnknownFields(raw any, t reflect.Type, path string, seen map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		items, ok := raw.([]any)
+		if !ok {
+			return
+		}
+		for _, item := range items {
+			collectUnknownFields(item, t.Elem(), path+"[]", seen)
+		}
+	case reflect.Struct:
+		// Optional and nullable wrappers (OptX, NilX, OptNilX) hold the
+		// value in Value
+		if isOptionalWrapper(t) {
+			value, _ := t.FieldByName("Value")
+			collectUnknownFields(raw, value.Type, path, seen)
+			return
+		}
+		object, ok := raw.(map[string]any)
+		if !ok {
+			return
+		}
+		fields := jsonFields(t)
+		if fields == nil {
+			// A sum type (oneOf/anyOf); the variant isn't known here
+			return
+		}
+		for key, value := range object {
+			name := key
+			if path != "" {
+				name = path + "." + key
+			}
+			field, ok := fields[key]
+			if !ok {
+				seen[name] = true
+				continue
+			}
+			collectUnknownFields(value, field, name, seen)
+		}
+	}
+	// Maps allow any key, and scalars have no fields
+}
+
+// isOptionalWrapper reports whether t is a generated optional or nullable
+// wrapper type.
+func isOptionalWrapper(t reflect.Type) bool {
+	if _, ok := t.FieldByName("Value"); !ok {
+		return false
+	}
+	_, set := t.FieldByName("Set")
+	_, null := t.FieldByName("Null")
+	return set || null
+}
+
+// jsonFields returns the types of t's fields by JSON name, or nil if t
+// has no JSON fields.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	var fields map[string]reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, ok := f.Tag.Lookup("json")
+		if !ok {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		if fields == nil {
+			fields = make(map[string]reflect.Type)
+		}
+		fields[name] = f.Type
+	}
+	return fields
+}
@@ -0,0 +1,88 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientAPIHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/models":
+			_, _ = w.Write([]byte(`[]`))
+		case "/v1/voices":
+			_, _ = w.Write([]byte(`{"voices": [{"voice_id": "v1", "name": "Nova", "category": "brand_new",
+				"description": null, "preview_url": null, "sharing_tier": "gold",
+				"available_for_tiers": [], "high_quality_base_model_ids": [],
+				"labels": {"accent": "british"}}], "has_more": false}`))
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"detail": {"status": "missing_permissions"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	health, err := client.APIHealth(context.Background())
+	if err != nil {
+		t.Fatalf("APIHealth() error = %v", err)
+	}
+	if !health.Drifted() || len(health.Endpoints) != 4 {
+		t.Fatalf("health = %+v, want drift on 4 endpoints", health)
+	}
+
+	byOp := make(map[string]EndpointHealth)
+	for _, e := range health.Endpoints {
+		byOp[e.Operation] = e
+	}
+	if e := byOp["GET /v1/models"]; e.Drifted() || e.Err != nil {
+		t.Errorf("models = %+v, want ok", e)
+	}
+	if e := byOp["GET /v1/user"]; e.Err == nil || e.Drifted() {
+		t.Errorf("user = %+v, want request error", e)
+	}
+	voices := byOp["GET /v1/voices"]
+	if got := strings.Join(voices.UnknownFields, ","); got != "has_more,voices[].sharing_tier" {
+		t.Errorf("UnknownFields = %q", got)
+	}
+	if voices.Mismatch == nil || !strings.Contains(voices.Mismatch.Error(), "category") {
+		t.Errorf("Mismatch = %v, want category", voices.Mismatch)
+	}
+	if s := health.String(); !strings.Contains(s, "GET /v1/models: ok") || !strings.Contains(s, "voices[].sharing_tier") {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestClientDecodeWarn(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"voices": [{"voice_id": "v1", "name": "Nova", "category": "brand_new",
+			"description": null, "preview_url": null}]}`))
+	}))
+	defer server.Close()
+
+	var warnings []*DecodeError
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL),
+		WithDecodeWarningHandler(func(err *DecodeError) { warnings = append(warnings, err) }))
+	voices, err := client.Dubbing().SimilarVoices(context.Background(), "dub1", "speaker_0")
+	if err != nil {
+		t.Fatalf("SimilarVoices() error = %v", err)
+	}
+	if len(voices) != 1 || voices[0].Category != "brand_new" {
+		t.Errorf("voices = %+v, want the category as sent", voices)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("warnings = %v, want 1", warnings)
+	}
+	if op := warnings[0].Operation; op != "GET /v1/dubbing/resource/dub1/speaker/speaker_0/similar-voices" {
+		t.Errorf("Operation = %q", op)
+	}
+	if !strings.Contains(warnings[0].Error(), warnings[0].Operation) || errors.Unwrap(warnings[0]) == nil {
+		t.Errorf("Error() = %q", warnings[0].Error())
+	}
+}
@@ -0,0 +1,302 @@
+// Package audioinfo inspects generated audio files without external tools.
+//
+// It recognizes MP3 (MPEG Layer III) and WAV (RIFF PCM) data and reports the
+// format, duration, sample rate, channel count, and bitrate. Raw PCM has no
+// header, so its properties are computed from the known sample rate with
+// FromPCM.
+//
+//	info, err := audioinfo.InspectFile("output/slide01_seg01_en.mp3")
+//	fmt.Println(info.Duration, info.SampleRate, info.Bitrate)
+//
+// EdgeSilence and TrimPCM find and remove leading and trailing silence in
+// PCM audio, such as breaths after the last word of a generated segment.
+package audioinfo
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// Format is an audio container/codec format.
+type Format string
+
+// Supported formats.
+const (
+	FormatMP3 Format = "mp3"
+	FormatWAV Format = "wav"
+	FormatPCM Format = "pcm"
+)
+
+// ErrUnknownFormat is returned when the data is not recognized as MP3 or WAV.
+var ErrUnknownFormat = errors.New("audioinfo: unknown audio format")
+
+// Info describes an audio stream.
+type Info struct {
+	// Format is the detected format.
+	Format Format
+
+	// SampleRate is the sample rate in Hz.
+	SampleRate int
+
+	// Channels is the number of channels.
+	Channels int
+
+	// BitsPerSample is the sample size for PCM and WAV (0 for MP3).
+	BitsPerSample int
+
+	// Bitrate is the average bitrate in bits per second.
+	Bitrate int
+
+	// Duration is the playback duration.
+	Duration time.Duration
+
+	// Frames is the number of MP3 frames (0 for other formats).
+	Frames int
+}
+
+// DurationMs returns the duration in whole milliseconds.
+func (i *Info) DurationMs() int {
+	return int(i.Duration.Milliseconds())
+}
+
+// Compatible reports whether two streams can be concatenated without
+// re-encoding: same format, sample rate, channel count, and sample size.
+func (i *Info) Compatible(other *Info) bool {
+	return i.Format == other.Format &&
+		i.SampleRate == other.SampleRate &&
+		i.Channels == other.Channels &&
+		i.BitsPerSample == other.BitsPerSample
+}
+
+// String returns a short description, e.g. "mp3 44100Hz mono 128kbps 2.35s".
+func (i *Info) String() string {
+	channels := fmt.Sprintf("%dch", i.Channels)
+	switch i.Channels {
+	case 1:
+		channels = "mono"
+	case 2:
+		channels = "stereo"
+	}
+	return fmt.Sprintf("%s %dHz %s %dkbps %.2fs", i.Format, i.SampleRate, channels, i.Bitrate/1000, i.Duration.Seconds())
+}
+
+// InspectFile inspects an audio file.
+func InspectFile(path string) (*Info, error) {
+	f, err := os.Open(path) // #nosec G304 -- inspecting a caller-provided file is the purpose of this function
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Inspect(f)
+}
+
+// Inspect reads an MP3 or WAV stream and returns its properties.
+// MP3 data is read to the end to count frames, so the duration is exact for
+// both constant and variable bitrate files.
+func Inspect(r io.Reader) (*Info, error) {
+	br := bufio.NewReader(r)
+	head, err := br.Peek(4)
+	if err != nil && len(head) < 4 {
+		return nil, ErrUnknownFormat
+	}
+
+	switch {
+	case string(head) == "RIFF":
+		return inspectWAV(br)
+	case string(head[:3]) == "ID3", isFrameSync(head):
+		return inspectMP3(br)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// FromPCM describes raw 16-bit signed little-endian mono PCM, the format
+// ElevenLabs returns for "pcm_*" output formats.
+func FromPCM(size int64, sampleRate int) *Info {
+	const bitsPerSample, channels = 16, 1
+	info := &Info{
+		Format:        FormatPCM,
+		SampleRate:    sampleRate,
+		Channels:      channels,
+		BitsPerSample: bitsPerSample,
+		Bitrate:       sampleRate * channels * bitsPerSample,
+	}
+	if info.Bitrate > 0 {
+		info.Duration = time.Duration(size * 8 * int64(time.Second) / int64(info.Bitrate))
+	}
+	return info
+}
+
+// inspectWAV parses RIFF chunks until the data chunk.
+func inspectWAV(r *bufio.Reader) (*Info, error) {
+	var riff [12]byte
+	if _, err := io.ReadFull(r, riff[:]); err != nil || string(riff[8:12]) != "WAVE" {
+		return nil, ErrUnknownFormat
+	}
+
+	info := &Info{Format: FormatWAV}
+	var byteRate int
+	for {
+		var chunk [8]byte
+		if _, err := io.ReadFull(r, chunk[:]); err != nil {
+			return nil, fmt.Errorf("audioinfo: WAV data chunk not found: %w", err)
+		}
+		id := string(chunk[:4])
+		size := int64(binary.LittleEndian.Uint32(chunk[4:]))
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, fmt.Errorf("audioinfo: invalid WAV fmt chunk")
+			}
+			fmtChunk := make([]byte, size+size%2)
+			if _, err := io.ReadFull(r, fmtChunk); err != nil {
+				return nil, fmt.Errorf("audioinfo: reading WAV fmt chunk: %w", err)
+			}
+			info.Channels = int(binary.LittleEndian.Uint16(fmtChunk[2:]))
+			info.SampleRate = int(binary.LittleEndian.Uint32(fmtChunk[4:]))
+			byteRate = int(binary.LittleEndian.Uint32(fmtChunk[8:]))
+			info.BitsPerSample = int(binary.LittleEndian.Uint16(fmtChunk[14:]))
+			info.Bitrate = byteRate * 8
+		case "data":
+			if byteRate == 0 {
+				return nil, fmt.Errorf("audioinfo: WAV data chunk before fmt chunk")
+			}
+			info.Duration = time.Duration(size * int64(time.Second) / int64(byteRate))
+			return info, nil
+		default:
+			if _, err := r.Discard(int(size + size%2)); err != nil {
+				return nil, fmt.Errorf("audioinfo: skipping WAV chunk %q: %w", id, err)
+			}
+		}
+	}
+}
+
+// MPEG audio tables for Layer III.
+var (
+	// mp3Bitrates are in kbps, indexed by [MPEG-1 ? 0 : 1][bitrate index].
+	mp3Bitrates = [2][16]int{
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0},
+	}
+	// mp3SampleRates are indexed by [version bits][sample rate index].
+	mp3SampleRates = [4][3]int{
+		{11025, 12000, 8000},  // MPEG 2.5
+		{0, 0, 0},             // reserved
+		{22050, 24000, 16000}, // MPEG 2
+		{44100, 48000, 32000}, // MPEG 1
+	}
+)
+
+// isFrameSync reports whether b starts with an MPEG audio frame sync.
+func isFrameSync(b []byte) bool {
+	return len(b) >= 2 && b[0] == 0xFF && b[1]&0xE0 == 0xE0
+}
+
+// mp3Frame is a parsed MPEG Layer III frame header.
+type mp3Frame struct {
+	size       int
+	samples    int
+	sampleRate int
+	channels   int
+}
+
+// parseMP3Frame parses a 4-byte frame header. ok is false for anything that
+// is not a valid Layer III header.
+func parseMP3Frame(h []byte) (mp3Frame, bool) {
+	if !isFrameSync(h) {
+		return mp3Frame{}, false
+	}
+	version := (h[1] >> 3) & 0x03
+	layer := (h[1] >> 1) & 0x03
+	bitrateIdx := h[2] >> 4
+	rateIdx := (h[2] >> 2) & 0x03
+	padding := int((h[2] >> 1) & 0x01)
+	channelMode := h[3] >> 6
+
+	if version == 1 || layer != 1 || bitrateIdx == 0 || bitrateIdx == 15 || rateIdx == 3 {
+		return mp3Frame{}, false
+	}
+
+	mpeg1 := version == 3
+	table, samples, coef := 1, 576, 72
+	if mpeg1 {
+		table, samples, coef = 0, 1152, 144
+	}
+	bitrate := mp3Bitrates[table][bitrateIdx] * 1000
+	sampleRate := mp3SampleRates[version][rateIdx]
+
+	channels := 2
+	if channelMode == 3 {
+		channels = 1
+	}
+
+	return mp3Frame{
+		size:       coef*bitrate/sampleRate + padding,
+		samples:    samples,
+		sampleRate: sampleRate,
+		channels:   channels,
+	}, true
+}
+
+// inspectMP3 skips any ID3v2 tag and counts frames.
+func inspectMP3(r *bufio.Reader) (*Info, error) {
+	if head, _ := r.Peek(10); len(head) == 10 && string(head[:3]) == "ID3" {
+		// Syncsafe size excludes the 10-byte header; a footer adds 10 more.
+		size := int(head[6])<<21 | int(head[7])<<14 | int(head[8])<<7 | int(head[9])
+		size += 10
+		if head[5]&0x10 != 0 {
+			size += 10
+		}
+		if _, err := r.Discard(size); err != nil {
+			return nil, ErrUnknownFormat
+		}
+	}
+
+	info := &Info{Format: FormatMP3}
+	var totalSamples, totalBytes int64
+	for {
+		h, err := r.Peek(4)
+		if len(h) < 4 {
+			if err != nil && err != io.EOF {
+				return nil, fmt.Errorf("audioinfo: reading MP3: %w", err)
+			}
+			break
+		}
+		frame, ok := parseMP3Frame(h)
+		if !ok {
+			if string(h[:3]) == "TAG" {
+				break // ID3v1 trailer
+			}
+			// Resynchronize on the next byte
+			if _, err := r.Discard(1); err != nil {
+				break
+			}
+			continue
+		}
+		if info.Frames == 0 {
+			info.SampleRate = frame.sampleRate
+			info.Channels = frame.channels
+		}
+		info.Frames++
+		totalSamples += int64(frame.samples)
+		totalBytes += int64(frame.size)
+		if _, err := r.Discard(frame.size); err != nil {
+			break // truncated final frame
+		}
+	}
+
+	if info.Frames == 0 {
+		return nil, ErrUnknownFormat
+	}
+	info.Duration = time.Duration(totalSamples * int64(time.Second) / int64(info.SampleRate))
+	if info.Duration > 0 {
+		info.Bitrate = int(totalBytes * 8 * int64(time.Second) / int64(info.Duration))
+	}
+	return info, nil
+}
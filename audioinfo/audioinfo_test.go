@@ -0,0 +1,104 @@
+package audioinfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// mp3Frames builds n silent MPEG-1 Layer III frames: 128kbps, 44.1kHz, mono.
+func mp3Frames(n int) []byte {
+	frame := make([]byte, 417) // 144 * 128000 / 44100
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0xC4})
+	return bytes.Repeat(frame, n)
+}
+
+func wavData(sampleRate, channels, bits int, pcm []byte) []byte {
+	var buf bytes.Buffer
+	w := func(v any) { _ = binary.Write(&buf, binary.LittleEndian, v) }
+	byteRate := sampleRate * channels * bits / 8
+	buf.WriteString("RIFF")
+	w(uint32(36 + 8 + 4 + len(pcm)))
+	buf.WriteString("WAVE")
+	buf.WriteString("LIST") // unrelated chunk to skip
+	w(uint32(4))
+	buf.WriteString("INFO")
+	buf.WriteString("fmt ")
+	w(uint32(16))
+	w(uint16(1))
+	w(uint16(channels))
+	w(uint32(sampleRate))
+	w(uint32(byteRate))
+	w(uint16(channels * bits / 8))
+	w(uint16(bits))
+	buf.WriteString("data")
+	w(uint32(len(pcm)))
+	buf.Write(pcm)
+	return buf.Bytes()
+}
+
+func TestInspectMP3(t *testing.T) {
+	id3 := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 5, 1, 2, 3, 4, 5}
+	data := append(id3, mp3Frames(100)...)
+	data = append(data, []byte("TAG")...)
+
+	info, err := Inspect(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Inspect() error = %v", err)
+	}
+	if info.Format != FormatMP3 || info.SampleRate != 44100 || info.Channels != 1 || info.Frames != 100 {
+		t.Errorf("unexpected info: %+v", info)
+	}
+	want := time.Duration(100 * 1152 * int64(time.Second) / 44100)
+	if info.Duration != want {
+		t.Errorf("Duration = %v, want %v", info.Duration, want)
+	}
+	if info.Bitrate < 127000 || info.Bitrate > 129000 {
+		t.Errorf("Bitrate = %d, want ~128000", info.Bitrate)
+	}
+}
+
+func TestInspectWAV(t *testing.T) {
+	pcm := make([]byte, 44100*2) // 1s of 16-bit mono
+	path := filepath.Join(t.TempDir(), "a.wav")
+	if err := os.WriteFile(path, wavData(44100, 1, 16, pcm), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := InspectFile(path)
+	if err != nil {
+		t.Fatalf("InspectFile() error = %v", err)
+	}
+	if info.Format != FormatWAV || info.SampleRate != 44100 || info.Channels != 1 || info.BitsPerSample != 16 {
+		t.Errorf("unexpected info: %+v", info)
+	}
+	if info.Duration != time.Second || info.DurationMs() != 1000 {
+		t.Errorf("Duration = %v, want 1s", info.Duration)
+	}
+	if info.Bitrate != 705600 {
+		t.Errorf("Bitrate = %d, want 705600", info.Bitrate)
+	}
+}
+
+func TestFromPCM(t *testing.T) {
+	info := FromPCM(32000, 16000)
+	if info.Duration != time.Second {
+		t.Errorf("Duration = %v, want 1s", info.Duration)
+	}
+	if !info.Compatible(FromPCM(10, 16000)) || info.Compatible(FromPCM(10, 22050)) {
+		t.Error("unexpected compatibility result")
+	}
+}
+
+func TestInspectUnknown(t *testing.T) {
+	if _, err := Inspect(bytes.NewReader([]byte("hello world"))); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("expected ErrUnknownFormat, got %v", err)
+	}
+	if _, err := Inspect(bytes.NewReader(nil)); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("expected ErrUnknownFormat for empty input, got %v", err)
+	}
+}
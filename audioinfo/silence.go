@@ -0,0 +1,103 @@
+package audioinfo
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// Silence detection defaults.
+const (
+	// DefaultSilenceThresholdDB is the level, in dBFS, below which audio
+	// is treated as silence. It is above the noise floor of generated
+	// speech and below quiet breaths.
+	DefaultSilenceThresholdDB = -50.0
+
+	// DefaultSilencePadding is the silence kept before the first and after
+	// the last sound, so trimmed speech does not start or end abruptly.
+	DefaultSilencePadding = 50 * time.Millisecond
+)
+
+// silenceWindow is the span over which the signal level is measured.
+const silenceWindow = 10 * time.Millisecond
+
+// SilenceOptions configures silence detection.
+type SilenceOptions struct {
+	// ThresholdDB is the RMS level, in dBFS, below which a window is
+	// silent. Defaults to DefaultSilenceThresholdDB.
+	ThresholdDB float64
+
+	// Padding is the silence to keep at each edge.
+	// Defaults to DefaultSilencePadding.
+	Padding time.Duration
+}
+
+func (o *SilenceOptions) withDefaults() SilenceOptions {
+	opts := SilenceOptions{ThresholdDB: DefaultSilenceThresholdDB, Padding: DefaultSilencePadding}
+	if o != nil {
+		if o.ThresholdDB != 0 {
+			opts.ThresholdDB = o.ThresholdDB
+		}
+		if o.Padding > 0 {
+			opts.Padding = o.Padding
+		}
+	}
+	return opts
+}
+
+// EdgeSilence returns the leading and trailing silence in 16-bit signed
+// little-endian PCM with interleaved channels, less the padding to keep.
+// Audio that is silent throughout has no edges to trim and returns zero.
+// opts may be nil for defaults.
+func EdgeSilence(pcm []byte, sampleRate, channels int, opts *SilenceOptions) (lead, trail time.Duration) {
+	o := opts.withDefaults()
+	if sampleRate <= 0 || channels <= 0 {
+		return 0, 0
+	}
+	frameSize := 2 * channels
+	frames := len(pcm) / frameSize
+	window := max(int(int64(sampleRate)*int64(silenceWindow)/int64(time.Second)), 1)
+	threshold := math.Pow(10, o.ThresholdDB/20) * math.MaxInt16
+
+	first, last := -1, -1
+	for start := 0; start < frames; start += window {
+		end := min(start+window, frames)
+		var sum float64
+		for i := start * channels; i < end*channels; i++ {
+			s := float64(int16(binary.LittleEndian.Uint16(pcm[2*i:])))
+			sum += s * s
+		}
+		if math.Sqrt(sum/float64((end-start)*channels)) > threshold {
+			if first < 0 {
+				first = start
+			}
+			last = end
+		}
+	}
+	if first < 0 {
+		return 0, 0
+	}
+
+	toDuration := func(n int) time.Duration {
+		return time.Duration(int64(n) * int64(time.Second) / int64(sampleRate))
+	}
+	lead = max(toDuration(first)-o.Padding, 0)
+	trail = max(toDuration(frames-last)-o.Padding, 0)
+	return lead, trail
+}
+
+// TrimPCM removes leading and trailing silence from 16-bit signed
+// little-endian PCM (see EdgeSilence) and returns the trimmed audio and
+// the amounts removed. The result shares pcm's memory.
+func TrimPCM(pcm []byte, sampleRate, channels int, opts *SilenceOptions) (trimmed []byte, lead, trail time.Duration) {
+	lead, trail = EdgeSilence(pcm, sampleRate, channels, opts)
+	if lead == 0 && trail == 0 {
+		return pcm, 0, 0
+	}
+	frameSize := 2 * channels
+	toBytes := func(d time.Duration) int {
+		return int(int64(d)*int64(sampleRate)/int64(time.Second)) * frameSize
+	}
+	end := len(pcm) - len(pcm)%frameSize
+	return pcm[toBytes(lead) : end-toBytes(trail)], lead, trail
+}
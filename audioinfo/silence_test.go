@@ -0,0 +1,65 @@
+package audioinfo
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+)
+
+// pcmWithTone builds mono 16 kHz PCM: silence, a tone, then silence.
+func pcmWithTone(before, tone, after time.Duration) []byte {
+	const rate = 16000
+	n := func(d time.Duration) int { return int(d * rate / time.Second) }
+	samples := make([]int16, n(before)+n(tone)+n(after))
+	for i := 0; i < n(tone); i++ {
+		samples[n(before)+i] = int16(8000 * math.Sin(2*math.Pi*440*float64(i)/rate))
+	}
+	// A faint noise floor stays below the threshold
+	for i := range samples {
+		if samples[i] == 0 && i%2 == 0 {
+			samples[i] = 3
+		}
+	}
+	pcm := make([]byte, 2*len(samples))
+	for i, s := range samples {
+		binary.LittleEndian.PutUint16(pcm[2*i:], uint16(s))
+	}
+	return pcm
+}
+
+func TestEdgeSilence(t *testing.T) {
+	pcm := pcmWithTone(300*time.Millisecond, time.Second, 500*time.Millisecond)
+
+	lead, trail := EdgeSilence(pcm, 16000, 1, nil)
+	if lead != 250*time.Millisecond || trail != 450*time.Millisecond {
+		t.Errorf("EdgeSilence() = %v, %v, want 250ms, 450ms", lead, trail)
+	}
+
+	lead, trail = EdgeSilence(pcm, 16000, 1, &SilenceOptions{Padding: 100 * time.Millisecond})
+	if lead != 200*time.Millisecond || trail != 400*time.Millisecond {
+		t.Errorf("EdgeSilence() with padding = %v, %v", lead, trail)
+	}
+
+	// Everything is silent at a threshold above the tone
+	if lead, trail := EdgeSilence(pcm, 16000, 1, &SilenceOptions{ThresholdDB: -1}); lead != 0 || trail != 0 {
+		t.Errorf("EdgeSilence() of silence = %v, %v, want no trimming", lead, trail)
+	}
+}
+
+func TestTrimPCM(t *testing.T) {
+	pcm := pcmWithTone(300*time.Millisecond, time.Second, 500*time.Millisecond)
+
+	trimmed, lead, trail := TrimPCM(pcm, 16000, 1, nil)
+	if lead != 250*time.Millisecond || trail != 450*time.Millisecond {
+		t.Errorf("TrimPCM() removed %v, %v", lead, trail)
+	}
+	if got := FromPCM(int64(len(trimmed)), 16000).Duration; got != 1100*time.Millisecond {
+		t.Errorf("trimmed duration = %v, want 1.1s", got)
+	}
+
+	noTone := pcmWithTone(0, 0, time.Second)
+	if trimmed, _, _ := TrimPCM(noTone, 16000, 1, nil); len(trimmed) != len(noTone) {
+		t.Error("TrimPCM() should leave silent audio unchanged")
+	}
+}
@@ -0,0 +1,347 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Batch defaults.
+const (
+	// DefaultBatchConcurrency is the number of concurrent generations.
+	DefaultBatchConcurrency = 4
+
+	// DefaultBatchMaxRetries is the number of retries for rate limited and
+	// server errors.
+	DefaultBatchMaxRetries = 3
+
+	// DefaultBatchRetryBackoff is the initial backoff between retries. It
+	// doubles with each retry unless the API requests a longer wait.
+	DefaultBatchRetryBackoff = time.Second
+)
+
+// BatchOptions configures batch generation.
+type BatchOptions struct {
+	// OutputDir is the directory generated files are written to. It is
+	// created if needed.
+	OutputDir string
+
+	// Concurrency is the number of concurrent generations.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+
+	// MaxRetries is the number of retries for rate limited (429) and
+	// server (5xx) errors. Defaults to DefaultBatchMaxRetries; use a
+	// negative value to disable retries.
+	MaxRetries int
+
+	// RetryBackoff is the initial backoff between retries.
+	// Defaults to DefaultBatchRetryBackoff.
+	RetryBackoff time.Duration
+
+	// Log, if set, receives a GenerationRecord for every item, as a
+	// manifest of the batch.
+	Log *GenerationLog
+
+	// OnResult, if set, is called as each item finishes. It may be called
+	// concurrently.
+	OnResult func(BatchResult)
+
+	// Dedupe generates identical requests in the batch once and copies the
+	// audio to the other items' output files, so duplicated segments are
+	// billed once. Requests are identical when their GenerationKeys match.
+	// Leave it unset to get independent takes of repeated prompts.
+	Dedupe bool
+
+	// Completed are records of earlier generations, such as a previous
+	// run's Log read with LoadGenerationRecords. An item whose request
+	// matches the IdempotencyKey of a successful record whose output file
+	// still exists reuses that file instead of generating, so a retried
+	// pipeline only bills for items that failed or changed.
+	Completed []GenerationRecord
+}
+
+// BatchResult is the outcome of one batch item.
+type BatchResult struct {
+	// Index is the item's position in the batch.
+	Index int
+
+	// ID is the item's ID.
+	ID string
+
+	// OutputPath is the generated file.
+	OutputPath string
+
+	// Bytes is the size of the generated file.
+	Bytes int64
+
+	// Attempts is the number of requests made, including retries.
+	Attempts int
+
+	// Err is the error if the item failed.
+	Err error
+
+	// Skipped is set if the output was reused from a Completed record.
+	Skipped bool
+
+	// DuplicateOf is the ID of the identical item whose audio was reused,
+	// with Dedupe set.
+	DuplicateOf string
+}
+
+// batchJob is one item of a batch: its output path, a generation record,
+// and the request that produces its audio.
+type batchJob struct {
+	id       string
+	path     string
+	record   *GenerationRecord
+	generate func(ctx context.Context) (io.Reader, error)
+}
+
+// runBatch runs jobs concurrently with retries and returns their results in
+// job order. Items reusing earlier or duplicate output are not logged, as
+// they make no request.
+func (c *Client) runBatch(ctx context.Context, jobs []batchJob, opts *BatchOptions) []BatchResult {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	completed := completedGenerations(opts.Completed)
+	leaders := make(map[string]int)
+	done := make([]chan struct{}, len(jobs))
+	for i := range jobs {
+		done[i] = make(chan struct{})
+		if rec := jobs[i].record; rec != nil {
+			rec.IdempotencyKey = GenerationKey(rec)
+			if _, ok := leaders[rec.IdempotencyKey]; opts.Dedupe && !ok {
+				leaders[rec.IdempotencyKey] = i
+			}
+		}
+	}
+
+	results := make([]BatchResult, len(jobs))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, job := range jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(done[i])
+
+			var key string
+			if job.record != nil {
+				key = job.record.IdempotencyKey
+			}
+			prev, isCompleted := completed[key]
+			leader, isLeader := leaders[key]
+			var res BatchResult
+			switch {
+			case isCompleted:
+				res = reuseBatchOutput(job, prev.OutputPath, nil)
+				res.Skipped = true
+			case isLeader && leader != i:
+				// Wait outside the semaphore so the leader can run
+				select {
+				case <-done[leader]:
+				case <-ctx.Done():
+					results[i] = BatchResult{Index: i, ID: job.id, OutputPath: job.path, Err: ctx.Err()}
+					return
+				}
+				res = reuseBatchOutput(job, results[leader].OutputPath, results[leader].Err)
+				res.DuplicateOf = jobs[leader].id
+			default:
+				select {
+				case sem <- struct{}{}:
+				case <-ctx.Done():
+					results[i] = BatchResult{Index: i, ID: job.id, OutputPath: job.path, Err: ctx.Err()}
+					return
+				}
+				res = c.runBatchJob(ctx, job, opts)
+				<-sem
+			}
+
+			res.Index = i
+			results[i] = res
+			if opts.OnResult != nil {
+				opts.OnResult(res)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// completedGenerations indexes successful records whose output file still
+// exists by idempotency key. Later records win.
+func completedGenerations(records []GenerationRecord) map[string]GenerationRecord {
+	completed := make(map[string]GenerationRecord)
+	for _, rec := range records {
+		if rec.IdempotencyKey == "" || rec.Error != "" || rec.OutputPath == "" {
+			continue
+		}
+		if _, err := os.Stat(rec.OutputPath); err == nil {
+			completed[rec.IdempotencyKey] = rec
+		}
+	}
+	return completed
+}
+
+// reuseBatchOutput copies the output of an earlier or identical
+// generation to a job's output file. srcErr is the source's error, if it
+// failed.
+func reuseBatchOutput(job batchJob, src string, srcErr error) BatchResult {
+	res := BatchResult{ID: job.id, OutputPath: job.path}
+	if srcErr != nil {
+		res.Err = fmt.Errorf("reusing %s: %w", src, srcErr)
+		return res
+	}
+	if src == job.path {
+		info, err := os.Stat(src)
+		if err != nil {
+			res.Err = err
+			return res
+		}
+		res.Bytes = info.Size()
+		return res
+	}
+	res.Bytes, res.Err = copyFile(src, job.path)
+	return res
+}
+
+// copyFile copies src to dst, removing dst on failure.
+func copyFile(src, dst string) (int64, error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return 0, err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(out, in)
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(dst)
+		return 0, fmt.Errorf("writing %s: %w", dst, err)
+	}
+	return n, nil
+}
+
+// runBatchJob generates one job, retrying retryable errors, and records it.
+func (c *Client) runBatchJob(ctx context.Context, job batchJob, opts *BatchOptions) BatchResult {
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultBatchMaxRetries
+	}
+
+	if job.record != nil {
+		job.record.Timestamp = c.clock.Now().UTC()
+	}
+	res := BatchResult{ID: job.id, OutputPath: job.path}
+	res.Attempts, res.Err = c.retry(ctx, maxRetries, opts.RetryBackoff, func() error {
+		var err error
+		res.Bytes, err = c.generateBatchFile(ctx, job)
+		return err
+	})
+
+	if opts.Log != nil {
+		job.record.complete(c.clock.Now(), job.path, res.Bytes, res.Err)
+		job.record.setParam("attempts", res.Attempts)
+		// The manifest is best effort; the result carries the outcome
+		_ = opts.Log.Append(job.record)
+	}
+	return res
+}
+
+// generateBatchFile generates a job's audio into its output file.
+func (c *Client) generateBatchFile(ctx context.Context, job batchJob) (int64, error) {
+	audio, err := job.generate(ctx)
+	if err != nil {
+		return 0, err
+	}
+	f, err := os.Create(job.path)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, audio)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(job.path)
+		return 0, fmt.Errorf("writing %s: %w", job.path, err)
+	}
+	return n, nil
+}
+
+// retry calls fn until it succeeds, fails with an error that is not
+// retryable, or has been retried maxRetries times, and returns the number
+// of attempts. The wait between attempts starts at backoff (default
+// DefaultBatchRetryBackoff) and doubles, unless the API requests a longer
+// wait.
+func (c *Client) retry(ctx context.Context, maxRetries int, backoff time.Duration, fn func() error) (int, error) {
+	if backoff <= 0 {
+		backoff = DefaultBatchRetryBackoff
+	}
+	for attempts := 1; ; attempts++ {
+		err := fn()
+		if err == nil || attempts > maxRetries || !isRetryableError(err) {
+			return attempts, err
+		}
+
+		wait := backoff << (attempts - 1)
+		if IsRateLimitError(err) {
+			if after := c.RateLimitState().RetryAfter; after > wait {
+				wait = after
+			}
+		}
+		select {
+		case <-c.clock.After(wait):
+		case <-ctx.Done():
+			return attempts, ctx.Err()
+		}
+	}
+}
+
+// isRetryableError reports whether err is a rate limit or server error.
+func isRetryableError(err error) bool {
+	apiErr := ParseAPIError(err)
+	return apiErr != nil && (apiErr.StatusCode == 429 || apiErr.StatusCode >= 500)
+}
+
+// checkBatchID checks that a batch item ID is unique and usable as a file
+// name, and marks it seen.
+func checkBatchID(seen map[string]bool, id string) error {
+	if seen[id] {
+		return &ValidationError{Field: "id", Message: fmt.Sprintf("duplicate id %q", id)}
+	}
+	seen[id] = true
+	if strings.ContainsAny(id, `/\`) {
+		return &ValidationError{Field: "id", Message: fmt.Sprintf("%q cannot contain path separators", id)}
+	}
+	return nil
+}
+
+// batchOutputPath returns the output file for an item ID and output format.
+func batchOutputPath(dir, id, outputFormat string) string {
+	return filepath.Join(dir, id+"."+outputFormatExtension(outputFormat))
+}
+
+// outputFormatExtension returns the file extension for an output format
+// such as "mp3_44100_128" or "pcm_16000".
+func outputFormatExtension(outputFormat string) string {
+	codec, _, _ := strings.Cut(outputFormat, "_")
+	if codec == "" {
+		return "mp3"
+	}
+	return codec
+}
@@ -6,11 +6,14 @@
 package elevenlabs
 
 import (
+	"log"
 	"net/http"
 	"os"
 	"time"
 
 	"github.com/agentplexus/go-elevenlabs/internal/api"
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+	"github.com/agentplexus/go-elevenlabs/recorder"
 )
 
 // Version is the SDK version.
@@ -25,8 +28,29 @@ const DefaultModelID = "eleven_multilingual_v2"
 // Client is the main ElevenLabs client for interacting with the API.
 type Client struct {
 	apiClient *api.Client
-	apiKey    string
 	baseURL   string
+	headers   *requestHeaders
+
+	// rawHTTPClient sends hand-rolled requests to endpoints not covered by
+	// the generated client. It is the configured HTTP client, with its
+	// timeout.
+	rawHTTPClient *http.Client
+
+	// streamHTTPClient sends streams, downloads, and uploads. It is the
+	// configured HTTP client without a timeout, so long transfers are
+	// bounded only by the request context.
+	streamHTTPClient *http.Client
+
+	// streamAPIClient is the generated client over streamHTTPClient, for
+	// uploads that may outlast the timeout.
+	streamAPIClient *api.Client
+
+	clock         clock.Clock
+	rateLimits    *rateLimitTracker
+	debugRecorder *DebugRecorder
+	streamRetries int
+	modelCache    *modelCache
+	tierCache     *tierCache
 
 	// Service accessors
 	tts             *TextToSpeechService
@@ -44,6 +68,8 @@ type Client struct {
 	textToDialogue  *TextToDialogueService
 	voiceDesign     *VoiceDesignService
 	music           *MusicService
+	workspace       *WorkspaceService
+	agents          *AgentsService
 
 	// Real-time services
 	webSocketTTS   *WebSocketTTSService
@@ -72,11 +98,43 @@ func NewClient(opts ...Option) (*Client, error) {
 			Timeout: options.timeout,
 		}
 	}
+	if options.transport != nil {
+		withTransport := *httpClient
+		withTransport.Transport = options.transport
+		httpClient = &withTransport
+	}
+
+	rawHTTPClient := httpClient
+	withoutTimeout := *httpClient
+	withoutTimeout.Timeout = 0
+	streamHTTPClient := &withoutTimeout
+
+	rateLimits := &rateLimitTracker{callback: options.rateLimitCallback, clock: options.clock}
+	httpClient = rateLimits.wrap(httpClient)
+	rawHTTPClient = rateLimits.wrap(rawHTTPClient)
+	streamHTTPClient = rateLimits.wrap(streamHTTPClient)
+
+	var debugRecorder *DebugRecorder
+	if options.debugDir != "" {
+		debugRecorder = &DebugRecorder{dir: options.debugDir, clock: options.clock}
+		debugRecorder.SetEnabled(true)
+		httpClient = debugRecorder.wrap(httpClient)
+		rawHTTPClient = debugRecorder.wrap(rawHTTPClient)
+		streamHTTPClient = debugRecorder.wrap(streamHTTPClient)
+	}
+
+	headers := &requestHeaders{
+		apiKey:    options.apiKey,
+		userAgent: options.userAgent,
+		extra:     options.headers,
+	}
 
 	// Wrap with auth transport
 	authClient := &authHTTPClient{
-		client: httpClient,
-		apiKey: options.apiKey,
+		client:          httpClient,
+		headers:         headers,
+		decodeMode:      options.decodeMode,
+		onDecodeWarning: options.decodeWarningHandler,
 	}
 
 	// Create the ogen client
@@ -87,11 +145,33 @@ func NewClient(opts ...Option) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	streamAuthClient := *authClient
+	streamAuthClient.client = streamHTTPClient
+	streamAPIClient, err := api.NewClient(
+		options.baseURL,
+		api.WithClient(&streamAuthClient),
+	)
+	if err != nil {
+		return nil, err
+	}
 
 	c := &Client{
-		apiClient: apiClient,
-		apiKey:    options.apiKey,
-		baseURL:   options.baseURL,
+		apiClient:        apiClient,
+		baseURL:          options.baseURL,
+		headers:          headers,
+		rawHTTPClient:    rawHTTPClient,
+		streamHTTPClient: streamHTTPClient,
+		streamAPIClient:  streamAPIClient,
+		clock:            options.clock,
+		rateLimits:       rateLimits,
+		debugRecorder:    debugRecorder,
+		streamRetries:    options.streamRetries,
+	}
+	if options.modelChecks {
+		c.modelCache = &modelCache{}
+	}
+	if options.outputFormatChecks {
+		c.tierCache = &tierCache{}
 	}
 
 	// Initialize services
@@ -110,6 +190,8 @@ func NewClient(opts ...Option) (*Client, error) {
 	c.textToDialogue = &TextToDialogueService{client: c}
 	c.voiceDesign = &VoiceDesignService{client: c}
 	c.music = &MusicService{client: c}
+	c.workspace = &WorkspaceService{client: c}
+	c.agents = &AgentsService{client: c}
 
 	// Initialize real-time services
 	c.webSocketTTS = &WebSocketTTSService{client: c}
@@ -121,24 +203,77 @@ func NewClient(opts ...Option) (*Client, error) {
 	return c, nil
 }
 
+// requestHeaders are the headers sent with every request: generated API
+// calls, hand-rolled REST calls, and WebSocket handshakes.
+type requestHeaders struct {
+	apiKey    string
+	userAgent string
+	extra     http.Header
+}
+
+// apply sets the default headers, then authentication, SDK, and User-Agent
+// headers, on h.
+func (r *requestHeaders) apply(h http.Header) {
+	for key, values := range r.extra {
+		h[key] = append([]string(nil), values...)
+	}
+
+	// Add authentication header
+	if r.apiKey != "" {
+		h.Set("xi-api-key", r.apiKey)
+	}
+
+	// Add SDK version headers
+	h.Set("X-ElevenLabs-SDK-Version", Version)
+	h.Set("X-ElevenLabs-SDK-Lang", "go")
+
+	if r.userAgent != "" {
+		h.Set("User-Agent", r.userAgent)
+	}
+}
+
 // authHTTPClient wraps an http.Client to add authentication headers.
 type authHTTPClient struct {
-	client *http.Client
-	apiKey string
+	client     *http.Client
+	headers    *requestHeaders
+	decodeMode DecodeMode
+
+	onDecodeWarning DecodeWarningHandler
 }
 
 // Do implements ht.Client interface.
 func (c *authHTTPClient) Do(req *http.Request) (*http.Response, error) {
-	// Add authentication header
-	if c.apiKey != "" {
-		req.Header.Set("xi-api-key", c.apiKey)
-	}
+	c.headers.apply(req.Header)
 
-	// Add SDK version headers
-	req.Header.Set("X-ElevenLabs-SDK-Version", Version)
-	req.Header.Set("X-ElevenLabs-SDK-Lang", "go")
+	resp, err := c.client.Do(req)
+	if err == nil {
+		captureRequestID(req.Context(), resp)
+	}
+	if err == nil && resp.Request != nil {
+		// The generated decoders read the validator from the response's request
+		resp.Request = resp.Request.WithContext(api.WithResponseValidator(resp.Request.Context(), c.validator(req)))
+	}
+	return resp, err
+}
 
-	return c.client.Do(req)
+// validator returns the api.ResponseValidator for a request, which applies
+// the client's DecodeMode.
+func (c *authHTTPClient) validator(req *http.Request) api.ResponseValidator {
+	return func(err error) error {
+		decodeErr := &DecodeError{Operation: req.Method + " " + req.URL.Path, Err: err}
+		switch c.decodeMode {
+		case DecodeLenient:
+			return nil
+		case DecodeWarn:
+			if c.onDecodeWarning != nil {
+				c.onDecodeWarning(decodeErr)
+			} else {
+				log.Printf("%v (accepted)", decodeErr)
+			}
+			return nil
+		}
+		return decodeErr
+	}
 }
 
 // API returns the underlying ogen-generated API client for advanced usage.
@@ -223,6 +358,16 @@ func (c *Client) Music() *MusicService {
 	return c.music
 }
 
+// Workspace returns the workspace service for sharing resources.
+func (c *Client) Workspace() *WorkspaceService {
+	return c.workspace
+}
+
+// Agents returns the conversational AI agents service.
+func (c *Client) Agents() *AgentsService {
+	return c.agents
+}
+
 // WebSocketTTS returns the WebSocket text-to-speech service for real-time streaming.
 func (c *Client) WebSocketTTS() *WebSocketTTSService {
 	return c.webSocketTTS
@@ -254,12 +399,26 @@ type clientOptions struct {
 	baseURL    string
 	httpClient *http.Client
 	timeout    time.Duration
+	userAgent  string
+	headers    http.Header
+	transport  http.RoundTripper
+	clock      clock.Clock
+	debugDir   string
+
+	rateLimitCallback  RateLimitCallback
+	streamRetries      int
+	modelChecks        bool
+	outputFormatChecks bool
+	decodeMode         DecodeMode
+
+	decodeWarningHandler DecodeWarningHandler
 }
 
 func defaultClientOptions() *clientOptions {
 	return &clientOptions{
 		baseURL: DefaultBaseURL,
 		timeout: 120 * time.Second, // TTS can take a while
+		clock:   clock.Real{},
 	}
 }
 
@@ -287,9 +446,153 @@ func WithHTTPClient(client *http.Client) Option {
 	}
 }
 
-// WithTimeout sets the request timeout.
+// WithUserAgent sets the User-Agent header sent with every request,
+// including WebSocket handshakes.
+func WithUserAgent(userAgent string) Option {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a default header sent with every request, including
+// WebSocket handshakes. Use it for API gateways that require extra headers.
+// It can be repeated to add multiple headers or values. Authentication and
+// SDK headers set by the client take precedence.
+func WithHeader(key, value string) Option {
+	return func(o *clientOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// WithHeaders adds default headers sent with every request.
+// See WithHeader.
+func WithHeaders(headers http.Header) Option {
+	return func(o *clientOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		for key, values := range headers {
+			for _, v := range values {
+				o.headers.Add(key, v)
+			}
+		}
+	}
+}
+
+// WithTimeout sets the request timeout of the default HTTP client. Streams,
+// downloads, and uploads are not limited by it, only by their context. It
+// has no effect with WithHTTPClient; set the client's Timeout instead.
 func WithTimeout(timeout time.Duration) Option {
 	return func(o *clientOptions) {
 		o.timeout = timeout
 	}
 }
+
+// WithTransport sets the http.RoundTripper used for all HTTP requests,
+// including those sent outside the generated API client. If WithHTTPClient
+// is also used, the transport replaces that client's transport.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(o *clientOptions) {
+		o.transport = transport
+	}
+}
+
+// WithRecorder routes all HTTP requests through a fixture recorder, to
+// record interactions against the live API or replay them in tests.
+// See the recorder package.
+func WithRecorder(rec *recorder.Recorder) Option {
+	return WithTransport(rec)
+}
+
+// WithStreamRetries retries text-to-speech requests whose audio response
+// fails partway, such as on a connection reset, up to n times. Generate
+// repeats the request. GenerateStream resumes where it left off when the
+// request has a Seed, so the audio is regenerated identically, and
+// otherwise returns a StreamInterruptedError. Disabled by default.
+func WithStreamRetries(n int) Option {
+	return func(o *clientOptions) {
+		o.streamRetries = n
+	}
+}
+
+// WithModelChecks checks text-to-speech requests against the capabilities
+// of their model before sending them, so that settings the model doesn't
+// support, such as a style with a model that can't use style, fail with a
+// ValidationError instead of an API error. The model list is fetched once,
+// on the first request. Disabled by default.
+func WithModelChecks() Option {
+	return func(o *clientOptions) {
+		o.modelChecks = true
+	}
+}
+
+// WithOutputFormatChecks checks the output format of text-to-speech, sound
+// effects, and music requests before sending them, so that a format the
+// endpoint or the subscription tier doesn't allow fails with an
+// *OutputFormatError listing the allowed formats instead of an API error.
+// The subscription tier is fetched once, on the first request with a
+// format. Disabled by default.
+func WithOutputFormatChecks() Option {
+	return func(o *clientOptions) {
+		o.outputFormatChecks = true
+	}
+}
+
+// DecodeMode controls how responses that don't match the API schema are
+// handled, such as a status or category value added to the API after this
+// package was released.
+//
+// It applies to responses that decode but fail validation. Responses that
+// can't be decoded into the generated types, such as a fractional number
+// in an integer field, always fail, in every mode.
+type DecodeMode int
+
+const (
+	// DecodeStrict returns a DecodeError naming the fields that don't
+	// match. This is the default.
+	DecodeStrict DecodeMode = iota
+
+	// DecodeLenient accepts the response. Fields that didn't match keep the
+	// value the API sent, so an unknown category is returned as is rather
+	// than as an empty string.
+	DecodeLenient
+
+	// DecodeWarn accepts the response like DecodeLenient and reports the
+	// DecodeError to the handler set with WithDecodeWarningHandler, or logs
+	// it with the standard logger. Use it in production to keep working
+	// when the API adds values, while still noticing that it did.
+	DecodeWarn
+)
+
+// DecodeWarningHandler receives the responses accepted by DecodeWarn that
+// don't match the API schema. It is called from the goroutine making the
+// request.
+type DecodeWarningHandler func(err *DecodeError)
+
+// WithDecodeMode sets how responses that don't match the API schema are
+// handled. The default is DecodeStrict.
+func WithDecodeMode(mode DecodeMode) Option {
+	return func(o *clientOptions) {
+		o.decodeMode = mode
+	}
+}
+
+// WithDecodeWarningHandler sets the handler for responses accepted by
+// DecodeWarn, such as one that records a metric. It also selects
+// DecodeWarn.
+func WithDecodeWarningHandler(handler DecodeWarningHandler) Option {
+	return func(o *clientOptions) {
+		o.decodeMode = DecodeWarn
+		o.decodeWarningHandler = handler
+	}
+}
+
+// withClock sets the clock used for polling and rate limit timestamps.
+func withClock(c clock.Clock) Option {
+	return func(o *clientOptions) {
+		o.clock = c
+	}
+}
@@ -1,8 +1,14 @@
 package elevenlabs
 
 import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -92,6 +98,48 @@ func TestNewClientWithOptions(t *testing.T) {
 	}
 }
 
+func TestClientHeaders(t *testing.T) {
+	var got []http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Clone())
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(
+		WithAPIKey("test-api-key"),
+		WithBaseURL(server.URL),
+		WithUserAgent("my-app/1.0"),
+		WithHeader("X-Gateway-Token", "secret"),
+		WithHeaders(http.Header{"X-Team": []string{"audio"}}),
+		WithHeader("xi-api-key", "ignored"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// Generated client request
+	_, _ = client.Models().List(context.Background())
+	// Hand-rolled request
+	_ = client.postJSON(context.Background(), "/v1/test", map[string]string{}, nil)
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(got))
+	}
+	for i, h := range got {
+		if h.Get("User-Agent") != "my-app/1.0" {
+			t.Errorf("request %d: User-Agent = %q", i, h.Get("User-Agent"))
+		}
+		if h.Get("X-Gateway-Token") != "secret" || h.Get("X-Team") != "audio" {
+			t.Errorf("request %d: missing default headers: %v", i, h)
+		}
+		if h.Get("xi-api-key") != "test-api-key" {
+			t.Errorf("request %d: xi-api-key = %q, want test-api-key", i, h.Get("xi-api-key"))
+		}
+	}
+}
+
 // Helper function to get API key for live tests
 func getAPIKey(t *testing.T) string {
 	t.Helper()
@@ -101,3 +149,85 @@ func getAPIKey(t *testing.T) string {
 	}
 	return apiKey
 }
+
+func TestClientDecodeMode(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"voices": [{"voice_id": "v1", "name": "Nova", "category": "brand_new",
+			"description": null, "preview_url": null}]}`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	_, err := client.Dubbing().SimilarVoices(ctx, "dub1", "speaker_0")
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("SimilarVoices() error = %v, want DecodeError", err)
+	}
+
+	client, _ = NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithDecodeMode(DecodeLenient))
+	voices, err := client.Dubbing().SimilarVoices(ctx, "dub1", "speaker_0")
+	if err != nil {
+		t.Fatalf("SimilarVoices() lenient error = %v", err)
+	}
+	if len(voices) != 1 || voices[0].Category != "brand_new" {
+		t.Errorf("voices = %+v, want the category as sent", voices)
+	}
+}
+
+// countingTransport counts requests before passing them on.
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestClientRawHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Headers first, then the body after a delay
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithTimeout(50*time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	var result map[string]any
+	if err := client.getJSON(ctx, "/v1/slow", &result); err == nil {
+		t.Error("getJSON() error = nil, want the WithTimeout timeout")
+	}
+
+	// Streams are bounded only by the context
+	stream, err := client.TextToSpeech().GenerateStream(ctx, &TTSRequest{VoiceID: "v", Text: "hi"})
+	if err != nil {
+		t.Fatalf("GenerateStream() error = %v", err)
+	}
+	data, err := io.ReadAll(stream)
+	stream.Close()
+	if err != nil || string(data) != `{}` {
+		t.Errorf("stream = %q, %v; want the full body", data, err)
+	}
+
+	// Hand-rolled requests go through a custom client
+	transport := &countingTransport{}
+	client, err = NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if err := client.getJSON(ctx, "/v1/slow", &result); err != nil {
+		t.Errorf("getJSON() error = %v", err)
+	}
+	if transport.requests != 1 {
+		t.Errorf("requests through the custom client = %d, want 1", transport.requests)
+	}
+}
@@ -0,0 +1,141 @@
+package elevenlabs
+
+import (
+	"context"
+	"io"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// ClientAPI is the high-level API of Client. Depend on it instead of
+// *Client to substitute a fake or generated mock in tests:
+//
+//	type Narrator struct {
+//	    client elevenlabs.ClientAPI
+//	}
+//
+//	narrator := &Narrator{client: client.AsClientAPI()}
+//
+// Each accessor returns an interface with the service's methods, so a test
+// only implements the services it uses. Services not listed here are
+// available on *Client.
+type ClientAPI interface {
+	TextToSpeech() TextToSpeechAPI
+	SpeechToText() SpeechToTextAPI
+	SoundEffects() SoundEffectsAPI
+	Voices() VoicesAPI
+	Models() ModelsAPI
+	History() HistoryAPI
+	User() UserAPI
+}
+
+// AsClientAPI returns c as a ClientAPI.
+//
+// *Client can't satisfy ClientAPI itself: a Go method only implements an
+// interface method with the identical signature, so accessors returning
+// interfaces would have to replace the ones returning *TextToSpeechService
+// and the other concrete services. That would break callers that store
+// those types or use methods the interfaces leave out, so an adapter is
+// used instead.
+func (c *Client) AsClientAPI() ClientAPI {
+	return clientAPI{c}
+}
+
+// clientAPI adapts *Client to ClientAPI.
+type clientAPI struct {
+	c *Client
+}
+
+var _ ClientAPI = clientAPI{}
+
+func (a clientAPI) TextToSpeech() TextToSpeechAPI { return a.c.TextToSpeech() }
+func (a clientAPI) SpeechToText() SpeechToTextAPI { return a.c.SpeechToText() }
+func (a clientAPI) SoundEffects() SoundEffectsAPI { return a.c.SoundEffects() }
+func (a clientAPI) Voices() VoicesAPI             { return a.c.Voices() }
+func (a clientAPI) Models() ModelsAPI             { return a.c.Models() }
+func (a clientAPI) History() HistoryAPI           { return a.c.History() }
+func (a clientAPI) User() UserAPI                 { return a.c.User() }
+
+// TextToSpeechAPI is the API of TextToSpeechService.
+type TextToSpeechAPI interface {
+	Generate(ctx context.Context, req *TTSRequest) (*TTSResponse, error)
+	GenerateToWriter(ctx context.Context, req *TTSRequest, w io.Writer) error
+	GenerateStream(ctx context.Context, req *TTSRequest) (io.ReadCloser, error)
+	Simple(ctx context.Context, voiceID, text string) (io.Reader, error)
+	MeasureLatency(ctx context.Context, req *TTSRequest, opts *LatencyOptions) (*LatencyReport, error)
+	BatchGenerate(ctx context.Context, items []TTSBatchItem, opts *BatchOptions) ([]BatchResult, error)
+	GenerateFromReader(ctx context.Context, voiceID string, r io.Reader, opts *ReaderTTSOptions) (io.ReadCloser, error)
+}
+
+var _ TextToSpeechAPI = (*TextToSpeechService)(nil)
+
+// SpeechToTextAPI is the API of SpeechToTextService.
+type SpeechToTextAPI interface {
+	Transcribe(ctx context.Context, req *TranscriptionRequest) (*TranscriptionResponse, error)
+	TranscribeURL(ctx context.Context, url string) (*TranscriptionResponse, error)
+	TranscribeWithDiarization(ctx context.Context, url string) (*TranscriptionResponse, error)
+}
+
+var _ SpeechToTextAPI = (*SpeechToTextService)(nil)
+
+// SoundEffectsAPI is the API of SoundEffectsService.
+type SoundEffectsAPI interface {
+	Generate(ctx context.Context, req *SoundEffectRequest) (*SoundEffectResponse, error)
+	Simple(ctx context.Context, description string) (io.Reader, error)
+	GenerateLoop(ctx context.Context, description string, durationSeconds float64) (io.Reader, error)
+	BatchGenerate(ctx context.Context, items []SoundEffectBatchItem, opts *BatchOptions) ([]BatchResult, error)
+	GeneratePack(ctx context.Context, pack *SoundPack, opts *SoundPackOptions) (*SoundPackManifest, error)
+}
+
+var _ SoundEffectsAPI = (*SoundEffectsService)(nil)
+
+// VoicesAPI is the API of VoicesService.
+type VoicesAPI interface {
+	List(ctx context.Context) ([]*Voice, error)
+	Get(ctx context.Context, voiceID string) (*Voice, error)
+	GetSettings(ctx context.Context, voiceID string) (*VoiceSettings, error)
+	GetDefaultSettings(ctx context.Context) (*VoiceSettings, error)
+	Delete(ctx context.Context, voiceID string) error
+	Audit(ctx context.Context, refs []VoiceReference) (*VoiceAuditReport, error)
+	UpdateSettings(ctx context.Context, voiceID string, settings *VoiceSettings) error
+	DeleteMany(ctx context.Context, voiceIDs []string, opts *BulkVoiceOptions) []BulkVoiceResult
+	UpdateSettingsMany(ctx context.Context, voiceIDs []string, settings *VoiceSettings, opts *BulkVoiceOptions) []BulkVoiceResult
+	GetVerificationCaptcha(ctx context.Context, voiceID string) (*VerificationCaptcha, error)
+	SubmitVerificationRecording(ctx context.Context, voiceID string, recording io.Reader, filename string) error
+	RequestManualVerification(ctx context.Context, voiceID string, req *ManualVerificationRequest) error
+	DumpVoiceConfig(ctx context.Context, voiceID string) (*VoiceConfig, error)
+	ApplyVoiceConfig(ctx context.Context, cfg *VoiceConfig, opts *ApplyVoiceConfigOptions) ([]VoiceConfigChange, error)
+}
+
+var _ VoicesAPI = (*VoicesService)(nil)
+
+// ModelsAPI is the API of ModelsService.
+type ModelsAPI interface {
+	List(ctx context.Context) ([]*Model, error)
+	ListTTSModels(ctx context.Context) ([]*Model, error)
+}
+
+var _ ModelsAPI = (*ModelsService)(nil)
+
+// HistoryAPI is the API of HistoryService.
+type HistoryAPI interface {
+	List(ctx context.Context, opts *HistoryListOptions) (*HistoryListResponse, error)
+	Get(ctx context.Context, historyItemID string) (*HistoryItem, error)
+	GetAudio(ctx context.Context, historyItemID string) (io.Reader, error)
+	GetAudioToWriter(ctx context.Context, historyItemID string, w io.Writer) error
+	Delete(ctx context.Context, historyItemID string) error
+	Restore(ctx context.Context, entries []ttsscript.ManifestEntry, opts *HistoryRestoreOptions) (*HistoryRestoreReport, error)
+	ExportAll(ctx context.Context, dir string, opts *HistoryExportOptions) (*HistoryExportIndex, error)
+	Regenerate(ctx context.Context, historyItemID string, overrides *HistoryRegenerateOverrides) (*TTSResponse, error)
+}
+
+var _ HistoryAPI = (*HistoryService)(nil)
+
+// UserAPI is the API of UserService.
+type UserAPI interface {
+	GetInfo(ctx context.Context) (*User, error)
+	GetSubscription(ctx context.Context) (*Subscription, error)
+	GetCharactersRemaining(ctx context.Context) (int, error)
+}
+
+var _ UserAPI = (*UserService)(nil)
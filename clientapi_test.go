@@ -0,0 +1,62 @@
+package elevenlabs
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+// fakeClient is a ClientAPI that serves canned text-to-speech audio.
+type fakeClient struct {
+	ClientAPI
+	tts *fakeTTS
+}
+
+func (f *fakeClient) TextToSpeech() TextToSpeechAPI { return f.tts }
+
+type fakeTTS struct {
+	TextToSpeechAPI
+	requests []*TTSRequest
+}
+
+func (f *fakeTTS) Generate(_ context.Context, req *TTSRequest) (*TTSResponse, error) {
+	f.requests = append(f.requests, req)
+	return &TTSResponse{Audio: strings.NewReader("audio for " + req.Text)}, nil
+}
+
+// narrate is downstream code that depends on the interface.
+func narrate(ctx context.Context, client ClientAPI, voiceID, text string) (string, error) {
+	resp, err := client.TextToSpeech().Generate(ctx, &TTSRequest{VoiceID: voiceID, Text: text})
+	if err != nil {
+		return "", err
+	}
+	data, err := io.ReadAll(resp.Audio)
+	return string(data), err
+}
+
+func TestClientAPIFake(t *testing.T) {
+	fake := &fakeClient{tts: &fakeTTS{}}
+
+	audio, err := narrate(context.Background(), fake, "voice1", "Hello")
+	if err != nil {
+		t.Fatalf("narrate() error = %v", err)
+	}
+	if audio != "audio for Hello" {
+		t.Errorf("audio = %q", audio)
+	}
+	if len(fake.tts.requests) != 1 || fake.tts.requests[0].VoiceID != "voice1" {
+		t.Errorf("requests = %+v", fake.tts.requests)
+	}
+}
+
+func TestClientAsClientAPI(t *testing.T) {
+	client, err := NewClient(WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	api := client.AsClientAPI()
+	if _, ok := api.TextToSpeech().(*TextToSpeechService); !ok {
+		t.Errorf("TextToSpeech() = %T, want *TextToSpeechService", api.TextToSpeech())
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+)
+
+// defaultBenchText is short so each run costs little.
+const defaultBenchText = "The quick brown fox jumps over the lazy dog."
+
+// runBench implements "elevenlabs bench".
+func runBench(args []string) {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	voiceID := flags.String("voice", "", "Voice ID to measure (required)")
+	modelID := flags.String("model", elevenlabs.DefaultModelID, "Model ID")
+	format := flags.String("format", "", "Output format (e.g., mp3_44100_128, pcm_16000)")
+	text := flags.String("text", defaultBenchText, "Text to generate on each run")
+	runs := flags.Int("runs", elevenlabs.DefaultLatencyRuns, "Number of measured runs")
+	warmup := flags.Int("warmup", elevenlabs.DefaultLatencyWarmupRuns, "Number of unmeasured warm-up runs")
+	jsonOut := flags.Bool("json", false, "Print the report as JSON")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s bench -voice <id> [flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Measure time to first byte and total latency of streamed text-to-speech\n")
+		fmt.Fprintf(os.Stderr, "for a voice, model, and output format. Every run is a billed generation.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if *voiceID == "" || flags.NArg() != 0 {
+		flags.Usage()
+		os.Exit(1)
+	}
+	if *warmup == 0 {
+		// Zero means the default in LatencyOptions
+		*warmup = -1
+	}
+
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+
+	opts := &elevenlabs.LatencyOptions{Runs: *runs, WarmupRuns: *warmup}
+	if !*jsonOut {
+		opts.OnSample = func(run int, sample elevenlabs.LatencySample) {
+			fmt.Printf("  run %-3d ttfb %-8s total %-8s %d bytes\n", run,
+				formatLatency(sample.TTFB), formatLatency(sample.Total), sample.Bytes)
+		}
+		fmt.Printf("Measuring %s with %s", *voiceID, *modelID)
+		if *format != "" {
+			fmt.Printf(" (%s)", *format)
+		}
+		fmt.Println()
+	}
+
+	report, err := client.TextToSpeech().MeasureLatency(context.Background(), &elevenlabs.TTSRequest{
+		VoiceID:      *voiceID,
+		Text:         *text,
+		ModelID:      *modelID,
+		OutputFormat: *format,
+	}, opts)
+	if err != nil {
+		log.Fatalf("Benchmark failed: %v", err)
+	}
+
+	if *jsonOut {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	fmt.Println()
+	fmt.Printf("%-6s %8s %8s %8s %8s %8s %8s\n", "", "min", "mean", "p50", "p90", "p99", "max")
+	for _, row := range []struct {
+		name  string
+		stats elevenlabs.LatencyStats
+	}{
+		{"ttfb", report.TTFB},
+		{"total", report.Total},
+	} {
+		s := row.stats
+		fmt.Printf("%-6s %8s %8s %8s %8s %8s %8s\n", row.name,
+			formatLatency(s.Min), formatLatency(s.Mean), formatLatency(s.P50),
+			formatLatency(s.P90), formatLatency(s.P99), formatLatency(s.Max))
+	}
+}
+
+// formatLatency formats a duration in whole milliseconds.
+func formatLatency(d time.Duration) string {
+	return fmt.Sprintf("%dms", d.Round(time.Millisecond).Milliseconds())
+}
@@ -0,0 +1,81 @@
+// Command elevenlabs is a command-line client for ElevenLabs API tasks that
+// are not tied to a script.
+//
+// Usage:
+//
+//	elevenlabs sfx batch [flags] <prompts.jsonl>
+//	elevenlabs sfx pack [flags] <pack.json>
+//	elevenlabs bench -voice <id> [flags]
+//	elevenlabs voices dump [flags] <voice_id>...
+//	elevenlabs voices apply [flags] <voices.yaml>
+//
+// "elevenlabs sfx batch" generates every sound effect in a JSONL prompt
+// file, one JSON object per line:
+//
+//	{"id": "door_creak", "text": "old wooden door creaking open", "duration_seconds": 2}
+//	{"id": "rain_loop", "text": "steady rain on a tin roof", "duration_seconds": 10, "loop": true}
+//
+// "elevenlabs sfx pack" generates a set of UI sounds, such as notification
+// sounds, from a pack definition that maps event names to prompts. A shared
+// style keeps the sounds consistent, loudness is normalized with ffmpeg,
+// and a pack manifest is written with the sounds.
+//
+// "elevenlabs bench" measures time to first byte and total latency of
+// streamed text-to-speech for a voice, model, and output format, and
+// reports percentiles across runs.
+//
+// "elevenlabs voices dump" writes voices' names, descriptions, labels,
+// settings, and workspace sharing to a YAML or JSON file, and "elevenlabs
+// voices apply" reconciles voices toward such a file, so a voice library
+// can be managed from version control. Use -dry-run to review changes.
+//
+// Environment:
+//
+//	ELEVENLABS_API_KEY    Required API key for ElevenLabs
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) > 2 && os.Args[1] == "sfx" && os.Args[2] == "batch" {
+		runSFXBatch(os.Args[3:])
+		return
+	}
+	if len(os.Args) > 2 && os.Args[1] == "sfx" && os.Args[2] == "pack" {
+		runSFXPack(os.Args[3:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "bench" {
+		runBench(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 2 && os.Args[1] == "voices" && os.Args[2] == "dump" {
+		runVoicesDump(os.Args[3:])
+		return
+	}
+	if len(os.Args) > 2 && os.Args[1] == "voices" && os.Args[2] == "apply" {
+		runVoicesApply(os.Args[3:])
+		return
+	}
+	usage()
+	os.Exit(1)
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s sfx batch [flags] <prompts.jsonl>\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s sfx pack [flags] <pack.json>\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s bench -voice <id> [flags]\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s voices dump [flags] <voice_id>...\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "       %s voices apply [flags] <voices.yaml>\n\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "Commands:\n")
+	fmt.Fprintf(os.Stderr, "  sfx batch    Generate sound effects from a JSONL prompt file\n")
+	fmt.Fprintf(os.Stderr, "  sfx pack     Generate a consistent set of UI sounds from a pack definition\n")
+	fmt.Fprintf(os.Stderr, "  bench        Measure text-to-speech latency for a voice\n")
+	fmt.Fprintf(os.Stderr, "  voices dump  Write voice configuration to a YAML or JSON file\n")
+	fmt.Fprintf(os.Stderr, "  voices apply Reconcile voices toward a configuration file\n")
+	fmt.Fprintf(os.Stderr, "\nEnvironment:\n")
+	fmt.Fprintf(os.Stderr, "  ELEVENLABS_API_KEY    Required API key for ElevenLabs\n")
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+)
+
+// runSFXBatch implements "elevenlabs sfx batch".
+func runSFXBatch(args []string) {
+	flags := flag.NewFlagSet("sfx batch", flag.ExitOnError)
+	outputDir := flags.String("o", "./sfx", "Output directory")
+	concurrency := flags.Int("concurrency", elevenlabs.DefaultBatchConcurrency, "Number of concurrent generations")
+	retries := flags.Int("retries", elevenlabs.DefaultBatchMaxRetries, "Retries for rate limited and server errors")
+	format := flags.String("format", "", "Output format for items without one (e.g., mp3_44100_128)")
+	dryRun := flags.Bool("dry-run", false, "Validate the prompt file and show what would be generated")
+	resume := flags.Bool("resume", false, "Reuse outputs of identical prompts recorded in generations.jsonl by earlier runs")
+	dedupe := flags.Bool("dedupe", false, "Generate identical prompts once instead of as separate takes")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s sfx batch [flags] <prompts.jsonl>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Generate every sound effect in a JSONL prompt file, one JSON object per line:\n\n")
+		fmt.Fprintf(os.Stderr, "  {\"id\": \"door_creak\", \"text\": \"old wooden door creaking open\", \"duration_seconds\": 2}\n")
+		fmt.Fprintf(os.Stderr, "  {\"id\": \"rain_loop\", \"text\": \"steady rain on a tin roof\", \"duration_seconds\": 10, \"loop\": true}\n\n")
+		fmt.Fprintf(os.Stderr, "Each generation is recorded in <output>/generations.jsonl. With -resume, items\n")
+		fmt.Fprintf(os.Stderr, "already generated there are reused, so a rerun only bills for failed or changed items.\n")
+		fmt.Fprintf(os.Stderr, "The exit status is 2 if any item failed.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	items, err := elevenlabs.LoadSoundEffectBatch(flags.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i := range items {
+		if items[i].OutputFormat == "" {
+			items[i].OutputFormat = *format
+		}
+	}
+	for i := range items {
+		if err := items[i].Request().Validate(); err != nil {
+			log.Fatalf("Item %d: %v", i+1, err)
+		}
+	}
+
+	if *dryRun {
+		fmt.Printf("Would generate %d sound effects into %s:\n", len(items), *outputDir)
+		for i, item := range items {
+			id := item.ID
+			if id == "" {
+				id = fmt.Sprintf("sfx_%03d", i+1)
+			}
+			fmt.Printf("  %s: %s\n", id, item.Text)
+		}
+		return
+	}
+
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+
+	if err := os.MkdirAll(*outputDir, 0750); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+	logPath := filepath.Join(*outputDir, "generations.jsonl")
+	var completed []elevenlabs.GenerationRecord
+	if *resume {
+		completed, err = elevenlabs.LoadGenerationRecords(logPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Fatalf("Failed to read generation log: %v", err)
+		}
+	}
+	genLog, err := elevenlabs.OpenGenerationLog(logPath)
+	if err != nil {
+		log.Fatalf("Failed to open generation log: %v", err)
+	}
+	defer genLog.Close()
+
+	maxRetries := *retries
+	if maxRetries == 0 {
+		maxRetries = -1 // zero means the default in BatchOptions
+	}
+
+	var done atomic.Int64
+	results, err := client.SoundEffects().BatchGenerate(context.Background(), items, &elevenlabs.BatchOptions{
+		OutputDir:   *outputDir,
+		Concurrency: *concurrency,
+		MaxRetries:  maxRetries,
+		Log:         genLog,
+		Dedupe:      *dedupe,
+		Completed:   completed,
+		OnResult: func(res elevenlabs.BatchResult) {
+			n := done.Add(1)
+			if res.Err != nil {
+				log.Printf("[%d/%d] %s: ERROR: %v", n, len(items), res.ID, res.Err)
+				return
+			}
+			reused := ""
+			switch {
+			case res.Skipped:
+				reused = ", reused"
+			case res.DuplicateOf != "":
+				reused = ", duplicate of " + res.DuplicateOf
+			}
+			fmt.Printf("[%d/%d] %s: %s (%d bytes%s)\n", n, len(items), res.ID, res.OutputPath, res.Bytes, reused)
+		},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	failed := 0
+	for _, res := range results {
+		if res.Err != nil {
+			failed++
+		}
+	}
+	fmt.Printf("\nDone! Generated %d of %d sound effects.\n", len(results)-failed, len(results))
+	if failed > 0 {
+		genLog.Close()
+		os.Exit(2)
+	}
+}
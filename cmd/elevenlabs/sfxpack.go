@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+)
+
+// runSFXPack implements "elevenlabs sfx pack".
+func runSFXPack(args []string) {
+	flags := flag.NewFlagSet("sfx pack", flag.ExitOnError)
+	outputDir := flags.String("o", "", "Output directory (default: ./<pack name>)")
+	concurrency := flags.Int("concurrency", elevenlabs.DefaultBatchConcurrency, "Number of concurrent generations")
+	retries := flags.Int("retries", elevenlabs.DefaultBatchMaxRetries, "Retries for rate limited and server errors")
+	noNormalize := flags.Bool("no-normalize", false, "Skip loudness normalization even if the pack sets target_lufs")
+	dryRun := flags.Bool("dry-run", false, "Validate the pack and show the prompts that would be generated")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s sfx pack [flags] <pack.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Generate a consistent set of UI sounds from a pack definition:\n\n")
+		fmt.Fprintf(os.Stderr, "  {\"name\": \"chime\", \"style\": \"soft glassy marimba\", \"target_lufs\": -18,\n")
+		fmt.Fprintf(os.Stderr, "   \"sounds\": {\"message\": {\"prompt\": \"two-note rising chime\", \"duration_seconds\": 0.8}}}\n\n")
+		fmt.Fprintf(os.Stderr, "The style is appended to every prompt. If target_lufs is set, sounds are\n")
+		fmt.Fprintf(os.Stderr, "normalized with ffmpeg's loudnorm filter. A manifest is written to\n")
+		fmt.Fprintf(os.Stderr, "<output>/%s. The exit status is 2 if any sound failed.\n\n", elevenlabs.SoundPackManifestFile)
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	pack, err := elevenlabs.LoadSoundPack(flags.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := pack.Validate(); err != nil {
+		log.Fatal(err)
+	}
+	dir := *outputDir
+	if dir == "" {
+		name := pack.Name
+		if name == "" {
+			name = strings.TrimSuffix(filepath.Base(flags.Arg(0)), filepath.Ext(flags.Arg(0)))
+		}
+		dir = filepath.Join(".", name)
+	}
+
+	normalize := pack.TargetLUFS != 0 && !*noNormalize
+	if normalize {
+		if _, err := exec.LookPath("ffmpeg"); err != nil {
+			log.Printf("Warning: ffmpeg not found, sounds will not be normalized to %g LUFS", pack.TargetLUFS)
+			normalize = false
+		}
+	}
+
+	items := pack.Items()
+	if *dryRun {
+		fmt.Printf("Would generate %d sounds into %s:\n", len(items), dir)
+		for _, item := range items {
+			fmt.Printf("  %s: %s\n", item.ID, item.Text)
+		}
+		if normalize {
+			fmt.Printf("Sounds would be normalized to %g LUFS.\n", pack.TargetLUFS)
+		}
+		return
+	}
+
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+
+	maxRetries := *retries
+	if maxRetries == 0 {
+		maxRetries = -1 // zero means the default in BatchOptions
+	}
+
+	opts := &elevenlabs.SoundPackOptions{
+		BatchOptions: elevenlabs.BatchOptions{
+			OutputDir:   dir,
+			Concurrency: *concurrency,
+			MaxRetries:  maxRetries,
+		},
+	}
+	var done atomic.Int64
+	opts.OnResult = func(res elevenlabs.BatchResult) {
+		n := done.Add(1)
+		if res.Err != nil {
+			log.Printf("[%d/%d] %s: ERROR: %v", n, len(items), res.ID, res.Err)
+			return
+		}
+		fmt.Printf("[%d/%d] %s: %s\n", n, len(items), res.ID, res.OutputPath)
+	}
+	if normalize {
+		opts.Normalize = loudnormFile
+	}
+
+	manifest, err := client.SoundEffects().GeneratePack(context.Background(), pack, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, s := range manifest.Sounds {
+		if s.Error != "" && s.File != "" {
+			log.Printf("%s: %s", s.Event, s.Error)
+		}
+	}
+
+	failed := manifest.Failed()
+	fmt.Printf("\nDone! Generated %d of %d sounds in %s.\n", len(manifest.Sounds)-failed, len(manifest.Sounds), dir)
+	if failed > 0 {
+		os.Exit(2)
+	}
+}
+
+// loudnormFile normalizes an audio file in place to an integrated loudness
+// with ffmpeg's loudnorm filter.
+func loudnormFile(path string, targetLUFS float64) error {
+	tmp := strings.TrimSuffix(path, filepath.Ext(path)) + ".loudnorm" + filepath.Ext(path)
+	cmd := exec.Command("ffmpeg", "-y", "-v", "error", "-i", path,
+		"-af", fmt.Sprintf("loudnorm=I=%g:TP=-1.5:LRA=11", targetLUFS),
+		"-ar", "44100", tmp)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("ffmpeg: %v: %s", err, strings.TrimSpace(string(out)))
+	}
+	return os.Rename(tmp, path)
+}
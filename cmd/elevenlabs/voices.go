@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+)
+
+// newClient creates a client from ELEVENLABS_API_KEY.
+func newClient() *elevenlabs.Client {
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+	return client
+}
+
+// runVoicesDump implements "elevenlabs voices dump".
+func runVoicesDump(args []string) {
+	flags := flag.NewFlagSet("voices dump", flag.ExitOnError)
+	output := flags.String("o", "voices.yaml", "Output file (.json for JSON, otherwise YAML)")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s voices dump [flags] <voice_id>...\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Write the name, description, labels, settings, and workspace sharing of\n")
+		fmt.Fprintf(os.Stderr, "voices to a configuration file for \"voices apply\".\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	client := newClient()
+	configs := make([]*elevenlabs.VoiceConfig, 0, flags.NArg())
+	for _, voiceID := range flags.Args() {
+		cfg, err := client.Voices().DumpVoiceConfig(context.Background(), voiceID)
+		if err != nil {
+			log.Fatalf("Voice %s: %v", voiceID, err)
+		}
+		configs = append(configs, cfg)
+	}
+	if err := elevenlabs.SaveVoiceConfigs(*output, configs); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Wrote %d voices to %s\n", len(configs), *output)
+}
+
+// runVoicesApply implements "elevenlabs voices apply".
+func runVoicesApply(args []string) {
+	flags := flag.NewFlagSet("voices apply", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "Show the changes without making them")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s voices apply [flags] <voices.yaml>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Reconcile voices toward a YAML or JSON configuration file. Fields left out\n")
+		fmt.Fprintf(os.Stderr, "of a voice's configuration are not changed.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	configs, err := elevenlabs.LoadVoiceConfigs(flags.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	client := newClient()
+	total := 0
+	for _, cfg := range configs {
+		changes, err := client.Voices().ApplyVoiceConfig(context.Background(), cfg, &elevenlabs.ApplyVoiceConfigOptions{DryRun: *dryRun})
+		if err != nil {
+			log.Fatalf("Voice %s: %v", cfg.VoiceID, err)
+		}
+		for _, c := range changes {
+			fmt.Printf("%s %s\n", cfg.VoiceID, c)
+		}
+		total += len(changes)
+	}
+
+	switch {
+	case total == 0:
+		fmt.Println("No changes.")
+	case *dryRun:
+		fmt.Printf("\n%d changes would be made.\n", total)
+	default:
+		fmt.Printf("\nApplied %d changes.\n", total)
+	}
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// pathFilter selects the spec paths to keep, by include and exclude
+// patterns. In a pattern, "*" matches within one path segment and "**"
+// matches any number of segments, so "/v1/voices/**" matches "/v1/voices"
+// and every path below it.
+type pathFilter struct {
+	include []*regexp.Regexp
+	exclude []*regexp.Regexp
+}
+
+// newPathFilter returns a filter for comma-separated include and exclude
+// patterns, or nil if both are empty.
+func newPathFilter(include, exclude string) *pathFilter {
+	f := &pathFilter{
+		include: compilePatterns(include),
+		exclude: compilePatterns(exclude),
+	}
+	if len(f.include) == 0 && len(f.exclude) == 0 {
+		return nil
+	}
+	return f
+}
+
+// compilePatterns compiles comma-separated path patterns.
+func compilePatterns(patterns string) []*regexp.Regexp {
+	var res []*regexp.Regexp
+	for _, p := range strings.Split(patterns, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, compilePattern(p))
+		}
+	}
+	return res
+}
+
+// compilePattern converts a path pattern to an anchored regexp.
+func compilePattern(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); {
+		switch {
+		case strings.HasPrefix(pattern[i:], "/**"):
+			b.WriteString("(/.*)?")
+			i += 3
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i += 2
+		case pattern[i] == '*':
+			b.WriteString("[^/]*")
+			i++
+		default:
+			b.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+			i++
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// keep reports whether path passes the filter: it matches an include
+// pattern, or there are none, and no exclude pattern.
+func (f *pathFilter) keep(path string) bool {
+	if len(f.include) > 0 && !matchAny(f.include, path) {
+		return false
+	}
+	return !matchAny(f.exclude, path)
+}
+
+func matchAny(patterns []*regexp.Regexp, path string) bool {
+	for _, re := range patterns {
+		if re.MatchString(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterPaths removes the paths the filter doesn't keep, then the
+// components no remaining path refers to, so the generated client only has
+// the types it needs.
+func (c *converter) filterPaths(spec map[string]any, f *pathFilter) {
+	paths, _ := spec["paths"].(map[string]any)
+	for _, path := range sortedKeys(paths) {
+		if !f.keep(path) {
+			delete(paths, path)
+			c.record(pointer("#/paths", path), KindPathRemoved, nil)
+		}
+	}
+
+	components, _ := spec["components"].(map[string]any)
+	if components == nil {
+		return
+	}
+
+	// Find the components reachable from everything outside components,
+	// following refs between components
+	reachable := make(map[string]bool)
+	var queue []string
+	addRefs := func(v any) {
+		collectRefs(v, func(ref string) {
+			if !reachable[ref] {
+				reachable[ref] = true
+				queue = append(queue, ref)
+			}
+		})
+	}
+	for key, v := range spec {
+		if key != "components" {
+			addRefs(v)
+		}
+	}
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+		if v, ok := resolveComponent(components, ref); ok {
+			addRefs(v)
+		}
+	}
+
+	for _, section := range sortedKeys(components) {
+		// Security schemes are referred to by name, not by $ref
+		if section == "securitySchemes" {
+			continue
+		}
+		entries, ok := components[section].(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, name := range sortedKeys(entries) {
+			ref := pointer(pointer("#/components", section), name)
+			if !reachable[ref] {
+				delete(entries, name)
+				c.record(ref, KindComponentRemoved, nil)
+			}
+		}
+	}
+}
+
+// collectRefs calls fn with every $ref value in v.
+func collectRefs(v any, fn func(ref string)) {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if ref, ok := item.(string); ok && k == "$ref" {
+				fn(ref)
+				continue
+			}
+			collectRefs(item, fn)
+		}
+	case []any:
+		for _, item := range val {
+			collectRefs(item, fn)
+		}
+	}
+}
+
+// resolveComponent returns the component a local ref such as
+// "#/components/schemas/Voice" points to.
+func resolveComponent(components map[string]any, ref string) (any, bool) {
+	rest, ok := strings.CutPrefix(ref, "#/components/")
+	if !ok {
+		return nil, false
+	}
+	section, name, ok := strings.Cut(rest, "/")
+	if !ok {
+		return nil, false
+	}
+	name = strings.ReplaceAll(strings.ReplaceAll(name, "~1", "/"), "~0", "~")
+	entries, _ := components[section].(map[string]any)
+	v, ok := entries[name]
+	return v, ok
+}
@@ -2,23 +2,55 @@
 //
 // Usage:
 //
-//	go run ./cmd/openapi-convert openapi/openapi-v3.1.json openapi/openapi-v3.0.json
+//	go run ./cmd/openapi-convert [flags] openapi/openapi-v3.1.json openapi/openapi-v3.0.json
+//
+// Flags:
+//
+//	-report <file>                 Write a JSON report of every transformation applied
+//	-keep-webhooks-as-extension    Keep webhooks as the x-webhooks extension instead of dropping them
+//	-include <patterns>            Keep only paths matching these comma-separated patterns
+//	-exclude <patterns>            Drop paths matching these comma-separated patterns
+//
+// Some 3.1 information has no 3.0 equivalent ogen accepts, such as numeric
+// exclusiveMinimum bounds, and is dropped. The report lists each such change
+// with its JSON pointer and original value, so constraints needed later for
+// client-side validation can be recovered.
+//
+// Path patterns use "*" for one path segment and "**" for any number, so
+// a smaller client with only text-to-speech and voices can be generated
+// from:
+//
+//	go run ./cmd/openapi-convert -include '/v1/text-to-speech/**,/v1/voices/**' in.json out.json
+//
+// Components that no remaining path refers to are dropped as well.
 package main
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 )
 
 func main() {
-	if len(os.Args) != 3 {
-		fmt.Fprintf(os.Stderr, "Usage: %s <input-v3.1.json> <output-v3.0.json>\n", os.Args[0])
+	reportFile := flag.String("report", "", "Write a JSON report of every transformation applied to `file`")
+	keepWebhooks := flag.Bool("keep-webhooks-as-extension", false, "Keep webhooks as the x-webhooks extension instead of dropping them")
+	include := flag.String("include", "", "Keep only paths matching these comma-separated `patterns` (\"*\" is one segment, \"**\" any)")
+	exclude := flag.String("exclude", "", "Drop paths matching these comma-separated `patterns`")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <input-v3.1.json> <output-v3.0.json>\n\nFlags:\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
 		os.Exit(1)
 	}
 
-	inputFile := os.Args[1]
-	outputFile := os.Args[2]
+	inputFile := flag.Arg(0)
+	outputFile := flag.Arg(1)
 
 	// Read raw JSON
 	data, err := os.ReadFile(inputFile)
@@ -37,14 +69,37 @@ func main() {
 	originalVersion := spec["openapi"]
 	fmt.Printf("Input OpenAPI version: %v\n", originalVersion)
 
+	c := &converter{
+		report: &Report{
+			Input:         inputFile,
+			InputVersion:  fmt.Sprint(originalVersion),
+			OutputVersion: "3.0.3",
+		},
+	}
+
 	// Convert to 3.0.3
 	spec["openapi"] = "3.0.3"
 
 	// Remove 3.1-only top-level fields
-	delete(spec, "webhooks")
+	if webhooks, ok := spec["webhooks"]; ok {
+		delete(spec, "webhooks")
+		if *keepWebhooks {
+			spec["x-webhooks"] = webhooks
+			c.record("#/webhooks", KindWebhooksKept, webhookNames(webhooks))
+		} else {
+			c.record("#/webhooks", KindWebhooksDropped, webhookNames(webhooks))
+		}
+	}
 
 	// Fix all schemas recursively
-	fixValue(spec)
+	c.fixValue(spec, "#")
+
+	// Drop unwanted paths and the components only they used
+	if filter := newPathFilter(*include, *exclude); filter != nil {
+		c.filterPaths(spec, filter)
+		paths, _ := spec["paths"].(map[string]any)
+		fmt.Printf("Kept %d paths\n", len(paths))
+	}
 
 	// Marshal back to JSON
 	output, err := json.MarshalIndent(spec, "", "  ")
@@ -61,22 +116,71 @@ func main() {
 
 	fmt.Printf("Output OpenAPI version: 3.0.3\n")
 	fmt.Printf("Wrote converted spec to: %s\n", outputFile)
+
+	c.report.finish()
+	for _, kind := range sortedKeys(c.report.Counts) {
+		fmt.Printf("  %-28s %d\n", kind, c.report.Counts[kind])
+	}
+	if *reportFile != "" {
+		if err := c.report.write(*reportFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Wrote transformation report to: %s\n", *reportFile)
+	}
+}
+
+// converter converts 3.1 patterns to 3.0 and records what it changed.
+type converter struct {
+	report *Report
+}
+
+// record adds a transformation at the JSON pointer path to the report.
+func (c *converter) record(path string, kind TransformKind, original any) {
+	c.report.Transformations = append(c.report.Transformations, Transformation{
+		Path:     path,
+		Kind:     kind,
+		Original: original,
+	})
+}
+
+// webhookNames returns the sorted names of the webhooks object.
+func webhookNames(webhooks any) []string {
+	obj, _ := webhooks.(map[string]any)
+	return sortedKeys(obj)
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
+
+// pointer appends a reference token to a JSON pointer, escaping "~" and "/".
+func pointer(path, token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	token = strings.ReplaceAll(token, "/", "~1")
+	return path + "/" + token
 }
 
 // fixValue recursively processes JSON values to convert 3.1 -> 3.0 patterns
-func fixValue(v any) {
+func (c *converter) fixValue(v any, path string) {
 	switch val := v.(type) {
 	case map[string]any:
-		fixObject(val)
+		c.fixObject(val, path)
 	case []any:
-		for _, item := range val {
-			fixValue(item)
+		for i, item := range val {
+			c.fixValue(item, fmt.Sprintf("%s/%d", path, i))
 		}
 	}
 }
 
 // fixObject processes a JSON object to convert 3.1 -> 3.0 patterns
-func fixObject(obj map[string]any) {
+func (c *converter) fixObject(obj map[string]any, path string) {
 	// Handle exclusiveMinimum (number in 3.1 -> remove, use minimum)
 	if exMin, ok := obj["exclusiveMinimum"]; ok {
 		if _, isNum := exMin.(float64); isNum {
@@ -84,6 +188,7 @@ func fixObject(obj map[string]any) {
 			// In 3.0, exclusiveMinimum is a boolean
 			// Convert: remove exclusiveMinimum, keep minimum if exists
 			delete(obj, "exclusiveMinimum")
+			c.record(path, KindExclusiveMinimumRemoved, exMin)
 		}
 	}
 
@@ -91,6 +196,7 @@ func fixObject(obj map[string]any) {
 	if exMax, ok := obj["exclusiveMaximum"]; ok {
 		if _, isNum := exMax.(float64); isNum {
 			delete(obj, "exclusiveMaximum")
+			c.record(path, KindExclusiveMaximumRemoved, exMax)
 		}
 	}
 
@@ -98,6 +204,7 @@ func fixObject(obj map[string]any) {
 	if constVal, ok := obj["const"]; ok {
 		obj["enum"] = []any{constVal}
 		delete(obj, "const")
+		c.record(path, KindConstToEnum, constVal)
 	}
 
 	// Handle anyOf with type:null (convert to nullable:true)
@@ -118,6 +225,7 @@ func fixObject(obj map[string]any) {
 		}
 
 		if hasNull {
+			c.record(path, KindAnyOfNullToNullable, nil)
 			if len(nonNullSchemas) == 1 {
 				// Replace anyOf with the single non-null schema + nullable:true
 				if schemaMap, ok := nonNullSchemas[0].(map[string]any); ok {
@@ -157,6 +265,7 @@ func fixObject(obj map[string]any) {
 		}
 
 		if hasNull {
+			c.record(path, KindOneOfNullToNullable, nil)
 			if len(nonNullSchemas) == 1 {
 				if schemaMap, ok := nonNullSchemas[0].(map[string]any); ok {
 					delete(obj, "oneOf")
@@ -190,6 +299,9 @@ func fixObject(obj map[string]any) {
 			}
 		}
 
+		if len(nonNullTypes) >= 1 {
+			c.record(path, KindTypeArrayConverted, typeVal)
+		}
 		if len(nonNullTypes) == 1 {
 			obj["type"] = nonNullTypes[0]
 			if hasNull {
@@ -209,8 +321,16 @@ func fixObject(obj map[string]any) {
 		}
 	}
 
+	// A nullable binary string is an optional file part. ogen generates a
+	// string rather than a file for it, and a null file is the same as an
+	// omitted one, so drop nullable
+	if obj["type"] == "string" && obj["format"] == "binary" && obj["nullable"] == true {
+		delete(obj, "nullable")
+		c.record(path, KindNullableFileToOptional, nil)
+	}
+
 	// Recurse into all values
-	for _, v := range obj {
-		fixValue(v)
+	for k, v := range obj {
+		c.fixValue(v, pointer(path, k))
 	}
 }
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+)
+
+// TransformKind names a kind of change made to the spec.
+type TransformKind string
+
+// Transformation kinds.
+const (
+	// KindExclusiveMinimumRemoved is a numeric exclusiveMinimum bound that
+	// was removed. Original is the bound.
+	KindExclusiveMinimumRemoved TransformKind = "exclusive_minimum_removed"
+
+	// KindExclusiveMaximumRemoved is a numeric exclusiveMaximum bound that
+	// was removed. Original is the bound.
+	KindExclusiveMaximumRemoved TransformKind = "exclusive_maximum_removed"
+
+	// KindConstToEnum is a const replaced by a single-value enum. Original
+	// is the value.
+	KindConstToEnum TransformKind = "const_to_enum"
+
+	// KindAnyOfNullToNullable is an anyOf with a null schema replaced by
+	// nullable.
+	KindAnyOfNullToNullable TransformKind = "anyof_null_to_nullable"
+
+	// KindOneOfNullToNullable is a oneOf with a null schema replaced by
+	// nullable.
+	KindOneOfNullToNullable TransformKind = "oneof_null_to_nullable"
+
+	// KindTypeArrayConverted is a type array replaced by a single type or
+	// anyOf, with nullable for "null". Original is the type array.
+	KindTypeArrayConverted TransformKind = "type_array_converted"
+
+	// KindNullableFileToOptional is a nullable binary string, a file part,
+	// made non-nullable so it is generated as an optional file.
+	KindNullableFileToOptional TransformKind = "nullable_file_to_optional"
+
+	// KindWebhooksDropped is the webhooks object, which was dropped.
+	// Original is the webhook names.
+	KindWebhooksDropped TransformKind = "webhooks_dropped"
+
+	// KindWebhooksKept is the webhooks object, which was moved to
+	// x-webhooks. Original is the webhook names.
+	KindWebhooksKept TransformKind = "webhooks_kept_as_extension"
+
+	// KindPathRemoved is a path removed by -include or -exclude.
+	KindPathRemoved TransformKind = "path_removed"
+
+	// KindComponentRemoved is a component no remaining path refers to.
+	KindComponentRemoved TransformKind = "component_removed"
+)
+
+// Transformation is one change made to the spec.
+type Transformation struct {
+	// Path is the JSON pointer of the changed object in the input spec,
+	// such as "#/components/schemas/Model/properties/rate".
+	Path string `json:"path"`
+
+	// Kind is the change made.
+	Kind TransformKind `json:"kind"`
+
+	// Original is the information removed or replaced, if any.
+	Original any `json:"original,omitempty"`
+}
+
+// Report lists every transformation applied by a conversion.
+type Report struct {
+	Input           string                `json:"input"`
+	InputVersion    string                `json:"input_version"`
+	OutputVersion   string                `json:"output_version"`
+	Counts          map[TransformKind]int `json:"counts"`
+	Transformations []Transformation      `json:"transformations"`
+}
+
+// finish sorts the transformations by path and counts them by kind.
+func (r *Report) finish() {
+	sort.SliceStable(r.Transformations, func(i, j int) bool {
+		a, b := r.Transformations[i], r.Transformations[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Kind < b.Kind
+	})
+	r.Counts = make(map[TransformKind]int)
+	for _, t := range r.Transformations {
+		r.Counts[t.Kind]++
+	}
+}
+
+// write saves the report as indented JSON.
+func (r *Report) write(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runBalance implements "ttsscript balance": it reports narration length per
+// slide and flags slides much longer or shorter than the median, to balance
+// pacing before generation.
+func runBalance(args []string) {
+	flags := flag.NewFlagSet("balance", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to analyze")
+	long := flags.Float64("long", ttsscript.DefaultBalanceLongRatio, "Flag slides longer than this multiple of the median")
+	short := flags.Float64("short", ttsscript.DefaultBalanceShortRatio, "Flag slides shorter than this multiple of the median")
+	asJSON := flags.Bool("json", false, "Print the report as JSON")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s balance [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Report narration length per slide and flag slides much longer or shorter than the median.\n")
+		fmt.Fprintf(os.Stderr, "The exit status is 1 if any slide is flagged.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	script, err := loadScript(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to load script: %v", err)
+	}
+
+	report := ttsscript.AnalyzeBalance(script, *lang, &ttsscript.BalanceOptions{LongRatio: *long, ShortRatio: *short})
+	outliers := report.Outliers()
+
+	if *asJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal report: %v", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "SLIDE\tTITLE\tSEGMENTS\tCHARS\tMIN\tMEDIAN\tMAX\tEST.\tRATIO\tFLAG")
+		for _, s := range report.Slides {
+			fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t%d\t%d\t%d\t%.1fs\t%.2f\t%s\n",
+				s.SlideIndex+1, truncate(s.Title, 30), s.Segments, s.Characters,
+				s.MinSegment, s.MedianSegment, s.MaxSegment,
+				float64(s.EstimatedMs)/1000, s.Ratio, s.Flag)
+		}
+		tw.Flush()
+		fmt.Printf("\nMedian slide: %d characters. %d of %d slides flagged.\n",
+			report.MedianCharacters, len(outliers), len(report.Slides))
+	}
+	if len(outliers) > 0 {
+		os.Exit(1)
+	}
+}
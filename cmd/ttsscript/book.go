@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// loadScriptOrBook loads a script like loadScript or, if the file is a
+// book (see ttsscript.IsBook), loads the book and flattens it into a
+// script. The book is nil for a script.
+func loadScriptOrBook(path string) (*ttsscript.Script, *ttsscript.Book, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading script file: %w", err)
+	}
+	if !ttsscript.IsBook(data) {
+		script, err := loadScript(path)
+		return script, nil, err
+	}
+
+	var book *ttsscript.Book
+	if isLenientScript(path) {
+		book, err = ttsscript.ParseBookLenient(data)
+	} else {
+		book, err = ttsscript.ParseBook(data)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	script, _ := book.Script()
+	script, err = decryptScript(script)
+	if err != nil {
+		return nil, nil, err
+	}
+	return script, book, nil
+}
+
+// concatenateChapters concatenates each chapter of a book into
+// <chapter-id>_<lang>.mp3, with a marker per section, as -single-file does
+// for a whole script.
+func concatenateChapters(chapters []ttsscript.BookChapter, entries []ttsscript.ManifestEntry, language, outputDir string, slideGapMs int) {
+	for _, ch := range chapters {
+		var chapterEntries []ttsscript.ManifestEntry
+		for _, e := range entries {
+			if e.SlideIndex >= ch.FirstSlide && e.SlideIndex < ch.FirstSlide+ch.SlideCount {
+				chapterEntries = append(chapterEntries, e)
+			}
+		}
+		if len(chapterEntries) == 0 {
+			log.Printf("  Chapter %s: no audio", ch.ID)
+			continue
+		}
+		base := fmt.Sprintf("%s_%s", ch.ID, language)
+		if err := concatenateTrack(chapterEntries, language, outputDir, ch.Title, slideGapMs, base, ""); err != nil {
+			log.Printf("  Chapter %s failed: %v", ch.ID, err)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// cacheEnv names the environment variable with the default shared audio
+// cache directory.
+const cacheEnv = "TTSSCRIPT_CACHE"
+
+// runCache implements "ttsscript cache": maintenance of the shared audio
+// cache used by -cache.
+func runCache(args []string) {
+	usage := func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s cache gc [flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Maintain the shared audio cache used by -cache.\n\n")
+		fmt.Fprintf(os.Stderr, "Commands:\n")
+		fmt.Fprintf(os.Stderr, "  gc    Remove the least recently used audio until the cache fits -max-size\n")
+	}
+	if len(args) < 1 {
+		usage()
+		os.Exit(1)
+	}
+	switch args[0] {
+	case "gc":
+		runCacheGC(args[1:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+}
+
+func runCacheGC(args []string) {
+	flags := flag.NewFlagSet("cache gc", flag.ExitOnError)
+	dir := flags.String("dir", os.Getenv(cacheEnv), "Shared audio cache directory (default $"+cacheEnv+")")
+	maxSize := flags.String("max-size", "", "Maximum cache size to keep (e.g., 500MB, 5GB)")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s cache gc -max-size <size> [flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Remove the least recently used audio until the cache fits -max-size.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if *dir == "" || *maxSize == "" {
+		flags.Usage()
+		os.Exit(1)
+	}
+	limit, err := ttsscript.ParseByteSize(*maxSize)
+	if err != nil {
+		log.Fatalf("Invalid -max-size: %v", err)
+	}
+
+	result, err := ttsscript.NewAudioCache(*dir).GC(limit)
+	if err != nil {
+		log.Fatalf("Cache GC failed: %v", err)
+	}
+	fmt.Printf("Removed %d files (%s)\n", result.Removed, ttsscript.FormatByteSize(result.FreedBytes))
+	fmt.Printf("Cache: %d files (%s) in %s\n", result.Remaining.Files, ttsscript.FormatByteSize(result.Remaining.Bytes), *dir)
+}
+
+// audioCacheKey hashes everything in a request that affects the generated
+// audio. Unlike the manifest hash, it covers the request as it is sent,
+// including the voice settings from the -preset flag.
+func audioCacheKey(req *elevenlabs.TTSRequest) string {
+	return req.RequestKey()
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runCoverage implements "ttsscript coverage": it reports which languages
+// each pronunciation term is defined for and how often it is used, to
+// prune dead entries and find missing localized pronunciations.
+func runCoverage(args []string) {
+	flags := flag.NewFlagSet("coverage", flag.ExitOnError)
+	asJSON := flags.Bool("json", false, "Print coverage as JSON")
+	problems := flags.Bool("problems", false, "Only show unused terms and terms used in a language without a pronunciation")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s coverage [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Report pronunciation coverage across the script's languages: the languages\n")
+		fmt.Fprintf(os.Stderr, "each term is defined for and how often it occurs in each. Terms that never\n")
+		fmt.Fprintf(os.Stderr, "occur are marked unused; languages that use a term without a pronunciation\n")
+		fmt.Fprintf(os.Stderr, "are marked as gaps. The exit status is 1 if there are any.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	script, err := loadScript(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to load script: %v", err)
+	}
+
+	var coverage []ttsscript.PronunciationCoverage
+	found := false
+	for _, c := range ttsscript.AnalyzePronunciationCoverage(script) {
+		problem := c.Unused() || len(c.Gaps()) > 0
+		found = found || problem
+		if problem || !*problems {
+			coverage = append(coverage, c)
+		}
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(coverage, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal coverage: %v", err)
+		}
+		fmt.Println(string(data))
+	} else if len(coverage) == 0 {
+		fmt.Println("No pronunciation problems.")
+	} else {
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "TERM\tSCOPE\tDEFINED\tOCCURRENCES\tSTATUS")
+		for _, c := range coverage {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", c.Term, c.Scope, strings.Join(c.Languages, ","), formatOccurrences(c.Occurrences), coverageStatus(c))
+		}
+		tw.Flush()
+	}
+	if found {
+		os.Exit(1)
+	}
+}
+
+// formatOccurrences formats per-language counts like "en:3 es:1".
+func formatOccurrences(occurrences map[string]int) string {
+	langs := make([]string, 0, len(occurrences))
+	for lang := range occurrences {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+	parts := make([]string, len(langs))
+	for i, lang := range langs {
+		parts[i] = fmt.Sprintf("%s:%d", lang, occurrences[lang])
+	}
+	return strings.Join(parts, " ")
+}
+
+// coverageStatus describes a term's problems, or "ok".
+func coverageStatus(c ttsscript.PronunciationCoverage) string {
+	switch gaps := c.Gaps(); {
+	case c.Unused():
+		return "unused"
+	case len(gaps) > 0:
+		return "missing " + strings.Join(gaps, ",")
+	default:
+		return "ok"
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// earconPending reports whether the script's speaker change earcon is
+// used by a job and still has to be generated from its prompt.
+func earconPending(script *ttsscript.Script, jobs []ttsscript.ElevenLabsSegment, scriptDir string) bool {
+	earcon := script.SpeakerChangeEarcon
+	if earcon == nil || earcon.Prompt == "" || fileExists(resolveAssetPath(earcon.AudioFile, scriptDir)) {
+		return false
+	}
+	for _, job := range jobs {
+		if job.IsEarcon {
+			return true
+		}
+	}
+	return false
+}
+
+// generateEarcon generates the speaker change earcon from its prompt with
+// ElevenLabs sound effects and saves it to its audio file, where later
+// runs reuse it.
+func generateEarcon(ctx context.Context, earcon *ttsscript.Earcon, scriptDir string) error {
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.SoundEffects().Generate(ctx, &elevenlabs.SoundEffectRequest{
+		Text:            earcon.Prompt,
+		DurationSeconds: float64(ttsscript.ParseDuration(earcon.Duration)) / 1000,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(resp.Audio)
+	if err != nil {
+		return fmt.Errorf("reading earcon audio: %w", err)
+	}
+
+	path := resolveAssetPath(earcon.AudioFile, scriptDir)
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
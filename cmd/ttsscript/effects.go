@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/agentplexus/go-elevenlabs/audioinfo"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// applyEffects renders a segment's fades and gain into a temporary file and
+// returns its path. Entries without effects are returned unchanged.
+func applyEffects(entry ttsscript.ManifestEntry, outputDir string, slideIdx, segIdx int) (string, error) {
+	if !entry.HasEffects() {
+		return entry.OutputFile, nil
+	}
+
+	var filters []string
+	if entry.FadeInMs > 0 {
+		filters = append(filters, fmt.Sprintf("afade=t=in:st=0:d=%.3f", msToSeconds(entry.FadeInMs)))
+	}
+	if entry.FadeOutMs > 0 {
+		durationMs := entry.DurationMs
+		if durationMs == 0 {
+			info, err := audioinfo.InspectFile(entry.OutputFile)
+			if err != nil {
+				return "", fmt.Errorf("fade-out needs the audio duration: %w", err)
+			}
+			durationMs = info.DurationMs()
+		}
+		start := durationMs - entry.FadeOutMs
+		if start < 0 {
+			start = 0
+		}
+		filters = append(filters, fmt.Sprintf("afade=t=out:st=%.3f:d=%.3f", msToSeconds(start), msToSeconds(entry.FadeOutMs)))
+	}
+	if entry.GainDB != 0 {
+		filters = append(filters, fmt.Sprintf("volume=%.2fdB", entry.GainDB))
+	}
+
+	filename := filepath.Join(outputDir, fmt.Sprintf(".fx_s%02d_%02d.mp3", slideIdx, segIdx))
+	// #nosec G204 -- input and output paths are generated from the output directory flag
+	cmd := exec.Command("ffmpeg", "-y", "-i", entry.OutputFile,
+		"-af", strings.Join(filters, ","),
+		"-c:a", "libmp3lame", "-ar", "44100", "-ac", "1", filename)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("ffmpeg effects failed: %v\n%s", err, string(output))
+	}
+	return filename, nil
+}
+
+// hasCrossfade reports whether any join of a concatenation crossfades.
+func hasCrossfade(crossfadesMs []int) bool {
+	for _, ms := range crossfadesMs {
+		if ms > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// crossfadeArgs returns ffmpeg arguments that join audio inputs in order
+// into one stream labeled [out], overlapping input i with the audio before
+// it by crossfadesMs[i] milliseconds; zero joins them end to end. Inputs
+// are named relative to the output directory, where ffmpeg runs, and are
+// resampled to a common format so they can be joined.
+func crossfadeArgs(inputs []string, crossfadesMs []int) (inputArgs []string, graph string) {
+	var b strings.Builder
+	for i, input := range inputs {
+		inputArgs = append(inputArgs, "-i", filepath.Base(input))
+		fmt.Fprintf(&b, "[%d:a]aformat=sample_rates=44100:channel_layouts=mono[a%d];", i, i)
+	}
+	if len(inputs) == 1 {
+		b.WriteString("[a0]anull[out]")
+		return inputArgs, b.String()
+	}
+
+	prev := "[a0]"
+	for i := 1; i < len(inputs); i++ {
+		out := fmt.Sprintf("[j%d]", i)
+		if i == len(inputs)-1 {
+			out = "[out]"
+		}
+		if ms := crossfadesMs[i]; ms > 0 {
+			fmt.Fprintf(&b, "%s[a%d]acrossfade=d=%.3f:c1=tri:c2=tri%s", prev, i, msToSeconds(ms), out)
+		} else {
+			fmt.Fprintf(&b, "%s[a%d]concat=n=2:v=0:a=1%s", prev, i, out)
+		}
+		if out != "[out]" {
+			b.WriteString(";")
+		}
+		prev = out
+	}
+	return inputArgs, b.String()
+}
+
+// cleanupEffectFiles removes temporary effect files for a slide.
+func cleanupEffectFiles(outputDir string, slideIdx int) {
+	files, _ := filepath.Glob(filepath.Join(outputDir, fmt.Sprintf(".fx_s%02d_*.mp3", slideIdx)))
+	for _, f := range files {
+		os.Remove(f)
+	}
+}
+
+func msToSeconds(ms int) float64 {
+	return float64(ms) / 1000.0
+}
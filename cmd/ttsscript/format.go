@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// runFormat implements "ttsscript fmt": like gofmt, it rewrites scripts in
+// a canonical form so that diffs only show content changes.
+func runFormat(args []string) {
+	flags := flag.NewFlagSet("fmt", flag.ExitOnError)
+	write := flags.Bool("w", false, "Write the result to the script file instead of stdout")
+	list := flags.Bool("l", false, "List scripts whose formatting differs; exit status 1 if any")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s fmt [flags] <script.json>...\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Format scripts with sorted keys, two-space indentation, and normalized\n")
+		fmt.Fprintf(os.Stderr, "durations (\"0.5s\" becomes \"500ms\"). Comments in .jsonc files are not\n")
+		fmt.Fprintf(os.Stderr, "kept, so they are only printed, never rewritten.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	unformatted := 0
+	for _, path := range flags.Args() {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("Failed to read script: %v", err)
+		}
+		script, err := readScript(path)
+		if err != nil {
+			log.Fatalf("Failed to load %s: %v", path, err)
+		}
+		script.Canonicalize()
+		formatted, err := script.Format()
+		if err != nil {
+			log.Fatalf("Failed to format %s: %v", path, err)
+		}
+
+		changed := !bytes.Equal(data, formatted)
+		switch {
+		case *list:
+			if changed {
+				fmt.Println(path)
+				unformatted++
+			}
+		case *write:
+			if !changed {
+				continue
+			}
+			if isLenientScript(path) {
+				log.Fatalf("Not rewriting %s: formatting would drop its comments", path)
+			}
+			if err := os.WriteFile(path, formatted, 0600); err != nil {
+				log.Fatalf("Failed to write %s: %v", path, err)
+			}
+		default:
+			os.Stdout.Write(formatted)
+		}
+	}
+	if unformatted > 0 {
+		os.Exit(1)
+	}
+}
+
+// isLenientScript reports whether a script is loaded with
+// LoadScriptLenient, which accepts comments.
+func isLenientScript(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonc", ".json5":
+		return true
+	}
+	return false
+}
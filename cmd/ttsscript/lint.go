@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runLint implements "ttsscript lint": it reports text that would be charged
+// but not spoken, such as Markdown formatting, URLs, and emoji.
+func runLint(args []string) {
+	flags := flag.NewFlagSet("lint", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to analyze")
+	keep := flags.String("keep", "", "Comma-separated rules to skip ("+strings.Join(ttsscript.CleanRules, ", ")+")")
+	asJSON := flags.Bool("json", false, "Print issues as JSON")
+	modelID := flags.String("model", "eleven_multilingual_v2", "ElevenLabs model ID, for audio tag warnings")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s lint [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Report text that would be charged but not spoken. Generate with -clean to strip it.\n")
+		fmt.Fprintf(os.Stderr, "The exit status is 1 if any issue is found. Audio tags that may not work\n")
+		fmt.Fprintf(os.Stderr, "with the model are reported as warnings on stderr.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	script, err := loadScript(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to load script: %v", err)
+	}
+
+	issues := ttsscript.LintText(script, *lang, splitList(*keep)...)
+	for _, warning := range ttsscript.LintAudioTags(script, *lang, *modelID) {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal issues: %v", err)
+		}
+		fmt.Println(string(data))
+	} else if len(issues) == 0 {
+		fmt.Println("No issues: all text will be spoken.")
+	} else {
+		wasted := 0
+		for _, issue := range issues {
+			fmt.Println(issue)
+			wasted += issue.WastedChars
+		}
+		fmt.Printf("\n%d issues, about %d characters wasted\n", len(issues), wasted)
+	}
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+}
+
+// splitList splits a comma-separated flag value, ignoring empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -3,19 +3,127 @@
 // Usage:
 //
 //	ttsscript [flags] <script.json>
+//	ttsscript watch [flags] <script.json>
+//	ttsscript review [flags]
+//	ttsscript suggest [flags] <script.json>
+//	ttsscript coverage [flags] <script.json>
+//	ttsscript lint [flags] <script.json>
+//	ttsscript preview [flags] <script.json>
+//	ttsscript balance [flags] <script.json>
+//	ttsscript pauses [flags] [<script.json>]
+//	ttsscript transcripts [flags] <script.json>
+//	ttsscript restore [flags]
+//	ttsscript takes [flags] [<output-file> <take>]
+//	ttsscript preflight [flags] <script.json>
+//	ttsscript serve [flags] [output-dir]
+//	ttsscript fmt [flags] <script.json>...
+//	ttsscript cache gc [flags]
+//	ttsscript encrypt [flags] <script.json>...
+//	ttsscript decrypt [flags] <script.json>...
 //
 // Flags:
 //
 //	-lang string      Language code to generate (default "en")
 //	-output string    Output directory (default "./output")
 //	-per-slide        Concatenate segments into per-slide audio files (requires ffmpeg)
+//	-single-file      Concatenate all slides into full_<lang>.mp3 with chapter markers (requires ffmpeg)
+//	-chapters         Concatenate each chapter of a book into <chapter-id>_<lang>.mp3 (requires ffmpeg)
+//	-slide-gap string Extra silence between slides in -single-file and -chapters mode (e.g., "2s")
 //	-manifest         Generate manifest JSON file (default true)
 //	-dry-run          Show what would be generated without calling API
 //	-model string     ElevenLabs model ID (default "eleven_multilingual_v2")
+//	-fallback string  Comma-separated fallback languages for segments missing -lang
+//	-titles           Narrate every slide title, not just section headers
+//	-dest string      Publish outputs to a destination (dir, s3://bucket/prefix, gs://bucket/prefix)
+//	-slides string    Only generate these slides (e.g., "3,5-7")
+//	-segments string  Only generate these segments (e.g., "slide03:2,4:title")
+//	-only string      Only generate segments with these IDs (e.g., "id=intro,outro")
+//	-check-voices     Verify referenced voices exist before generating (default true)
+//	-strict           Fail if a segment has no text for -lang (or a fallback)
+//	-fail-on-missing  Exit with status 3 if any segment has no audio for -lang
+//	-preset string    Voice settings preset for all segments (e.g., narration, stable)
+//	-video            Mux each slide's audio with its image or a title slate into an MP4 (requires -per-slide)
+//	-video-size       Video size for -video (default "1920x1080")
+//	-slate-color      Background color for title slates (default "black")
+//	-calibrate        Learn speaking rates from generated audio into <script>.calibration.json (default true)
+//	-keep-approved    Keep audio approved in review_<lang>.json instead of regenerating it (default true)
+//	-takes int        Generate this many takes of each segment with different seeds (default 1)
+//	-tag              Write ID3 tags with the slide title, voice, and generation provenance (default true)
+//	-clean            Strip Markdown, URLs, emoji, and extra whitespace before generation
+//	-clean-keep       Comma-separated -clean rules to skip (markdown, urls, emoji, whitespace)
+//	-cache string     Shared audio cache directory, reused across scripts (default $TTSSCRIPT_CACHE)
+//	-trim             Trim leading and trailing silence from generated audio (requires ffmpeg)
+//	-trim-threshold   Level in dBFS below which -trim treats audio as silence (default -50)
+//	-trim-padding     Silence -trim keeps at each edge (default "50ms")
+//	-retry int        Retries per segment for rate limited and server errors (default 3)
+//	-max-failures int Stop the run after this many failed segments (0 for no limit)
+//	-fail-fast        Stop the run at the first failed segment
+//	-max-characters   Stop the run before it sends more than this many characters (0 for no limit)
+//	-max-segments     Stop the run before it generates more than this many segments (0 for no limit)
+//	-resume           Keep audio a previous run of this language already generated
+//
+// "ttsscript review" plays each generated file and records approve/regenerate
+// decisions in review_<lang>.json, which the next run reads.
+//
+// "ttsscript suggest" lists acronyms, brand names, and proper nouns with no
+// pronunciation rule and suggests entries for them.
+//
+// "ttsscript coverage" reports which languages each pronunciation term is
+// defined for and how often it occurs, flagging unused terms and languages
+// that use a term without a pronunciation.
+//
+// "ttsscript lint" reports text that would be charged but not spoken, such
+// as Markdown formatting, URLs, and emoji, which -clean strips.
+//
+// "ttsscript preview" prints the compiled text of each segment, with
+// pronunciations applied and pauses inline, to check before generating.
+//
+// "ttsscript balance" reports narration length per slide and flags slides
+// much longer or shorter than the median, to balance pacing.
+//
+// "ttsscript pauses" measures the silence between generated segments with
+// forced alignment and suggests, or with -apply writes, pause after values
+// that hit a target rhythm.
+//
+// "ttsscript transcripts" exports the full and per-slide transcripts and
+// WebVTT and SRT captions for a generated language, zipped with a
+// manifest, for accessibility compliance.
+//
+// "ttsscript restore" downloads missing output files from the ElevenLabs
+// history, matched by text and voice, instead of regenerating them.
+//
+// "ttsscript takes" lists the takes generated with -takes, or makes a
+// take active for concatenation and the manifest.
+//
+// "ttsscript preflight" checks that a run will succeed before starting
+// it: the API key, voices, model language support, remaining characters,
+// output directory, and ffmpeg.
+//
+// "ttsscript serve" serves a web page with each segment's text and an
+// audio player, for reviewers without local tooling.
+//
+// "ttsscript fmt" rewrites scripts with sorted keys, consistent
+// indentation, and normalized durations, so diffs only show real changes.
+//
+// "ttsscript cache gc" removes the least recently used audio from the
+// shared -cache directory until it fits -max-size.
+//
+// "ttsscript encrypt" encrypts the segment text of scripts in place with
+// the key in $TTSSCRIPT_KEY, for scripts with unreleased product
+// information kept in Git; "ttsscript decrypt" prints them decrypted.
+// Other commands decrypt encrypted scripts transparently.
+//
+// A JSON run report (report_<lang>.json) is written to the output directory.
+// The exit status is 2 if any segment failed to generate, 3 with
+// -fail-on-missing if any segment has no audio for the language, or 4 if
+// -max-failures or -fail-fast stopped the run. A stopped run still writes
+// its manifest and report, but does not concatenate or publish.
 //
 // Environment:
 //
 //	ELEVENLABS_API_KEY    Required API key for ElevenLabs
+//	TTSSCRIPT_CACHE       Default shared audio cache directory
+//	TTSSCRIPT_KEY         Key for encrypted scripts (see "ttsscript encrypt -keygen")
 package main
 
 import (
@@ -28,29 +136,151 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"sort"
 	"strings"
+	"time"
 
 	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/audioinfo"
+	"github.com/agentplexus/go-elevenlabs/id3"
 	"github.com/agentplexus/go-elevenlabs/ttsscript"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "watch" {
+		runWatch(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "review" {
+		runReview(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "suggest" {
+		runSuggest(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "coverage" {
+		runCoverage(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "lint" {
+		runLint(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "preview" {
+		runPreview(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "balance" {
+		runBalance(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "pauses" {
+		runPauses(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "transcripts" {
+		runTranscripts(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "takes" {
+		runTakes(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "restore" {
+		runRestore(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "preflight" {
+		runPreflight(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "serve" {
+		runServe(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "fmt" {
+		runFormat(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "cache" {
+		runCache(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "encrypt" {
+		runEncrypt(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "decrypt" {
+		runDecrypt(os.Args[2:])
+		return
+	}
+
 	// Parse flags
 	lang := flag.String("lang", "en", "Language code to generate")
 	outputDir := flag.String("output", "./output", "Output directory")
 	perSlide := flag.Bool("per-slide", false, "Concatenate segments into per-slide audio files (requires ffmpeg)")
+	singleFile := flag.Bool("single-file", false, "Concatenate all slides into full_<lang>.mp3 with chapter markers (requires ffmpeg)")
+	slideGap := flag.String("slide-gap", "", "Extra silence between slides in -single-file and -chapters mode (e.g., \"2s\")")
+	perChapter := flag.Bool("chapters", false, "Concatenate each chapter of a book into <chapter-id>_<lang>.mp3 (requires ffmpeg)")
 	manifest := flag.Bool("manifest", true, "Generate manifest JSON file")
 	dryRun := flag.Bool("dry-run", false, "Show what would be generated without calling API")
 	modelID := flag.String("model", "eleven_multilingual_v2", "ElevenLabs model ID")
+	fallback := flag.String("fallback", "", "Comma-separated fallback languages for segments missing -lang")
+	titles := flag.Bool("titles", false, "Narrate every slide title, not just section headers")
+	dest := flag.String("dest", "", "Publish outputs to a destination (dir, s3://bucket/prefix, gs://bucket/prefix)")
+	slidesSel := flag.String("slides", "", "Only generate these slides (e.g., \"3,5-7\")")
+	segmentsSel := flag.String("segments", "", "Only generate these segments (e.g., \"slide03:2,4:title\")")
+	onlySel := flag.String("only", "", "Only generate segments with these IDs (e.g., \"id=intro,outro\")")
+	checkVoices := flag.Bool("check-voices", true, "Verify referenced voices exist before generating")
+	strict := flag.Bool("strict", false, "Fail if a segment has no text for -lang (or a fallback)")
+	failOnMissing := flag.Bool("fail-on-missing", false, "Exit with status 3 if any segment has no audio for -lang (no text, voice, or engine)")
+	preset := flag.String("preset", "", "Voice settings preset for all segments (e.g., narration, conversational, expressive, stable)")
+	video := flag.Bool("video", false, "Mux each slide's audio with its image or a title slate into an MP4 (requires -per-slide)")
+	videoSize := flag.String("video-size", "1920x1080", "Video size for -video")
+	slateColor := flag.String("slate-color", "black", "Background color for title slates")
+	calibrate := flag.Bool("calibrate", true, "Learn speaking rates from generated audio into <script>.calibration.json")
+	keepApproved := flag.Bool("keep-approved", true, "Keep audio approved in review_<lang>.json instead of regenerating it")
+	tag := flag.Bool("tag", true, "Write ID3 tags with the slide title, voice, and generation provenance")
+	clean := flag.Bool("clean", false, "Strip Markdown, URLs, emoji, and extra whitespace before generation")
+	cleanKeep := flag.String("clean-keep", "", "Comma-separated -clean rules to skip (markdown, urls, emoji, whitespace)")
+	cacheDir := flag.String("cache", os.Getenv(cacheEnv), "Shared audio cache directory, reused across scripts (default $"+cacheEnv+")")
+	trim := flag.Bool("trim", false, "Trim leading and trailing silence from generated audio (requires ffmpeg)")
+	trimThreshold := flag.Float64("trim-threshold", audioinfo.DefaultSilenceThresholdDB, "Level in dBFS below which -trim treats audio as silence")
+	trimPadding := flag.String("trim-padding", "50ms", "Silence -trim keeps at each edge")
+	takes := flag.Int("takes", 1, "Generate this many takes of each segment with different seeds (see \"takes\")")
+	retries := flag.Int("retry", elevenlabs.DefaultBatchMaxRetries, "Retries per segment for rate limited and server errors")
+	maxFailures := flag.Int("max-failures", 0, "Stop the run after this many failed segments (0 for no limit)")
+	failFast := flag.Bool("fail-fast", false, "Stop the run at the first failed segment (same as -max-failures 1)")
+	maxCharacters := flag.Int("max-characters", 0, "Stop the run before it sends more than this many characters (0 for no limit)")
+	maxSegments := flag.Int("max-segments", 0, "Stop the run before it generates more than this many segments (0 for no limit)")
+	resume := flag.Bool("resume", false, "Keep audio a previous run of this language already generated, using its manifest")
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s watch [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s review [flags]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s suggest [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s coverage [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s lint [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s preview [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s balance [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s pauses [flags] [<script.json>]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s transcripts [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s restore [flags]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s takes [flags] [<output-file> <take>]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s preflight [flags] <script.json>\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s serve [flags] [output-dir]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s fmt [flags] <script.json>...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s cache gc [flags]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s encrypt [flags] <script.json>...\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s decrypt [flags] <script.json>...\n\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "Generate TTS audio from a JSON script file using ElevenLabs.\n\n")
 		fmt.Fprintf(os.Stderr, "Flags:\n")
 		flag.PrintDefaults()
 		fmt.Fprintf(os.Stderr, "\nEnvironment:\n")
 		fmt.Fprintf(os.Stderr, "  ELEVENLABS_API_KEY    Required API key for ElevenLabs\n")
+		fmt.Fprintf(os.Stderr, "  %s       Default shared audio cache directory\n", cacheEnv)
+		fmt.Fprintf(os.Stderr, "  %s         Key for encrypted scripts\n", scriptKeyEnv)
 	}
 
 	flag.Parse()
@@ -73,15 +303,77 @@ func main() {
 			log.Fatal("ffmpeg is required for --per-slide mode but was not found in PATH")
 		}
 	}
+	if *singleFile {
+		if _, err := exec.LookPath("ffmpeg"); err != nil {
+			log.Fatal("ffmpeg is required for -single-file mode but was not found in PATH")
+		}
+	}
+	if *trim {
+		if _, err := exec.LookPath("ffmpeg"); err != nil {
+			log.Fatal("ffmpeg is required for -trim but was not found in PATH")
+		}
+	}
+	if *perChapter {
+		if _, err := exec.LookPath("ffmpeg"); err != nil {
+			log.Fatal("ffmpeg is required for -chapters but was not found in PATH")
+		}
+	}
+	trimOpts := &audioinfo.SilenceOptions{
+		ThresholdDB: *trimThreshold,
+		Padding:     time.Duration(ttsscript.ParseDuration(*trimPadding)) * time.Millisecond,
+	}
+	if *retries < 0 || *maxFailures < 0 {
+		log.Fatal("-retry and -max-failures cannot be negative")
+	}
+	if *maxCharacters < 0 || *maxSegments < 0 {
+		log.Fatal("-max-characters and -max-segments cannot be negative")
+	}
+	budget := ttsscript.Budget{MaxCharacters: *maxCharacters, MaxSegments: *maxSegments}
+	failureLimit := *maxFailures
+	if *failFast {
+		failureLimit = 1
+	}
+	if *video {
+		if !*perSlide {
+			log.Fatal("-video requires -per-slide")
+		}
+		if w, h, ok := strings.Cut(*videoSize, "x"); !ok || w == "" || h == "" {
+			log.Fatalf("Invalid -video-size %q: expected WIDTHxHEIGHT", *videoSize)
+		}
+	}
 
-	// Load script
-	script, err := ttsscript.LoadScript(scriptPath)
+	selector, err := ttsscript.ParseSelector(*slidesSel, *segmentsSel, *onlySel)
+	if err != nil {
+		log.Fatalf("Invalid selector: %v", err)
+	}
+
+	// Resolve publish destination early so misconfiguration fails fast
+	var storage Storage
+	if *dest != "" && !*dryRun {
+		storage, err = NewStorage(*dest)
+		if err != nil {
+			log.Fatalf("Invalid destination: %v", err)
+		}
+		if err := checkPublishDest(storage, *outputDir); err != nil {
+			log.Fatalf("Invalid destination: %v", err)
+		}
+	}
+
+	// Load script; a book is flattened into one
+	script, book, err := loadScriptOrBook(scriptPath)
 	if err != nil {
 		log.Fatalf("Failed to load script: %v", err)
 	}
+	if *perChapter && book == nil {
+		log.Fatal("-chapters requires a book (a script with parts instead of slides)")
+	}
 
 	// Validate script
-	if issues := script.Validate(); len(issues) > 0 {
+	issues := script.Validate()
+	if book != nil {
+		issues = book.Validate()
+	}
+	if len(issues) > 0 {
 		log.Fatalf("Script validation failed:\n  - %s", strings.Join(issues, "\n  - "))
 	}
 
@@ -91,17 +383,65 @@ func main() {
 
 	// Compile script
 	compiler := ttsscript.NewCompiler()
-	segments, err := compiler.Compile(script, *lang)
+	compiler.IncludeSlideTitles = *titles
+	compiler.ErrOnMissingLanguage = *strict
+	compiler.Trace = true // record pronunciation substitutions in the manifest for review
+	compiler.StripInaudible = *clean
+	compiler.KeepInaudible = splitList(*cleanKeep)
+	if !*clean {
+		wasted := 0
+		for _, issue := range ttsscript.LintText(script, *lang) {
+			wasted += issue.WastedChars
+		}
+		if wasted > 0 {
+			log.Printf("Warning: about %d characters of Markdown, URLs, emoji, or whitespace will be charged but not spoken (see \"%s lint\"; use -clean to strip)", wasted, os.Args[0])
+		}
+	}
+	var compileOpts []ttsscript.CompileOption
+	if *fallback != "" {
+		compileOpts = append(compileOpts, ttsscript.WithFallback(splitList(*fallback)...))
+	}
+	result, err := compiler.CompileWithResult(script, *lang, compileOpts...)
 	if err != nil {
 		log.Fatalf("Failed to compile script: %v", err)
 	}
+	segments := result.Segments
+	for _, skip := range result.Skipped {
+		log.Printf("Warning: skipping slide %d, segment %d: %s", skip.SlideIndex+1, skip.SegmentIndex+1, skip.Reason)
+	}
 
 	// Format for ElevenLabs
 	formatter := ttsscript.NewElevenLabsFormatter()
+	formatter.ModelID = *modelID
 	jobs := formatter.Format(segments)
 
 	fmt.Printf("Generated %d TTS jobs\n\n", len(jobs))
 
+	// Pre-recorded audio is resolved relative to the script
+	scriptDir := filepath.Dir(scriptPath)
+
+	// A speaker change earcon with a prompt is generated once and reused
+	pendingEarcon := earconPending(script, jobs, scriptDir)
+	if pendingEarcon && !*dryRun {
+		earcon := script.SpeakerChangeEarcon
+		if err := generateEarcon(context.Background(), earcon, scriptDir); err != nil {
+			log.Fatalf("Failed to generate speaker change earcon: %v", err)
+		}
+		fmt.Printf("Generated speaker change earcon: %s\n\n", earcon.AudioFile)
+		pendingEarcon = false
+	}
+
+	for _, job := range jobs {
+		if job.IsPrerecorded() && !(job.IsEarcon && pendingEarcon) && !fileExists(resolveAssetPath(job.AudioFile, scriptDir)) {
+			log.Fatalf("Pre-recorded audio for slide %d not found: %s", job.SlideIndex+1, job.AudioFile)
+		}
+	}
+
+	baseSettings, err := resolvePreset(*preset, jobs)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// Create output directory
 	if err := os.MkdirAll(*outputDir, 0750); err != nil {
 		log.Fatalf("Failed to create output directory: %v", err)
@@ -110,29 +450,81 @@ func main() {
 	// Generate batch config
 	config := ttsscript.NewBatchConfig(*outputDir)
 	config.IncludeLanguageInFilename = true
+	config.ModelID = *modelID
 
 	// Generate manifest
 	manifestEntries := ttsscript.GenerateManifest(jobs, config, *lang)
 
+	// Segments a stopped run already generated are kept when resuming, and
+	// voices recorded by the last run are checked for renames and deletions
+	previous, previousErr := ttsscript.LoadManifest(filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang)))
+	var completed map[string]string
+	if *resume {
+		if previousErr != nil {
+			log.Printf("Warning: nothing to resume: %v", previousErr)
+		}
+		completed = ttsscript.CompletedHashes(previous)
+	}
+	recordedVoices := ttsscript.ManifestVoiceInfo(previous)
+
+	// Measured speaking rates from previous runs refine duration estimates
+	calibrationPath := ttsscript.CalibrationPath(scriptPath)
+	calibration, err := ttsscript.LoadCalibration(calibrationPath)
+	if err != nil {
+		calibration = ttsscript.NewCalibration()
+	}
+
 	if *dryRun {
+		if pendingEarcon {
+			fmt.Printf("Would generate speaker change earcon: %s\n", script.SpeakerChangeEarcon.AudioFile)
+		}
 		fmt.Println("Dry run - would generate:")
 		for _, entry := range manifestEntries {
+			if !selector.Matches(entry.SlideIndex, entry.SegmentIndex, entry.ID) {
+				continue
+			}
 			segType := "segment"
 			if entry.IsTitleSegment {
 				segType = "title"
+			} else if entry.IsEarcon {
+				segType = "earcon"
 			}
 			fmt.Printf("  [%s] %s\n", segType, entry.OutputFile)
+			if entry.AudioFile != "" {
+				fmt.Printf("    Pre-recorded: %s\n", entry.AudioFile)
+				continue
+			}
 			fmt.Printf("    Text: %s\n", truncate(entry.Text, 60))
 			fmt.Printf("    Voice: %s\n", entry.VoiceID)
+			if entry.Engine != "" {
+				fmt.Printf("    Engine: %s\n", entry.Engine)
+			}
 		}
 
+		fmt.Println("\nEstimated slide durations:")
+		estimates := ttsscript.EstimateSlideDurations(segments, calibration)
+		var total int
+		for slide := 0; slide < script.SlideCount(); slide++ {
+			if ms, ok := estimates[slide]; ok {
+				fmt.Printf("  Slide %d: %s\n", slide+1, (time.Duration(ms) * time.Millisecond).Round(100*time.Millisecond))
+				total += ms
+			}
+		}
+		fmt.Printf("  Total: %s\n", (time.Duration(total) * time.Millisecond).Round(time.Second))
+
 		if *perSlide {
 			fmt.Println("\nPer-slide output:")
 			slideFiles := getSlideOutputFiles(manifestEntries, config, *lang)
 			for slide, file := range slideFiles {
 				fmt.Printf("  Slide %d: %s\n", slide+1, file)
+				if *video {
+					fmt.Printf("  Slide %d: %s\n", slide+1, strings.TrimSuffix(file, ".mp3")+".mp4")
+				}
 			}
 		}
+		if *singleFile {
+			fmt.Printf("\nSingle-file output: %s\n", filepath.Join(*outputDir, fmt.Sprintf("full_%s.mp3", *lang)))
+		}
 		return
 	}
 
@@ -142,58 +534,285 @@ func main() {
 		log.Fatalf("Failed to create ElevenLabs client: %v", err)
 	}
 
+	engine := elevenlabs.NewScriptEngine(client)
+	engine.ModelID = *modelID
+	engine.VoiceSettings = baseSettings
+	engine.MaxRetries = *retries
+	engine.OnRetry = func(_ ttsscript.SegmentJob, attempt int, err error) {
+		log.Printf("  Retrying after attempt %d failed: %v", attempt, err)
+	}
+
+	// Segments routed to other engines by the script are skipped; only
+	// ElevenLabs is available here
+	router := ttsscript.NewRouter(elevenlabs.ScriptEngineName, engine)
+
 	ctx := context.Background()
 
+	// Fail fast on deleted or inaccessible voices instead of midway through the run
+	voiceNames := make(map[string]string)
+	if *checkVoices {
+		// Voices routed to other engines are not ElevenLabs voices
+		byEngine := ttsscript.ManifestsByEngine(manifestEntries, elevenlabs.ScriptEngineName)
+		voiceIDs := ttsscript.ManifestVoiceIDs(byEngine[elevenlabs.ScriptEngineName])
+		refs := voiceReferencesFromManifest(scriptPath, voiceIDs, recordedVoices)
+		audit, err := client.Voices().Audit(ctx, refs)
+		if err != nil {
+			log.Fatalf("Failed to audit voices: %v", err)
+		}
+		if !audit.OK() {
+			var lines []string
+			for _, res := range audit.Missing() {
+				lines = append(lines, describeMissingVoice(res))
+			}
+			log.Fatalf("Voices not found in account:\n  - %s", strings.Join(lines, "\n  - "))
+		}
+		for _, res := range audit.Renamed() {
+			log.Printf("Warning: voice %s was renamed from %q to %q", res.Reference.VoiceID, res.Reference.Name, res.Voice.Name)
+		}
+		for _, res := range audit.Results {
+			if res.Voice != nil {
+				voiceNames[res.Reference.VoiceID] = res.Voice.Name
+				recordedVoices[res.Reference.VoiceID] = *auditedVoiceInfo(res)
+			}
+		}
+	}
+	// Record the voices in the manifest so the next run can detect changes
+	for i := range manifestEntries {
+		if info, ok := recordedVoices[manifestEntries[i].VoiceID]; ok && manifestEntries[i].AudioFile == "" {
+			manifestEntries[i].Voice = &info
+		}
+	}
+
+	// Record every generation for billing reconciliation
+	genLog, err := elevenlabs.OpenGenerationLog(filepath.Join(*outputDir, "generations.jsonl"))
+	if err != nil {
+		log.Fatalf("Failed to open generation log: %v", err)
+	}
+	defer genLog.Close()
+
+	// Approved audio from a previous review is kept; rejected audio is regenerated
+	var review *ttsscript.Review
+	if *keepApproved {
+		review, err = loadOrNewReview(reviewPath(*outputDir, *lang), *lang)
+		if err != nil {
+			log.Fatalf("Failed to load review: %v", err)
+		}
+	}
+	reviewChanged := false
+
+	// With -takes, each segment is generated several times and the active
+	// take, chosen with "ttsscript takes", is used
+	var selection *ttsscript.TakeSelection
+	takesChanged := false
+	if *takes > 1 {
+		selection, err = loadOrNewTakeSelection(takesPath(*outputDir, *lang), *lang)
+		if err != nil {
+			log.Fatalf("Failed to load takes: %v", err)
+		}
+	}
+
+	// Identical segments in any script sharing the cache are generated once
+	var cache *ttsscript.AudioCache
+	if *cacheDir != "" {
+		cache = ttsscript.NewAudioCache(*cacheDir)
+	}
+
+	scriptHash := ttsscript.ScriptHash(script)
+
+	report := ttsscript.NewRunReport(script, *lang)
+	for _, skip := range result.Skipped {
+		report.RecordSkipped(skip.SlideIndex, skip.SegmentIndex, skip.Reason)
+	}
+
 	// Generate audio for each segment
 	generatedFiles := make([]string, 0, len(jobs))
+	var measured []ttsscript.ManifestEntry
 	for i, job := range jobs {
+		if !selector.Matches(job.SlideIndex, job.SegmentIndex, job.ID) {
+			// Keep the previous run's audio, so -resume doesn't regenerate it
+			manifestEntries[i].CarryOver(previous)
+			continue
+		}
+		if failureLimit > 0 && report.Failed >= failureLimit {
+			report.Stop(fmt.Sprintf("failure limit %d reached; segments %d-%d were not generated", failureLimit, i+1, len(jobs)))
+			log.Printf("Stopping: %s", report.Stopped)
+			break
+		}
+		if job.IsPrerecorded() {
+			outputFile := config.GenerateFilename(job, *lang)
+			fmt.Printf("[%d/%d] Copying pre-recorded %s\n", i+1, len(jobs), job.AudioFile)
+			if err := copyFile(resolveAssetPath(job.AudioFile, scriptDir), outputFile); err != nil {
+				log.Printf("  ERROR: %v", err)
+				report.RecordFailed(job, err)
+				manifestEntries[i].Status = ttsscript.EntryFailed
+				continue
+			}
+			report.RecordPrerecorded(job)
+			manifestEntries[i].Status = ttsscript.EntryComplete
+			if info, err := audioinfo.InspectFile(outputFile); err == nil {
+				manifestEntries[i].DurationMs = info.DurationMs()
+			}
+			fmt.Printf("  Saved: %s\n", outputFile)
+			continue
+		}
+		segJob := ttsscript.NewSegmentJob(job, *lang)
+		if name := router.EngineName(segJob); router.Engines[name] == nil {
+			log.Printf("Skipping segment %d: engine %q is not available", i+1, name)
+			report.RecordSkipped(job.SlideIndex, job.SegmentIndex, fmt.Sprintf("engine %q not available", name))
+			manifestEntries[i].MarkMissing(fmt.Sprintf("engine %q not available", name))
+			continue
+		}
 		if job.VoiceID == "" {
 			log.Printf("Skipping segment %d: no voice ID configured", i+1)
+			report.RecordSkipped(job.SlideIndex, job.SegmentIndex, "no voice ID configured")
+			manifestEntries[i].MarkMissing("no voice ID configured")
 			continue
 		}
 
 		outputFile := config.GenerateFilename(job, *lang)
 
+		if review != nil && review.Approved(manifestEntries[i]) && fileExists(outputFile) {
+			fmt.Printf("[%d/%d] Keeping approved %s\n", i+1, len(jobs), outputFile)
+			report.RecordApproved(job)
+			manifestEntries[i].Status = ttsscript.EntryComplete
+			if info, err := audioinfo.InspectFile(outputFile); err == nil {
+				manifestEntries[i].DurationMs = info.DurationMs()
+			}
+			continue
+		}
+
+		if h, ok := completed[outputFile]; ok && h == manifestEntries[i].Hash && selection == nil &&
+			(review == nil || review.Decision(manifestEntries[i]) == nil) && fileExists(outputFile) {
+			fmt.Printf("[%d/%d] Keeping %s from previous run\n", i+1, len(jobs), outputFile)
+			report.RecordResumed(job)
+			manifestEntries[i].Status = ttsscript.EntryComplete
+			if info, err := audioinfo.InspectFile(outputFile); err == nil {
+				manifestEntries[i].DurationMs = info.DurationMs()
+			}
+			continue
+		}
+
 		segType := "segment"
 		if job.IsTitleSegment {
 			segType = "title"
 		}
 
-		fmt.Printf("[%d/%d] Generating %s: %s\n", i+1, len(jobs), segType, truncate(job.Text, 50))
+		req := engine.Request(segJob)
 
-		resp, err := client.TextToSpeech().Generate(ctx, &elevenlabs.TTSRequest{
-			VoiceID:       job.VoiceID,
-			Text:          job.Text,
-			ModelID:       *modelID,
-			VoiceSettings: elevenlabs.DefaultVoiceSettings(),
-		})
-		if err != nil {
-			log.Printf("  ERROR: %v", err)
-			continue
+		// Audio rejected in review is regenerated, not restored from the cache
+		var cacheKey string
+		cached := false
+		if cache != nil && selection == nil {
+			cacheKey = audioCacheKey(req)
+			rejected := review != nil && review.Decision(manifestEntries[i]) != nil && !review.Approved(manifestEntries[i])
+			if !rejected {
+				cached, err = cache.Get(cacheKey, filepath.Ext(outputFile), outputFile)
+				if err != nil {
+					log.Printf("  Warning: failed to read audio cache: %v", err)
+				}
+			}
 		}
-		audio := resp.Audio
 
-		f, err := os.Create(outputFile)
-		if err != nil {
-			log.Printf("  ERROR creating file: %v", err)
-			continue
+		if !cached {
+			budgetTakes := 1
+			if selection != nil {
+				budgetTakes = *takes
+			}
+			if reason := budget.Exceeded(report, job, budgetTakes); reason != "" {
+				report.Stop(fmt.Sprintf("%s; segments %d-%d were not generated", reason, i+1, len(jobs)))
+				log.Printf("Stopping: %s (rerun with -resume to continue)", report.Stopped)
+				break
+			}
 		}
 
-		_, err = io.Copy(f, audio)
-		f.Close()
-		if err != nil {
-			log.Printf("  ERROR writing file: %v", err)
-			continue
+		if selection != nil {
+			fmt.Printf("[%d/%d] Generating %d takes of %s: %s\n", i+1, len(jobs), *takes, segType, truncate(job.Text, 50))
+			generated, active, err := generateTakes(ctx, router, engine, genLog, segJob, manifestEntries[i], selection, *takes)
+			takesChanged = true
+			if err != nil {
+				log.Printf("  ERROR: %v", err)
+				report.RecordFailed(job, err)
+				manifestEntries[i].Status = ttsscript.EntryFailed
+				continue
+			}
+			if generated > 0 {
+				report.RecordGeneratedTakes(job, generated)
+				generatedFiles = append(generatedFiles, outputFile)
+			} else {
+				report.RecordCached(job)
+			}
+			manifestEntries[i].Take = active
+			fmt.Printf("  Using take %d of %d\n", active, *takes)
+		} else if cached {
+			fmt.Printf("[%d/%d] Reusing cached %s: %s\n", i+1, len(jobs), segType, truncate(job.Text, 50))
+			report.RecordCached(job)
+		} else {
+			fmt.Printf("[%d/%d] Generating %s: %s\n", i+1, len(jobs), segType, truncate(job.Text, 50))
+
+			rec := elevenlabs.NewTTSGenerationRecord(req)
+			n, err := synthesizeToFile(ctx, router, segJob, outputFile)
+			rec.Complete(outputFile, n, err)
+			if logErr := genLog.Append(rec); logErr != nil {
+				log.Printf("  Warning: failed to record generation: %v", logErr)
+			}
+			if err != nil {
+				log.Printf("  ERROR: %v", err)
+				report.RecordFailed(job, err)
+				manifestEntries[i].Status = ttsscript.EntryFailed
+				continue
+			}
+
+			report.RecordGenerated(job)
+			generatedFiles = append(generatedFiles, outputFile)
+
+			// Cache before tagging: tags are specific to this script
+			if cache != nil {
+				if err := cache.Put(cacheKey, filepath.Ext(outputFile), outputFile); err != nil {
+					log.Printf("  Warning: failed to cache audio: %v", err)
+				}
+			}
 		}
 
-		fmt.Printf("  Saved: %s\n", outputFile)
-		generatedFiles = append(generatedFiles, outputFile)
+		manifestEntries[i].Status = ttsscript.EntryComplete
+
+		if *trim {
+			lead, trail, err := trimSilence(outputFile, trimOpts)
+			if err != nil {
+				log.Printf("  Warning: failed to trim %s: %v", outputFile, err)
+			} else {
+				manifestEntries[i].TrimmedStartMs = int(lead.Milliseconds())
+				manifestEntries[i].TrimmedEndMs = int(trail.Milliseconds())
+			}
+		}
+		if *tag {
+			if err := tagFile(outputFile, script, manifestEntries[i], voiceNames, scriptHash); err != nil {
+				log.Printf("  Warning: failed to tag %s: %v", outputFile, err)
+			}
+		}
+		if review != nil && review.Decision(manifestEntries[i]) != nil {
+			// New audio needs a new review
+			review.Clear(outputFile)
+			reviewChanged = true
+		}
+		if info, err := audioinfo.InspectFile(outputFile); err == nil {
+			manifestEntries[i].DurationMs = info.DurationMs()
+			if !cached {
+				measured = append(measured, manifestEntries[i])
+			}
+			fmt.Printf("  Saved: %s (%s)\n", outputFile, info.Duration.Round(time.Millisecond))
+		} else {
+			fmt.Printf("  Saved: %s\n", outputFile)
+		}
 	}
 
+	// The written manifest also lists segments with no text for the language
+	fullManifest := ttsscript.MergeManifest(manifestEntries, ttsscript.MissingManifestEntries(script, result.Skipped, *lang))
+	status := ttsscript.NewLocalizationStatus(fullManifest, *lang)
+
 	// Write manifest
 	if *manifest {
 		manifestPath := filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang))
-		manifestData, err := json.MarshalIndent(manifestEntries, "", "  ")
+		manifestData, err := json.MarshalIndent(fullManifest, "", "  ")
 		if err != nil {
 			log.Printf("Failed to marshal manifest: %v", err)
 		} else if err := os.WriteFile(manifestPath, manifestData, 0600); err != nil {
@@ -201,45 +820,204 @@ func main() {
 		} else {
 			fmt.Printf("\nManifest saved: %s\n", manifestPath)
 		}
+
+		// Mixed-engine scripts also get one manifest per engine
+		if byEngine := ttsscript.ManifestsByEngine(manifestEntries, elevenlabs.ScriptEngineName); len(byEngine) > 1 {
+			for name, entries := range byEngine {
+				path := filepath.Join(*outputDir, fmt.Sprintf("manifest_%s_%s.json", *lang, name))
+				data, err := json.MarshalIndent(entries, "", "  ")
+				if err == nil {
+					err = os.WriteFile(path, data, 0600)
+				}
+				if err != nil {
+					log.Printf("Failed to write %s manifest: %v", name, err)
+				} else {
+					fmt.Printf("Manifest saved: %s\n", path)
+				}
+			}
+		}
+	}
+
+	if *calibrate && calibration.ObserveManifest(measured) > 0 {
+		if err := calibration.Save(calibrationPath); err != nil {
+			log.Printf("Failed to save calibration: %v", err)
+		}
 	}
 
+	if reviewChanged {
+		if err := review.WriteJSON(reviewPath(*outputDir, *lang)); err != nil {
+			log.Printf("Failed to update review: %v", err)
+		}
+	}
+	if takesChanged {
+		if err := selection.WriteJSON(takesPath(*outputDir, *lang)); err != nil {
+			log.Printf("Failed to save takes: %v", err)
+		}
+	}
+
+	// A stopped run leaves partial output that is not worth assembling
+	stopped := report.Stopped != ""
+
 	// Concatenate per-slide if requested
-	if *perSlide {
+	if *perSlide && !stopped {
 		fmt.Println("\nConcatenating per-slide audio...")
 		concatenatePerSlide(manifestEntries, *lang, *outputDir)
+
+		if *video {
+			fmt.Println("\nRendering per-slide video...")
+			renderSlideVideos(manifestEntries, *lang, *outputDir, videoOptions{
+				Size:     *videoSize,
+				Color:    *slateColor,
+				AssetDir: filepath.Dir(scriptPath),
+			})
+		}
+	}
+
+	// Concatenate into a single track if requested
+	if *singleFile && !stopped {
+		fmt.Println("\nConcatenating single-file audio...")
+		if err := concatenateSingleFile(manifestEntries, *lang, *outputDir, script.Title, ttsscript.ParseDuration(*slideGap)); err != nil {
+			log.Printf("  Single file failed: %v", err)
+		}
+	}
+
+	// Concatenate each chapter of a book if requested
+	if *perChapter && !stopped {
+		fmt.Println("\nConcatenating per-chapter audio...")
+		concatenateChapters(book.Chapters(), manifestEntries, *lang, *outputDir, ttsscript.ParseDuration(*slideGap))
+	}
+
+	// Write run report
+	report.Finish()
+	reportPath := filepath.Join(*outputDir, fmt.Sprintf("report_%s.json", *lang))
+	if err := report.WriteJSON(reportPath); err != nil {
+		log.Printf("Failed to write report: %v", err)
+	}
+	fmt.Printf("\nRun report (%s):\n%s", reportPath, report.Summary())
+	fmt.Printf("\nLocalization %s\n", status.Badge())
+
+	// Publish outputs if requested
+	if storage != nil && !stopped {
+		fmt.Printf("\nPublishing outputs to %s...\n", *dest)
+		genLog.Close()
+		n, err := publishDir(ctx, storage, *outputDir)
+		if err != nil {
+			log.Fatalf("Failed to publish outputs: %v", err)
+		}
+		fmt.Printf("  Uploaded %d files\n", n)
 	}
 
 	fmt.Printf("\nDone! Generated %d audio files.\n", len(generatedFiles))
+
+	if stopped {
+		os.Exit(4)
+	}
+	if !report.Success() {
+		os.Exit(2)
+	}
+	if *failOnMissing && status.Missing > 0 {
+		os.Exit(3)
+	}
 }
 
-// concatenatePerSlide uses ffmpeg to concatenate segment audio files into per-slide files.
-func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir string) {
-	// Group entries by slide
-	slideSegments := make(map[int][]ttsscript.ManifestEntry)
-	for _, entry := range entries {
-		slideSegments[entry.SlideIndex] = append(slideSegments[entry.SlideIndex], entry)
+// tagFile writes an ID3 tag recording what a generated file contains and
+// which script revision it came from. Voices missing from voiceNames are
+// recorded by ID.
+func tagFile(path string, script *ttsscript.Script, entry ttsscript.ManifestEntry, voiceNames map[string]string, scriptHash string) error {
+	title := entry.SlideTitle
+	if title == "" {
+		title = entry.SegmentRef()
+	}
+	artist := voiceNames[entry.VoiceID]
+	if artist == "" {
+		artist = entry.VoiceID
+	}
+	return id3.WriteFile(path, &id3.Tag{
+		Title:   title,
+		Artist:  artist,
+		Album:   script.Title,
+		Comment: entry.Provenance(scriptHash),
+	})
+}
+
+// describeMissingVoice describes a missing voice with the name it had and
+// the suggested replacements, if any.
+func describeMissingVoice(res elevenlabs.VoiceAuditResult) string {
+	line := res.Reference.VoiceID
+	if res.Reference.Name != "" {
+		line += fmt.Sprintf(" (was %q)", res.Reference.Name)
+	}
+	if len(res.Suggestions) > 0 {
+		var names []string
+		for _, v := range res.Suggestions {
+			names = append(names, fmt.Sprintf("%s (%s)", v.Name, v.VoiceID))
+		}
+		line += " - suggestions: " + strings.Join(names, ", ")
+	}
+	return line
+}
+
+// voiceReferencesFromManifest creates references for voice IDs with the
+// name, category, and labels recorded for them by an earlier run (see
+// ttsscript.ManifestVoiceInfo), so the audit can detect renames and suggest
+// replacements for deleted voices. IDs with nothing recorded get plain
+// references.
+func voiceReferencesFromManifest(source string, voiceIDs []string, recorded map[string]ttsscript.VoiceInfo) []elevenlabs.VoiceReference {
+	refs := elevenlabs.VoiceReferencesFromIDs(source, voiceIDs...)
+	for i, ref := range refs {
+		if info, ok := recorded[ref.VoiceID]; ok {
+			refs[i].Name = info.Name
+			refs[i].Category = info.Category
+			refs[i].Labels = info.Labels
+		}
 	}
+	return refs
+}
 
-	// Get sorted slide indices
-	slideIndices := make([]int, 0, len(slideSegments))
-	for idx := range slideSegments {
-		slideIndices = append(slideIndices, idx)
+// auditedVoiceInfo returns a found voice's name, category, and labels for
+// recording in the manifest, or nil if the voice is missing.
+func auditedVoiceInfo(res elevenlabs.VoiceAuditResult) *ttsscript.VoiceInfo {
+	if res.Voice == nil {
+		return nil
 	}
-	sort.Ints(slideIndices)
+	return &ttsscript.VoiceInfo{
+		Name:     res.Voice.Name,
+		Category: string(res.Voice.Category),
+		Labels:   res.Voice.Labels,
+	}
+}
 
-	for _, slideIdx := range slideIndices {
-		segments := slideSegments[slideIdx]
+// synthesizeToFile synthesizes a job with engine and writes the audio to
+// outputFile, returning the number of bytes written.
+func synthesizeToFile(ctx context.Context, engine ttsscript.Engine, job ttsscript.SegmentJob, outputFile string) (int64, error) {
+	audio, err := engine.Synthesize(ctx, job)
+	if err != nil {
+		return 0, err
+	}
+	if err := audio.WriteFile(outputFile); err != nil {
+		return 0, err
+	}
+	return int64(len(audio.Data)), nil
+}
 
-		// Sort segments: title first (SegmentIndex -1), then by segment index
-		sort.Slice(segments, func(i, j int) bool {
-			return segments[i].SegmentIndex < segments[j].SegmentIndex
-		})
+// concatenatePerSlide uses ffmpeg to concatenate segment audio files into per-slide files.
+func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir string) {
+	// Slides in order, each with its title first and then its segments
+	for _, segments := range ttsscript.GroupManifestBySlide(entries) {
+		slideIdx := segments[0].SlideIndex
 
 		// Skip if only one segment (no need to concatenate)
 		if len(segments) == 1 {
 			// Just copy/rename to slide output
 			slideOutput := filepath.Join(outputDir, fmt.Sprintf("slide%02d_%s.mp3", slideIdx+1, language))
-			if err := copyFile(segments[0].OutputFile, slideOutput); err != nil {
+			source, err := applyEffects(segments[0], outputDir, slideIdx, 0)
+			if err != nil {
+				log.Printf("  Slide %d: failed to apply effects: %v", slideIdx+1, err)
+				source = segments[0].OutputFile
+			}
+			err = copyFile(source, slideOutput)
+			cleanupEffectFiles(outputDir, slideIdx)
+			if err != nil {
 				log.Printf("  Slide %d: failed to copy: %v", slideIdx+1, err)
 				continue
 			}
@@ -250,6 +1028,8 @@ func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir
 		// Create concat list file for ffmpeg
 		listFile := filepath.Join(outputDir, fmt.Sprintf(".concat_slide%02d.txt", slideIdx+1))
 		var listContent strings.Builder
+		var inputs []string
+		var crossfades []int
 
 		for i, seg := range segments {
 			// Add pause before (as silence) if needed
@@ -259,11 +1039,25 @@ func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir
 					log.Printf("  Warning: failed to generate silence: %v", err)
 				} else {
 					listContent.WriteString(fmt.Sprintf("file '%s'\n", filepath.Base(silenceFile)))
+					inputs = append(inputs, silenceFile)
+					crossfades = append(crossfades, 0)
 				}
 			}
 
-			// Add the audio file
-			listContent.WriteString(fmt.Sprintf("file '%s'\n", filepath.Base(seg.OutputFile)))
+			// Add the audio file, with fades and gain applied
+			audioFile, err := applyEffects(seg, outputDir, slideIdx, i)
+			if err != nil {
+				log.Printf("  Warning: failed to apply effects: %v", err)
+				audioFile = seg.OutputFile
+			}
+			listContent.WriteString(fmt.Sprintf("file '%s'\n", filepath.Base(audioFile)))
+			inputs = append(inputs, audioFile)
+			// The compiler only crossfades segments with no pause between them
+			crossfade := 0
+			if i > 0 {
+				crossfade = seg.CrossfadeMs
+			}
+			crossfades = append(crossfades, crossfade)
 
 			// Add pause after (as silence) if needed
 			if seg.PauseAfterMs > 0 {
@@ -272,6 +1066,8 @@ func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir
 					log.Printf("  Warning: failed to generate silence: %v", err)
 				} else {
 					listContent.WriteString(fmt.Sprintf("file '%s'\n", filepath.Base(silenceFile)))
+					inputs = append(inputs, silenceFile)
+					crossfades = append(crossfades, 0)
 				}
 			}
 		}
@@ -283,7 +1079,20 @@ func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir
 
 		// Run ffmpeg to concatenate
 		slideOutput := filepath.Join(outputDir, fmt.Sprintf("slide%02d_%s.mp3", slideIdx+1, language))
-		cmd := exec.Command("ffmpeg", "-y", "-f", "concat", "-safe", "0", "-i", listFile, "-c", "copy", slideOutput)
+		// Stream copy only works when all inputs share a format; otherwise re-encode
+		codecArgs := []string{"-c", "copy"}
+		if err := checkConcatInputs(inputs); err != nil {
+			log.Printf("  Slide %d: %v; re-encoding", slideIdx+1, err)
+			codecArgs = []string{"-c:a", "libmp3lame", "-ar", "44100", "-ac", "1"}
+		}
+		args := append([]string{"-y", "-f", "concat", "-safe", "0", "-i", listFile}, codecArgs...)
+		if hasCrossfade(crossfades) {
+			// Overlapping audio has to be decoded and re-encoded
+			inputArgs, graph := crossfadeArgs(inputs, crossfades)
+			args = append(append([]string{"-y"}, inputArgs...), "-filter_complex", graph, "-map", "[out]",
+				"-c:a", "libmp3lame", "-ar", "44100", "-ac", "1")
+		}
+		cmd := exec.Command("ffmpeg", append(args, slideOutput)...)
 		cmd.Dir = outputDir
 		if output, err := cmd.CombinedOutput(); err != nil {
 			log.Printf("  Slide %d: ffmpeg failed: %v\n%s", slideIdx+1, err, string(output))
@@ -293,14 +1102,43 @@ func concatenatePerSlide(entries []ttsscript.ManifestEntry, language, outputDir
 		// Clean up temp files
 		os.Remove(listFile)
 		cleanupSilenceFiles(outputDir, slideIdx)
+		cleanupEffectFiles(outputDir, slideIdx)
 
 		fmt.Printf("  Slide %d: %s (%d segments)\n", slideIdx+1, slideOutput, len(segments))
 	}
 }
 
+// checkConcatInputs verifies that all files can be concatenated without
+// re-encoding (same format, sample rate, and channels).
+func checkConcatInputs(files []string) error {
+	var first *audioinfo.Info
+	for _, file := range files {
+		info, err := audioinfo.InspectFile(file)
+		if err != nil {
+			return fmt.Errorf("cannot inspect %s: %w", filepath.Base(file), err)
+		}
+		if first == nil {
+			first = info
+			continue
+		}
+		if !first.Compatible(info) {
+			return fmt.Errorf("%s (%s) does not match %s (%s)", filepath.Base(file), info, filepath.Base(files[0]), first)
+		}
+	}
+	return nil
+}
+
 // generateSilence creates a silent audio file of the specified duration.
 func generateSilence(outputDir string, durationMs, slideIdx, segIdx int, position string) (string, error) {
 	filename := filepath.Join(outputDir, fmt.Sprintf(".silence_s%02d_%02d_%s.mp3", slideIdx, segIdx, position))
+	if err := generateSilenceFile(filename, durationMs); err != nil {
+		return "", err
+	}
+	return filename, nil
+}
+
+// generateSilenceFile writes a silent audio file of the specified duration.
+func generateSilenceFile(filename string, durationMs int) error {
 	duration := float64(durationMs) / 1000.0
 
 	// #nosec G204 -- filename is constructed from user-controlled outputDir flag, which is intentional for CLI tools
@@ -309,10 +1147,9 @@ func generateSilence(outputDir string, durationMs, slideIdx, segIdx int, positio
 		"-c:a", "libmp3lame", "-q:a", "9", filename)
 
 	if output, err := cmd.CombinedOutput(); err != nil {
-		return "", fmt.Errorf("ffmpeg silence generation failed: %v\n%s", err, string(output))
+		return fmt.Errorf("ffmpeg silence generation failed: %v\n%s", err, string(output))
 	}
-
-	return filename, nil
+	return nil
 }
 
 // cleanupSilenceFiles removes temporary silence files for a slide.
@@ -324,6 +1161,74 @@ func cleanupSilenceFiles(outputDir string, slideIdx int) {
 	}
 }
 
+// resolvePreset returns the base voice settings for the -preset flag and
+// verifies that every preset referenced by the jobs exists.
+func resolvePreset(name string, jobs []ttsscript.ElevenLabsSegment) (*elevenlabs.VoiceSettings, error) {
+	unknown := func(n string) error {
+		return fmt.Errorf("unknown voice settings preset %q (available: %s)", n, strings.Join(elevenlabs.VoiceSettingsPresetNames(), ", "))
+	}
+	var base *elevenlabs.VoiceSettings
+	if name != "" {
+		preset, ok := elevenlabs.VoiceSettingsPreset(name)
+		if !ok {
+			return nil, unknown(name)
+		}
+		base = preset
+	}
+	for _, job := range jobs {
+		if job.VoiceSettings == nil || job.VoiceSettings.Preset == "" {
+			continue
+		}
+		if _, ok := elevenlabs.VoiceSettingsPreset(job.VoiceSettings.Preset); !ok {
+			return nil, unknown(job.VoiceSettings.Preset)
+		}
+	}
+	return base, nil
+}
+
+// loadScript loads a script, accepting comments and trailing commas in
+// .jsonc and .json5 files. Encrypted segment text is decrypted with the
+// key in $TTSSCRIPT_KEY.
+func loadScript(path string) (*ttsscript.Script, error) {
+	script, err := readScript(path)
+	if err != nil {
+		return nil, err
+	}
+	return decryptScript(script)
+}
+
+// decryptScript decrypts a loaded script's encrypted segment text with
+// the key in $TTSSCRIPT_KEY. Scripts without encrypted text are returned
+// as they are.
+func decryptScript(script *ttsscript.Script) (*ttsscript.Script, error) {
+	if !script.IsEncrypted() {
+		return script, nil
+	}
+	key, err := scriptKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := script.Decrypt(key); err != nil {
+		return nil, err
+	}
+	return script, nil
+}
+
+// readScript loads a script as it is on disk, leaving encrypted text
+// encrypted.
+func readScript(path string) (*ttsscript.Script, error) {
+	if isLenientScript(path) {
+		return ttsscript.LoadScriptLenient(path)
+	}
+	return ttsscript.LoadScript(path)
+}
+
+// fileExists returns true if path exists and is a regular file.
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
 // copyFile copies a file from src to dst.
 func copyFile(src, dst string) error {
 	in, err := os.Open(src)
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/audioinfo"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// markupTag matches SSML and other tags in manifest text, which aren't
+// spoken and would confuse alignment.
+var markupTag = regexp.MustCompile(`<[^>]*>`)
+
+// runPauses implements "ttsscript pauses": it aligns each generated file
+// with its text, measures the silence between segments, and suggests (or,
+// with -apply, writes) pause after values that hit a target rhythm.
+func runPauses(args []string) {
+	flags := flag.NewFlagSet("pauses", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to analyze")
+	outputDir := flags.String("output", "./output", "Output directory containing the manifest")
+	target := flags.Duration("target", 0, "Silence wanted between segments (default: the narrator's median sentence gap)")
+	tolerance := flags.Duration("tolerance", ttsscript.DefaultPauseToleranceMs*time.Millisecond, "Leave gaps within this much of the target")
+	apply := flags.Bool("apply", false, "Write the suggested pauses to the script")
+	asJSON := flags.Bool("json", false, "Print the suggestions as JSON")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s pauses [flags] [<script.json>]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Measure the silence between generated segments with forced alignment and suggest\n")
+		fmt.Fprintf(os.Stderr, "pause after values that hit a target rhythm. The script is needed with -apply.\n")
+		fmt.Fprintf(os.Stderr, "Alignment is billed per file.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if *apply && flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+	if *apply && isLenientScript(flags.Arg(0)) {
+		log.Fatalf("Not rewriting %s: saving would drop its comments", flags.Arg(0))
+	}
+
+	manifestPath := filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang))
+	entries, err := ttsscript.LoadManifest(manifestPath)
+	if err != nil {
+		log.Fatalf("Failed to load manifest: %v", err)
+	}
+
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	timings := make(map[string]ttsscript.SpeechTiming)
+	for _, e := range entries {
+		text := strings.TrimSpace(markupTag.ReplaceAllString(e.Text, ""))
+		if e.IsEarcon || e.Status != ttsscript.EntryComplete || text == "" {
+			continue
+		}
+		timing, err := alignTiming(ctx, client, e.OutputFile, text)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Fatalf("Interrupted: %v", ctx.Err())
+			}
+			log.Printf("Skipping %s: %v", e.OutputFile, err)
+			continue
+		}
+		timings[e.OutputFile] = timing
+	}
+
+	suggestions, targetMs := ttsscript.SuggestPauses(entries, timings, ttsscript.PauseTuning{
+		TargetMs:    int(target.Milliseconds()),
+		ToleranceMs: int(tolerance.Milliseconds()),
+	})
+	if targetMs == 0 {
+		log.Fatal("No sentence gaps to take a target from; set -target")
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(suggestions, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal suggestions: %v", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "SLIDE\tSEGMENT\tID\tGAP\tPAUSE AFTER\tSUGGESTED")
+		for _, s := range suggestions {
+			segment := fmt.Sprint(s.SegmentIndex + 1)
+			if s.SegmentIndex < 0 {
+				segment = "title"
+			}
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%dms\t%dms\t%dms\n",
+				s.SlideIndex+1, segment, s.ID, s.GapMs, s.PauseAfterMs, s.SuggestedMs)
+		}
+		tw.Flush()
+		fmt.Printf("\nTarget gap: %dms. %d of %d files aligned, %d pauses to adjust.\n",
+			targetMs, len(timings), len(entries), len(suggestions))
+	}
+
+	if !*apply || len(suggestions) == 0 {
+		return
+	}
+	path := flags.Arg(0)
+	script, err := readScript(path)
+	if err != nil {
+		log.Fatalf("Failed to load %s: %v", path, err)
+	}
+	n := ttsscript.ApplyPauseSuggestions(script, suggestions)
+	if err := script.Save(path); err != nil {
+		log.Fatalf("Failed to save %s: %v", path, err)
+	}
+	fmt.Printf("Updated %d pauses in %s; regenerate to hear them.\n", n, path)
+}
+
+// alignTiming aligns an output file with its text and measures where the
+// speech is.
+func alignTiming(ctx context.Context, client *elevenlabs.Client, path, text string) (ttsscript.SpeechTiming, error) {
+	info, err := audioinfo.InspectFile(path)
+	if err != nil {
+		return ttsscript.SpeechTiming{}, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return ttsscript.SpeechTiming{}, err
+	}
+	defer f.Close()
+
+	resp, err := client.ForcedAlignment().AlignFile(ctx, f, filepath.Base(path), text)
+	if err != nil {
+		return ttsscript.SpeechTiming{}, err
+	}
+	words := make([]ttsscript.AlignedWord, len(resp.Words))
+	for i, w := range resp.Words {
+		words[i] = ttsscript.AlignedWord{
+			Text:    w.Text,
+			StartMs: int(w.Start * 1000),
+			EndMs:   int(w.End * 1000),
+		}
+	}
+	return ttsscript.NewSpeechTiming(words, info.DurationMs()), nil
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// preflightCheck is the outcome of one preflight check. Fix says what to do
+// when it failed.
+type preflightCheck struct {
+	Name   string
+	OK     bool
+	Detail string
+	Fix    string
+}
+
+// preflight collects check results.
+type preflight struct {
+	checks []preflightCheck
+}
+
+func (p *preflight) pass(name, detail string) {
+	p.checks = append(p.checks, preflightCheck{Name: name, OK: true, Detail: detail})
+}
+
+func (p *preflight) fail(name, detail, fix string) {
+	p.checks = append(p.checks, preflightCheck{Name: name, Detail: detail, Fix: fix})
+}
+
+func (p *preflight) failed() int {
+	n := 0
+	for _, c := range p.checks {
+		if !c.OK {
+			n++
+		}
+	}
+	return n
+}
+
+// runPreflight implements "ttsscript preflight": it checks everything a
+// generation run needs, such as the API key, voices, model language
+// support, remaining characters, and ffmpeg, and reports a single pass or
+// fail with what to fix.
+func runPreflight(args []string) {
+	flags := flag.NewFlagSet("preflight", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to generate")
+	outputDir := flags.String("output", "./output", "Output directory")
+	modelID := flags.String("model", elevenlabs.DefaultModelID, "ElevenLabs model ID")
+	fallback := flags.String("fallback", "", "Comma-separated fallback languages for segments missing -lang")
+	titles := flags.Bool("titles", false, "Narrate every slide title, not just section headers")
+	clean := flags.Bool("clean", false, "Strip Markdown, URLs, emoji, and extra whitespace before generation")
+	perSlide := flags.Bool("per-slide", false, "The run will concatenate per-slide audio (requires ffmpeg)")
+	singleFile := flags.Bool("single-file", false, "The run will concatenate a single file (requires ffmpeg)")
+	trim := flags.Bool("trim", false, "The run will trim silence (requires ffmpeg)")
+	video := flags.Bool("video", false, "The run will render slide videos (requires ffmpeg)")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s preflight [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Check that a run will succeed before starting it: the script is valid, the\n")
+		fmt.Fprintf(os.Stderr, "API key is set, the voices exist, the models support the language, enough\n")
+		fmt.Fprintf(os.Stderr, "characters remain, the output directory is writable, and ffmpeg is installed\n")
+		fmt.Fprintf(os.Stderr, "if the run needs it. Pass the same flags as the run. The exit status is 1 if\n")
+		fmt.Fprintf(os.Stderr, "any check fails.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+	scriptPath := flags.Arg(0)
+
+	p := &preflight{}
+	jobs := preflightScript(p, scriptPath, *lang, *fallback, *titles, *clean)
+	preflightAPI(p, scriptPath, jobs, *lang, *modelID, *outputDir)
+	preflightOutput(p, *outputDir)
+	if *perSlide || *singleFile || *trim || *video {
+		if path, err := exec.LookPath("ffmpeg"); err != nil {
+			p.fail("ffmpeg", "not found in PATH", "install ffmpeg, or run without -per-slide, -single-file, -trim, and -video")
+		} else {
+			p.pass("ffmpeg", path)
+		}
+	}
+
+	for _, c := range p.checks {
+		status := "ok  "
+		if !c.OK {
+			status = "FAIL"
+		}
+		fmt.Printf("[%s] %s: %s\n", status, c.Name, c.Detail)
+		if c.Fix != "" {
+			fmt.Printf("       -> %s\n", c.Fix)
+		}
+	}
+	if n := p.failed(); n > 0 {
+		fmt.Printf("\nPreflight FAILED: %d of %d checks failed.\n", n, len(p.checks))
+		os.Exit(1)
+	}
+	fmt.Printf("\nPreflight passed: %d checks.\n", len(p.checks))
+}
+
+// preflightScript loads, validates, and compiles the script, returning the
+// jobs to generate, or nil if the script can't be used.
+func preflightScript(p *preflight, scriptPath, lang, fallback string, titles, clean bool) []ttsscript.ElevenLabsSegment {
+	script, book, err := loadScriptOrBook(scriptPath)
+	if err != nil {
+		p.fail("script", err.Error(), "fix the script file")
+		return nil
+	}
+	issues := script.Validate()
+	if book != nil {
+		issues = book.Validate()
+	}
+	if len(issues) > 0 {
+		p.fail("script", strings.Join(issues, "; "), "fix the validation issues")
+		return nil
+	}
+
+	compiler := ttsscript.NewCompiler()
+	compiler.IncludeSlideTitles = titles
+	compiler.StripInaudible = clean
+	var opts []ttsscript.CompileOption
+	if fallback != "" {
+		opts = append(opts, ttsscript.WithFallback(splitList(fallback)...))
+	}
+	result, err := compiler.CompileWithResult(script, lang, opts...)
+	if err != nil {
+		p.fail("script", err.Error(), "fix the script or pass -fallback")
+		return nil
+	}
+	jobs := ttsscript.NewElevenLabsFormatter().Format(result.Segments)
+	if len(result.Skipped) > 0 {
+		p.fail("script", fmt.Sprintf("%d segments have no text for %q", len(result.Skipped), lang),
+			fmt.Sprintf("add %q text to every segment, or pass -fallback", lang))
+	} else {
+		p.pass("script", fmt.Sprintf("%d slides, %d segments", script.SlideCount(), len(jobs)))
+	}
+
+	scriptDir := filepath.Dir(scriptPath)
+	pendingEarcon := earconPending(script, jobs, scriptDir)
+	var missing []string
+	for _, job := range jobs {
+		if job.IsPrerecorded() && !(job.IsEarcon && pendingEarcon) && !fileExists(resolveAssetPath(job.AudioFile, scriptDir)) {
+			missing = append(missing, job.AudioFile)
+		} else if !job.IsPrerecorded() && job.VoiceID == "" {
+			missing = append(missing, fmt.Sprintf("voice for slide %d, segment %d", job.SlideIndex+1, job.SegmentIndex+1))
+		}
+	}
+	if len(missing) > 0 {
+		p.fail("inputs", "missing "+strings.Join(missing, ", "), "add the pre-recorded audio files and voice IDs to the script")
+	}
+	return jobs
+}
+
+// preflightAPI checks the API key and, with it, the voices, models, and
+// remaining characters of the ElevenLabs jobs.
+func preflightAPI(p *preflight, scriptPath string, jobs []ttsscript.ElevenLabsSegment, lang, defaultModel, outputDir string) {
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		p.fail("api key", "ELEVENLABS_API_KEY is not set", "export ELEVENLABS_API_KEY (voices, models, and quota were not checked)")
+		return
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		p.fail("api key", err.Error(), "check ELEVENLABS_API_KEY")
+		return
+	}
+	ctx := context.Background()
+
+	chars := 0
+	voiceSet := make(map[string]bool)
+	modelSet := make(map[string]bool)
+	for _, job := range jobs {
+		if job.IsPrerecorded() || job.VoiceID == "" || (job.Engine != "" && job.Engine != elevenlabs.ScriptEngineName) {
+			continue
+		}
+		chars += utf8.RuneCountInString(job.Text)
+		voiceSet[job.VoiceID] = true
+		model := job.ModelID
+		if model == "" {
+			model = defaultModel
+		}
+		modelSet[model] = true
+	}
+
+	remaining, err := client.User().GetCharactersRemaining(ctx)
+	if err != nil {
+		p.fail("api key", err.Error(), "check that ELEVENLABS_API_KEY is valid and has the user_read permission")
+		return
+	}
+	p.pass("api key", "valid")
+	if chars > remaining {
+		p.fail("characters", fmt.Sprintf("about %d needed, %d remaining", chars, remaining),
+			"upgrade the plan, wait for the quota to reset, or generate fewer slides with -slides")
+	} else {
+		p.pass("characters", fmt.Sprintf("about %d needed, %d remaining", chars, remaining))
+	}
+
+	// Voices recorded by the last run are checked for renames, and give
+	// suggestions for deleted voices
+	voiceIDs := sortedKeys(voiceSet)
+	previous, _ := ttsscript.LoadManifest(filepath.Join(outputDir, fmt.Sprintf("manifest_%s.json", lang)))
+	refs := voiceReferencesFromManifest(scriptPath, voiceIDs, ttsscript.ManifestVoiceInfo(previous))
+	audit, err := client.Voices().Audit(ctx, refs)
+	switch {
+	case err != nil:
+		p.fail("voices", err.Error(), "check that the API key can read voices")
+	case !audit.OK():
+		var missing []string
+		for _, res := range audit.Missing() {
+			missing = append(missing, describeMissingVoice(res))
+		}
+		p.fail("voices", "not found: "+strings.Join(missing, "; "), "add the voices to the account or change them in the script")
+	default:
+		detail := fmt.Sprintf("%d voices found", len(voiceIDs))
+		var renamed []string
+		for _, res := range audit.Renamed() {
+			renamed = append(renamed, fmt.Sprintf("%s renamed from %q to %q", res.Reference.VoiceID, res.Reference.Name, res.Voice.Name))
+		}
+		if len(renamed) > 0 {
+			detail += " (" + strings.Join(renamed, ", ") + ")"
+		}
+		p.pass("voices", detail)
+	}
+
+	models, err := client.Models().List(ctx)
+	if err != nil {
+		p.fail("models", err.Error(), "check that the API key can read models")
+		return
+	}
+	byID := make(map[string]*elevenlabs.Model, len(models))
+	for _, m := range models {
+		byID[m.ModelID] = m
+	}
+	primary, _, _ := strings.Cut(lang, "-")
+	var problems []string
+	for _, id := range sortedKeys(modelSet) {
+		m := byID[id]
+		switch {
+		case m == nil:
+			problems = append(problems, id+" not found")
+		case !m.CanDoTextToSpeech:
+			problems = append(problems, id+" does not support text-to-speech")
+		case len(m.Languages) > 0 && !m.SupportsLanguage(lang) && !m.SupportsLanguage(primary):
+			problems = append(problems, fmt.Sprintf("%s does not support %q", id, lang))
+		}
+	}
+	if len(problems) > 0 {
+		p.fail("models", strings.Join(problems, "; "), "choose a multilingual model with -model or the script's default_model")
+	} else {
+		p.pass("models", strings.Join(sortedKeys(modelSet), ", "))
+	}
+}
+
+// preflightOutput checks that the output directory can be created and
+// written to.
+func preflightOutput(p *preflight, outputDir string) {
+	fix := "choose a writable directory with -output"
+	if err := os.MkdirAll(outputDir, 0750); err != nil {
+		p.fail("output", err.Error(), fix)
+		return
+	}
+	f, err := os.CreateTemp(outputDir, ".preflight-*")
+	if err != nil {
+		p.fail("output", err.Error(), fix)
+		return
+	}
+	f.Close()
+	if err := os.Remove(f.Name()); err != nil {
+		log.Printf("Warning: failed to remove %s: %v", f.Name(), err)
+	}
+	p.pass("output", outputDir+" is writable")
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runPreview implements "ttsscript preview": it prints the fully compiled
+// text of each segment, so authors can check what will be spoken before
+// spending credits.
+func runPreview(args []string) {
+	flags := flag.NewFlagSet("preview", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to preview")
+	fallback := flags.String("fallback", "", "Comma-separated fallback languages for segments missing -lang")
+	titles := flags.Bool("titles", false, "Narrate every slide title, not just section headers")
+	clean := flags.Bool("clean", false, "Strip Markdown, URLs, emoji, and extra whitespace, as generation would")
+	cleanKeep := flags.String("clean-keep", "", "Comma-separated -clean rules to skip (markdown, urls, emoji, whitespace)")
+	output := flags.String("o", "", "Write the preview to a file instead of stdout")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s preview [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Print the compiled text of each segment, with pronunciations applied and pauses inline.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+	scriptPath := flags.Arg(0)
+	// Flags may also follow the script path
+	_ = flags.Parse(flags.Args()[1:])
+
+	script, err := loadScript(scriptPath)
+	if err != nil {
+		log.Fatalf("Failed to load script: %v", err)
+	}
+
+	compiler := ttsscript.NewCompiler()
+	compiler.IncludeSlideTitles = *titles
+	compiler.StripInaudible = *clean
+	compiler.KeepInaudible = splitList(*cleanKeep)
+	var compileOpts []ttsscript.CompileOption
+	if *fallback != "" {
+		compileOpts = append(compileOpts, ttsscript.WithFallback(splitList(*fallback)...))
+	}
+	result, err := compiler.CompileWithResult(script, *lang, compileOpts...)
+	if err != nil {
+		log.Fatalf("Failed to compile script: %v", err)
+	}
+	for _, skip := range result.Skipped {
+		log.Printf("Warning: skipping slide %d, segment %d: %s", skip.SlideIndex+1, skip.SegmentIndex+1, skip.Reason)
+	}
+
+	preview := ttsscript.PreviewText(result.Segments)
+	if *output == "" {
+		fmt.Print(preview)
+		return
+	}
+	if err := os.WriteFile(*output, []byte(preview), 0600); err != nil {
+		log.Fatalf("Failed to write preview: %v", err)
+	}
+	fmt.Printf("Preview saved: %s\n", *output)
+}
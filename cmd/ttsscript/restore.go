@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runRestore implements "ttsscript restore": it downloads lost output files
+// from the ElevenLabs history instead of paying to regenerate them.
+func runRestore(args []string) {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code of the manifest to restore")
+	outputDir := flags.String("output", "./output", "Output directory containing manifest_<lang>.json")
+	maxItems := flags.Int("max-items", elevenlabs.DefaultHistoryRestoreMaxItems, "Number of most recent history items to search")
+	modelID := flags.String("model", "", "Only restore audio generated with this model")
+	overwrite := flags.Bool("overwrite", false, "Restore entries whose output file exists")
+	dryRun := flags.Bool("dry-run", false, "Show what would be restored without downloading")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s restore [flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Download missing output files from ElevenLabs history, matched by text and voice,\n")
+		fmt.Fprintf(os.Stderr, "instead of regenerating them.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	manifestPath := filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang))
+	entries, err := ttsscript.LoadManifest(manifestPath)
+	if err != nil {
+		log.Fatalf("Failed to load manifest: %v", err)
+	}
+
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	verb := "Restored"
+	if *dryRun {
+		verb = "Would restore"
+	}
+	report, err := client.History().Restore(ctx, entries, &elevenlabs.HistoryRestoreOptions{
+		MaxItems:  *maxItems,
+		ModelID:   *modelID,
+		Overwrite: *overwrite,
+		DryRun:    *dryRun,
+		OnRestore: func(r elevenlabs.RestoredEntry) {
+			fmt.Printf("%s %s from %s\n", verb, r.Entry.OutputFile, r.HistoryItemID)
+		},
+	})
+	if err != nil {
+		log.Fatalf("Restore failed: %v", err)
+	}
+
+	for _, e := range report.NotFound {
+		fmt.Printf("Not in history: %s (%s)\n", e.OutputFile, truncate(e.Text, 50))
+	}
+	fmt.Printf("\n%s %d files, %d not found, %d already present (searched %d history items).\n",
+		verb, len(report.Restored), len(report.NotFound), report.Existing, report.Searched)
+	if len(report.NotFound) > 0 {
+		fmt.Printf("Run %s to generate the rest.\n", os.Args[0])
+	}
+}
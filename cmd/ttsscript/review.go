@@ -0,0 +1,189 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/audioinfo"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// reviewPath returns the review file path for a language.
+func reviewPath(outputDir, lang string) string {
+	return filepath.Join(outputDir, fmt.Sprintf("review_%s.json", lang))
+}
+
+// loadOrNewReview loads the review file, or returns an empty review if it
+// does not exist.
+func loadOrNewReview(path, lang string) (*ttsscript.Review, error) {
+	review, err := ttsscript.LoadReview(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ttsscript.NewReview(lang), nil
+	}
+	return review, err
+}
+
+// runReview implements "ttsscript review": it steps through manifest
+// entries, plays each file, and records approve/regenerate decisions.
+func runReview(args []string) {
+	flags := flag.NewFlagSet("review", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to review")
+	outputDir := flags.String("output", "./output", "Output directory containing the manifest")
+	all := flags.Bool("all", false, "Review all entries, including ones already decided")
+	play := flags.Bool("play", true, "Play each file (requires ffplay or afplay)")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s review [flags]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Review generated audio and record approve/regenerate decisions.\n")
+		fmt.Fprintf(os.Stderr, "Decisions are saved to review_<lang>.json and used by the next run:\n")
+		fmt.Fprintf(os.Stderr, "approved audio is kept, everything else is regenerated.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	manifestPath := filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang))
+	entries, err := ttsscript.LoadManifest(manifestPath)
+	if err != nil {
+		log.Fatalf("Failed to load manifest: %v", err)
+	}
+
+	path := reviewPath(*outputDir, *lang)
+	review, err := loadOrNewReview(path, *lang)
+	if err != nil {
+		log.Fatalf("Failed to load review: %v", err)
+	}
+
+	var todo []ttsscript.ManifestEntry
+	if *all {
+		for _, e := range entries {
+			if e.Available() {
+				todo = append(todo, e)
+			}
+		}
+	} else {
+		todo = review.Pending(entries)
+	}
+	if len(todo) == 0 {
+		fmt.Printf("Nothing to review: all %d entries have decisions (use -all to review again).\n", len(entries))
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	r := &reviewer{
+		in:     bufio.NewReader(os.Stdin),
+		out:    os.Stdout,
+		review: review,
+		path:   path,
+		play:   *play,
+	}
+	approved, regenerate := r.run(ctx, todo)
+	fmt.Printf("\nReviewed %d of %d: %d approved, %d to regenerate. Saved %s\n",
+		approved+regenerate, len(todo), approved, regenerate, path)
+}
+
+// reviewer holds the state of an interactive review session.
+type reviewer struct {
+	in     *bufio.Reader
+	out    io.Writer
+	review *ttsscript.Review
+	path   string
+	play   bool
+}
+
+// run reviews entries in order and returns the number of decisions made.
+// The review file is saved after every decision so quitting loses nothing.
+func (r *reviewer) run(ctx context.Context, entries []ttsscript.ManifestEntry) (approved, regenerate int) {
+	for i, entry := range entries {
+		r.show(i, len(entries), entry)
+		if r.play {
+			playAudio(ctx, entry.OutputFile)
+		}
+
+		for {
+			answer, ok := r.prompt("[a]pprove, [r]egenerate, [p]lay again, [s]kip, [q]uit: ")
+			if !ok {
+				return approved, regenerate
+			}
+			switch strings.ToLower(answer) {
+			case "a", "approve":
+				r.decide(entry, ttsscript.DecisionApprove, "")
+				approved++
+			case "r", "regenerate":
+				note, _ := r.prompt("  Note (optional): ")
+				r.decide(entry, ttsscript.DecisionRegenerate, note)
+				regenerate++
+			case "p", "play":
+				playAudio(ctx, entry.OutputFile)
+				continue
+			case "s", "skip", "":
+			case "q", "quit":
+				return approved, regenerate
+			default:
+				continue
+			}
+			break
+		}
+		if ctx.Err() != nil {
+			return approved, regenerate
+		}
+	}
+	return approved, regenerate
+}
+
+// show prints an entry: file, duration, text, and pronunciation substitutions.
+func (r *reviewer) show(i, n int, entry ttsscript.ManifestEntry) {
+	label := "segment"
+	if entry.IsTitleSegment {
+		label = "title"
+	}
+	fmt.Fprintf(r.out, "\n[%d/%d] Slide %d %s: %s", i+1, n, entry.SlideIndex+1, label, filepath.Base(entry.OutputFile))
+	if info, err := audioinfo.InspectFile(entry.OutputFile); err == nil {
+		fmt.Fprintf(r.out, " (%s)", info.Duration.Round(time.Millisecond))
+	} else {
+		fmt.Fprintf(r.out, " (missing audio)")
+	}
+	fmt.Fprintln(r.out)
+	if entry.SlideTitle != "" {
+		fmt.Fprintf(r.out, "  Slide: %s\n", entry.SlideTitle)
+	}
+	fmt.Fprintf(r.out, "  Voice: %s\n", entry.VoiceID)
+	fmt.Fprintf(r.out, "  Text:  %s\n", entry.Text)
+	for _, hit := range entry.Pronunciations {
+		fmt.Fprintf(r.out, "    %q -> %q (%s)\n", hit.Matched, hit.Replacement, hit.Source)
+	}
+	if d := r.review.Decision(entry); d != nil {
+		fmt.Fprintf(r.out, "  Previous decision: %s %s\n", d.Decision, d.Note)
+	}
+}
+
+// decide records a decision and saves the review file.
+func (r *reviewer) decide(entry ttsscript.ManifestEntry, decision, note string) {
+	r.review.Set(entry, decision, note, time.Now().UTC())
+	if err := r.review.WriteJSON(r.path); err != nil {
+		log.Printf("  Failed to save review: %v", err)
+	}
+}
+
+// prompt prints a prompt and returns the trimmed answer. ok is false at
+// end of input.
+func (r *reviewer) prompt(text string) (answer string, ok bool) {
+	fmt.Fprint(r.out, text)
+	line, err := r.in.ReadString('\n')
+	if err != nil && line == "" {
+		return "", false
+	}
+	return strings.TrimSpace(line), true
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// servePage is the preview page: one section per slide with each
+// segment's text and an audio player.
+var servePage = template.Must(template.New("serve").Parse(`<!DOCTYPE html>
+<html lang="{{.Lang}}">
+<head>
+<meta charset="utf-8">
+<title>Narration preview ({{.Lang}})</title>
+<style>
+body { font-family: system-ui, sans-serif; max-width: 52rem; margin: 2rem auto; padding: 0 1rem; color: #222; }
+nav a { margin-right: .75rem; }
+nav a.current { font-weight: bold; }
+section { border-top: 1px solid #ddd; padding: .5rem 0; }
+.segment { margin: .75rem 0; }
+.segment p { margin: .25rem 0; white-space: pre-wrap; }
+.meta { color: #777; font-size: .85rem; }
+.missing { color: #b00; }
+audio { width: 100%; }
+</style>
+</head>
+<body>
+<nav>{{range .Languages}}<a href="/?lang={{.}}"{{if eq . $.Lang}} class="current"{{end}}>{{.}}</a>{{end}}</nav>
+<h1>Narration preview ({{.Lang}})</h1>
+{{range .Slides}}
+<section>
+<h2>Slide {{.Number}}{{if .Title}}: {{.Title}}{{end}}</h2>
+{{range .Segments}}
+<div class="segment">
+<div class="meta">{{.Label}}{{if .VoiceID}} &middot; voice {{.VoiceID}}{{end}}{{if .Take}} &middot; take {{.Take}}{{end}}</div>
+<p>{{.Text}}</p>
+{{if .Missing}}<div class="missing">{{.Missing}}</div>{{else}}<audio controls preload="none" src="{{.AudioURL}}"></audio>{{end}}
+</div>
+{{end}}
+</section>
+{{end}}
+</body>
+</html>
+`))
+
+type servePageData struct {
+	Lang      string
+	Languages []string
+	Slides    []serveSlide
+}
+
+type serveSlide struct {
+	Number   int
+	Title    string
+	Segments []serveSegment
+}
+
+type serveSegment struct {
+	Label    string
+	Text     string
+	VoiceID  string
+	Take     int
+	AudioURL string
+	Missing  string
+}
+
+// previewServer serves the preview of an output directory. Manifests are
+// read on every request, so the page reflects the latest run.
+type previewServer struct {
+	dir string
+}
+
+// languages returns the languages with a manifest in the output directory.
+func (s *previewServer) languages() []string {
+	matches, _ := filepath.Glob(filepath.Join(s.dir, "manifest_*.json"))
+	var langs []string
+	for _, m := range matches {
+		lang := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(m), "manifest_"), ".json")
+		// manifest_<lang>_<engine>.json files are per-engine subsets
+		if lang != "" && !strings.Contains(lang, "_") {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+func (s *previewServer) manifest(lang string) ([]ttsscript.ManifestEntry, error) {
+	return ttsscript.LoadManifest(filepath.Join(s.dir, fmt.Sprintf("manifest_%s.json", lang)))
+}
+
+// audioPath returns the file for a manifest entry. Output files are
+// recorded relative to where the run was started, so files moved with the
+// output directory are found by name.
+func (s *previewServer) audioPath(entry ttsscript.ManifestEntry) string {
+	if fileExists(entry.OutputFile) {
+		return entry.OutputFile
+	}
+	return filepath.Join(s.dir, filepath.Base(entry.OutputFile))
+}
+
+func (s *previewServer) handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	langs := s.languages()
+	if len(langs) == 0 {
+		http.Error(w, "no manifest_<lang>.json files in "+s.dir, http.StatusNotFound)
+		return
+	}
+	lang := r.URL.Query().Get("lang")
+	if lang == "" {
+		lang = langs[0]
+	}
+	// Only languages with a manifest are read, so lang can't name other files
+	if !slices.Contains(langs, lang) {
+		http.Error(w, fmt.Sprintf("no manifest for language %q", lang), http.StatusNotFound)
+		return
+	}
+	entries, err := s.manifest(lang)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	ttsscript.SortManifest(entries)
+
+	// Audio URLs index the sorted manifest, matching handleAudio
+	data := servePageData{Lang: lang, Languages: langs}
+	i := 0
+	for _, group := range ttsscript.GroupManifestBySlide(entries) {
+		slide := serveSlide{Number: group[0].SlideIndex + 1, Title: group[0].SlideTitle}
+		for _, e := range group {
+			seg := serveSegment{
+				Label:    fmt.Sprintf("Segment %d", e.SegmentIndex+1),
+				Text:     e.Text,
+				VoiceID:  e.VoiceID,
+				Take:     e.Take,
+				AudioURL: fmt.Sprintf("/audio/%s/%d", lang, i),
+			}
+			if e.IsTitleSegment {
+				seg.Label = "Title"
+			} else if e.IsEarcon {
+				seg.Label = "Earcon"
+			}
+			if e.ID != "" {
+				seg.Label += " (" + e.ID + ")"
+			}
+			switch {
+			case e.Status == ttsscript.EntryMissing:
+				seg.Missing = "No audio: " + e.MissingReason
+			case !fileExists(s.audioPath(e)):
+				seg.Missing = "Audio file not found: " + filepath.Base(e.OutputFile)
+			}
+			slide.Segments = append(slide.Segments, seg)
+			i++
+		}
+		data.Slides = append(data.Slides, slide)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := servePage.Execute(w, data); err != nil {
+		log.Printf("Failed to render page: %v", err)
+	}
+}
+
+// handleAudio serves /audio/<lang>/<index>, the audio of an entry in the
+// sorted manifest. Only files listed in a manifest are served.
+func (s *previewServer) handleAudio(w http.ResponseWriter, r *http.Request) {
+	lang, index, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/audio/"), "/")
+	i, err := strconv.Atoi(index)
+	if !ok || err != nil || !slices.Contains(s.languages(), lang) {
+		http.NotFound(w, r)
+		return
+	}
+	entries, err := s.manifest(lang)
+	if err != nil || i < 0 || i >= len(entries) {
+		http.NotFound(w, r)
+		return
+	}
+	ttsscript.SortManifest(entries)
+	http.ServeFile(w, r, s.audioPath(entries[i]))
+}
+
+// runServe implements "ttsscript serve": it serves a web page listing each
+// language's slides and segments with their text and audio players, so
+// reviewers can listen to the narration in a browser.
+func runServe(args []string) {
+	flags := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := flags.String("addr", "localhost:8080", "Address to listen on (use :8080 to allow other machines)")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s serve [flags] [output-dir]\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Serve a web page for listening to generated narration, with the text of\n")
+		fmt.Fprintf(os.Stderr, "each segment next to its audio. Reads manifest_<lang>.json files from the\n")
+		fmt.Fprintf(os.Stderr, "output directory (default ./output).\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	dir := "./output"
+	if flags.NArg() > 0 {
+		dir = flags.Arg(0)
+	}
+	s := &previewServer{dir: dir}
+	if len(s.languages()) == 0 {
+		log.Fatalf("No manifest_<lang>.json files in %s", dir)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handleIndex)
+	mux.HandleFunc("/audio/", s.handleAudio)
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		// Long enough for a slow client to fetch a long audio file
+		WriteTimeout: 5 * time.Minute,
+		IdleTimeout:  2 * time.Minute,
+	}
+	fmt.Printf("Serving %s (%s) at http://%s/\n", dir, strings.Join(s.languages(), ", "), *addr)
+	log.Fatal(server.ListenAndServe())
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/agentplexus/go-elevenlabs/audioinfo"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// concatenateSingleFile concatenates every slide into full_<lang>.mp3 with
+// embedded chapter markers, and writes the markers to chapters_<lang>.json.
+func concatenateSingleFile(entries []ttsscript.ManifestEntry, language, outputDir, title string, slideGapMs int) error {
+	return concatenateTrack(entries, language, outputDir, title, slideGapMs, "full_"+language, fmt.Sprintf("chapters_%s.json", language))
+}
+
+// concatenateTrack concatenates the slides of entries into <base>.mp3 with
+// a chapter marker per slide, and writes the markers to <base>.cue,
+// <base>.chapters.json, and chaptersFile, unless it is empty.
+func concatenateTrack(entries []ttsscript.ManifestEntry, language, outputDir, title string, slideGapMs int, base, chaptersFile string) error {
+	measured, err := measureEntries(entries)
+	if err != nil {
+		return err
+	}
+	track, err := ttsscript.BuildTrack(measured, language, slideGapMs)
+	if err != nil {
+		return err
+	}
+
+	var listContent strings.Builder
+	var inputs []string
+	var crossfades []int
+	silences := make(map[int]string)
+	defer cleanupTrackFiles(outputDir, track)
+
+	for i, part := range track.Parts {
+		file := ""
+		if part.Entry == nil {
+			file = silences[part.SilenceMs]
+			if file == "" {
+				file = filepath.Join(outputDir, fmt.Sprintf(".silence_track_%dms.mp3", part.SilenceMs))
+				if err := generateSilenceFile(file, part.SilenceMs); err != nil {
+					return err
+				}
+				silences[part.SilenceMs] = file
+			}
+		} else {
+			file, err = applyEffects(*part.Entry, outputDir, part.Entry.SlideIndex, i)
+			if err != nil {
+				return fmt.Errorf("slide %d: %w", part.Entry.SlideIndex+1, err)
+			}
+		}
+		listContent.WriteString(fmt.Sprintf("file '%s'\n", filepath.Base(file)))
+		inputs = append(inputs, file)
+		crossfades = append(crossfades, part.CrossfadeMs)
+	}
+
+	listFile := filepath.Join(outputDir, ".concat_"+base+".txt")
+	if err := os.WriteFile(listFile, []byte(listContent.String()), 0600); err != nil {
+		return fmt.Errorf("writing concat list: %w", err)
+	}
+	defer os.Remove(listFile)
+
+	metaFile := filepath.Join(outputDir, ".chapters_"+base+".txt")
+	if err := os.WriteFile(metaFile, []byte(track.FFMetadata(title)), 0600); err != nil {
+		return fmt.Errorf("writing chapter metadata: %w", err)
+	}
+	defer os.Remove(metaFile)
+
+	// Stream copy only works when all inputs share a format; otherwise re-encode
+	codecArgs := []string{"-c", "copy"}
+	if err := checkConcatInputs(inputs); err != nil {
+		fmt.Printf("  %v; re-encoding\n", err)
+		codecArgs = []string{"-c:a", "libmp3lame", "-ar", "44100", "-ac", "1"}
+	}
+
+	output := filepath.Join(outputDir, base+".mp3")
+	args := []string{"-y", "-f", "concat", "-safe", "0", "-i", listFile, "-i", metaFile,
+		"-map", "0:a", "-map_metadata", "1", "-map_chapters", "1", "-id3v2_version", "3"}
+	args = append(args, codecArgs...)
+	if hasCrossfade(crossfades) {
+		// Overlapping audio has to be decoded and re-encoded
+		inputArgs, graph := crossfadeArgs(inputs, crossfades)
+		meta := strconv.Itoa(len(inputs))
+		args = append(append([]string{"-y"}, inputArgs...), "-i", metaFile, "-filter_complex", graph,
+			"-map", "[out]", "-map_metadata", meta, "-map_chapters", meta, "-id3v2_version", "3",
+			"-c:a", "libmp3lame", "-ar", "44100", "-ac", "1")
+	}
+	// #nosec G204 -- paths are generated from the output directory flag
+	cmd := exec.Command("ffmpeg", append(args, output)...)
+	cmd.Dir = outputDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg failed: %v\n%s", err, string(out))
+	}
+
+	cuePath := filepath.Join(outputDir, base+".cue")
+	if err := os.WriteFile(cuePath, []byte(track.CUE(filepath.Base(output), title)), 0600); err != nil {
+		return fmt.Errorf("writing CUE sheet: %w", err)
+	}
+	podcast, err := track.PodcastChapters()
+	if err != nil {
+		return err
+	}
+	podcastPath := filepath.Join(outputDir, base+".chapters.json")
+	if err := os.WriteFile(podcastPath, podcast, 0600); err != nil {
+		return fmt.Errorf("writing podcast chapters: %w", err)
+	}
+
+	markers := []string{cuePath, podcastPath}
+	if chaptersFile != "" {
+		chaptersPath := filepath.Join(outputDir, chaptersFile)
+		if err := track.WriteJSON(chaptersPath); err != nil {
+			return err
+		}
+		markers = append([]string{chaptersPath}, markers...)
+	}
+
+	fmt.Printf("  %s (%d slides)\n", output, len(track.Chapters))
+	fmt.Printf("  Chapters: %s\n", strings.Join(markers, ", "))
+	for _, line := range strings.Split(strings.TrimSpace(track.ChapterList()), "\n") {
+		fmt.Printf("    %s\n", line)
+	}
+	return nil
+}
+
+// measureEntries returns a copy of entries with DurationMs measured for
+// those without it, such as files kept from earlier runs, since track
+// offsets need every duration.
+func measureEntries(entries []ttsscript.ManifestEntry) ([]ttsscript.ManifestEntry, error) {
+	measured := make([]ttsscript.ManifestEntry, len(entries))
+	copy(measured, entries)
+	for i := range measured {
+		if measured[i].DurationMs > 0 {
+			continue
+		}
+		info, err := audioinfo.InspectFile(measured[i].OutputFile)
+		if err != nil {
+			return nil, fmt.Errorf("missing audio for slide %d: %w", measured[i].SlideIndex+1, err)
+		}
+		measured[i].DurationMs = info.DurationMs()
+	}
+	return measured, nil
+}
+
+// cleanupTrackFiles removes temporary silence and effect files for a track.
+func cleanupTrackFiles(outputDir string, track *ttsscript.Track) {
+	files, _ := filepath.Glob(filepath.Join(outputDir, ".silence_track_*.mp3"))
+	for _, f := range files {
+		os.Remove(f)
+	}
+	for _, c := range track.Chapters {
+		cleanupEffectFiles(outputDir, c.SlideIndex)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Storage is a destination for generated audio and manifests.
+type Storage interface {
+	// Put writes the contents of r to the given slash-separated relative path.
+	Put(ctx context.Context, path string, r io.Reader) error
+}
+
+// NewStorage creates a Storage from a destination URL.
+//
+// Supported destinations:
+//
+//	/local/dir or file:///local/dir   Local filesystem
+//	s3://bucket/prefix                Amazon S3 (requires the aws CLI)
+//	gs://bucket/prefix                Google Cloud Storage (requires the gcloud CLI)
+func NewStorage(dest string) (Storage, error) {
+	u, err := url.Parse(dest)
+	if err != nil || u.Scheme == "" || len(u.Scheme) == 1 { // single letter: Windows drive
+		return &LocalStorage{Dir: dest}, nil
+	}
+
+	switch u.Scheme {
+	case "file":
+		return &LocalStorage{Dir: u.Path}, nil
+	case "s3":
+		if _, err := exec.LookPath("aws"); err != nil {
+			return nil, fmt.Errorf("aws CLI is required for s3:// destinations but was not found in PATH")
+		}
+		return &CommandStorage{
+			BaseURL: "s3://" + u.Host + "/" + strings.Trim(u.Path, "/"),
+			Command: []string{"aws", "s3", "cp", "-"},
+		}, nil
+	case "gs":
+		if _, err := exec.LookPath("gcloud"); err != nil {
+			return nil, fmt.Errorf("gcloud CLI is required for gs:// destinations but was not found in PATH")
+		}
+		return &CommandStorage{
+			BaseURL: "gs://" + u.Host + "/" + strings.Trim(u.Path, "/"),
+			Command: []string{"gcloud", "storage", "cp", "-"},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported destination scheme %q", u.Scheme)
+	}
+}
+
+// LocalStorage writes files under a local directory.
+type LocalStorage struct {
+	Dir string
+}
+
+// Put implements Storage.
+func (s *LocalStorage) Put(_ context.Context, name string, r io.Reader) error {
+	dst := filepath.Join(s.Dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(dst), 0750); err != nil {
+		return err
+	}
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// checkPublishDest returns an error if storage is a local directory that
+// is dir or inside it, where publishing would overwrite the files being
+// published.
+func checkPublishDest(storage Storage, dir string) error {
+	local, ok := storage.(*LocalStorage)
+	if !ok {
+		return nil
+	}
+	destAbs, err := filepath.Abs(local.Dir)
+	if err != nil {
+		return err
+	}
+	dirAbs, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(dirAbs, destAbs)
+	if err != nil {
+		return nil
+	}
+	if rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))) {
+		return fmt.Errorf("destination %s is inside the output directory %s", local.Dir, dir)
+	}
+	return nil
+}
+
+// CommandStorage uploads files by piping them to a CLI tool that accepts
+// "-" as its source and a destination URL as its final argument.
+type CommandStorage struct {
+	// BaseURL is the destination prefix (e.g., "s3://bucket/prefix").
+	BaseURL string
+
+	// Command is the command and arguments preceding the destination URL.
+	Command []string
+}
+
+// Put implements Storage.
+func (s *CommandStorage) Put(ctx context.Context, name string, r io.Reader) error {
+	dest := strings.TrimSuffix(s.BaseURL, "/") + "/" + path.Clean(name)
+	args := append(append([]string{}, s.Command[1:]...), dest)
+
+	// #nosec G204 -- command is selected from a fixed set; dest comes from the -dest flag
+	cmd := exec.CommandContext(ctx, s.Command[0], args...)
+	cmd.Stdin = r
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%s failed: %v\n%s", s.Command[0], err, string(output))
+	}
+	return nil
+}
+
+// publishDir uploads all non-hidden files in dir to storage, preserving
+// relative paths. Returns the number of files uploaded.
+func publishDir(ctx context.Context, storage Storage, dir string) (int, error) {
+	if err := checkPublishDest(storage, dir); err != nil {
+		return 0, err
+	}
+	count := 0
+	err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(d.Name(), ".") && p != dir {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if err := storage.Put(ctx, filepath.ToSlash(rel), f); err != nil {
+			return fmt.Errorf("uploading %s: %w", rel, err)
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
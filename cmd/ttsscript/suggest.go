@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runSuggest implements "ttsscript suggest": it lists likely acronyms and
+// proper nouns that have no pronunciation rule, before paying for generation.
+func runSuggest(args []string) {
+	flags := flag.NewFlagSet("suggest", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to analyze")
+	minScore := flags.Int("min-score", 1, "Only show suggestions with at least this score")
+	asJSON := flags.Bool("json", false, "Print suggestions as JSON")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s suggest [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Suggest pronunciation entries for acronyms, brand names, and proper nouns.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	script, err := loadScript(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to load script: %v", err)
+	}
+
+	var suggestions []ttsscript.PronunciationSuggestion
+	for _, s := range ttsscript.SuggestPronunciations(script, *lang) {
+		if s.Score >= *minScore {
+			suggestions = append(suggestions, s)
+		}
+	}
+
+	if *asJSON {
+		data, err := json.MarshalIndent(suggestions, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to marshal suggestions: %v", err)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	if len(suggestions) == 0 {
+		fmt.Println("No suggestions: every acronym and proper noun has a pronunciation.")
+		return
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SCORE\tTERM\tKIND\tCOUNT\tSUGGESTION\tFIRST SEEN")
+	for _, s := range suggestions {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\t%s\n", s.Score, s.Term, s.Kind, s.Count, s.Suggestion, formatLocation(s.Locations[0]))
+	}
+	tw.Flush()
+
+	prons := ttsscript.SuggestedPronunciations(suggestions, *lang)
+	if len(prons) > 0 {
+		data, _ := json.MarshalIndent(map[string]any{"pronunciations": prons}, "", "  ")
+		fmt.Printf("\nSuggested entries to review and merge into the script:\n%s\n", data)
+	}
+}
+
+// formatLocation formats a suggestion location like "slide 3, segment 2".
+func formatLocation(loc ttsscript.SuggestionLocation) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "slide %d, ", loc.SlideIndex+1)
+	if loc.SegmentIndex < 0 {
+		sb.WriteString("title")
+	} else {
+		fmt.Fprintf(&sb, "segment %d", loc.SegmentIndex+1)
+	}
+	return sb.String()
+}
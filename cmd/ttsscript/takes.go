@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// takesPath returns the take selection file for a language.
+func takesPath(outputDir, lang string) string {
+	return filepath.Join(outputDir, fmt.Sprintf("takes_%s.json", lang))
+}
+
+// loadOrNewTakeSelection loads the take selection file, or returns an
+// empty selection if it does not exist.
+func loadOrNewTakeSelection(path, lang string) (*ttsscript.TakeSelection, error) {
+	selection, err := ttsscript.LoadTakeSelection(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return ttsscript.NewTakeSelection(lang), nil
+	}
+	return selection, err
+}
+
+// generateTakes generates the takes of a segment that don't exist yet,
+// each with its own seed, records them in the selection, and copies the
+// active take to the entry's output file. It returns the number of takes
+// generated and the active take.
+func generateTakes(ctx context.Context, engine ttsscript.Engine, requester *elevenlabs.ScriptEngine, genLog *elevenlabs.GenerationLog,
+	job ttsscript.SegmentJob, entry ttsscript.ManifestEntry, selection *ttsscript.TakeSelection, takes int) (int, int, error) {
+	prev := selection.Get(entry)
+	generated := 0
+	for take := 1; take <= takes; take++ {
+		file := ttsscript.TakeFile(entry.OutputFile, take)
+		if prev != nil && take <= prev.Takes && fileExists(file) {
+			continue
+		}
+		takeJob := job
+		takeJob.Seed = ttsscript.TakeSeed(job.Seed, take)
+
+		rec := elevenlabs.NewTTSGenerationRecord(requester.Request(takeJob))
+		n, err := synthesizeToFile(ctx, engine, takeJob, file)
+		rec.Complete(file, n, err)
+		if logErr := genLog.Append(rec); logErr != nil {
+			log.Printf("  Warning: failed to record generation: %v", logErr)
+		}
+		if err != nil {
+			return generated, 0, fmt.Errorf("take %d: %w", take, err)
+		}
+		generated++
+	}
+
+	t := selection.Record(entry, takes)
+	if err := copyFile(ttsscript.TakeFile(entry.OutputFile, t.Active), entry.OutputFile); err != nil {
+		return generated, 0, err
+	}
+	return generated, t.Active, nil
+}
+
+// runTakes implements "ttsscript takes": it lists the takes of each
+// segment, or makes a take active by copying it to the segment's output
+// file and updating the manifest.
+func runTakes(args []string) {
+	flags := flag.NewFlagSet("takes", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code")
+	outputDir := flags.String("output", "./output", "Output directory containing manifest_<lang>.json")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s takes [flags]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "       %s takes [flags] <output-file> <take>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "List the takes generated with -takes, or make a take active. The active\n")
+		fmt.Fprintf(os.Stderr, "take is copied to the segment's output file and used for concatenation;\n")
+		fmt.Fprintf(os.Stderr, "rerun with -per-slide or -single-file to rebuild combined audio.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	path := takesPath(*outputDir, *lang)
+	selection, err := ttsscript.LoadTakeSelection(path)
+	if err != nil {
+		log.Fatalf("Failed to load takes (generate with -takes first): %v", err)
+	}
+
+	if flags.NArg() == 0 {
+		for _, t := range selection.Segments {
+			fmt.Printf("%s: take %d of %d\n", t.OutputFile, t.Active, t.Takes)
+			for i, file := range t.Files() {
+				marker := " "
+				if i+1 == t.Active {
+					marker = "*"
+				}
+				fmt.Printf("  %s %d  %s\n", marker, i+1, file)
+			}
+		}
+		return
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(1)
+	}
+	take, err := strconv.Atoi(flags.Arg(1))
+	if err != nil {
+		log.Fatalf("Invalid take %q", flags.Arg(1))
+	}
+
+	manifestPath := filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang))
+	entries, err := ttsscript.LoadManifest(manifestPath)
+	if err != nil {
+		log.Fatalf("Failed to load manifest: %v", err)
+	}
+	idx := -1
+	for i, e := range entries {
+		if e.OutputFile == flags.Arg(0) || filepath.Base(e.OutputFile) == flags.Arg(0) {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		log.Fatalf("No manifest entry for %s", flags.Arg(0))
+	}
+	entry := &entries[idx]
+	if selection.Get(*entry) == nil {
+		log.Fatalf("No current takes for %s; the segment changed or was generated without -takes", entry.OutputFile)
+	}
+	if err := selection.Select(entry.OutputFile, take); err != nil {
+		log.Fatal(err)
+	}
+	if err := copyFile(ttsscript.TakeFile(entry.OutputFile, take), entry.OutputFile); err != nil {
+		log.Fatalf("Failed to activate take: %v", err)
+	}
+	entry.Take = take
+
+	if err := selection.WriteJSON(path); err != nil {
+		log.Fatalf("Failed to save takes: %v", err)
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err == nil {
+		err = os.WriteFile(manifestPath, data, 0600)
+	}
+	if err != nil {
+		log.Fatalf("Failed to update manifest: %v", err)
+	}
+	fmt.Printf("Take %d is now active for %s\n", take, entry.OutputFile)
+}
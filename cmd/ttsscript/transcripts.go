@@ -0,0 +1,94 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runTranscripts implements "ttsscript transcripts": it exports the
+// accessibility bundle for a generated language, with the full and
+// per-slide transcripts and captions, zipped with a manifest.
+func runTranscripts(args []string) {
+	flags := flag.NewFlagSet("transcripts", flag.ExitOnError)
+	lang := flags.String("lang", "en", "Language code to export")
+	outputDir := flags.String("output", "./output", "Output directory containing the manifest and audio")
+	fallback := flags.String("fallback", "", "Comma-separated fallback languages for segments missing -lang")
+	titles := flags.Bool("titles", false, "Narrate every slide title, not just section headers")
+	slideGap := flags.String("slide-gap", "", "Extra silence between slides, as given to -single-file (e.g., \"2s\")")
+	maxChars := flags.Int("max-chars", ttsscript.DefaultCaptionCueChars, "Maximum characters per caption cue")
+	output := flags.String("o", "", "Write the bundle to this zip file (default: <output>/transcripts_<lang>.zip)")
+	dir := flags.String("dir", "", "Write the bundle files to this directory instead of a zip file")
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s transcripts [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Export the full transcript, per-slide transcripts, and WebVTT and SRT captions for a\n")
+		fmt.Fprintf(os.Stderr, "generated language, zipped with a manifest. Use the -titles and -fallback given to the run.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 {
+		flags.Usage()
+		os.Exit(1)
+	}
+
+	script, err := loadScript(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("Failed to load script: %v", err)
+	}
+	compiler := ttsscript.NewCompiler()
+	compiler.IncludeSlideTitles = *titles
+	var compileOpts []ttsscript.CompileOption
+	if *fallback != "" {
+		compileOpts = append(compileOpts, ttsscript.WithFallback(splitList(*fallback)...))
+	}
+	segments, err := compiler.Compile(script, *lang, compileOpts...)
+	if err != nil {
+		log.Fatalf("Failed to compile script: %v", err)
+	}
+
+	entries, err := ttsscript.LoadManifest(filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang)))
+	if err != nil {
+		log.Fatalf("Failed to load manifest: %v", err)
+	}
+	measured, err := measureEntries(entries)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := ttsscript.TranscriptBundleOptions{
+		Title:       script.Title,
+		Language:    *lang,
+		SlideGapMs:  ttsscript.ParseDuration(*slideGap),
+		MaxCueChars: *maxChars,
+	}
+	if *dir != "" {
+		if err := ttsscript.WriteTranscriptBundleDir(*dir, segments, measured, opts); err != nil {
+			log.Fatalf("Failed to write transcripts: %v", err)
+		}
+		fmt.Printf("Transcripts saved: %s\n", *dir)
+		return
+	}
+
+	path := *output
+	if path == "" {
+		path = filepath.Join(*outputDir, fmt.Sprintf("transcripts_%s.zip", *lang))
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		log.Fatalf("Failed to create %s: %v", path, err)
+	}
+	if err := ttsscript.WriteTranscriptBundleZip(f, segments, measured, opts); err != nil {
+		f.Close()
+		log.Fatalf("Failed to write transcripts: %v", err)
+	}
+	if err := f.Close(); err != nil {
+		log.Fatalf("Failed to write %s: %v", path, err)
+	}
+	fmt.Printf("Transcripts saved: %s\n", path)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/audioinfo"
+)
+
+// trimSampleRate is the rate audio is decoded at to detect silence.
+const trimSampleRate = 16000
+
+// trimSilence removes leading and trailing silence from an MP3 file in
+// place and returns the amounts removed. Silence is detected in Go on the
+// decoded PCM; ffmpeg decodes and re-encodes the MP3.
+func trimSilence(path string, opts *audioinfo.SilenceOptions) (lead, trail time.Duration, err error) {
+	// #nosec G204 -- path is generated from the output directory flag
+	pcm, err := exec.Command("ffmpeg", "-v", "error", "-i", path,
+		"-f", "s16le", "-ac", "1", "-ar", fmt.Sprint(trimSampleRate), "-").Output()
+	if err != nil {
+		return 0, 0, fmt.Errorf("ffmpeg decode failed: %w", err)
+	}
+	lead, trail = audioinfo.EdgeSilence(pcm, trimSampleRate, 1, opts)
+	if lead == 0 && trail == 0 {
+		return 0, 0, nil
+	}
+	keep := audioinfo.FromPCM(int64(len(pcm)), trimSampleRate).Duration - lead - trail
+
+	tmp := filepath.Join(filepath.Dir(path), ".trim_"+filepath.Base(path))
+	// #nosec G204 -- paths are generated from the output directory flag
+	cmd := exec.Command("ffmpeg", "-y", "-v", "error", "-i", path,
+		"-ss", fmt.Sprintf("%.3f", lead.Seconds()), "-t", fmt.Sprintf("%.3f", keep.Seconds()),
+		"-c:a", "libmp3lame", "-ar", "44100", "-ac", "1", tmp)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		os.Remove(tmp)
+		return 0, 0, fmt.Errorf("ffmpeg trim failed: %v\n%s", err, string(output))
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return 0, 0, err
+	}
+	return lead, trail, nil
+}
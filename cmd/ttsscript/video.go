@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// videoOptions configures per-slide video slates.
+type videoOptions struct {
+	// Size is the video size, e.g. "1920x1080".
+	Size string
+
+	// Color is the background color of generated slates.
+	Color string
+
+	// AssetDir resolves relative image paths (the script's directory).
+	AssetDir string
+}
+
+// renderSlideVideos muxes each per-slide audio file with the slide's image,
+// or a solid slate showing the slide title, into slideNN_<lang>.mp4.
+// All videos share codec settings so they can be concatenated with stream copy.
+func renderSlideVideos(entries []ttsscript.ManifestEntry, language, outputDir string, opts videoOptions) {
+	// First entry per slide carries the slide title and assets
+	for _, slide := range ttsscript.GroupManifestBySlide(entries) {
+		entry := slide[0]
+		slideIdx := entry.SlideIndex
+		audioFile := filepath.Join(outputDir, fmt.Sprintf("slide%02d_%s.mp3", slideIdx+1, language))
+		if !fileExists(audioFile) {
+			log.Printf("  Slide %d: no slide audio, skipping video", slideIdx+1)
+			continue
+		}
+		videoFile := filepath.Join(outputDir, fmt.Sprintf("slide%02d_%s.mp4", slideIdx+1, language))
+
+		source := "slate"
+		var err error
+		if img, ok := entry.Image(); ok {
+			source = img.Path
+			err = renderImageVideo(audioFile, resolveAssetPath(img.Path, opts.AssetDir), videoFile, opts)
+		} else {
+			err = renderSlateVideo(audioFile, entry.SlideTitle, videoFile, outputDir, slideIdx, opts)
+		}
+		if err != nil {
+			log.Printf("  Slide %d: %v", slideIdx+1, err)
+			continue
+		}
+		fmt.Printf("  Slide %d: %s (%s)\n", slideIdx+1, videoFile, source)
+	}
+}
+
+// renderImageVideo muxes audio with a still image, letterboxed to the video size.
+func renderImageVideo(audioFile, imageFile, videoFile string, opts videoOptions) error {
+	if !fileExists(imageFile) {
+		return fmt.Errorf("slide image not found: %s", imageFile)
+	}
+	w, h := videoDimensions(opts.Size)
+	filter := fmt.Sprintf("scale=%s:%s:force_original_aspect_ratio=decrease,pad=%s:%s:(ow-iw)/2:(oh-ih)/2:color=%s,setsar=1",
+		w, h, w, h, opts.Color)
+	args := append([]string{"-y", "-loop", "1", "-i", imageFile, "-i", audioFile, "-vf", filter}, videoCodecArgs(videoFile)...)
+	return runFFmpeg("video", args)
+}
+
+// renderSlateVideo muxes audio with a solid slate showing the slide title.
+// If ffmpeg lacks the drawtext filter, a plain slate is rendered instead.
+func renderSlateVideo(audioFile, title, videoFile, outputDir string, slideIdx int, opts videoOptions) error {
+	color := fmt.Sprintf("color=c=%s:s=%s:r=25", opts.Color, opts.Size)
+	base := []string{"-y", "-f", "lavfi", "-i", color, "-i", audioFile}
+
+	if strings.TrimSpace(title) != "" {
+		// Pass the title through a file to avoid drawtext escaping rules
+		textFile := filepath.Join(outputDir, fmt.Sprintf(".slate_s%02d.txt", slideIdx))
+		if err := os.WriteFile(textFile, []byte(title), 0600); err != nil {
+			return fmt.Errorf("writing slate text: %w", err)
+		}
+		defer os.Remove(textFile)
+
+		filter := fmt.Sprintf("drawtext=textfile='%s':fontcolor=white:fontsize=h/12:x=(w-text_w)/2:y=(h-text_h)/2",
+			escapeFilterPath(textFile))
+		args := append(append(base, "-vf", filter), videoCodecArgs(videoFile)...)
+		err := runFFmpeg("slate", args)
+		if err == nil {
+			return nil
+		}
+		log.Printf("  Slide %d: title slate failed, rendering plain slate: %v", slideIdx+1, err)
+	}
+
+	return runFFmpeg("slate", append(base, videoCodecArgs(videoFile)...))
+}
+
+// videoCodecArgs are the shared encoding settings for slide videos.
+func videoCodecArgs(videoFile string) []string {
+	return []string{
+		"-c:v", "libx264", "-tune", "stillimage", "-pix_fmt", "yuv420p", "-r", "25",
+		"-c:a", "aac", "-b:a", "192k", "-ar", "44100", "-ac", "1",
+		"-shortest", videoFile,
+	}
+}
+
+// runFFmpeg runs ffmpeg and includes its output in errors.
+func runFFmpeg(step string, args []string) error {
+	// #nosec G204 -- arguments are generated paths and flag values for a CLI tool
+	cmd := exec.Command("ffmpeg", args...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("ffmpeg %s failed: %v\n%s", step, err, string(output))
+	}
+	return nil
+}
+
+// videoDimensions splits "1920x1080" into width and height.
+func videoDimensions(size string) (string, string) {
+	w, h, ok := strings.Cut(size, "x")
+	if !ok {
+		return "1920", "1080"
+	}
+	return w, h
+}
+
+// resolveAssetPath resolves a relative asset path against dir.
+func resolveAssetPath(path, dir string) string {
+	if filepath.IsAbs(path) || dir == "" {
+		return path
+	}
+	return filepath.Join(dir, path)
+}
+
+// escapeFilterPath escapes a path for use inside a quoted ffmpeg filter option.
+func escapeFilterPath(path string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `'\''`, `:`, `\:`)
+	return r.Replace(path)
+}
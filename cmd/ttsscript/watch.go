@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"time"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// runWatch implements "ttsscript watch": it polls the script file and
+// regenerates only the segments whose content hash changed.
+func runWatch(args []string) {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	lang := fs.String("lang", "en", "Language code to generate")
+	outputDir := fs.String("output", "./output", "Output directory")
+	modelID := fs.String("model", "eleven_multilingual_v2", "ElevenLabs model ID")
+	interval := fs.Duration("interval", time.Second, "Polling interval for script changes")
+	play := fs.Bool("play", false, "Play regenerated audio (requires ffplay or afplay)")
+	preset := fs.String("preset", "", "Voice settings preset for all segments (e.g., narration, stable)")
+	fs.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s watch [flags] <script.json>\n\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Watch a script and regenerate changed segments on save.\n\n")
+		fmt.Fprintf(os.Stderr, "Flags:\n")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() < 1 {
+		fs.Usage()
+		os.Exit(1)
+	}
+	scriptPath := fs.Arg(0)
+
+	if os.Getenv("ELEVENLABS_API_KEY") == "" {
+		log.Fatal("ELEVENLABS_API_KEY environment variable is required")
+	}
+	if err := os.MkdirAll(*outputDir, 0750); err != nil {
+		log.Fatalf("Failed to create output directory: %v", err)
+	}
+
+	var base *elevenlabs.VoiceSettings
+	if *preset != "" {
+		var err error
+		if base, err = resolvePreset(*preset, nil); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create ElevenLabs client: %v", err)
+	}
+
+	engine := elevenlabs.NewScriptEngine(client)
+	engine.ModelID = *modelID
+	engine.VoiceSettings = base
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	w := &watcher{
+		engine:       ttsscript.NewRouter(elevenlabs.ScriptEngineName, engine),
+		scriptPath:   scriptPath,
+		lang:         *lang,
+		outputDir:    *outputDir,
+		modelID:      *modelID,
+		play:         *play,
+		manifestPath: filepath.Join(*outputDir, fmt.Sprintf("manifest_%s.json", *lang)),
+	}
+	if previous, err := ttsscript.LoadManifest(w.manifestPath); err == nil {
+		w.previous = previous
+	}
+
+	fmt.Printf("Watching %s (Ctrl-C to stop)\n", scriptPath)
+
+	var lastMod time.Time
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for {
+		if info, err := os.Stat(scriptPath); err == nil && !info.ModTime().Equal(lastMod) {
+			lastMod = info.ModTime()
+			w.iterate(ctx)
+		}
+		select {
+		case <-ctx.Done():
+			fmt.Println("\nStopped watching.")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// watcher holds state between watch iterations.
+type watcher struct {
+	engine       ttsscript.Engine
+	scriptPath   string
+	lang         string
+	outputDir    string
+	modelID      string
+	play         bool
+	manifestPath string
+	previous     []ttsscript.ManifestEntry
+}
+
+// iterate re-validates and re-compiles the script and regenerates changed segments.
+func (w *watcher) iterate(ctx context.Context) {
+	fmt.Printf("\n[%s] Change detected, recompiling...\n", time.Now().Format("15:04:05"))
+
+	script, err := loadScript(w.scriptPath)
+	if err != nil {
+		log.Printf("  Failed to load script: %v", err)
+		return
+	}
+	if issues := script.Validate(); len(issues) > 0 {
+		log.Printf("  Script validation failed:\n  - %s", strings.Join(issues, "\n  - "))
+		return
+	}
+
+	segments, err := ttsscript.NewCompiler().Compile(script, w.lang)
+	if err != nil {
+		log.Printf("  Failed to compile script: %v", err)
+		return
+	}
+	formatter := ttsscript.NewElevenLabsFormatter()
+	formatter.ModelID = w.modelID
+	jobs := formatter.Format(segments)
+	if _, err := resolvePreset("", jobs); err != nil {
+		log.Printf("  %v", err)
+		return
+	}
+
+	config := ttsscript.NewBatchConfig(w.outputDir)
+	config.ModelID = w.modelID
+	entries := ttsscript.GenerateManifest(jobs, config, w.lang)
+
+	jobsByFile := make(map[string]ttsscript.ElevenLabsSegment, len(jobs))
+	for _, job := range jobs {
+		jobsByFile[config.GenerateFilename(job, w.lang)] = job
+	}
+	failed := make(map[string]bool)
+
+	changed := ttsscript.ChangedEntries(w.previous, entries)
+	if len(changed) == 0 {
+		fmt.Println("  No audio changes.")
+	}
+	for _, entry := range changed {
+		job := jobsByFile[entry.OutputFile]
+		if job.VoiceID == "" {
+			log.Printf("  Skipping %s: no voice ID configured", entry.OutputFile)
+			failed[entry.OutputFile] = true
+			continue
+		}
+		fmt.Printf("  Regenerating %s: %s\n", filepath.Base(entry.OutputFile), truncate(job.Text, 50))
+		_, err := synthesizeToFile(ctx, w.engine, ttsscript.NewSegmentJob(job, w.lang), entry.OutputFile)
+		if err != nil {
+			log.Printf("    ERROR: %v", err)
+			failed[entry.OutputFile] = true
+			continue
+		}
+		if w.play {
+			playAudio(ctx, entry.OutputFile)
+		}
+	}
+
+	// Clear hashes for failed entries so they are retried on the next change
+	for i := range entries {
+		if failed[entries[i].OutputFile] {
+			entries[i].Hash = ""
+		}
+	}
+	w.previous = entries
+
+	ttsscript.SortManifest(entries)
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err == nil {
+		err = os.WriteFile(w.manifestPath, data, 0600)
+	}
+	if err != nil {
+		log.Printf("  Failed to write manifest: %v", err)
+	}
+}
+
+// playAudio plays an audio file with the first available player.
+func playAudio(ctx context.Context, file string) {
+	players := [][]string{
+		{"ffplay", "-nodisp", "-autoexit", "-loglevel", "quiet"},
+		{"afplay"},
+	}
+	for _, p := range players {
+		if _, err := exec.LookPath(p[0]); err != nil {
+			continue
+		}
+		// #nosec G204 -- player is selected from a fixed list; file is a generated output path
+		cmd := exec.CommandContext(ctx, p[0], append(p[1:], file)...)
+		if err := cmd.Run(); err != nil {
+			log.Printf("    Playback failed: %v", err)
+		}
+		return
+	}
+	log.Printf("    Playback skipped: no audio player (ffplay, afplay) found in PATH")
+}
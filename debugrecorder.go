@@ -0,0 +1,374 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+// maxDebugBodySize is the largest text body included in debug recordings.
+// Larger bodies are recorded by size only.
+const maxDebugBodySize = 64 << 10
+
+// redactedValue replaces sensitive header and query values.
+const redactedValue = "[REDACTED]"
+
+// debugRedactedHeaders have their values replaced in debug recordings.
+var debugRedactedHeaders = map[string]bool{
+	"xi-api-key":    true,
+	"authorization": true,
+	"cookie":        true,
+	"set-cookie":    true,
+}
+
+// debugRedactedParams have their values replaced in debug recordings.
+var debugRedactedParams = map[string]bool{
+	"xi-api-key": true,
+	"xi_api_key": true,
+	"api_key":    true,
+	"token":      true,
+}
+
+// DebugRecorder writes one HAR (HTTP Archive) file per API call to a
+// directory, for attaching to support requests. Recordings hold request and
+// response metadata and text bodies only: audio and other binary bodies are
+// recorded by size, and API keys and cookies are redacted.
+//
+// Recording can be turned on and off at runtime, to capture only the calls
+// around a problem:
+//
+//	client, _ := elevenlabs.NewClient(elevenlabs.WithDebugRecorder("./debug"))
+//	client.DebugRecorder().SetEnabled(false)
+//	// ...
+//	client.DebugRecorder().SetEnabled(true) // record the failing call
+//
+// Errors writing recordings are ignored so debugging never breaks API calls.
+// WebSocket sessions are not recorded.
+type DebugRecorder struct {
+	dir     string
+	clock   clock.Clock
+	enabled atomic.Bool
+	seq     atomic.Int64
+}
+
+// WithDebugRecorder records sanitized request/response metadata for every
+// HTTP call to HAR files in dir. See DebugRecorder.
+func WithDebugRecorder(dir string) Option {
+	return func(o *clientOptions) {
+		o.debugDir = dir
+	}
+}
+
+// DebugRecorder returns the client's debug recorder, or nil if the client
+// was created without WithDebugRecorder.
+func (c *Client) DebugRecorder() *DebugRecorder {
+	return c.debugRecorder
+}
+
+// SetEnabled turns recording on or off.
+func (d *DebugRecorder) SetEnabled(enabled bool) {
+	d.enabled.Store(enabled)
+}
+
+// Enabled reports whether calls are being recorded.
+func (d *DebugRecorder) Enabled() bool {
+	return d.enabled.Load()
+}
+
+// Dir returns the directory recordings are written to.
+func (d *DebugRecorder) Dir() string {
+	return d.dir
+}
+
+// wrap returns a copy of client whose transport records through d.
+func (d *DebugRecorder) wrap(client *http.Client) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	wrapped := *client
+	wrapped.Transport = &debugTransport{recorder: d, next: next}
+	return &wrapped
+}
+
+// debugTransport is an http.RoundTripper that records calls through a
+// DebugRecorder.
+type debugTransport struct {
+	recorder *DebugRecorder
+	next     http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	d := t.recorder
+	if !d.Enabled() {
+		return t.next.RoundTrip(req)
+	}
+
+	var reqBody []byte
+	// A body whose length is 0 or -1 is of unknown length and may be
+	// streamed, so it is not read
+	if req.Body != nil && isTextContent(req.Header.Get("Content-Type")) && req.ContentLength > 0 && req.ContentLength <= maxDebugBodySize {
+		data, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		reqBody = data
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+	}
+
+	start := d.clock.Now()
+	resp, err := t.next.RoundTrip(req)
+	elapsed := d.clock.Now().Sub(start)
+
+	entry := harEntry{
+		StartedDateTime: start.UTC().Format(time.RFC3339Nano),
+		Time:            elapsed.Milliseconds(),
+		Request:         newHARRequest(req, reqBody),
+		Timings:         harTimings{Wait: elapsed.Milliseconds()},
+	}
+	if err != nil {
+		entry.Comment = err.Error()
+		entry.Response = harResponse{Headers: []harNameValue{}, Content: harContent{}}
+		d.write(req, entry)
+		return nil, err
+	}
+
+	var respBody []byte
+	if isTextContent(resp.Header.Get("Content-Type")) && resp.ContentLength <= maxDebugBodySize {
+		// Read at most the limit, so long text streams are not buffered
+		data, readErr := io.ReadAll(io.LimitReader(resp.Body, maxDebugBodySize+1))
+		if readErr != nil {
+			resp.Body.Close()
+			return nil, readErr
+		}
+		if len(data) <= maxDebugBodySize {
+			respBody = data
+		}
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+	}
+	entry.Response = newHARResponse(resp, respBody)
+	d.write(req, entry)
+	return resp, nil
+}
+
+// write saves entry as <seq>_<method>_<path>.har in the recorder directory.
+func (d *DebugRecorder) write(req *http.Request, entry harEntry) {
+	har := harFile{Log: harLog{
+		Version: "1.2",
+		Creator: harCreator{Name: "go-elevenlabs", Version: Version},
+		Entries: []harEntry{entry},
+	}}
+	data, err := json.MarshalIndent(har, "", "  ")
+	if err != nil {
+		return
+	}
+	name := fmt.Sprintf("%06d_%s_%s.har", d.seq.Add(1), req.Method, pathSlug(req.URL.Path))
+	if err := os.MkdirAll(d.dir, 0750); err != nil {
+		return
+	}
+	_ = os.WriteFile(filepath.Join(d.dir, name), data, 0600)
+}
+
+// pathSlug turns a URL path into a short file name component.
+func pathSlug(path string) string {
+	slug := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+		return '_'
+	}, strings.Trim(path, "/"))
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+	return slug
+}
+
+// isTextContent reports whether a body of this content type is text that
+// can be included in a recording.
+func isTextContent(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasPrefix(mediaType, "text/") ||
+		mediaType == "application/json" ||
+		strings.HasSuffix(mediaType, "+json") ||
+		mediaType == "application/x-www-form-urlencoded"
+}
+
+// HAR 1.2 structures (http://www.softwareishard.com/blog/har-12-spec/).
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            int64       `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Comment         string      `json:"comment,omitempty"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	Cookies     []harNameValue `json:"cookies"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Headers     []harNameValue `json:"headers"`
+	Cookies     []harNameValue `json:"cookies"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int64          `json:"bodySize"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harContent struct {
+	Size     int64  `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+type harTimings struct {
+	Send    int64 `json:"send"`
+	Wait    int64 `json:"wait"`
+	Receive int64 `json:"receive"`
+}
+
+func newHARRequest(req *http.Request, body []byte) harRequest {
+	u := *req.URL
+	query := u.Query()
+	params := []harNameValue{}
+	for _, name := range sortedKeys(query) {
+		values := query[name]
+		for i, v := range values {
+			if debugRedactedParams[strings.ToLower(name)] {
+				v = redactedValue
+				values[i] = v
+			}
+			params = append(params, harNameValue{Name: name, Value: v})
+		}
+	}
+	u.RawQuery = query.Encode()
+	u.User = nil
+
+	r := harRequest{
+		Method:      req.Method,
+		URL:         u.String(),
+		HTTPVersion: req.Proto,
+		Headers:     harHeaders(req.Header),
+		QueryString: params,
+		Cookies:     []harNameValue{},
+		HeadersSize: -1,
+		BodySize:    req.ContentLength,
+	}
+	if r.HTTPVersion == "" {
+		r.HTTPVersion = "HTTP/1.1"
+	}
+	if body != nil {
+		r.PostData = &harPostData{MimeType: req.Header.Get("Content-Type"), Text: string(body)}
+		r.BodySize = int64(len(body))
+	}
+	return r
+}
+
+func newHARResponse(resp *http.Response, body []byte) harResponse {
+	r := harResponse{
+		Status:      resp.StatusCode,
+		StatusText:  http.StatusText(resp.StatusCode),
+		HTTPVersion: resp.Proto,
+		Headers:     harHeaders(resp.Header),
+		Cookies:     []harNameValue{},
+		Content: harContent{
+			Size:     resp.ContentLength,
+			MimeType: resp.Header.Get("Content-Type"),
+		},
+		HeadersSize: -1,
+		BodySize:    resp.ContentLength,
+	}
+	if body != nil {
+		r.Content.Size = int64(len(body))
+		r.Content.Text = string(body)
+		r.BodySize = int64(len(body))
+	} else {
+		r.Content.Comment = "body omitted"
+	}
+	return r
+}
+
+// harHeaders converts headers to HAR form with sensitive values redacted.
+func harHeaders(h http.Header) []harNameValue {
+	out := []harNameValue{}
+	for _, name := range sortedKeys(h) {
+		for _, v := range h[name] {
+			if debugRedactedHeaders[strings.ToLower(name)] {
+				v = redactedValue
+			}
+			out = append(out, harNameValue{Name: name, Value: v})
+		}
+	}
+	return out
+}
+
+func sortedKeys[M ~map[string][]string](m M) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,141 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDebugRecorder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasPrefix(r.URL.Path, "/v1/text-to-speech/"):
+			w.Header().Set("Content-Type", "audio/mpeg")
+			_, _ = w.Write([]byte{0xFF, 0xFB, 0x90, 0x00})
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("Set-Cookie", "session=secret-cookie")
+			_, _ = w.Write([]byte(`[]`))
+		}
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "debug")
+	client, err := NewClient(WithAPIKey("secret-key"), WithBaseURL(server.URL), WithDebugRecorder(dir))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.TextToSpeech().Simple(ctx, "voice1", "Hello"); err != nil {
+		t.Fatalf("Simple() error = %v", err)
+	}
+
+	client.DebugRecorder().SetEnabled(false)
+	if _, err := client.Models().List(ctx); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	client.DebugRecorder().SetEnabled(true)
+	if _, err := client.Models().List(ctx); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.har"))
+	if len(files) != 2 {
+		t.Fatalf("recorded %d files, want 2: %v", len(files), files)
+	}
+	if !strings.HasSuffix(files[0], "000001_POST_v1_text-to-speech_voice1.har") {
+		t.Errorf("unexpected file name %s", files[0])
+	}
+
+	for _, file := range files {
+		data, _ := os.ReadFile(file)
+		if strings.Contains(string(data), "secret") {
+			t.Errorf("%s contains unredacted secrets:\n%s", file, data)
+		}
+	}
+
+	var tts harFile
+	data, _ := os.ReadFile(files[0])
+	if err := json.Unmarshal(data, &tts); err != nil {
+		t.Fatalf("invalid HAR: %v", err)
+	}
+	entry := tts.Log.Entries[0]
+	if entry.Request.PostData == nil || !strings.Contains(entry.Request.PostData.Text, "Hello") {
+		t.Errorf("request body not recorded: %+v", entry.Request.PostData)
+	}
+	if entry.Response.Content.Text != "" || entry.Response.Content.MimeType != "audio/mpeg" {
+		t.Errorf("audio body should be omitted: %+v", entry.Response.Content)
+	}
+
+	var models harFile
+	data, _ = os.ReadFile(files[1])
+	if err := json.Unmarshal(data, &models); err != nil {
+		t.Fatalf("invalid HAR: %v", err)
+	}
+	if got := models.Log.Entries[0].Response.Content.Text; got != `[]` {
+		t.Errorf("JSON response body = %q", got)
+	}
+}
+
+func TestDebugRecorderDisabledByDefault(t *testing.T) {
+	client, err := NewClient(WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	if client.DebugRecorder() != nil {
+		t.Error("DebugRecorder() should be nil without WithDebugRecorder")
+	}
+}
+
+func TestDebugRecorderStreamedRequestBody(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		got = string(data)
+	}))
+	defer server.Close()
+
+	dir := filepath.Join(t.TempDir(), "debug")
+	client, err := NewClient(WithAPIKey("test-key"), WithDebugRecorder(dir))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	httpClient := client.DebugRecorder().wrap(&http.Client{})
+
+	// A body of unknown length is passed through without being buffered
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = pw.Write([]byte(`{"text":"streamed"}`))
+		pw.Close()
+	}()
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/v1/stream", pr)
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+	if got != `{"text":"streamed"}` {
+		t.Errorf("server received %q", got)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.har"))
+	if len(files) != 1 {
+		t.Fatalf("recorded %d files, want 1", len(files))
+	}
+	var har harFile
+	data, _ := os.ReadFile(files[0])
+	if err := json.Unmarshal(data, &har); err != nil {
+		t.Fatalf("invalid HAR: %v", err)
+	}
+	if postData := har.Log.Entries[0].Request.PostData; postData != nil {
+		t.Errorf("streamed request body recorded: %+v", postData)
+	}
+}
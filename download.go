@@ -0,0 +1,340 @@
+package elevenlabs
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ErrChecksumMismatch is returned when a downloaded file does not match
+// the expected checksum. The partial file is removed.
+var ErrChecksumMismatch = errors.New("downloaded file checksum mismatch")
+
+// DownloadProgressFunc is called as a download is written to disk. written is
+// the number of bytes on disk, including any resumed bytes; total is the
+// expected file size, or -1 if unknown.
+type DownloadProgressFunc func(written, total int64)
+
+// DownloadOptions configures a download to disk.
+type DownloadOptions struct {
+	// Resume continues an interrupted download from the partial file
+	// "<dest>.part" using an HTTP Range request. If the server ignores the
+	// range, returns a range that doesn't continue the partial file, or
+	// the file has changed since it was started, the download restarts
+	// from the beginning.
+	Resume bool
+
+	// SHA256 is the expected hex-encoded SHA-256 of the complete file
+	// (optional). On mismatch, ErrChecksumMismatch is returned.
+	SHA256 string
+
+	// OnProgress is called as bytes are written (optional).
+	OnProgress DownloadProgressFunc
+}
+
+// DownloadResult describes a completed download.
+type DownloadResult struct {
+	// Path is the destination file path.
+	Path string
+
+	// Size is the size of the file in bytes.
+	Size int64
+
+	// Resumed is true if the download continued from a partial file.
+	Resumed bool
+
+	// SHA256 is the hex-encoded SHA-256 of the file.
+	SHA256 string
+}
+
+// DownloadDubbedFileTo downloads the dubbed audio/video for a language to dest.
+func (s *DubbingService) DownloadDubbedFileTo(ctx context.Context, dubbingID, languageCode, dest string, opts *DownloadOptions) (*DownloadResult, error) {
+	if dubbingID == "" {
+		return nil, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+	if languageCode == "" {
+		return nil, &ValidationError{Field: "language_code", Message: "cannot be empty"}
+	}
+	path := fmt.Sprintf("/v1/dubbing/%s/audio/%s", url.PathEscape(dubbingID), url.PathEscape(languageCode))
+	return s.client.download(ctx, "GET", path, dest, opts)
+}
+
+// DownloadSnapshotArchiveTo downloads a project snapshot zip archive to dest.
+func (s *ProjectsService) DownloadSnapshotArchiveTo(ctx context.Context, projectID, snapshotID, dest string, opts *DownloadOptions) (*DownloadResult, error) {
+	if projectID == "" {
+		return nil, &ValidationError{Field: "project_id", Message: "cannot be empty"}
+	}
+	if snapshotID == "" {
+		return nil, &ValidationError{Field: "snapshot_id", Message: "cannot be empty"}
+	}
+	path := fmt.Sprintf("/v1/studio/projects/%s/snapshots/%s/archive", url.PathEscape(projectID), url.PathEscape(snapshotID))
+	return s.client.download(ctx, "POST", path, dest, opts)
+}
+
+// SeparateStemsTo separates stems and writes the resulting zip archive to dest.
+// Stem separation is generated per request, so opts.Resume is ignored.
+func (s *MusicService) SeparateStemsTo(ctx context.Context, req *StemSeparationRequest, dest string, opts *DownloadOptions) (*DownloadResult, error) {
+	stems, err := s.SeparateStems(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if rc, ok := stems.(io.Closer); ok {
+		defer rc.Close()
+	}
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	return writeDownload(dest, stems, false, -1, opts)
+}
+
+// download fetches an API path to dest, resuming from "<dest>.part" when
+// opts.Resume is set. The ETag or Last-Modified of the response that
+// started the partial file is kept in "<dest>.part.validator" and sent as
+// If-Range, so a changed file is downloaded again rather than appended.
+func (c *Client) download(ctx context.Context, method, path, dest string, opts *DownloadOptions) (*DownloadResult, error) {
+	if dest == "" {
+		return nil, &ValidationError{Field: "dest", Message: "cannot be empty"}
+	}
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+
+	var offset int64
+	if opts.Resume {
+		if info, err := os.Stat(dest + ".part"); err == nil {
+			offset = info.Size()
+		}
+	}
+
+	resp, err := c.downloadRequest(ctx, method, path, dest, offset)
+	if err != nil {
+		return nil, err
+	}
+	if offset > 0 && !continuesPartial(resp, offset) {
+		// The server's range doesn't continue the partial file, so start over
+		resp.Body.Close()
+		offset = 0
+		resp, err = c.downloadRequest(ctx, method, path, dest, offset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	var result *DownloadResult
+	switch {
+	case resp.StatusCode == http.StatusPartialContent && offset > 0:
+		total := int64(-1)
+		if resp.ContentLength >= 0 {
+			total = offset + resp.ContentLength
+		}
+		if size, ok := contentRangeSize(resp.Header.Get("Content-Range")); ok {
+			total = size
+		}
+		result, err = writeDownload(dest, resp.Body, true, total, opts)
+	case resp.StatusCode == http.StatusRequestedRangeNotSatisfiable && offset > 0:
+		// The partial file already holds the complete content.
+		result, err = writeDownload(dest, http.NoBody, true, offset, opts)
+	case resp.StatusCode == http.StatusOK:
+		if err := saveDownloadValidator(dest, resp.Header); err != nil {
+			return nil, err
+		}
+		result, err = writeDownload(dest, resp.Body, false, resp.ContentLength, opts)
+	default:
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(respBody),
+		}
+	}
+	if err == nil || errors.Is(err, ErrChecksumMismatch) {
+		// The partial file is gone, and its validator with it
+		_ = os.Remove(dest + ".part.validator")
+	}
+	return result, err
+}
+
+// downloadRequest requests an API path from offset, with the partial
+// file's validator as If-Range.
+func (c *Client) downloadRequest(ctx context.Context, method, path, dest string, offset int64) (*http.Response, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.headers.apply(httpReq.Header)
+	if offset > 0 {
+		httpReq.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if validator, err := os.ReadFile(dest + ".part.validator"); err == nil && len(validator) > 0 { // #nosec G304 -- path is the caller-provided download destination
+			httpReq.Header.Set("If-Range", string(validator))
+		}
+	}
+
+	resp, err := c.streamHTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	return resp, nil
+}
+
+// continuesPartial reports whether a response to a range request from
+// offset can be used with the partial file: a 206 must start at offset,
+// and a 416 must report a complete length of offset. Other responses
+// don't depend on the partial file.
+func continuesPartial(resp *http.Response, offset int64) bool {
+	contentRange := resp.Header.Get("Content-Range")
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		start, ok := contentRangeStart(contentRange)
+		return ok && start == offset
+	case http.StatusRequestedRangeNotSatisfiable:
+		size, ok := contentRangeSize(contentRange)
+		return ok && size == offset
+	}
+	return true
+}
+
+// saveDownloadValidator stores the ETag, or else the Last-Modified time,
+// of a response that starts a partial file, for If-Range when resuming.
+// Weak ETags can't be used with If-Range.
+func saveDownloadValidator(dest string, header http.Header) error {
+	validator := header.Get("ETag")
+	if validator == "" || strings.HasPrefix(validator, "W/") {
+		validator = header.Get("Last-Modified")
+	}
+	path := dest + ".part.validator"
+	if validator == "" {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove download validator: %w", err)
+		}
+		return nil
+	}
+	if err := os.WriteFile(path, []byte(validator), 0600); err != nil {
+		return fmt.Errorf("failed to write download validator: %w", err)
+	}
+	return nil
+}
+
+// writeDownload writes r to "<dest>.part" (appending if resume is set),
+// verifies the checksum, and renames the file to dest.
+func writeDownload(dest string, r io.Reader, resume bool, total int64, opts *DownloadOptions) (*DownloadResult, error) {
+	partPath := dest + ".part"
+	h := sha256.New()
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	var written int64
+	if resume {
+		n, err := hashFile(h, partPath)
+		if err != nil {
+			return nil, err
+		}
+		written = n
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	f, err := os.OpenFile(partPath, flags, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open download file: %w", err)
+	}
+
+	w := io.MultiWriter(f, h)
+	buf := make([]byte, 32*1024)
+	for {
+		n, rerr := r.Read(buf)
+		if n > 0 {
+			if _, err := w.Write(buf[:n]); err != nil {
+				f.Close()
+				return nil, fmt.Errorf("failed to write download file: %w", err)
+			}
+			written += int64(n)
+			if opts.OnProgress != nil {
+				opts.OnProgress(written, total)
+			}
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			// Keep the partial file so the download can be resumed.
+			f.Close()
+			return nil, fmt.Errorf("download interrupted after %d bytes: %w", written, rerr)
+		}
+	}
+	if err := f.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close download file: %w", err)
+	}
+
+	sum := hex.EncodeToString(h.Sum(nil))
+	if opts.SHA256 != "" && !strings.EqualFold(opts.SHA256, sum) {
+		_ = os.Remove(partPath)
+		return nil, fmt.Errorf("%w: got %s, want %s", ErrChecksumMismatch, sum, opts.SHA256)
+	}
+
+	if err := os.Rename(partPath, dest); err != nil {
+		return nil, fmt.Errorf("failed to finalize download: %w", err)
+	}
+
+	return &DownloadResult{
+		Path:    dest,
+		Size:    written,
+		Resumed: resume,
+		SHA256:  sum,
+	}, nil
+}
+
+// hashFile feeds an existing file into h and returns its size.
+// A missing file hashes as empty.
+func hashFile(h hash.Hash, path string) (int64, error) {
+	f, err := os.Open(path) // #nosec G304 -- path is the caller-provided download destination
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open partial download: %w", err)
+	}
+	defer f.Close()
+	n, err := io.Copy(h, f)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read partial download: %w", err)
+	}
+	return n, nil
+}
+
+// contentRangeStart parses the first byte position from
+// "bytes 100-199/200".
+func contentRangeStart(header string) (int64, bool) {
+	rangeSpec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, false
+	}
+	start, _, ok := strings.Cut(rangeSpec, "-")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+// contentRangeSize parses the complete length from "bytes 100-199/200".
+func contentRangeSize(header string) (int64, bool) {
+	_, size, ok := strings.Cut(header, "/")
+	if !ok || size == "*" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(size, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
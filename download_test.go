@@ -0,0 +1,209 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newDownloadServer(t *testing.T, content []byte, gotRange *string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if gotRange != nil {
+			*gotRange = r.Header.Get("Range")
+		}
+		http.ServeContent(w, r, "file.mp4", time.Time{}, bytes.NewReader(content))
+	}))
+}
+
+func TestDownloadDubbedFileTo(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	sum := sha256.Sum256(content)
+
+	var gotRange string
+	server := newDownloadServer(t, content, &gotRange)
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-api-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "dub_es.mp4")
+	if err := os.WriteFile(dest+".part", content[:4000], 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var lastWritten, lastTotal int64
+	result, err := client.Dubbing().DownloadDubbedFileTo(context.Background(), "dub1", "es", dest, &DownloadOptions{
+		Resume: true,
+		SHA256: hex.EncodeToString(sum[:]),
+		OnProgress: func(written, total int64) {
+			lastWritten, lastTotal = written, total
+		},
+	})
+	if err != nil {
+		t.Fatalf("DownloadDubbedFileTo() error = %v", err)
+	}
+	if gotRange != "bytes=4000-" {
+		t.Errorf("Range = %q, want bytes=4000-", gotRange)
+	}
+	if !result.Resumed || result.Size != int64(len(content)) {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if lastWritten != int64(len(content)) || lastTotal != int64(len(content)) {
+		t.Errorf("progress = %d/%d, want %d/%d", lastWritten, lastTotal, len(content), len(content))
+	}
+
+	got, err := os.ReadFile(dest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Error("downloaded content does not match")
+	}
+	if _, err := os.Stat(dest + ".part"); !os.IsNotExist(err) {
+		t.Error("expected partial file to be renamed")
+	}
+}
+
+func TestDownloadChecksumMismatch(t *testing.T) {
+	server := newDownloadServer(t, []byte("archive"), nil)
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-api-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	dest := filepath.Join(t.TempDir(), "snapshot.zip")
+	_, err = client.Projects().DownloadSnapshotArchiveTo(context.Background(), "p1", "s1", dest, &DownloadOptions{
+		SHA256: "deadbeef",
+	})
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	if _, err := os.Stat(dest); !os.IsNotExist(err) {
+		t.Error("expected no destination file after checksum mismatch")
+	}
+}
+
+func TestDownloadResumeRangeMismatch(t *testing.T) {
+	content := []byte("0123456789")
+	tests := []struct {
+		name         string
+		status       int
+		contentRange string
+	}{
+		{"206 from another offset", http.StatusPartialContent, "bytes 0-9/10"},
+		{"416 with another length", http.StatusRequestedRangeNotSatisfiable, "bytes */20"},
+		{"416 without length", http.StatusRequestedRangeNotSatisfiable, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Range") == "" {
+					_, _ = w.Write(content)
+					return
+				}
+				if tt.contentRange != "" {
+					w.Header().Set("Content-Range", tt.contentRange)
+				}
+				w.WriteHeader(tt.status)
+				if tt.status == http.StatusPartialContent {
+					_, _ = w.Write(content)
+				}
+			}))
+			defer server.Close()
+			client, _ := NewClient(WithAPIKey("test-api-key"), WithBaseURL(server.URL))
+
+			dest := filepath.Join(t.TempDir(), "dub_es.mp4")
+			if err := os.WriteFile(dest+".part", []byte("01234"), 0600); err != nil {
+				t.Fatal(err)
+			}
+			result, err := client.Dubbing().DownloadDubbedFileTo(context.Background(), "dub1", "es", dest, &DownloadOptions{Resume: true})
+			if err != nil {
+				t.Fatalf("DownloadDubbedFileTo() error = %v", err)
+			}
+			if result.Resumed {
+				t.Error("Resumed = true, want a restart")
+			}
+			if got, _ := os.ReadFile(dest); !bytes.Equal(got, content) {
+				t.Errorf("downloaded %q, want %q", got, content)
+			}
+		})
+	}
+}
+
+func TestDownloadResumeIfRange(t *testing.T) {
+	content := []byte("0123456789")
+	etag := `"v1"`
+	var gotIfRange string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotIfRange = r.Header.Get("If-Range")
+		w.Header().Set("ETag", etag)
+		if etag == `"v1"` {
+			// Drop the connection partway through
+			w.Header().Set("Content-Length", "10")
+			_, _ = w.Write(content[:4])
+			return
+		}
+		http.ServeContent(w, r, "file.mp4", time.Time{}, bytes.NewReader(content))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-api-key"), WithBaseURL(server.URL))
+
+	dest := filepath.Join(t.TempDir(), "dub_es.mp4")
+	opts := &DownloadOptions{Resume: true}
+	if _, err := client.Dubbing().DownloadDubbedFileTo(context.Background(), "dub1", "es", dest, opts); err == nil {
+		t.Fatal("expected interrupted download")
+	}
+	if validator, _ := os.ReadFile(dest + ".part.validator"); string(validator) != `"v1"` {
+		t.Errorf("validator = %q, want %q", validator, `"v1"`)
+	}
+
+	// The file changed, so the server ignores the range
+	etag = `"v2"`
+	result, err := client.Dubbing().DownloadDubbedFileTo(context.Background(), "dub1", "es", dest, opts)
+	if err != nil {
+		t.Fatalf("DownloadDubbedFileTo() error = %v", err)
+	}
+	if gotIfRange != `"v1"` {
+		t.Errorf("If-Range = %q, want %q", gotIfRange, `"v1"`)
+	}
+	if result.Resumed {
+		t.Error("Resumed = true for a changed file")
+	}
+	if got, _ := os.ReadFile(dest); !bytes.Equal(got, content) {
+		t.Errorf("downloaded %q, want %q", got, content)
+	}
+	if _, err := os.Stat(dest + ".part.validator"); !os.IsNotExist(err) {
+		t.Error("expected validator to be removed")
+	}
+}
+
+func TestContentRangeStart(t *testing.T) {
+	if n, ok := contentRangeStart("bytes 100-199/200"); !ok || n != 100 {
+		t.Errorf("contentRangeStart() = %d, %v; want 100, true", n, ok)
+	}
+	if _, ok := contentRangeStart("bytes */200"); ok {
+		t.Error("expected no start for an unsatisfied range")
+	}
+}
+
+func TestContentRangeSize(t *testing.T) {
+	if n, ok := contentRangeSize("bytes 100-199/200"); !ok || n != 200 {
+		t.Errorf("contentRangeSize() = %d, %v; want 200, true", n, ok)
+	}
+	if _, ok := contentRangeSize("bytes 0-9/*"); ok {
+		t.Error("expected unknown size for '*'")
+	}
+}
@@ -2,9 +2,13 @@ package elevenlabs
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"time"
 
+	ht "github.com/ogen-go/ogen/http"
+
 	"github.com/agentplexus/go-elevenlabs/internal/api"
 )
 
@@ -13,6 +17,17 @@ type DubbingService struct {
 	client *Client
 }
 
+// DubbingStatus is the status of a dubbing project.
+type DubbingStatus string
+
+// Dubbing project statuses.
+const (
+	DubbingStatusDubbing DubbingStatus = "dubbing"
+	DubbingStatusCloning DubbingStatus = "cloning"
+	DubbingStatusDubbed  DubbingStatus = "dubbed"
+	DubbingStatusFailed  DubbingStatus = "failed"
+)
+
 // DubbingProject represents a dubbing project.
 type DubbingProject struct {
 	// DubbingID is the unique identifier.
@@ -21,8 +36,8 @@ type DubbingProject struct {
 	// Name is the project name.
 	Name string
 
-	// Status is the current status (dubbed, dubbing, failed, cloning).
-	Status string
+	// Status is the current status.
+	Status DubbingStatus
 
 	// TargetLanguages are the target languages for dubbing.
 	TargetLanguages []string
@@ -57,6 +72,13 @@ type DubbingRequest struct {
 	// File is the source media file (alternative to SourceURL).
 	File io.Reader
 
+	// Filename is the name of File, used for format detection.
+	// Defaults to "source.mp4".
+	Filename string
+
+	// OnProgress is called as File is uploaded (optional).
+	OnProgress UploadProgressFunc
+
 	// SourceLanguage is the source language code (ISO 639-1).
 	SourceLanguage string
 
@@ -80,6 +102,15 @@ type DubbingRequest struct {
 
 	// DropBackgroundAudio removes background audio.
 	DropBackgroundAudio bool
+
+	// DubbingStudio creates an editable dubbing resource, needed for
+	// GetResource, segment edits, and speaker voice changes.
+	DubbingStudio bool
+
+	// DisableVoiceCloning uses voices from the ElevenLabs voice library for
+	// the speakers instead of cloning the source speakers. Pin specific
+	// voices afterwards with SetSpeakerVoices.
+	DisableVoiceCloning bool
 }
 
 // CreateFromURL creates a dubbing project from a URL source.
@@ -91,37 +122,37 @@ func (s *DubbingService) CreateFromURL(ctx context.Context, req *DubbingRequest)
 		return nil, &ValidationError{Field: "target_language", Message: "cannot be empty"}
 	}
 
-	// Build request body
-	body := api.BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart{}
+	body := req.apiBody()
 	body.SourceURL = api.NewOptNilString(req.SourceURL)
-	body.TargetLang = api.NewOptNilString(req.TargetLanguage)
+	return s.create(ctx, s.client.apiClient, body)
+}
 
-	if req.Name != "" {
-		body.Name = api.NewOptNilString(req.Name)
-	}
-	if req.SourceLanguage != "" {
-		body.SourceLang = api.NewOptString(req.SourceLanguage)
-	}
-	if req.NumSpeakers != 0 {
-		body.NumSpeakers = api.NewOptInt(req.NumSpeakers)
-	}
-	if req.Watermark {
-		body.Watermark = api.NewOptBool(true)
-	}
-	if req.StartTime > 0 {
-		body.StartTime = api.NewOptNilInt(req.StartTime)
-	}
-	if req.EndTime > 0 {
-		body.EndTime = api.NewOptNilInt(req.EndTime)
+// CreateFromFile creates a dubbing project by uploading a media file.
+// The file is streamed to the API, so large videos are never held in memory,
+// and the upload is bounded only by ctx, not the client timeout.
+func (s *DubbingService) CreateFromFile(ctx context.Context, req *DubbingRequest) (*DubbingResponse, error) {
+	if req.File == nil {
+		return nil, &ValidationError{Field: "file", Message: "cannot be nil"}
 	}
-	if req.HighestResolution {
-		body.HighestResolution = api.NewOptBool(true)
+	if req.TargetLanguage == "" {
+		return nil, &ValidationError{Field: "target_language", Message: "cannot be empty"}
 	}
-	if req.DropBackgroundAudio {
-		body.DropBackgroundAudio = api.NewOptBool(true)
+
+	filename := req.Filename
+	if filename == "" {
+		filename = "source.mp4"
 	}
+	body := req.apiBody()
+	body.File = api.NewOptMultipartFile(ht.MultipartFile{
+		Name: filename,
+		File: withUploadProgress(req.File, req.OnProgress),
+	})
+	return s.create(ctx, s.client.streamAPIClient, body)
+}
 
-	resp, err := s.client.apiClient.CreateDubbing(ctx, api.NewOptBodyDubAVideoOrAnAudioFileV1DubbingPostMultipart(body), api.CreateDubbingParams{})
+// create sends a dubbing request with the given generated client.
+func (s *DubbingService) create(ctx context.Context, client *api.Client, body api.BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) (*DubbingResponse, error) {
+	resp, err := client.CreateDubbing(ctx, api.NewOptBodyDubAVideoOrAnAudioFileV1DubbingPostMultipart(body), api.CreateDubbingParams{})
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +169,45 @@ func (s *DubbingService) CreateFromURL(ctx context.Context, req *DubbingRequest)
 	}
 }
 
+// apiBody returns the request's options as a generated request body,
+// without its source.
+func (r *DubbingRequest) apiBody() api.BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart {
+	body := api.BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart{}
+	body.TargetLang = api.NewOptNilString(r.TargetLanguage)
+
+	if r.Name != "" {
+		body.Name = api.NewOptNilString(r.Name)
+	}
+	if r.SourceLanguage != "" {
+		body.SourceLang = api.NewOptString(r.SourceLanguage)
+	}
+	if r.NumSpeakers != 0 {
+		body.NumSpeakers = api.NewOptInt(r.NumSpeakers)
+	}
+	if r.Watermark {
+		body.Watermark = api.NewOptBool(true)
+	}
+	if r.StartTime > 0 {
+		body.StartTime = api.NewOptNilInt(r.StartTime)
+	}
+	if r.EndTime > 0 {
+		body.EndTime = api.NewOptNilInt(r.EndTime)
+	}
+	if r.HighestResolution {
+		body.HighestResolution = api.NewOptBool(true)
+	}
+	if r.DropBackgroundAudio {
+		body.DropBackgroundAudio = api.NewOptBool(true)
+	}
+	if r.DubbingStudio {
+		body.DubbingStudio = api.NewOptBool(true)
+	}
+	if r.DisableVoiceCloning {
+		body.DisableVoiceCloning = api.NewOptBool(true)
+	}
+	return body
+}
+
 // Get returns a dubbing project metadata by ID.
 func (s *DubbingService) Get(ctx context.Context, dubbingID string) (*DubbingProject, error) {
 	if dubbingID == "" {
@@ -157,7 +227,7 @@ func (s *DubbingService) Get(ctx context.Context, dubbingID string) (*DubbingPro
 		project := &DubbingProject{
 			DubbingID:       r.DubbingID,
 			Name:            r.Name,
-			Status:          r.Status,
+			Status:          DubbingStatus(r.Status),
 			TargetLanguages: r.TargetLanguages,
 			CreatedAt:       r.CreatedAt,
 		}
@@ -212,17 +282,91 @@ func (s *DubbingService) GetDubbedFile(ctx context.Context, dubbingID, languageC
 	}
 }
 
+// DefaultDubbingPollInterval is the polling interval used by Wait when none
+// is given.
+const DefaultDubbingPollInterval = 5 * time.Second
+
+// Wait polls a dubbing project until it is complete and returns its final
+// state. It returns an error wrapping ErrDubbingFailed if dubbing fails, or
+// the context error if ctx is done first. An interval of 0 uses
+// DefaultDubbingPollInterval.
+func (s *DubbingService) Wait(ctx context.Context, dubbingID string, interval time.Duration) (*DubbingProject, error) {
+	if interval <= 0 {
+		interval = DefaultDubbingPollInterval
+	}
+
+	job := s.Job(dubbingID)
+	_, err := s.client.WaitAll(ctx, []Job{job}, &WaitOptions{Interval: interval})
+	switch {
+	case err == nil:
+		return job.Project, nil
+	case errors.Is(err, ErrJobFailed):
+		return job.Project, fmt.Errorf("%w: %s", ErrDubbingFailed, job.Project.Error)
+	case ctx.Err() != nil:
+		return job.Project, ctx.Err()
+	default:
+		// Drop WaitAll's job ID prefix, which is the dubbing ID passed in
+		return nil, errors.Unwrap(err)
+	}
+}
+
+// DubbingJob is a dubbing project as a Job, for waiting on several
+// projects, or on dubbing together with other jobs, with Client.WaitAll.
+type DubbingJob struct {
+	service *DubbingService
+
+	// DubbingID is the dubbing project.
+	DubbingID string
+
+	// Project is the project's state when it was last polled, or nil if it
+	// hasn't been.
+	Project *DubbingProject
+}
+
+// Job returns a Job for an existing dubbing project.
+func (s *DubbingService) Job(dubbingID string) *DubbingJob {
+	return &DubbingJob{service: s, DubbingID: dubbingID}
+}
+
+// ID returns the dubbing ID.
+func (j *DubbingJob) ID() string {
+	return j.DubbingID
+}
+
+// Poll fetches the project and reports its status. Dubbing doesn't report
+// progress.
+func (j *DubbingJob) Poll(ctx context.Context) (JobState, error) {
+	project, err := j.service.Get(ctx, j.DubbingID)
+	if err != nil {
+		return JobState{}, err
+	}
+	j.Project = project
+	switch {
+	case project.IsComplete():
+		return JobState{Status: JobSucceeded, Progress: 1}, nil
+	case project.IsFailed():
+		return JobState{Status: JobFailed, Progress: -1, Error: project.Error}, nil
+	}
+	return JobState{Status: JobRunning, Progress: -1}, nil
+}
+
+// Result returns the dubbed audio or video for a target language once the
+// job has succeeded.
+func (j *DubbingJob) Result(ctx context.Context, languageCode string) (io.Reader, error) {
+	return j.service.GetDubbedFile(ctx, j.DubbingID, languageCode)
+}
+
 // IsComplete checks if a dubbing project is complete.
 func (p *DubbingProject) IsComplete() bool {
-	return p.Status == "dubbed"
+	return p.Status == DubbingStatusDubbed
 }
 
 // IsFailed checks if a dubbing project has failed.
 func (p *DubbingProject) IsFailed() bool {
-	return p.Status == "failed"
+	return p.Status == DubbingStatusFailed
 }
 
 // IsProcessing checks if a dubbing project is still processing.
 func (p *DubbingProject) IsProcessing() bool {
-	return p.Status == "dubbing" || p.Status == "cloning"
+	return p.Status == DubbingStatusDubbing || p.Status == DubbingStatusCloning
 }
@@ -0,0 +1,438 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// Transcript formats for GetTranscript.
+const (
+	TranscriptFormatSRT    = "srt"
+	TranscriptFormatWebVTT = "webvtt"
+	TranscriptFormatJSON   = "json"
+)
+
+// DubbingResource is the editable "dubbing studio" view of a dubbing project:
+// its speakers, segments with per-language translations, and renders.
+type DubbingResource struct {
+	// ID is the dubbing ID.
+	ID string
+
+	// Version is the resource version, incremented on each edit.
+	Version int
+
+	// SourceLanguage is the source language code.
+	SourceLanguage string
+
+	// TargetLanguages are the target language codes.
+	TargetLanguages []string
+
+	// Speakers are the detected speakers, sorted by ID.
+	Speakers []DubbingSpeaker
+
+	// Segments are the speaker segments, sorted by start time.
+	Segments []DubbingSegment
+
+	// Renders are the rendered outputs by language, sorted by ID.
+	Renders []DubbingRender
+}
+
+// DubbingSpeaker is a speaker track in a dubbing resource.
+type DubbingSpeaker struct {
+	// ID is the speaker ID.
+	ID string
+
+	// Name is the speaker name.
+	Name string
+
+	// Voices maps language codes to voice IDs used for this speaker.
+	Voices map[string]string
+
+	// SegmentIDs are the IDs of segments spoken by this speaker.
+	SegmentIDs []string
+}
+
+// DubbingSegment is a single speaker segment in a dubbing resource.
+type DubbingSegment struct {
+	// ID is the segment ID.
+	ID string
+
+	// SpeakerID is the speaker that owns this segment.
+	SpeakerID string
+
+	// StartTime is the segment start in seconds.
+	StartTime float64
+
+	// EndTime is the segment end in seconds.
+	EndTime float64
+
+	// Text is the source-language text.
+	Text string
+
+	// Translations maps language codes to the dubbed segment.
+	Translations map[string]DubbingSegmentTranslation
+}
+
+// DubbingSegmentTranslation is a segment's translation for one language.
+type DubbingSegmentTranslation struct {
+	// Text is the translated text.
+	Text string
+
+	// StartTime is the dubbed segment start in seconds.
+	StartTime float64
+
+	// EndTime is the dubbed segment end in seconds.
+	EndTime float64
+
+	// AudioStale indicates the text changed since the audio was generated;
+	// call Dub to regenerate it.
+	AudioStale bool
+}
+
+// DubbingRender is a rendered output of a dubbing resource.
+type DubbingRender struct {
+	// ID is the render ID.
+	ID string
+
+	// Language is the rendered language code.
+	Language string
+
+	// Type is the render type (e.g., "mp4", "mp3", "wav").
+	Type string
+
+	// Status is the render status ("processing", "complete", "failed").
+	Status string
+
+	// URL is the download URL, when complete.
+	URL string
+
+	// Version is the resource version that was rendered.
+	Version int
+}
+
+// DubbingSegmentUpdate contains changes to a segment translation.
+// Zero values are left unchanged.
+type DubbingSegmentUpdate struct {
+	// Text is the new translated text.
+	Text string
+
+	// StartTime is the new start time in seconds.
+	StartTime float64
+
+	// EndTime is the new end time in seconds.
+	EndTime float64
+}
+
+// DubbingRenderResponse is the result of requesting a render.
+type DubbingRenderResponse struct {
+	// RenderID is the ID of the render job.
+	RenderID string `json:"render_id"`
+
+	// Version is the resource version being rendered.
+	Version int `json:"version"`
+}
+
+// GetResource returns the editable dubbing resource for a project.
+// The project must have been created with dubbing studio enabled.
+func (s *DubbingService) GetResource(ctx context.Context, dubbingID string) (*DubbingResource, error) {
+	if dubbingID == "" {
+		return nil, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+
+	resp, err := s.client.apiClient.GetDubbingResource(ctx, api.GetDubbingResourceParams{
+		DubbingID: dubbingID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := resp.(type) {
+	case *api.DubbingResource:
+		return dubbingResourceFromAPI(r), nil
+	default:
+		return nil, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// UpdateSegment updates a segment's translation for one language.
+// Returns the new resource version. Call Dub afterwards to regenerate audio.
+func (s *DubbingService) UpdateSegment(ctx context.Context, dubbingID, segmentID, language string, update *DubbingSegmentUpdate) (int, error) {
+	if dubbingID == "" {
+		return 0, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+	if segmentID == "" {
+		return 0, &ValidationError{Field: "segment_id", Message: "cannot be empty"}
+	}
+	if language == "" {
+		return 0, &ValidationError{Field: "language", Message: "cannot be empty"}
+	}
+
+	body := &api.SegmentUpdatePayload{}
+	if update.Text != "" {
+		body.Text = api.NewOptNilString(update.Text)
+	}
+	if update.StartTime > 0 {
+		body.StartTime = api.NewOptNilFloat64(update.StartTime)
+	}
+	if update.EndTime > 0 {
+		body.EndTime = api.NewOptNilFloat64(update.EndTime)
+	}
+
+	resp, err := s.client.apiClient.UpdateSegmentLanguage(ctx, body, api.UpdateSegmentLanguageParams{
+		DubbingID: dubbingID,
+		SegmentID: segmentID,
+		Language:  language,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	switch r := resp.(type) {
+	case *api.SegmentUpdateResponse:
+		return r.Version, nil
+	default:
+		return 0, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// Translate re-translates segments into languages. Empty slices mean all
+// segments or all target languages. Returns the new resource version.
+func (s *DubbingService) Translate(ctx context.Context, dubbingID string, segmentIDs, languages []string) (int, error) {
+	if dubbingID == "" {
+		return 0, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+
+	resp, err := s.client.apiClient.Translate(ctx, &api.BodyTranslatesAllOrSomeSegmentsAndLanguagesV1DubbingResourceDubbingIDTranslatePost{
+		Segments:  nonNilStrings(segmentIDs),
+		Languages: nonNilStrings(languages),
+	}, api.TranslateParams{DubbingID: dubbingID})
+	if err != nil {
+		return 0, err
+	}
+
+	switch r := resp.(type) {
+	case *api.SegmentTranslationResponse:
+		return r.Version, nil
+	default:
+		return 0, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// Dub regenerates dubbed audio for segments and languages. Empty slices mean
+// all segments or all target languages. Returns the new resource version.
+func (s *DubbingService) Dub(ctx context.Context, dubbingID string, segmentIDs, languages []string) (int, error) {
+	if dubbingID == "" {
+		return 0, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+
+	resp, err := s.client.apiClient.Dub(ctx, &api.BodyDubsAllOrSomeSegmentsAndLanguagesV1DubbingResourceDubbingIDDubPost{
+		Segments:  nonNilStrings(segmentIDs),
+		Languages: nonNilStrings(languages),
+	}, api.DubParams{DubbingID: dubbingID})
+	if err != nil {
+		return 0, err
+	}
+
+	switch r := resp.(type) {
+	case *api.SegmentDubResponse:
+		return r.Version, nil
+	default:
+		return 0, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// Render re-renders the output for a language after edits.
+// renderType is one of "mp4", "aac", "mp3", "wav", "aaf", "tracks_zip", "clips_zip".
+func (s *DubbingService) Render(ctx context.Context, dubbingID, language, renderType string) (*DubbingRenderResponse, error) {
+	if dubbingID == "" {
+		return nil, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+	if language == "" {
+		return nil, &ValidationError{Field: "language", Message: "cannot be empty"}
+	}
+	if renderType == "" {
+		return nil, &ValidationError{Field: "render_type", Message: "cannot be empty"}
+	}
+
+	body, err := json.Marshal(map[string]string{"render_type": renderType})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := fmt.Sprintf("/v1/dubbing/resource/%s/render/%s", url.PathEscape(dubbingID), url.PathEscape(language))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", s.client.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	s.client.headers.apply(httpReq.Header)
+
+	resp, err := s.client.rawHTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(respBody),
+		}
+	}
+
+	var result DubbingRenderResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return &result, nil
+}
+
+// GetTranscript downloads the transcript for a dubbed language.
+// format is one of TranscriptFormatSRT, TranscriptFormatWebVTT, or
+// TranscriptFormatJSON; empty defaults to SRT.
+func (s *DubbingService) GetTranscript(ctx context.Context, dubbingID, language, format string) ([]byte, error) {
+	if dubbingID == "" {
+		return nil, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+	if language == "" {
+		return nil, &ValidationError{Field: "language_code", Message: "cannot be empty"}
+	}
+
+	params := api.GetDubbedTranscriptFileParams{
+		DubbingID:    dubbingID,
+		LanguageCode: language,
+	}
+	if format != "" {
+		params.FormatType = api.NewOptGetDubbedTranscriptFileFormatType(api.GetDubbedTranscriptFileFormatType(format))
+	}
+
+	resp, err := s.client.apiClient.GetDubbedTranscriptFile(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := resp.(type) {
+	case *api.GetDubbedTranscriptFileOKTextPlain:
+		return io.ReadAll(r.Data)
+	case *api.GetDubbedTranscriptFileOKApplicationJSON:
+		if r.Type == api.StringGetDubbedTranscriptFileOKApplicationJSON {
+			return []byte(r.String), nil
+		}
+		return json.Marshal(r.DubbingTranscriptResponseModel)
+	default:
+		return nil, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// Segment returns the segment with the given ID, or nil.
+func (r *DubbingResource) Segment(id string) *DubbingSegment {
+	for i := range r.Segments {
+		if r.Segments[i].ID == id {
+			return &r.Segments[i]
+		}
+	}
+	return nil
+}
+
+// StaleSegments returns the IDs of segments whose audio is stale in the
+// given language and needs Dub to be called.
+func (r *DubbingResource) StaleSegments(language string) []string {
+	var ids []string
+	for _, seg := range r.Segments {
+		if t, ok := seg.Translations[language]; ok && t.AudioStale {
+			ids = append(ids, seg.ID)
+		}
+	}
+	return ids
+}
+
+// dubbingResourceFromAPI converts the API dubbing resource.
+func dubbingResourceFromAPI(r *api.DubbingResource) *DubbingResource {
+	res := &DubbingResource{
+		ID:              r.ID,
+		Version:         r.Version,
+		SourceLanguage:  r.SourceLanguage,
+		TargetLanguages: r.TargetLanguages,
+	}
+
+	speakerBySegment := make(map[string]string)
+	for id, track := range r.SpeakerTracks {
+		speaker := DubbingSpeaker{
+			ID:         id,
+			Name:       track.SpeakerName,
+			Voices:     make(map[string]string, len(track.Voices)),
+			SegmentIDs: track.Segments,
+		}
+		for lang, voiceID := range track.Voices {
+			speaker.Voices[lang] = voiceID
+		}
+		for _, segID := range track.Segments {
+			speakerBySegment[segID] = id
+		}
+		res.Speakers = append(res.Speakers, speaker)
+	}
+	sort.Slice(res.Speakers, func(i, j int) bool { return res.Speakers[i].ID < res.Speakers[j].ID })
+
+	for id, seg := range r.SpeakerSegments {
+		segment := DubbingSegment{
+			ID:           id,
+			SpeakerID:    speakerBySegment[id],
+			StartTime:    seg.StartTime,
+			EndTime:      seg.EndTime,
+			Text:         seg.Text,
+			Translations: make(map[string]DubbingSegmentTranslation, len(seg.Dubs)),
+		}
+		for lang, dub := range seg.Dubs {
+			t := DubbingSegmentTranslation{
+				StartTime:  dub.StartTime,
+				EndTime:    dub.EndTime,
+				AudioStale: dub.AudioStale,
+			}
+			if !dub.Text.Null {
+				t.Text = dub.Text.Value
+			}
+			segment.Translations[lang] = t
+		}
+		res.Segments = append(res.Segments, segment)
+	}
+	sort.Slice(res.Segments, func(i, j int) bool {
+		if res.Segments[i].StartTime != res.Segments[j].StartTime {
+			return res.Segments[i].StartTime < res.Segments[j].StartTime
+		}
+		return res.Segments[i].ID < res.Segments[j].ID
+	})
+
+	for id, render := range r.Renders {
+		dr := DubbingRender{
+			ID:      id,
+			Type:    string(render.Type),
+			Status:  string(render.Status),
+			URL:     render.MediaRef.URL,
+			Version: render.Version,
+		}
+		if !render.Language.Null {
+			dr.Language = render.Language.Value
+		}
+		res.Renders = append(res.Renders, dr)
+	}
+	sort.Slice(res.Renders, func(i, j int) bool { return res.Renders[i].ID < res.Renders[j].ID })
+
+	return res
+}
+
+// nonNilStrings returns an empty slice for nil, so the API receives [] rather than null.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
@@ -0,0 +1,73 @@
+package elevenlabs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+func TestDubbingResourceFromAPI(t *testing.T) {
+	r := &api.DubbingResource{
+		ID:              "dub1",
+		Version:         3,
+		SourceLanguage:  "en",
+		TargetLanguages: []string{"es"},
+		SpeakerTracks: api.DubbingResourceSpeakerTracks{
+			"spk1": {ID: "spk1", SpeakerName: "Alice", Segments: []string{"seg2", "seg1"}, Voices: api.SpeakerTrackVoices{"es": "voiceES"}},
+		},
+		SpeakerSegments: api.DubbingResourceSpeakerSegments{
+			"seg2": {ID: "seg2", StartTime: 5, EndTime: 8, Text: "Bye"},
+			"seg1": {
+				ID: "seg1", StartTime: 0, EndTime: 4, Text: "Hello",
+				Dubs: api.SpeakerSegmentDubs{
+					"es": {Text: api.NewNilString("Hola"), StartTime: 0, EndTime: 4, AudioStale: true},
+				},
+			},
+		},
+	}
+
+	res := dubbingResourceFromAPI(r)
+	if res.ID != "dub1" || res.Version != 3 {
+		t.Errorf("unexpected resource header: %+v", res)
+	}
+	if len(res.Segments) != 2 || res.Segments[0].ID != "seg1" {
+		t.Fatalf("segments not sorted by start time: %+v", res.Segments)
+	}
+	if res.Segments[0].SpeakerID != "spk1" {
+		t.Errorf("SpeakerID = %q, want spk1", res.Segments[0].SpeakerID)
+	}
+	if got := res.Segments[0].Translations["es"].Text; got != "Hola" {
+		t.Errorf("translation = %q, want Hola", got)
+	}
+	if len(res.Speakers) != 1 || res.Speakers[0].Voices["es"] != "voiceES" {
+		t.Errorf("unexpected speakers: %+v", res.Speakers)
+	}
+	if stale := res.StaleSegments("es"); len(stale) != 1 || stale[0] != "seg1" {
+		t.Errorf("StaleSegments = %v, want [seg1]", stale)
+	}
+	if res.Segment("seg2") == nil || res.Segment("missing") != nil {
+		t.Error("Segment lookup failed")
+	}
+}
+
+func TestDubbingResourceValidation(t *testing.T) {
+	client, err := NewClient(WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := client.Dubbing().GetResource(ctx, ""); !isValidationError(err, nil) {
+		t.Errorf("GetResource() error = %v, want validation error", err)
+	}
+	if _, err := client.Dubbing().UpdateSegment(ctx, "dub1", "", "es", &DubbingSegmentUpdate{}); !isValidationError(err, nil) {
+		t.Errorf("UpdateSegment() error = %v, want validation error", err)
+	}
+	if _, err := client.Dubbing().Render(ctx, "dub1", "es", ""); !isValidationError(err, nil) {
+		t.Errorf("Render() error = %v, want validation error", err)
+	}
+	if _, err := client.Dubbing().GetTranscript(ctx, "dub1", "", TranscriptFormatSRT); !isValidationError(err, nil) {
+		t.Errorf("GetTranscript() error = %v, want validation error", err)
+	}
+}
@@ -0,0 +1,214 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// Voice IDs that clone the source speaker instead of using a library voice.
+const (
+	// DubbingVoiceTrackClone clones the speaker from their whole track.
+	DubbingVoiceTrackClone = "track-clone"
+
+	// DubbingVoiceClipClone clones the speaker separately for each clip.
+	DubbingVoiceClipClone = "clip-clone"
+)
+
+// DubbingSpeakerUpdate contains changes to a speaker in a dubbing resource.
+// Zero values are left unchanged.
+type DubbingSpeakerUpdate struct {
+	// Name is the new speaker name.
+	Name string
+
+	// VoiceID is a voice library voice ID, DubbingVoiceTrackClone, or
+	// DubbingVoiceClipClone.
+	VoiceID string
+
+	// Languages are the target languages the change applies to. Empty means
+	// all target languages.
+	Languages []string
+
+	// Stability is the voice stability (0.0 to 1.0).
+	Stability float64
+
+	// SimilarityBoost is the voice similarity (0.0 to 1.0).
+	SimilarityBoost float64
+
+	// Style is the voice style (0.0 to 1.0).
+	Style float64
+}
+
+// DubbingSimilarVoice is a library voice similar to a dubbing speaker.
+type DubbingSimilarVoice struct {
+	// VoiceID is the voice ID.
+	VoiceID string
+
+	// Name is the voice name.
+	Name string
+
+	// Category is the voice category (e.g., "premade", "professional").
+	Category string
+
+	// Description is the voice description.
+	Description string
+
+	// PreviewURL is a sample of the voice.
+	PreviewURL string
+}
+
+// Speaker returns the speaker with the given ID, or nil.
+func (r *DubbingResource) Speaker(id string) *DubbingSpeaker {
+	for i := range r.Speakers {
+		if r.Speakers[i].ID == id {
+			return &r.Speakers[i]
+		}
+	}
+	return nil
+}
+
+// SpeakerByName returns the first speaker with the given name, or nil.
+func (r *DubbingResource) SpeakerByName(name string) *DubbingSpeaker {
+	for i := range r.Speakers {
+		if r.Speakers[i].Name == name {
+			return &r.Speakers[i]
+		}
+	}
+	return nil
+}
+
+// UpdateSpeaker changes a speaker's name, voice, or voice settings.
+// Returns the new resource version. Call Dub afterwards to regenerate the
+// speaker's audio with the new voice.
+func (s *DubbingService) UpdateSpeaker(ctx context.Context, dubbingID, speakerID string, update *DubbingSpeakerUpdate) (int, error) {
+	if dubbingID == "" {
+		return 0, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+	if speakerID == "" {
+		return 0, &ValidationError{Field: "speaker_id", Message: "cannot be empty"}
+	}
+	if update == nil {
+		return 0, &ValidationError{Field: "update", Message: "cannot be nil"}
+	}
+	for field, v := range map[string]float64{
+		"stability":        update.Stability,
+		"similarity_boost": update.SimilarityBoost,
+		"style":            update.Style,
+	} {
+		if v < 0 || v > 1 {
+			return 0, &ValidationError{Field: field, Message: "must be between 0.0 and 1.0"}
+		}
+	}
+
+	body := api.BodyUpdateMetadataForASpeakerV1DubbingResourceDubbingIDSpeakerSpeakerIDPatch{}
+	if update.Name != "" {
+		body.SpeakerName = api.NewOptNilString(update.Name)
+	}
+	if update.VoiceID != "" {
+		body.VoiceID = api.NewOptNilString(update.VoiceID)
+	}
+	if len(update.Languages) > 0 {
+		body.Languages = api.NewOptNilStringArray(update.Languages)
+	}
+	if update.Stability > 0 {
+		body.VoiceStability = api.NewOptNilFloat64(update.Stability)
+	}
+	if update.SimilarityBoost > 0 {
+		body.VoiceSimilarity = api.NewOptNilFloat64(update.SimilarityBoost)
+	}
+	if update.Style > 0 {
+		body.VoiceStyle = api.NewOptNilFloat64(update.Style)
+	}
+
+	resp, err := s.client.apiClient.UpdateSpeaker(ctx,
+		api.NewOptBodyUpdateMetadataForASpeakerV1DubbingResourceDubbingIDSpeakerSpeakerIDPatch(body),
+		api.UpdateSpeakerParams{DubbingID: dubbingID, SpeakerID: speakerID})
+	if err != nil {
+		return 0, err
+	}
+
+	switch r := resp.(type) {
+	case *api.SpeakerUpdatedResponse:
+		return r.Version, nil
+	default:
+		return 0, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// SetSpeakerVoices pins voices to speakers, mapping speaker IDs to voice
+// IDs, for the given target languages (empty means all). Speakers are
+// updated in ID order and the first failure is returned. Returns the new
+// resource version; call Dub afterwards to regenerate the audio.
+//
+// Example:
+//
+//	version, err := client.Dubbing().SetSpeakerVoices(ctx, dubbingID, map[string]string{
+//	    "speaker_0": brandNarratorVoiceID,
+//	    "speaker_1": brandGuestVoiceID,
+//	}, nil)
+//	if err == nil {
+//	    _, err = client.Dubbing().Dub(ctx, dubbingID, nil, nil)
+//	}
+func (s *DubbingService) SetSpeakerVoices(ctx context.Context, dubbingID string, voices map[string]string, languages []string) (int, error) {
+	speakerIDs := make([]string, 0, len(voices))
+	for id := range voices {
+		speakerIDs = append(speakerIDs, id)
+	}
+	sort.Strings(speakerIDs)
+
+	version := 0
+	for _, id := range speakerIDs {
+		if voices[id] == "" {
+			return version, &ValidationError{Field: "voices", Message: fmt.Sprintf("speaker %s has no voice ID", id)}
+		}
+		v, err := s.UpdateSpeaker(ctx, dubbingID, id, &DubbingSpeakerUpdate{VoiceID: voices[id], Languages: languages})
+		if err != nil {
+			return version, fmt.Errorf("speaker %s: %w", id, err)
+		}
+		version = v
+	}
+	return version, nil
+}
+
+// SimilarVoices returns library voices similar to a speaker, as candidates
+// for SetSpeakerVoices.
+func (s *DubbingService) SimilarVoices(ctx context.Context, dubbingID, speakerID string) ([]DubbingSimilarVoice, error) {
+	if dubbingID == "" {
+		return nil, &ValidationError{Field: "dubbing_id", Message: "cannot be empty"}
+	}
+	if speakerID == "" {
+		return nil, &ValidationError{Field: "speaker_id", Message: "cannot be empty"}
+	}
+
+	resp, err := s.client.apiClient.GetSimilarVoicesForSpeaker(ctx, api.GetSimilarVoicesForSpeakerParams{
+		DubbingID: dubbingID,
+		SpeakerID: speakerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := resp.(type) {
+	case *api.SimilarVoicesForSpeakerResponse:
+		voices := make([]DubbingSimilarVoice, 0, len(r.Voices))
+		for _, v := range r.Voices {
+			voice := DubbingSimilarVoice{
+				VoiceID:  v.VoiceID,
+				Name:     v.Name,
+				Category: string(v.Category),
+			}
+			if !v.Description.Null {
+				voice.Description = v.Description.Value
+			}
+			if !v.PreviewURL.Null {
+				voice.PreviewURL = v.PreviewURL.Value
+			}
+			voices = append(voices, voice)
+		}
+		return voices, nil
+	default:
+		return nil, &APIError{Message: "unexpected response type"}
+	}
+}
@@ -0,0 +1,107 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDubbingSetSpeakerVoices(t *testing.T) {
+	updates := map[string]map[string]any{}
+	var order []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			t.Errorf("method = %s, want PATCH", r.Method)
+		}
+		speakerID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		updates[speakerID] = body
+		order = append(order, speakerID)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"version": %d}`, len(order))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	version, err := client.Dubbing().SetSpeakerVoices(context.Background(), "dub1", map[string]string{
+		"speaker_1": "voiceB",
+		"speaker_0": "voiceA",
+	}, []string{"es"})
+	if err != nil {
+		t.Fatalf("SetSpeakerVoices() error = %v", err)
+	}
+	if version != 2 {
+		t.Errorf("version = %d, want 2", version)
+	}
+	if len(order) != 2 || order[0] != "speaker_0" {
+		t.Errorf("update order = %v", order)
+	}
+	if got := updates["speaker_1"]; got["voice_id"] != "voiceB" || got["speaker_name"] != nil {
+		t.Errorf("speaker_1 update = %v", got)
+	}
+	if langs, _ := updates["speaker_0"]["languages"].([]any); len(langs) != 1 || langs[0] != "es" {
+		t.Errorf("languages = %v", updates["speaker_0"]["languages"])
+	}
+
+	if _, err := client.Dubbing().SetSpeakerVoices(context.Background(), "dub1", map[string]string{"speaker_0": ""}, nil); !isValidationError(err, nil) {
+		t.Errorf("SetSpeakerVoices() with empty voice = %v", err)
+	}
+	if _, err := client.Dubbing().UpdateSpeaker(context.Background(), "dub1", "speaker_0", &DubbingSpeakerUpdate{Style: 2}); !isValidationError(err, nil) {
+		t.Errorf("UpdateSpeaker() with out of range style = %v", err)
+	}
+}
+
+func TestDubbingSimilarVoices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/dubbing/resource/dub1/speaker/speaker_0/similar-voices" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"voices": [{"voice_id": "v1", "name": "Rachel", "category": "premade",
+			"description": null, "preview_url": "https://example.com/v1.mp3"}]}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	voices, err := client.Dubbing().SimilarVoices(context.Background(), "dub1", "speaker_0")
+	if err != nil {
+		t.Fatalf("SimilarVoices() error = %v", err)
+	}
+	if len(voices) != 1 || voices[0].VoiceID != "v1" || voices[0].PreviewURL == "" || voices[0].Category != "premade" {
+		t.Errorf("voices = %+v", voices)
+	}
+}
+
+func TestDubbingCreateVoiceOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseMultipartForm(1 << 20)
+		if r.FormValue("disable_voice_cloning") != "true" || r.FormValue("dubbing_studio") != "true" {
+			t.Errorf("form = %v", r.MultipartForm.Value)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"dubbing_id":"dub1","expected_duration_sec":1}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	_, err := client.Dubbing().CreateFromFile(context.Background(), &DubbingRequest{
+		File:                bytes.NewReader([]byte("video")),
+		TargetLanguage:      "es",
+		DubbingStudio:       true,
+		DisableVoiceCloning: true,
+	})
+	if err != nil {
+		t.Fatalf("CreateFromFile() error = %v", err)
+	}
+
+	res := &DubbingResource{Speakers: []DubbingSpeaker{{ID: "speaker_0", Name: "Host"}}}
+	if res.Speaker("speaker_0") == nil || res.SpeakerByName("Host") == nil || res.Speaker("speaker_9") != nil {
+		t.Error("speaker lookup failed")
+	}
+}
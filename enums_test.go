@@ -0,0 +1,79 @@
+package elevenlabs
+
+import (
+	"testing"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// apiValues converts generated enum values to strings.
+func apiValues[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
+
+// TestEnumsCoverAPI fails when the regenerated API client adds an enum value
+// that has no exported constant, so new values are surfaced deliberately.
+func TestEnumsCoverAPI(t *testing.T) {
+	tests := []struct {
+		name  string
+		api   []string
+		known []string
+	}{
+		{
+			name: "VoiceCategory",
+			api:  apiValues(api.VoiceResponseModelCategory("").AllValues()),
+			known: []string{
+				string(VoiceCategoryPremade), string(VoiceCategoryCloned), string(VoiceCategoryGenerated),
+				string(VoiceCategoryProfessional), string(VoiceCategoryFamous), string(VoiceCategoryHighQuality),
+			},
+		},
+		{
+			name: "HistoryItem.VoiceCategory",
+			api:  apiValues(api.SpeechHistoryItemResponseModelVoiceCategory("").AllValues()),
+			known: []string{
+				string(VoiceCategoryPremade), string(VoiceCategoryCloned), string(VoiceCategoryGenerated),
+				string(VoiceCategoryProfessional), string(VoiceCategoryFamous), string(VoiceCategoryHighQuality),
+			},
+		},
+		{
+			name:  "HistoryState",
+			api:   apiValues(api.SpeechHistoryItemResponseModelState("").AllValues()),
+			known: []string{string(HistoryStateCreated), string(HistoryStateDeleted), string(HistoryStateProcessing)},
+		},
+		{
+			name: "HistorySource",
+			api:  apiValues(api.SpeechHistoryItemResponseModelSource("").AllValues()),
+			known: []string{
+				string(HistorySourceTTS), string(HistorySourceSTS), string(HistorySourceProjects),
+				string(HistorySourcePD), string(HistorySourceAN), string(HistorySourceDubbing),
+				string(HistorySourcePlayAPI), string(HistorySourceConvAI), string(HistorySourceVoiceGeneration),
+			},
+		},
+		{
+			name:  "Project.AccessLevel",
+			api:   apiValues(api.ProjectResponseModelAccessLevel("").AllValues()),
+			known: []string{string(RoleAdmin), string(RoleEditor), string(RoleCommenter), string(RoleViewer)},
+		},
+		{
+			name:  "ChapterState",
+			api:   apiValues(api.ChapterResponseModelState("").AllValues()),
+			known: []string{string(ChapterStateDefault), string(ChapterStateConverting)},
+		},
+	}
+
+	for _, tt := range tests {
+		known := make(map[string]bool, len(tt.known))
+		for _, v := range tt.known {
+			known[v] = true
+		}
+		for _, v := range tt.api {
+			if !known[v] {
+				t.Errorf("%s: API value %q has no constant", tt.name, v)
+			}
+		}
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
+	"syscall"
 
 	"github.com/agentplexus/ogen-tools/ogenerror"
 )
@@ -13,6 +16,14 @@ var (
 	// ErrNoAPIKey is returned when no API key is provided.
 	ErrNoAPIKey = errors.New("elevenlabs: API key is required")
 
+	// ErrInvalidAPIKey is returned by Client.Ping when the API key is
+	// rejected.
+	ErrInvalidAPIKey = errors.New("elevenlabs: invalid API key")
+
+	// ErrProductUnavailable is returned by PingResult.Require when a
+	// product is not available to the API key.
+	ErrProductUnavailable = errors.New("elevenlabs: product not available")
+
 	// ErrEmptyText is returned when text is empty.
 	ErrEmptyText = errors.New("elevenlabs: text cannot be empty")
 
@@ -30,6 +41,27 @@ var (
 
 	// ErrInvalidSpeed is returned when speed is out of range.
 	ErrInvalidSpeed = errors.New("elevenlabs: speed must be between 0.25 and 4.0")
+
+	// ErrDubbingFailed is returned by DubbingService.Wait when dubbing fails.
+	ErrDubbingFailed = errors.New("elevenlabs: dubbing failed")
+
+	// ErrJobFailed is returned by Client.WaitAll when a job fails.
+	ErrJobFailed = errors.New("elevenlabs: job failed")
+
+	// ErrWebSocketIdle is sent on a WebSocket connection's Errors channel
+	// when it is closed after WebSocketKeepalive.IdleTimeout.
+	ErrWebSocketIdle = errors.New("elevenlabs: websocket closed after idle timeout")
+
+	// ErrWebSocketTimeout is sent on a WebSocket connection's Errors channel
+	// when the server stops responding to pings.
+	ErrWebSocketTimeout = errors.New("elevenlabs: websocket ping timeout")
+
+	// ErrStreamInterrupted matches a StreamInterruptedError, returned when
+	// an audio response fails partway and cannot be retried or resumed.
+	ErrStreamInterrupted = errors.New("elevenlabs: audio stream interrupted")
+
+	// ErrUnsupportedOutputFormat matches an OutputFormatError.
+	ErrUnsupportedOutputFormat = errors.New("elevenlabs: unsupported output format")
 )
 
 // ValidationError represents a validation error.
@@ -58,6 +90,100 @@ func (e *APIError) Error() string {
 	return fmt.Sprintf("elevenlabs: API error (status %d): %s", e.StatusCode, e.Message)
 }
 
+// DecodeError is returned when a response decodes but doesn't match the
+// API schema, such as an enum value this package doesn't know about. With
+// WithDecodeMode(DecodeLenient) or DecodeWarn, such responses are accepted
+// instead.
+type DecodeError struct {
+	// Operation is the request whose response didn't match, such as
+	// "GET /v1/voices". Empty if unknown.
+	Operation string
+
+	// Err describes the fields that failed validation.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *DecodeError) Error() string {
+	if e.Operation != "" {
+		return fmt.Sprintf("elevenlabs: %s response does not match the API schema: %v", e.Operation, e.Err)
+	}
+	return fmt.Sprintf("elevenlabs: response does not match the API schema: %v", e.Err)
+}
+
+// Unwrap returns the validation error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// StreamInterruptedError is returned when reading an audio response fails
+// partway, such as on a connection reset, and the request could not be
+// retried or resumed. It matches ErrStreamInterrupted.
+type StreamInterruptedError struct {
+	// Delivered is the number of audio bytes already delivered to the
+	// caller. Zero for Generate, which delivers nothing on failure.
+	Delivered int64
+
+	// Attempts is the number of requests made.
+	Attempts int
+
+	// Err is the read error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *StreamInterruptedError) Error() string {
+	return fmt.Sprintf("elevenlabs: audio stream interrupted after %d bytes (%d attempts): %v", e.Delivered, e.Attempts, e.Err)
+}
+
+// Unwrap returns the read error.
+func (e *StreamInterruptedError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrStreamInterrupted.
+func (e *StreamInterruptedError) Is(target error) bool {
+	return target == ErrStreamInterrupted
+}
+
+// OutputFormatError is returned when an endpoint does not accept an output
+// format for the subscription tier. It matches ErrUnsupportedOutputFormat.
+type OutputFormatError struct {
+	// Endpoint is the endpoint the format was checked for.
+	Endpoint OutputFormatEndpoint
+
+	// Tier is the subscription tier, or empty if unknown.
+	Tier string
+
+	// Format is the rejected format, or the codec when negotiation found
+	// no allowed format.
+	Format string
+
+	// Allowed lists the formats the endpoint accepts for the tier.
+	Allowed []string
+}
+
+// Error implements the error interface.
+func (e *OutputFormatError) Error() string {
+	tier := ""
+	if e.Tier != "" {
+		tier = fmt.Sprintf(" on tier %q", e.Tier)
+	}
+	return fmt.Sprintf("elevenlabs: output format %q not available for %s%s; allowed: %s",
+		e.Format, e.Endpoint, tier, strings.Join(e.Allowed, ", "))
+}
+
+// Is reports whether target is ErrUnsupportedOutputFormat.
+func (e *OutputFormatError) Is(target error) bool {
+	return target == ErrUnsupportedOutputFormat
+}
+
+// isStreamInterruption reports whether err is a connection failure while
+// a response was being read.
+func isStreamInterruption(err error) bool {
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
 // IsNotFoundError returns true if the error is a 404 Not Found error.
 func IsNotFoundError(err error) bool {
 	var apiErr *APIError
@@ -0,0 +1,92 @@
+// Example server is an HTTP text-to-speech proxy that streams audio to the
+// browser as it is generated, keeping the API key on the server.
+//
+// Run with:
+//
+//	ELEVENLABS_API_KEY=your-api-key go run main.go -addr :8080
+//
+// Then open http://localhost:8080 and speak some text. The /speak endpoint
+// can also be called directly:
+//
+//	curl -o hello.mp3 'http://localhost:8080/speak?text=Hello'
+//
+// Each client IP may generate -quota characters per hour. Behind reverse
+// proxies, pass -trusted-proxies with their number so clients are told
+// apart by the X-Forwarded-For entry the outermost proxy added.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+	"github.com/agentplexus/go-elevenlabs/httpaudio"
+	"github.com/agentplexus/go-elevenlabs/voices"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "Address to listen on")
+	voiceID := flag.String("voice", voices.Rachel, "Default voice ID")
+	modelID := flag.String("model", "eleven_flash_v2_5", "Model ID (flash models start playback fastest)")
+	quota := flag.Int("quota", 5000, "Characters each client IP may generate per hour")
+	trustedProxies := flag.Int("trusted-proxies", 0, "Number of reverse proxies appending to X-Forwarded-For")
+	flag.Parse()
+
+	client, err := elevenlabs.NewClient()
+	if err != nil {
+		log.Fatalf("Failed to create client: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/speak", &httpaudio.Handler{
+		TTS:            client.TextToSpeech(),
+		VoiceID:        *voiceID,
+		ModelID:        *modelID,
+		OutputFormat:   "mp3_44100_128",
+		Quota:          httpaudio.NewQuota(*quota, time.Hour),
+		TrustedProxies: *trustedProxies,
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(page))
+	})
+
+	server := &http.Server{
+		Addr:              *addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		// No WriteTimeout: it would cut off long streams. Generation is
+		// bounded by the text length limit instead.
+		IdleTimeout: 2 * time.Minute,
+	}
+	log.Printf("Listening on %s", *addr)
+	log.Fatal(server.ListenAndServe())
+}
+
+// page plays /speak in an <audio> element, which starts as soon as the
+// first chunk arrives.
+const page = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Text to Speech</title></head>
+<body>
+<form id="form">
+  <textarea id="text" rows="4" cols="60">Hello! This audio is streamed as it is generated.</textarea><br>
+  <button>Speak</button>
+</form>
+<audio id="audio" controls autoplay></audio>
+<script>
+document.getElementById("form").addEventListener("submit", (e) => {
+  e.preventDefault();
+  const text = document.getElementById("text").value;
+  document.getElementById("audio").src = "/speak?text=" + encodeURIComponent(text);
+});
+</script>
+</body>
+</html>
+`
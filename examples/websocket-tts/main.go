@@ -49,9 +49,9 @@ func main() {
 
 	// Connect to WebSocket TTS with low-latency settings
 	conn, err := client.WebSocketTTS().Connect(ctx, voiceID, &elevenlabs.WebSocketTTSOptions{
-		ModelID:                  "eleven_turbo_v2_5", // Fast model for real-time
-		OutputFormat:             "mp3_44100_128",     // MP3 for easy playback
-		OptimizeStreamingLatency: 3,                   // Balance latency vs quality
+		ModelID:                  "eleven_turbo_v2_5",   // Fast model for real-time
+		OutputFormat:             "mp3_44100_128",       // MP3 for easy playback
+		OptimizeStreamingLatency: elevenlabs.LatencyMax, // Balance latency vs quality
 	})
 	if err != nil {
 		logError(ctx, "Failed to connect WebSocket", err)
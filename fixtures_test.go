@@ -0,0 +1,178 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+	"github.com/agentplexus/go-elevenlabs/recorder"
+)
+
+// newReplayClient returns a client that replays testdata/fixtures/<name>.
+func newReplayClient(t *testing.T, name string, opts ...Option) *Client {
+	t.Helper()
+	rec, err := recorder.New(filepath.Join("testdata", "fixtures", name), recorder.ModeReplay)
+	if err != nil {
+		t.Fatalf("recorder.New() error = %v", err)
+	}
+	opts = append([]Option{WithAPIKey("test-key"), WithRecorder(rec)}, opts...)
+	client, err := NewClient(opts...)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	return client
+}
+
+// waitResult is the outcome of DubbingService.Wait run in a goroutine.
+type waitResult struct {
+	project *DubbingProject
+	err     error
+}
+
+func startWait(ctx context.Context, client *Client, interval time.Duration) <-chan waitResult {
+	done := make(chan waitResult, 1)
+	go func() {
+		project, err := client.Dubbing().Wait(ctx, "dub123", interval)
+		done <- waitResult{project, err}
+	}()
+	return done
+}
+
+func TestDubbingWait_Fixture(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	client := newReplayClient(t, "dubbing_wait.json", withClock(fake))
+
+	done := startWait(context.Background(), client, 10*time.Second)
+
+	// Two in-progress polls, each followed by a wait on the clock.
+	for i := 0; i < 2; i++ {
+		fake.BlockUntil(1)
+		fake.Advance(10 * time.Second)
+	}
+
+	res := <-done
+	if res.err != nil {
+		t.Fatalf("Wait() error = %v", res.err)
+	}
+	if !res.project.IsComplete() {
+		t.Errorf("Status = %q, want dubbed", res.project.Status)
+	}
+	if res.project.Name != "Demo" || len(res.project.TargetLanguages) != 1 {
+		t.Errorf("unexpected project: %+v", res.project)
+	}
+}
+
+func TestDubbingWaitFailed_Fixture(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	client := newReplayClient(t, "dubbing_failed.json", withClock(fake))
+
+	done := startWait(context.Background(), client, 0)
+	fake.BlockUntil(1)
+	fake.Advance(DefaultDubbingPollInterval)
+
+	res := <-done
+	if !errors.Is(res.err, ErrDubbingFailed) {
+		t.Fatalf("Wait() error = %v, want ErrDubbingFailed", res.err)
+	}
+	if res.project == nil || res.project.Error != "source audio is silent" {
+		t.Errorf("unexpected project: %+v", res.project)
+	}
+}
+
+func TestDubbingWaitCanceled_Fixture(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	client := newReplayClient(t, "dubbing_wait.json", withClock(fake))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := startWait(ctx, client, time.Minute)
+	fake.BlockUntil(1)
+	cancel()
+
+	res := <-done
+	if !errors.Is(res.err, context.Canceled) {
+		t.Fatalf("Wait() error = %v, want context.Canceled", res.err)
+	}
+	if res.project == nil || res.project.Status != "cloning" {
+		t.Errorf("Wait() should return the last polled state, got %+v", res.project)
+	}
+}
+
+func TestHistoryList_Fixture(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	client := newReplayClient(t, "history_list.json", withClock(clock.NewFake(now)))
+
+	resp, err := client.History().List(context.Background(), &HistoryListOptions{PageSize: 1})
+	if err != nil {
+		t.Fatalf("History().List() error = %v", err)
+	}
+	if !resp.HasMore || resp.LastHistoryItemID != "h1" {
+		t.Errorf("unexpected pagination: HasMore=%v LastHistoryItemID=%q", resp.HasMore, resp.LastHistoryItemID)
+	}
+	if len(resp.Items) != 1 {
+		t.Fatalf("len(Items) = %d, want 1", len(resp.Items))
+	}
+	if item := resp.Items[0]; item.VoiceName != "Rachel" || item.Text != "Hello world" {
+		t.Errorf("unexpected item: %+v", item)
+	}
+
+	state := client.RateLimitState()
+	if state.Remaining != 42 {
+		t.Errorf("Remaining = %d, want 42", state.Remaining)
+	}
+	if !state.UpdatedAt.Equal(now) {
+		t.Errorf("UpdatedAt = %v, want fake clock time %v", state.UpdatedAt, now)
+	}
+}
+
+func TestReplayUnmatchedRequest(t *testing.T) {
+	client := newReplayClient(t, "history_list.json")
+
+	_, err := client.Dubbing().Get(context.Background(), "unknown")
+	if !errors.Is(err, recorder.ErrNoInteraction) {
+		t.Errorf("Get() error = %v, want recorder.ErrNoInteraction", err)
+	}
+}
+
+func TestProjectsCreateSnapshot_Fixture(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	client := newReplayClient(t, "project_snapshot.json", withClock(fake))
+
+	type result struct {
+		snapshot *ProjectSnapshot
+		err      error
+	}
+	done := make(chan result, 1)
+	go func() {
+		snap, err := client.Projects().CreateSnapshot(context.Background(), "p1", 0)
+		done <- result{snap, err}
+	}()
+
+	// The first poll still lists only the existing snapshot.
+	for i := 0; i < 2; i++ {
+		fake.BlockUntil(1)
+		fake.Advance(DefaultSnapshotPollInterval)
+	}
+
+	res := <-done
+	if res.err != nil {
+		t.Fatalf("CreateSnapshot() error = %v", res.err)
+	}
+	if res.snapshot.ProjectSnapshotID != "s2" {
+		t.Errorf("CreateSnapshot() = %+v, want s2", res.snapshot)
+	}
+
+	// Non-MP3 audio is returned as-is.
+	audio, err := client.Projects().StreamSnapshotAudio(context.Background(), "p1", "s2", nil)
+	if err != nil {
+		t.Fatalf("StreamSnapshotAudio() error = %v", err)
+	}
+	data, _ := io.ReadAll(audio)
+	if !bytes.HasPrefix(data, []byte("RIFF")) {
+		t.Errorf("unexpected audio: %q", data)
+	}
+}
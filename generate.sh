@@ -7,15 +7,34 @@
 # Usage:
 #   ./generate.sh
 #
+# To generate a smaller client with only some endpoints, for embedding in
+# another module, set path patterns ("*" is one segment, "**" any) and a
+# target package. The wrapper package needs every endpoint, so filtered
+# clients are not written to internal/api:
+#   API_INCLUDE='/v1/text-to-speech/**,/v1/voices/**' API_TARGET=../myapp/elevenapi ./generate.sh
+#
 # This script:
 #   1. Downloads the latest ElevenLabs OpenAPI 3.1 spec (optional, with --fetch)
 #   2. Converts OpenAPI 3.1 to 3.0.3 for ogen compatibility
 #   3. Runs ogen to generate Go code
-#   4. Runs go mod tidy to update dependencies
-#   5. Verifies the build compiles
+#   4. Post-processes the generated code (null handling, error bodies,
+#      response validation hook)
+#   5. Runs go mod tidy to update dependencies
+#   6. Verifies the build compiles
 
 set -e
 
+API_TARGET="${API_TARGET:-internal/api}"
+API_PACKAGE="${API_PACKAGE:-$(basename "$API_TARGET")}"
+SPEC="openapi/openapi-v3.0.json"
+if [ -n "$API_INCLUDE" ] || [ -n "$API_EXCLUDE" ]; then
+    if [ "$API_TARGET" == "internal/api" ]; then
+        echo "Error: set API_TARGET when filtering; the SDK needs the full internal/api client."
+        exit 1
+    fi
+    SPEC="openapi/openapi-v3.0.filtered.json"
+fi
+
 # Check if ogen is installed
 if ! command -v ogen &> /dev/null; then
     echo "Error: ogen is not installed."
@@ -41,22 +60,33 @@ fi
 
 # Convert 3.1 to 3.0.3
 echo "Converting OpenAPI 3.1 to 3.0.3..."
-go run ./cmd/openapi-convert openapi/openapi-v3.1.json openapi/openapi-v3.0.json
+# The report lists constraints dropped in conversion, such as exclusive bounds
+go run ./cmd/openapi-convert -report "${SPEC%.json}.report.json" \
+    -include "$API_INCLUDE" -exclude "$API_EXCLUDE" \
+    openapi/openapi-v3.1.json "$SPEC"
 
 # Generate API code
 echo ""
 echo "Generating API code with ogen..."
-ogen --package api --target internal/api --clean openapi/openapi-v3.0.json
+ogen --package "$API_PACKAGE" --target "$API_TARGET" --clean "$SPEC"
+if [ "$API_TARGET" != "internal/api" ]; then
+    sed "s/^package api$/package $API_PACKAGE/" internal/api/responsevalidator.go > "$API_TARGET/responsevalidator.go"
+fi
 
 # Post-process: Fix ogen null handling bug (https://github.com/ogen-go/ogen/issues/1358)
 echo ""
 echo "Post-processing: Fixing Opt* null handling..."
-go run github.com/agentplexus/ogen-tools/cmd/ogen-fixnull@latest internal/api/oas_json_gen.go
+go run github.com/agentplexus/ogen-tools/cmd/ogen-fixnull@latest "$API_TARGET/oas_json_gen.go"
 
 # Post-process: Fix error body preservation (body gets closed before caller can read it)
 echo ""
 echo "Post-processing: Fixing error body preservation..."
-go run github.com/agentplexus/ogen-tools/cmd/ogen-fixerror@latest internal/api/oas_response_decoders_gen.go
+go run github.com/agentplexus/ogen-tools/cmd/ogen-fixerror@latest "$API_TARGET/oas_response_decoders_gen.go"
+
+# Post-process: Route response validation errors through the client's decode mode
+echo ""
+echo "Post-processing: Adding response validation hook..."
+perl -0pi -e 's/\t\t\t\}\(\); err != nil \{\n\t\t\t\treturn res, errors.Wrap\(err, "validate"\)\n\t\t\t\}/\t\t\t}(); err != nil {\n\t\t\t\tif err := validateResponse(resp, err); err != nil {\n\t\t\t\t\treturn res, errors.Wrap(err, "validate")\n\t\t\t\t}\n\t\t\t}/g' "$API_TARGET/oas_response_decoders_gen.go"
 
 echo ""
 echo "Running go mod tidy..."
@@ -70,7 +100,7 @@ echo ""
 echo "Done! API client regenerated successfully."
 echo ""
 echo "Next steps:"
-echo "  1. Review changes in internal/api/"
+echo "  1. Review changes in internal/api/ and the conversion report (${SPEC%.json}.report.json, not committed)"
 echo "  2. Update SDK wrapper code if needed for new/changed endpoints"
 echo "  3. Run tests: go test ./..."
 echo "  4. Run linter: golangci-lint run"
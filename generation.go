@@ -0,0 +1,309 @@
+package elevenlabs
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"sync"
+	"time"
+)
+
+// GenerationType identifies the kind of audio generation.
+type GenerationType string
+
+// Generation types.
+const (
+	GenerationTypeTTS         GenerationType = "tts"
+	GenerationTypeSoundEffect GenerationType = "sound_effect"
+	GenerationTypeMusic       GenerationType = "music"
+)
+
+// GenerationRecord is a service-agnostic record of a single audio generation.
+// It is used for bookkeeping across TTS, sound effects, and music so that
+// outputs can be reconciled against billing.
+type GenerationRecord struct {
+	// Type is the kind of generation.
+	Type GenerationType `json:"type"`
+
+	// Timestamp is when the generation was requested.
+	Timestamp time.Time `json:"timestamp"`
+
+	// ModelID is the model used, if applicable.
+	ModelID string `json:"model_id,omitempty"`
+
+	// VoiceID is the voice used, if applicable.
+	VoiceID string `json:"voice_id,omitempty"`
+
+	// Text is the input text or prompt.
+	Text string `json:"text,omitempty"`
+
+	// OutputFormat is the requested audio format.
+	OutputFormat string `json:"output_format,omitempty"`
+
+	// Params holds additional request parameters.
+	Params map[string]any `json:"params,omitempty"`
+
+	// ResponseMeta holds response metadata such as request IDs or song IDs.
+	ResponseMeta map[string]string `json:"response_meta,omitempty"`
+
+	// OutputPath is where the generated audio was written.
+	OutputPath string `json:"output_path,omitempty"`
+
+	// Bytes is the size of the generated audio in bytes.
+	Bytes int64 `json:"bytes,omitempty"`
+
+	// AudioDurationMs is the duration of the generated audio, if known.
+	AudioDurationMs int64 `json:"audio_duration_ms,omitempty"`
+
+	// ElapsedMs is the wall-clock time the generation took.
+	ElapsedMs int64 `json:"elapsed_ms,omitempty"`
+
+	// Characters is the number of billable input characters.
+	Characters int `json:"characters,omitempty"`
+
+	// Cost is the reported character/credit cost, if known.
+	Cost float64 `json:"cost,omitempty"`
+
+	// Error is the error message if the generation failed.
+	Error string `json:"error,omitempty"`
+
+	// IdempotencyKey is the request's GenerationKey, set by batch
+	// generation.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+
+	// HistoryItemID is the history item of the generation, if known. For
+	// text-to-speech it is the response's RequestID.
+	HistoryItemID string `json:"history_item_id,omitempty"`
+
+	// Metadata holds the request's labels, such as TTSRequest.Metadata.
+	// It does not affect the audio, so it is not part of GenerationKey.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// GenerationKey returns a content hash of everything in a record that
+// affects the generated audio: type, model, voice, text, output format,
+// and parameters. Records with the same key describe identical requests,
+// so the key identifies a generation across runs. Compute it before the
+// record is completed, as batch generation adds an "attempts" parameter.
+func GenerationKey(rec *GenerationRecord) string {
+	data, _ := json.Marshal(struct {
+		Type         GenerationType `json:"type"`
+		ModelID      string         `json:"model_id,omitempty"`
+		VoiceID      string         `json:"voice_id,omitempty"`
+		Text         string         `json:"text"`
+		OutputFormat string         `json:"output_format,omitempty"`
+		Params       map[string]any `json:"params,omitempty"`
+	}{rec.Type, rec.ModelID, rec.VoiceID, rec.Text, rec.OutputFormat, rec.Params})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// NewTTSGenerationRecord creates a record for a text-to-speech request.
+func NewTTSGenerationRecord(req *TTSRequest) *GenerationRecord {
+	modelID := req.ModelID
+	if modelID == "" {
+		modelID = DefaultModelID
+	}
+	rec := &GenerationRecord{
+		Type:         GenerationTypeTTS,
+		Timestamp:    time.Now().UTC(),
+		ModelID:      modelID,
+		VoiceID:      req.VoiceID,
+		Text:         req.Text,
+		OutputFormat: req.OutputFormat,
+		Characters:   len([]rune(req.Text)),
+		Metadata:     maps.Clone(req.Metadata),
+	}
+	if req.LanguageCode != "" {
+		rec.setParam("language_code", req.LanguageCode)
+	}
+	if vs := req.VoiceSettings; vs != nil {
+		o := vs.Optional()
+		if o.Stability != nil {
+			rec.setParam("stability", *o.Stability)
+		}
+		if o.SimilarityBoost != nil {
+			rec.setParam("similarity_boost", *o.SimilarityBoost)
+		}
+		if o.Style != nil {
+			rec.setParam("style", *o.Style)
+		}
+		if o.Speed != nil {
+			rec.setParam("speed", *o.Speed)
+		}
+		if o.UseSpeakerBoost != nil {
+			rec.setParam("use_speaker_boost", *o.UseSpeakerBoost)
+		}
+	}
+	if req.Seed != 0 {
+		rec.setParam("seed", req.Seed)
+	}
+	return rec
+}
+
+// NewSoundEffectGenerationRecord creates a record for a sound effect request.
+func NewSoundEffectGenerationRecord(req *SoundEffectRequest) *GenerationRecord {
+	rec := &GenerationRecord{
+		Type:         GenerationTypeSoundEffect,
+		Timestamp:    time.Now().UTC(),
+		Text:         req.Text,
+		OutputFormat: req.OutputFormat,
+	}
+	if req.DurationSeconds > 0 {
+		rec.setParam("duration_seconds", req.DurationSeconds)
+	}
+	if req.PromptInfluence > 0 {
+		rec.setParam("prompt_influence", req.PromptInfluence)
+	}
+	if req.Loop {
+		rec.setParam("loop", true)
+	}
+	return rec
+}
+
+// NewMusicGenerationRecord creates a record for a music request.
+func NewMusicGenerationRecord(req *MusicRequest) *GenerationRecord {
+	rec := &GenerationRecord{
+		Type:         GenerationTypeMusic,
+		Timestamp:    time.Now().UTC(),
+		Text:         req.Prompt,
+		OutputFormat: req.OutputFormat,
+	}
+	if req.DurationMs > 0 {
+		rec.setParam("duration_ms", req.DurationMs)
+	}
+	if req.ForceInstrumental {
+		rec.setParam("force_instrumental", true)
+	}
+	if req.Seed != 0 {
+		rec.setParam("seed", req.Seed)
+	}
+	return rec
+}
+
+// Complete fills in the result of a generation. Pass a non-nil err for
+// failed generations; elapsed is measured from Timestamp.
+func (r *GenerationRecord) Complete(outputPath string, bytes int64, err error) {
+	r.complete(time.Now(), outputPath, bytes, err)
+}
+
+// complete is Complete at the time now, for generations timed by the
+// client's clock.
+func (r *GenerationRecord) complete(now time.Time, outputPath string, bytes int64, err error) {
+	r.OutputPath = outputPath
+	r.Bytes = bytes
+	r.ElapsedMs = now.Sub(r.Timestamp).Milliseconds()
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+func (r *GenerationRecord) setParam(key string, value any) {
+	if r.Params == nil {
+		r.Params = make(map[string]any)
+	}
+	r.Params[key] = value
+}
+
+// GenerationLog appends GenerationRecords to a JSONL file.
+// It is safe for concurrent use.
+type GenerationLog struct {
+	mu sync.Mutex
+	w  io.Writer
+	c  io.Closer
+}
+
+// OpenGenerationLog opens (or creates) a JSONL file for appending records.
+func OpenGenerationLog(path string) (*GenerationLog, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("open generation log: %w", err)
+	}
+	return &GenerationLog{w: f, c: f}, nil
+}
+
+// NewGenerationLog creates a GenerationLog that writes to w.
+func NewGenerationLog(w io.Writer) *GenerationLog {
+	return &GenerationLog{w: w}
+}
+
+// Append writes a record as a single JSON line.
+func (l *GenerationLog) Append(rec *GenerationRecord) error {
+	data, err := json.Marshal(rec)
+	if err != nil {
+		return fmt.Errorf("marshal generation record: %w", err)
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, err := l.w.Write(data); err != nil {
+		return fmt.Errorf("write generation record: %w", err)
+	}
+	return nil
+}
+
+// Close closes the underlying file, if any. It is safe to call more than once.
+func (l *GenerationLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.c == nil {
+		return nil
+	}
+	err := l.c.Close()
+	l.c = nil
+	return err
+}
+
+// ReadGenerationRecords reads JSONL generation records from r.
+// Blank lines are ignored.
+func ReadGenerationRecords(r io.Reader) ([]GenerationRecord, error) {
+	var records []GenerationRecord
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := scanner.Bytes()
+		if len(data) == 0 {
+			continue
+		}
+		var rec GenerationRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return nil, fmt.Errorf("parse generation record line %d: %w", line, err)
+		}
+		records = append(records, rec)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read generation records: %w", err)
+	}
+	return records, nil
+}
+
+// LoadGenerationRecords reads JSONL generation records from a file.
+func LoadGenerationRecords(path string) ([]GenerationRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open generation log: %w", err)
+	}
+	defer f.Close()
+	return ReadGenerationRecords(f)
+}
+
+// GenerationMetadata indexes the metadata of records by history item ID,
+// for HistoryExportOptions.Metadata. Records without either are skipped;
+// later records win.
+func GenerationMetadata(records []GenerationRecord) map[string]map[string]string {
+	byItem := make(map[string]map[string]string)
+	for _, rec := range records {
+		if rec.HistoryItemID != "" && len(rec.Metadata) > 0 {
+			byItem[rec.HistoryItemID] = rec.Metadata
+		}
+	}
+	return byItem
+}
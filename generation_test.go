@@ -0,0 +1,103 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestNewTTSGenerationRecord(t *testing.T) {
+	rec := NewTTSGenerationRecord(&TTSRequest{
+		VoiceID:       "voice-1",
+		Text:          "Héllo",
+		VoiceSettings: DefaultVoiceSettings(),
+	})
+
+	if rec.Type != GenerationTypeTTS {
+		t.Errorf("expected type %q, got %q", GenerationTypeTTS, rec.Type)
+	}
+	if rec.ModelID != DefaultModelID {
+		t.Errorf("expected default model, got %q", rec.ModelID)
+	}
+	if rec.Characters != 5 {
+		t.Errorf("expected 5 characters, got %d", rec.Characters)
+	}
+	if rec.Params["stability"] != 0.5 {
+		t.Errorf("expected stability param 0.5, got %v", rec.Params["stability"])
+	}
+}
+
+func TestGenerationLogRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	log := NewGenerationLog(&buf)
+
+	sfx := NewSoundEffectGenerationRecord(&SoundEffectRequest{Text: "thunder", Loop: true})
+	sfx.Complete("thunder.mp3", 1024, nil)
+	music := NewMusicGenerationRecord(&MusicRequest{Prompt: "jazz", DurationMs: 10000})
+	music.Complete("", 0, errors.New("boom"))
+
+	for _, rec := range []*GenerationRecord{sfx, music} {
+		if err := log.Append(rec); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+
+	records, err := ReadGenerationRecords(&buf)
+	if err != nil {
+		t.Fatalf("ReadGenerationRecords failed: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if records[0].Type != GenerationTypeSoundEffect || records[0].OutputPath != "thunder.mp3" || records[0].Bytes != 1024 {
+		t.Errorf("unexpected sound effect record: %+v", records[0])
+	}
+	if records[1].Type != GenerationTypeMusic || records[1].Error != "boom" {
+		t.Errorf("unexpected music record: %+v", records[1])
+	}
+}
+
+func TestOpenGenerationLogAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "generations.jsonl")
+
+	for i := 0; i < 2; i++ {
+		log, err := OpenGenerationLog(path)
+		if err != nil {
+			t.Fatalf("OpenGenerationLog failed: %v", err)
+		}
+		if err := log.Append(NewTTSGenerationRecord(&TTSRequest{VoiceID: "v", Text: "hi"})); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+		if err := log.Close(); err != nil {
+			t.Fatalf("Close failed: %v", err)
+		}
+	}
+
+	records, err := LoadGenerationRecords(path)
+	if err != nil {
+		t.Fatalf("LoadGenerationRecords failed: %v", err)
+	}
+	if len(records) != 2 {
+		t.Errorf("expected 2 records, got %d", len(records))
+	}
+}
+
+func TestGenerationKey(t *testing.T) {
+	req := &TTSRequest{VoiceID: "v1", Text: "Hello.", VoiceSettings: DefaultVoiceSettings()}
+	a := NewTTSGenerationRecord(req)
+	b := NewTTSGenerationRecord(req)
+	b.Timestamp = a.Timestamp.Add(time.Hour)
+	if GenerationKey(a) != GenerationKey(b) {
+		t.Error("GenerationKey() differs for identical requests")
+	}
+
+	req.Seed = 42
+	if GenerationKey(a) == GenerationKey(NewTTSGenerationRecord(req)) {
+		t.Error("GenerationKey() ignores the seed")
+	}
+	if GenerationKey(a) == GenerationKey(NewSoundEffectGenerationRecord(&SoundEffectRequest{Text: "Hello."})) {
+		t.Error("GenerationKey() ignores the generation type")
+	}
+}
@@ -13,6 +13,32 @@ type HistoryService struct {
 	client *Client
 }
 
+// HistoryState is the state of a history item.
+type HistoryState string
+
+// History item states.
+const (
+	HistoryStateCreated    HistoryState = "created"
+	HistoryStateDeleted    HistoryState = "deleted"
+	HistoryStateProcessing HistoryState = "processing"
+)
+
+// HistorySource is the product that generated a history item.
+type HistorySource string
+
+// History item sources.
+const (
+	HistorySourceTTS             HistorySource = "TTS"
+	HistorySourceSTS             HistorySource = "STS"
+	HistorySourceProjects        HistorySource = "Projects"
+	HistorySourcePD              HistorySource = "PD"
+	HistorySourceAN              HistorySource = "AN"
+	HistorySourceDubbing         HistorySource = "Dubbing"
+	HistorySourcePlayAPI         HistorySource = "PlayAPI"
+	HistorySourceConvAI          HistorySource = "ConvAI"
+	HistorySourceVoiceGeneration HistorySource = "VoiceGeneration"
+)
+
 // HistoryItem represents a speech generation history item.
 type HistoryItem struct {
 	// HistoryItemID is the unique identifier.
@@ -25,7 +51,7 @@ type HistoryItem struct {
 	VoiceName string
 
 	// VoiceCategory is the category of the voice.
-	VoiceCategory string
+	VoiceCategory VoiceCategory
 
 	// ModelID is the ID of the model used.
 	ModelID string
@@ -34,10 +60,10 @@ type HistoryItem struct {
 	Text string
 
 	// State is the state of the history item.
-	State string
+	State HistoryState
 
-	// Source is the source of the generation.
-	Source string
+	// Source is the product that generated the item.
+	Source HistorySource
 
 	// ContentType is the content type of the audio.
 	ContentType string
@@ -109,7 +135,7 @@ func (s *HistoryService) List(ctx context.Context, opts *HistoryListOptions) (*H
 		for _, h := range r.History {
 			item := &HistoryItem{
 				HistoryItemID:  h.HistoryItemID,
-				State:          string(h.State),
+				State:          HistoryState(h.State),
 				ContentType:    h.ContentType,
 				CharactersUsed: h.CharacterCountChangeTo - h.CharacterCountChangeFrom,
 				CreatedAt:      time.Unix(int64(h.DateUnix), 0),
@@ -122,7 +148,7 @@ func (s *HistoryService) List(ctx context.Context, opts *HistoryListOptions) (*H
 				item.VoiceName = h.VoiceName.Value
 			}
 			if h.VoiceCategory.Set && !h.VoiceCategory.Null {
-				item.VoiceCategory = string(h.VoiceCategory.Value)
+				item.VoiceCategory = VoiceCategory(h.VoiceCategory.Value)
 			}
 			if h.ModelID.Set && !h.ModelID.Null {
 				item.ModelID = h.ModelID.Value
@@ -131,7 +157,7 @@ func (s *HistoryService) List(ctx context.Context, opts *HistoryListOptions) (*H
 				item.Text = h.Text.Value
 			}
 			if h.Source.Set && !h.Source.Null {
-				item.Source = string(h.Source.Value)
+				item.Source = HistorySource(h.Source.Value)
 			}
 
 			result.Items = append(result.Items, item)
@@ -161,7 +187,7 @@ func (s *HistoryService) Get(ctx context.Context, historyItemID string) (*Histor
 	case *api.SpeechHistoryItemResponseModel:
 		item := &HistoryItem{
 			HistoryItemID:  r.HistoryItemID,
-			State:          string(r.State),
+			State:          HistoryState(r.State),
 			ContentType:    r.ContentType,
 			CharactersUsed: r.CharacterCountChangeTo - r.CharacterCountChangeFrom,
 			CreatedAt:      time.Unix(int64(r.DateUnix), 0),
@@ -174,7 +200,7 @@ func (s *HistoryService) Get(ctx context.Context, historyItemID string) (*Histor
 			item.VoiceName = r.VoiceName.Value
 		}
 		if r.VoiceCategory.Set && !r.VoiceCategory.Null {
-			item.VoiceCategory = string(r.VoiceCategory.Value)
+			item.VoiceCategory = VoiceCategory(r.VoiceCategory.Value)
 		}
 		if r.ModelID.Set && !r.ModelID.Null {
 			item.ModelID = r.ModelID.Value
@@ -183,7 +209,7 @@ func (s *HistoryService) Get(ctx context.Context, historyItemID string) (*Histor
 			item.Text = r.Text.Value
 		}
 		if r.Source.Set && !r.Source.Null {
-			item.Source = string(r.Source.Value)
+			item.Source = HistorySource(r.Source.Value)
 		}
 
 		return item, nil
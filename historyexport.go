@@ -0,0 +1,299 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// History export defaults.
+const (
+	// DefaultHistoryExportNameTemplate names exported files by creation
+	// time and history item ID, so a directory listing is chronological.
+	DefaultHistoryExportNameTemplate = `{{.CreatedAt.UTC.Format "20060102-150405"}}_{{.HistoryItemID}}`
+
+	// HistoryExportIndexFile is the name of the index written by
+	// HistoryService.ExportAll.
+	HistoryExportIndexFile = "index.json"
+)
+
+// HistoryExportOptions configures HistoryService.ExportAll.
+type HistoryExportOptions struct {
+	// NameTemplate is a text/template executed with each *HistoryItem to
+	// name its file, without extension. It may contain "/" to export into
+	// subdirectories. Defaults to DefaultHistoryExportNameTemplate.
+	NameTemplate string
+
+	// VoiceID, if set, only exports items generated with this voice.
+	VoiceID string
+
+	// Since and Until, if set, only export items created in this range.
+	Since time.Time
+	Until time.Time
+
+	// MaxItems is the maximum number of items to export. Zero exports all.
+	MaxItems int
+
+	// Concurrency is the number of concurrent downloads.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+
+	// MaxRetries is the number of retries for rate limited (429) and
+	// server (5xx) errors. Defaults to DefaultBatchMaxRetries; use a
+	// negative value to disable retries.
+	MaxRetries int
+
+	// Overwrite downloads items whose file already exists. By default they
+	// are skipped, so an interrupted export can be run again.
+	Overwrite bool
+
+	// Metadata labels exported items by history item ID, such as the
+	// result of GenerationMetadata, so the index can attribute characters
+	// to courses or customers.
+	Metadata map[string]map[string]string
+
+	// OnExport, if set, is called as each item finishes. It may be called
+	// concurrently.
+	OnExport func(HistoryExportEntry)
+}
+
+// HistoryExportEntry is an exported history item, as recorded in the index.
+type HistoryExportEntry struct {
+	HistoryItemID  string        `json:"history_item_id"`
+	File           string        `json:"file"`
+	VoiceID        string        `json:"voice_id,omitempty"`
+	VoiceName      string        `json:"voice_name,omitempty"`
+	ModelID        string        `json:"model_id,omitempty"`
+	Source         HistorySource `json:"source,omitempty"`
+	Text           string        `json:"text"`
+	CharactersUsed int           `json:"characters_used"`
+	CreatedAt      time.Time     `json:"created_at"`
+	Bytes          int64         `json:"bytes,omitempty"`
+	Existing       bool          `json:"existing,omitempty"`
+	Error          string        `json:"error,omitempty"`
+
+	// Metadata is the item's labels from HistoryExportOptions.Metadata.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// HistoryExportIndex is the index of an export, written to
+// HistoryExportIndexFile in the export directory.
+type HistoryExportIndex struct {
+	ExportedAt time.Time            `json:"exported_at"`
+	Items      []HistoryExportEntry `json:"items"`
+}
+
+// Failed returns the entries whose download failed.
+func (idx *HistoryExportIndex) Failed() []HistoryExportEntry {
+	var failed []HistoryExportEntry
+	for _, e := range idx.Items {
+		if e.Error != "" {
+			failed = append(failed, e)
+		}
+	}
+	return failed
+}
+
+// GetAudioToWriter writes the audio for a history item to a writer.
+func (s *HistoryService) GetAudioToWriter(ctx context.Context, historyItemID string, w io.Writer) error {
+	audio, err := s.GetAudio(ctx, historyItemID)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, audio)
+	return err
+}
+
+// ExportAll downloads the audio of every history item to dir, several at
+// a time, and writes an index of the items with their text, voice, and
+// model to HistoryExportIndexFile. This archives generations before they
+// age out of history.
+//
+// A failed download doesn't stop the others; it is recorded in the
+// entry's Error. Listing history, an invalid name template, or writing
+// the index returns an error.
+//
+// Example:
+//
+//	idx, err := client.History().ExportAll(ctx, "archive", &elevenlabs.HistoryExportOptions{
+//	    NameTemplate: `{{.VoiceName}}/{{.HistoryItemID}}`,
+//	    Since:        time.Now().AddDate(0, -1, 0),
+//	})
+//	fmt.Printf("exported %d, failed %d\n", len(idx.Items), len(idx.Failed()))
+func (s *HistoryService) ExportAll(ctx context.Context, dir string, opts *HistoryExportOptions) (*HistoryExportIndex, error) {
+	if dir == "" {
+		return nil, &ValidationError{Field: "dir", Message: "cannot be empty"}
+	}
+	if opts == nil {
+		opts = &HistoryExportOptions{}
+	}
+	nameTemplate := opts.NameTemplate
+	if nameTemplate == "" {
+		nameTemplate = DefaultHistoryExportNameTemplate
+	}
+	tmpl, err := template.New("name").Option("missingkey=error").Parse(nameTemplate)
+	if err != nil {
+		return nil, &ValidationError{Field: "NameTemplate", Message: err.Error()}
+	}
+
+	items, err := s.listForExport(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &HistoryExportIndex{ExportedAt: s.client.clock.Now().UTC(), Items: make([]HistoryExportEntry, 0, len(items))}
+	var jobs []batchJob
+	var jobEntries []int
+	names := make(map[string]string)
+	for _, item := range items {
+		name, err := historyExportName(tmpl, item)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := names[name]; ok {
+			return nil, &ValidationError{
+				Field:   "NameTemplate",
+				Message: fmt.Sprintf("items %s and %s are both named %q, include {{.HistoryItemID}}", other, item.HistoryItemID, name),
+			}
+		}
+		names[name] = item.HistoryItemID
+
+		entry := HistoryExportEntry{
+			HistoryItemID:  item.HistoryItemID,
+			File:           filepath.ToSlash(name),
+			VoiceID:        item.VoiceID,
+			VoiceName:      item.VoiceName,
+			ModelID:        item.ModelID,
+			Source:         item.Source,
+			Text:           item.Text,
+			CharactersUsed: item.CharactersUsed,
+			CreatedAt:      item.CreatedAt.UTC(),
+			Metadata:       opts.Metadata[item.HistoryItemID],
+		}
+		path := filepath.Join(dir, name)
+		if !opts.Overwrite {
+			if _, err := os.Stat(path); err == nil {
+				entry.Existing = true
+				idx.Items = append(idx.Items, entry)
+				continue
+			}
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return nil, err
+		}
+
+		historyItemID := item.HistoryItemID
+		jobs = append(jobs, batchJob{
+			id:   historyItemID,
+			path: path,
+			generate: func(ctx context.Context) (io.Reader, error) {
+				return s.GetAudio(ctx, historyItemID)
+			},
+		})
+		jobEntries = append(jobEntries, len(idx.Items))
+		idx.Items = append(idx.Items, entry)
+	}
+
+	batchOpts := &BatchOptions{Concurrency: opts.Concurrency, MaxRetries: opts.MaxRetries}
+	if opts.OnExport != nil {
+		batchOpts.OnResult = func(res BatchResult) {
+			opts.OnExport(historyExportResult(idx.Items[jobEntries[res.Index]], res))
+		}
+	}
+	for i, res := range s.client.runBatch(ctx, jobs, batchOpts) {
+		entry := &idx.Items[jobEntries[i]]
+		*entry = historyExportResult(*entry, res)
+	}
+
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return idx, err
+	}
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return idx, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, HistoryExportIndexFile), data, 0600); err != nil {
+		return idx, fmt.Errorf("writing index: %w", err)
+	}
+	return idx, nil
+}
+
+// historyExportResult returns an entry updated with its download result.
+func historyExportResult(entry HistoryExportEntry, res BatchResult) HistoryExportEntry {
+	entry.Bytes = res.Bytes
+	if res.Err != nil {
+		entry.Error = res.Err.Error()
+	}
+	return entry
+}
+
+// listForExport pages through history, newest first, and returns the
+// items with audio that match the export filters.
+func (s *HistoryService) listForExport(ctx context.Context, opts *HistoryExportOptions) ([]*HistoryItem, error) {
+	var items []*HistoryItem
+	listOpts := &HistoryListOptions{PageSize: historyRestorePageSize, VoiceID: opts.VoiceID}
+	for {
+		page, err := s.List(ctx, listOpts)
+		if err != nil {
+			return nil, fmt.Errorf("listing history: %w", err)
+		}
+		for _, item := range page.Items {
+			if !opts.Since.IsZero() && item.CreatedAt.Before(opts.Since) {
+				// Everything after this is older
+				return items, nil
+			}
+			if item.State != HistoryStateCreated || (!opts.Until.IsZero() && item.CreatedAt.After(opts.Until)) {
+				continue
+			}
+			items = append(items, item)
+			if opts.MaxItems > 0 && len(items) >= opts.MaxItems {
+				return items, nil
+			}
+		}
+		if !page.HasMore || page.LastHistoryItemID == "" {
+			return items, nil
+		}
+		listOpts.StartAfterHistoryItemID = page.LastHistoryItemID
+	}
+}
+
+// historyExportName executes the name template for an item and adds the
+// extension for its content type.
+func historyExportName(tmpl *template.Template, item *HistoryItem) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, item); err != nil {
+		return "", &ValidationError{Field: "NameTemplate", Message: err.Error()}
+	}
+	name := filepath.FromSlash(strings.TrimSpace(buf.String()))
+	if name == "" || !filepath.IsLocal(name) {
+		return "", &ValidationError{
+			Field:   "NameTemplate",
+			Message: fmt.Sprintf("name %q for item %s is not a relative path inside the export directory", buf.String(), item.HistoryItemID),
+		}
+	}
+	return name + contentTypeExtension(item.ContentType), nil
+}
+
+// contentTypeExtension returns the file extension for an audio content
+// type, defaulting to ".mp3".
+func contentTypeExtension(contentType string) string {
+	switch strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0]) {
+	case "audio/wav", "audio/x-wav", "audio/wave":
+		return ".wav"
+	case "audio/pcm", "audio/l16":
+		return ".pcm"
+	case "audio/ogg", "audio/opus":
+		return ".opus"
+	case "audio/basic", "audio/x-mulaw":
+		return ".ulaw"
+	default:
+		return ".mp3"
+	}
+}
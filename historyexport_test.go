@@ -0,0 +1,95 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestHistoryGetAudioToWriter(t *testing.T) {
+	server := newHistoryServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	var buf bytes.Buffer
+	if err := client.History().GetAudioToWriter(context.Background(), "h3", &buf); err != nil {
+		t.Fatalf("GetAudioToWriter() error = %v", err)
+	}
+	if buf.String() != "audio h3" {
+		t.Errorf("audio = %q", buf.String())
+	}
+}
+
+func TestHistoryExportAll(t *testing.T) {
+	server := newHistoryServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "voice1", "h1.mp3")
+	if err := os.MkdirAll(filepath.Dir(existing), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(existing, []byte("kept"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var exported atomic.Int32
+	idx, err := client.History().ExportAll(context.Background(), dir, &HistoryExportOptions{
+		NameTemplate: "{{.VoiceID}}/{{.HistoryItemID}}",
+		Concurrency:  2,
+		Metadata:     map[string]map[string]string{"h3": {"course": "go-101"}},
+		OnExport:     func(HistoryExportEntry) { exported.Add(1) },
+	})
+	if err != nil {
+		t.Fatalf("ExportAll() error = %v", err)
+	}
+
+	// Deleted items have no audio and are left out
+	if len(idx.Items) != 3 || exported.Load() != 2 || len(idx.Failed()) != 0 {
+		t.Fatalf("index = %+v, exported = %d", idx.Items, exported.Load())
+	}
+	if e := idx.Items[0]; e.File != "voice1/h3.mp3" || e.Bytes != int64(len("audio h3")) || e.Text != "Welcome." || e.Metadata["course"] != "go-101" {
+		t.Errorf("first entry = %+v", e)
+	}
+	if !idx.Items[1].Existing {
+		t.Error("existing file was not skipped")
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "kept" {
+		t.Error("existing file was overwritten")
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "voice2", "h0.mp3")); string(data) != "audio h0" {
+		t.Errorf("exported audio = %q", data)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, HistoryExportIndexFile))
+	if err != nil {
+		t.Fatalf("reading index: %v", err)
+	}
+	var written HistoryExportIndex
+	if err := json.Unmarshal(data, &written); err != nil || len(written.Items) != 3 {
+		t.Errorf("index file = %s, err = %v", data, err)
+	}
+}
+
+func TestHistoryExportAllNameTemplate(t *testing.T) {
+	server := newHistoryServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	for _, tmpl := range []string{"{{.VoiceID}}", "../{{.HistoryItemID}}", "{{.Missing}}", "{{"} {
+		_, err := client.History().ExportAll(context.Background(), t.TempDir(), &HistoryExportOptions{NameTemplate: tmpl})
+		if !isValidationError(err, nil) {
+			t.Errorf("ExportAll(%q) = %v, want ValidationError", tmpl, err)
+		}
+	}
+
+	idx, err := client.History().ExportAll(context.Background(), t.TempDir(), &HistoryExportOptions{MaxItems: 1})
+	if err != nil {
+		t.Fatalf("ExportAll() error = %v", err)
+	}
+	if len(idx.Items) != 1 || idx.Items[0].File != "20231114-221320_h3.mp3" {
+		t.Errorf("items = %+v", idx.Items)
+	}
+}
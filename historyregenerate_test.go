@@ -0,0 +1,78 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHistoryRegenerate(t *testing.T) {
+	var voiceID string
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/history/h1":
+			item := historyItemJSON("h1", "voice1", "Helo world.", "created")
+			item["settings"] = map[string]any{"stability": 0.3, "similarity_boost": 0.9, "style": 0.2, "use_speaker_boost": false}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(item)
+		case "/v1/history/h2":
+			item := historyItemJSON("h2", "", "", "created")
+			item["voice_id"] = nil
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(item)
+		case "/v1/text-to-speech/voice1", "/v1/text-to-speech/voice2":
+			voiceID = r.URL.Path[len("/v1/text-to-speech/"):]
+			body = nil
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			w.Header().Set("Content-Type", "audio/mpeg")
+			_, _ = w.Write([]byte("new audio"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	// Original text, voice, model, and settings
+	resp, err := client.History().Regenerate(ctx, "h1", nil)
+	if err != nil {
+		t.Fatalf("Regenerate() error = %v", err)
+	}
+	if data, _ := io.ReadAll(resp.Audio); string(data) != "new audio" {
+		t.Errorf("audio = %q", data)
+	}
+	settings, _ := body["voice_settings"].(map[string]any)
+	if voiceID != "voice1" || body["text"] != "Helo world." || body["model_id"] != "eleven_multilingual_v2" ||
+		settings["stability"] != 0.3 || settings["similarity_boost"] != 0.9 || settings["style"] != 0.2 {
+		t.Errorf("request = %s %v", voiceID, body)
+	}
+
+	// Overrides
+	_, err = client.History().Regenerate(ctx, "h1", &HistoryRegenerateOverrides{
+		VoiceID:       "voice2",
+		Text:          "Hello world.",
+		VoiceSettings: &VoiceSettings{Stability: 0.7, SimilarityBoost: 0.75},
+	})
+	if err != nil {
+		t.Fatalf("Regenerate() with overrides error = %v", err)
+	}
+	settings, _ = body["voice_settings"].(map[string]any)
+	if voiceID != "voice2" || body["text"] != "Hello world." || settings["stability"] != 0.7 {
+		t.Errorf("request = %s %v", voiceID, body)
+	}
+
+	if _, err := client.History().Regenerate(ctx, "h2", nil); !isValidationError(err, nil) {
+		t.Errorf("Regenerate(no voice) error = %v, want ValidationError", err)
+	}
+	if _, err := client.History().Regenerate(ctx, "", nil); !isValidationError(err, nil) {
+		t.Errorf("Regenerate(\"\") error = %v, want ValidationError", err)
+	}
+	if _, err := client.History().Regenerate(ctx, "missing", nil); !IsNotFoundError(err) {
+		t.Errorf("Regenerate(missing) error = %v, want not found", err)
+	}
+}
@@ -0,0 +1,184 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// DefaultHistoryRestoreMaxItems is the default number of history items
+// searched by HistoryService.Restore.
+const DefaultHistoryRestoreMaxItems = 1000
+
+// historyRestorePageSize is the page size used to search history.
+const historyRestorePageSize = 100
+
+// HistoryRestoreOptions configures HistoryService.Restore.
+type HistoryRestoreOptions struct {
+	// MaxItems is the number of most recent history items to search.
+	// Defaults to DefaultHistoryRestoreMaxItems.
+	MaxItems int
+
+	// ModelID, if set, only matches items generated with this model.
+	ModelID string
+
+	// Overwrite restores entries whose output file already exists.
+	Overwrite bool
+
+	// DryRun finds matches without downloading audio.
+	DryRun bool
+
+	// OnRestore, if set, is called for each matched entry after its audio
+	// is restored (or found, in a dry run).
+	OnRestore func(RestoredEntry)
+}
+
+// RestoredEntry is a manifest entry matched to a history item.
+type RestoredEntry struct {
+	// Entry is the manifest entry.
+	Entry ttsscript.ManifestEntry
+
+	// HistoryItemID is the matching history item.
+	HistoryItemID string
+
+	// Bytes is the size of the restored audio (0 in a dry run).
+	Bytes int64
+}
+
+// HistoryRestoreReport is the outcome of HistoryService.Restore.
+type HistoryRestoreReport struct {
+	// Restored lists the entries matched to history items.
+	Restored []RestoredEntry
+
+	// NotFound lists the entries with no matching history item, to
+	// regenerate.
+	NotFound []ttsscript.ManifestEntry
+
+	// Existing is the number of entries skipped because their output file
+	// exists.
+	Existing int
+
+	// Searched is the number of history items searched.
+	Searched int
+}
+
+// Restore downloads audio from history for manifest entries whose output
+// files are missing, instead of regenerating it. Entries are matched to
+// the most recent history item with the same text and voice. This recovers
+// lost output when the generations already happened and were paid for.
+//
+// Pre-recorded entries and entries without audio are ignored.
+//
+// Example:
+//
+//	entries, _ := ttsscript.LoadManifest("output/manifest_en.json")
+//	report, err := client.History().Restore(ctx, entries, nil)
+//	fmt.Printf("restored %d, regenerate %d\n", len(report.Restored), len(report.NotFound))
+func (s *HistoryService) Restore(ctx context.Context, entries []ttsscript.ManifestEntry, opts *HistoryRestoreOptions) (*HistoryRestoreReport, error) {
+	if opts == nil {
+		opts = &HistoryRestoreOptions{}
+	}
+	maxItems := opts.MaxItems
+	if maxItems <= 0 {
+		maxItems = DefaultHistoryRestoreMaxItems
+	}
+
+	report := &HistoryRestoreReport{}
+	wanted := make(map[string][]ttsscript.ManifestEntry)
+	var order []string
+	for _, e := range entries {
+		if e.AudioFile != "" || !e.Available() || e.OutputFile == "" || e.Text == "" {
+			continue
+		}
+		if !opts.Overwrite {
+			if _, err := os.Stat(e.OutputFile); err == nil {
+				report.Existing++
+				continue
+			}
+		}
+		key := historyRestoreKey(e.VoiceID, e.Text)
+		if _, ok := wanted[key]; !ok {
+			order = append(order, key)
+		}
+		wanted[key] = append(wanted[key], e)
+	}
+
+	// History is listed newest first, so the first match is the latest take
+	matches := make(map[string]string)
+	listOpts := &HistoryListOptions{PageSize: historyRestorePageSize}
+	for len(matches) < len(wanted) && report.Searched < maxItems {
+		page, err := s.List(ctx, listOpts)
+		if err != nil {
+			return report, fmt.Errorf("listing history: %w", err)
+		}
+		for _, item := range page.Items {
+			report.Searched++
+			if item.State == HistoryStateDeleted || (opts.ModelID != "" && item.ModelID != opts.ModelID) {
+				continue
+			}
+			key := historyRestoreKey(item.VoiceID, item.Text)
+			if _, ok := wanted[key]; ok && matches[key] == "" {
+				matches[key] = item.HistoryItemID
+			}
+		}
+		if !page.HasMore || page.LastHistoryItemID == "" {
+			break
+		}
+		listOpts.StartAfterHistoryItemID = page.LastHistoryItemID
+	}
+
+	for _, key := range order {
+		itemID, ok := matches[key]
+		if !ok {
+			report.NotFound = append(report.NotFound, wanted[key]...)
+			continue
+		}
+		for _, e := range wanted[key] {
+			restored := RestoredEntry{Entry: e, HistoryItemID: itemID}
+			if !opts.DryRun {
+				n, err := s.restoreFile(ctx, itemID, e.OutputFile)
+				if err != nil {
+					return report, fmt.Errorf("restoring %s: %w", e.OutputFile, err)
+				}
+				restored.Bytes = n
+			}
+			report.Restored = append(report.Restored, restored)
+			if opts.OnRestore != nil {
+				opts.OnRestore(restored)
+			}
+		}
+	}
+	return report, nil
+}
+
+// restoreFile downloads a history item's audio to a file.
+func (s *HistoryService) restoreFile(ctx context.Context, historyItemID, path string) (int64, error) {
+	audio, err := s.GetAudio(ctx, historyItemID)
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return 0, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return 0, err
+	}
+	n, err := io.Copy(f, audio)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(path)
+		return 0, err
+	}
+	return n, nil
+}
+
+func historyRestoreKey(voiceID, text string) string {
+	return voiceID + "\x00" + text
+}
@@ -0,0 +1,132 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+func historyItemJSON(id, voiceID, text, state string) map[string]any {
+	return map[string]any{
+		"history_item_id":             id,
+		"voice_id":                    voiceID,
+		"text":                        text,
+		"model_id":                    "eleven_multilingual_v2",
+		"state":                       state,
+		"content_type":                "audio/mpeg",
+		"character_count_change_from": 0,
+		"character_count_change_to":   len(text),
+		"date_unix":                   1700000000,
+	}
+}
+
+func newHistoryServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	pages := map[string]map[string]any{
+		"": {
+			"history": []any{
+				historyItemJSON("h3", "voice1", "Welcome.", "created"),
+				historyItemJSON("h2", "voice1", "Deleted take.", "deleted"),
+			},
+			"has_more":             true,
+			"last_history_item_id": "h2",
+			"scanned_until":        1700000000,
+		},
+		"h2": {
+			"history": []any{
+				historyItemJSON("h1", "voice1", "Welcome.", "created"),
+				historyItemJSON("h0", "voice2", "Goodbye.", "created"),
+			},
+			"has_more":      false,
+			"scanned_until": 1700000000,
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/history":
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(pages[r.URL.Query().Get("start_after_history_item_id")])
+		case strings.HasSuffix(r.URL.Path, "/audio"):
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/history/"), "/audio")
+			w.Header().Set("Content-Type", "audio/mpeg")
+			_, _ = w.Write([]byte("audio " + id))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestHistoryRestore(t *testing.T) {
+	server := newHistoryServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "slide02_seg01_en.mp3")
+	if err := os.WriteFile(existing, []byte("kept"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	entries := []ttsscript.ManifestEntry{
+		{Text: "Welcome.", VoiceID: "voice1", OutputFile: filepath.Join(dir, "slide01_seg01_en.mp3")},
+		{Text: "Kept.", VoiceID: "voice1", OutputFile: existing},
+		{Text: "Goodbye.", VoiceID: "voice2", OutputFile: filepath.Join(dir, "slide03_seg01_en.mp3")},
+		{Text: "Deleted take.", VoiceID: "voice1", OutputFile: filepath.Join(dir, "slide04_seg01_en.mp3")},
+		{Text: "Never generated.", VoiceID: "voice1", OutputFile: filepath.Join(dir, "slide05_seg01_en.mp3")},
+		{Text: "Intro music", AudioFile: "intro.mp3", OutputFile: filepath.Join(dir, "slide06_seg01_en.mp3")},
+	}
+
+	report, err := client.History().Restore(context.Background(), entries, nil)
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+
+	if len(report.Restored) != 2 || report.Existing != 1 || report.Searched != 4 {
+		t.Fatalf("report = %+v", report)
+	}
+	// The newest take of a repeated text is restored
+	if got := report.Restored[0].HistoryItemID; got != "h3" {
+		t.Errorf("restored item = %s, want the newest match h3", got)
+	}
+	data, _ := os.ReadFile(entries[0].OutputFile)
+	if string(data) != "audio h3" {
+		t.Errorf("restored audio = %q", data)
+	}
+	if data, _ := os.ReadFile(existing); string(data) != "kept" {
+		t.Error("existing output was overwritten")
+	}
+
+	var notFound []string
+	for _, e := range report.NotFound {
+		notFound = append(notFound, e.Text)
+	}
+	if got := strings.Join(notFound, ","); got != "Deleted take.,Never generated." {
+		t.Errorf("not found = %s", got)
+	}
+}
+
+func TestHistoryRestoreDryRun(t *testing.T) {
+	server := newHistoryServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	output := filepath.Join(t.TempDir(), "slide01_seg01_en.mp3")
+	entries := []ttsscript.ManifestEntry{{Text: "Welcome.", VoiceID: "voice1", OutputFile: output}}
+
+	report, err := client.History().Restore(context.Background(), entries, &HistoryRestoreOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if len(report.Restored) != 1 || report.Searched != 2 {
+		t.Errorf("report = %+v, want one match found on the first page", report)
+	}
+	if _, err := os.Stat(output); err == nil {
+		t.Error("dry run wrote audio")
+	}
+}
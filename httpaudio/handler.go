@@ -0,0 +1,183 @@
+package httpaudio
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"unicode/utf8"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+)
+
+// DefaultMaxTextLength is the longest text Handler accepts by default, in
+// characters.
+const DefaultMaxTextLength = 2500
+
+// Handler is an HTTP text-to-speech endpoint that streams audio as it is
+// generated. It accepts GET requests with "text" and "voice_id" query
+// parameters, so it can be the src of an <audio> element, and POST
+// requests with a JSON body:
+//
+//	{"text": "Hello!", "voice_id": "21m00Tcm4TlvDq8ikWAM"}
+//
+// The upstream request uses the HTTP request's context, so it is canceled
+// when the client disconnects and stops using characters. Errors before
+// audio starts are returned as JSON: {"error": "..."}.
+type Handler struct {
+	// TTS generates the audio, usually client.TextToSpeech().
+	TTS elevenlabs.TextToSpeechAPI
+
+	// VoiceID is the voice used when the request doesn't name one.
+	VoiceID string
+
+	// Voices, if set, are the only voices requests may name.
+	Voices []string
+
+	// ModelID is the model to generate with. Empty means the client's
+	// default.
+	ModelID string
+
+	// OutputFormat is the audio format, such as "mp3_44100_128". Empty
+	// means the API default, MP3.
+	OutputFormat string
+
+	// MaxTextLength is the longest text accepted, in characters. Zero
+	// means DefaultMaxTextLength.
+	MaxTextLength int
+
+	// Quota, if set, limits the characters each client IP may generate.
+	Quota *Quota
+
+	// TrustedProxies is the number of reverse proxies in front of the
+	// handler that append to X-Forwarded-For. The client IP is taken from
+	// the entry the outermost of them added (see ClientIP). Zero uses the
+	// connection's address.
+	TrustedProxies int
+
+	// ErrorLog logs errors that occur after audio starts, when they can
+	// no longer be returned to the client. Nil means the standard logger.
+	ErrorLog *log.Logger
+}
+
+// speakRequest is the JSON body of a POST request.
+type speakRequest struct {
+	Text    string `json:"text"`
+	VoiceID string `json:"voice_id"`
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req speakRequest
+	switch r.Method {
+	case http.MethodGet:
+		req.Text = r.URL.Query().Get("text")
+		req.VoiceID = r.URL.Query().Get("voice_id")
+	case http.MethodPost:
+		r.Body = http.MaxBytesReader(w, r.Body, 64<<10)
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body")
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	chars := utf8.RuneCountInString(req.Text)
+	maxLength := h.MaxTextLength
+	if maxLength <= 0 {
+		maxLength = DefaultMaxTextLength
+	}
+	switch {
+	case chars == 0:
+		writeError(w, http.StatusBadRequest, "text is required")
+		return
+	case chars > maxLength:
+		writeError(w, http.StatusRequestEntityTooLarge, "text is longer than "+strconv.Itoa(maxLength)+" characters")
+		return
+	}
+
+	voiceID := req.VoiceID
+	if voiceID == "" {
+		voiceID = h.VoiceID
+	}
+	if !h.voiceAllowed(voiceID) {
+		writeError(w, http.StatusBadRequest, "voice is not allowed")
+		return
+	}
+
+	if h.Quota != nil {
+		if ok, retryAfter := h.Quota.Take(ClientIP(r, h.TrustedProxies), chars); !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			writeError(w, http.StatusTooManyRequests, "character quota exceeded")
+			return
+		}
+	}
+
+	audio, err := h.TTS.GenerateStream(r.Context(), &elevenlabs.TTSRequest{
+		VoiceID:      voiceID,
+		Text:         req.Text,
+		ModelID:      h.ModelID,
+		OutputFormat: h.OutputFormat,
+	})
+	if err != nil {
+		h.writeUpstreamError(w, err)
+		return
+	}
+	defer audio.Close()
+
+	if _, err := Stream(w, r, ContentType(h.OutputFormat), audio); err != nil && r.Context().Err() == nil {
+		h.logf("httpaudio: streaming %d characters: %v", chars, err)
+	}
+}
+
+// voiceAllowed reports whether a request may use voiceID.
+func (h *Handler) voiceAllowed(voiceID string) bool {
+	if voiceID == "" {
+		return false
+	}
+	if len(h.Voices) == 0 {
+		return true
+	}
+	for _, v := range h.Voices {
+		if v == voiceID {
+			return true
+		}
+	}
+	return false
+}
+
+// writeUpstreamError reports an error from the TTS API without exposing
+// its details, which may describe the account.
+func (h *Handler) writeUpstreamError(w http.ResponseWriter, err error) {
+	var validationErr *elevenlabs.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		writeError(w, http.StatusBadRequest, validationErr.Error())
+	case elevenlabs.IsRateLimitError(err):
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, "speech service is busy")
+	default:
+		h.logf("httpaudio: generating speech: %v", err)
+		writeError(w, http.StatusBadGateway, "speech generation failed")
+	}
+}
+
+func (h *Handler) logf(format string, args ...any) {
+	if h.ErrorLog != nil {
+		h.ErrorLog.Printf(format, args...)
+		return
+	}
+	log.Printf(format, args...)
+}
+
+// writeError writes a JSON error response.
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
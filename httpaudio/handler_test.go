@@ -0,0 +1,100 @@
+package httpaudio
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	elevenlabs "github.com/agentplexus/go-elevenlabs"
+)
+
+// fakeTTS streams fixed audio, or blocks until the request is canceled.
+type fakeTTS struct {
+	elevenlabs.TextToSpeechAPI
+	audio    string
+	block    bool
+	canceled chan struct{}
+	got      *elevenlabs.TTSRequest
+}
+
+func (f *fakeTTS) GenerateStream(ctx context.Context, req *elevenlabs.TTSRequest) (io.ReadCloser, error) {
+	f.got = req
+	if f.block {
+		pr, pw := io.Pipe()
+		go func() {
+			_, _ = pw.Write([]byte("first"))
+			<-ctx.Done()
+			close(f.canceled)
+			pw.CloseWithError(ctx.Err())
+		}()
+		return pr, nil
+	}
+	return io.NopCloser(strings.NewReader(f.audio)), nil
+}
+
+func TestHandler(t *testing.T) {
+	tts := &fakeTTS{audio: "mp3 audio"}
+	h := &Handler{TTS: tts, VoiceID: "v1", Voices: []string{"v1", "v2"}, Quota: NewQuota(20, time.Hour)}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/speak?text=Hello+there", nil))
+	if rec.Code != http.StatusOK || rec.Body.String() != "mp3 audio" || rec.Header().Get("Content-Type") != "audio/mpeg" {
+		t.Fatalf("GET = %d %q %v", rec.Code, rec.Body.String(), rec.Header())
+	}
+	if tts.got.VoiceID != "v1" || tts.got.Text != "Hello there" {
+		t.Errorf("request = %+v", tts.got)
+	}
+
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/speak", strings.NewReader(`{"text": "Hi", "voice_id": "v2"}`)))
+	if rec.Code != http.StatusOK || tts.got.VoiceID != "v2" {
+		t.Errorf("POST = %d, voice %q", rec.Code, tts.got.VoiceID)
+	}
+
+	tests := []struct {
+		name   string
+		req    *http.Request
+		status int
+	}{
+		{"empty", httptest.NewRequest(http.MethodGet, "/speak", nil), http.StatusBadRequest},
+		{"voice", httptest.NewRequest(http.MethodGet, "/speak?text=Hi&voice_id=v9", nil), http.StatusBadRequest},
+		{"method", httptest.NewRequest(http.MethodPut, "/speak", nil), http.StatusMethodNotAllowed},
+		{"quota", httptest.NewRequest(http.MethodGet, "/speak?text=Over+the+quota", nil), http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, tt.req)
+		if rec.Code != tt.status || !strings.Contains(rec.Body.String(), `"error"`) {
+			t.Errorf("%s: status = %d, body %q, want %d", tt.name, rec.Code, rec.Body.String(), tt.status)
+		}
+	}
+}
+
+func TestHandlerClientDisconnect(t *testing.T) {
+	tts := &fakeTTS{block: true, canceled: make(chan struct{})}
+	server := httptest.NewServer(&Handler{TTS: tts, VoiceID: "v1"})
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"?text=Hello", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	if _, err := io.ReadFull(resp.Body, buf); err != nil || string(buf) != "first" {
+		t.Fatalf("first chunk = %q, %v", buf, err)
+	}
+	cancel()
+	resp.Body.Close()
+
+	select {
+	case <-tts.canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("upstream request was not canceled after the client disconnected")
+	}
+}
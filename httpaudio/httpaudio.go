@@ -0,0 +1,94 @@
+// Package httpaudio serves generated audio over HTTP.
+//
+// Handler is a text-to-speech endpoint that streams audio to the browser
+// as ElevenLabs generates it, cancels the upstream request when the client
+// disconnects, and limits the characters each client IP may generate:
+//
+//	client, _ := elevenlabs.NewClient()
+//	http.Handle("/speak", &httpaudio.Handler{
+//	    TTS:     client.TextToSpeech(),
+//	    VoiceID: voices.Rachel,
+//	    Quota:   httpaudio.NewQuota(5000, time.Hour),
+//	})
+//
+// Stream, ContentType, and Quota can also be used on their own in
+// handlers with other needs.
+package httpaudio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ContentType returns the MIME type of audio in an ElevenLabs output
+// format such as "mp3_44100_128". An empty format is the API default, MP3.
+func ContentType(outputFormat string) string {
+	codec, rest, _ := strings.Cut(outputFormat, "_")
+	rate, _, _ := strings.Cut(rest, "_")
+	switch codec {
+	case "pcm":
+		return "audio/L16; rate=" + rate + "; channels=1"
+	case "opus":
+		return "audio/ogg; codecs=opus"
+	case "ulaw":
+		return "audio/basic"
+	case "alaw":
+		return "audio/x-alaw-basic"
+	default:
+		return "audio/mpeg"
+	}
+}
+
+// streamChunkSize is the size of the writes Stream flushes.
+const streamChunkSize = 4096
+
+// Stream copies audio to w as it arrives, flushing each chunk so the
+// browser can start playback before generation finishes. The response is
+// sent with chunked transfer encoding, as its length isn't known. It stops
+// when audio ends or the request's context is canceled, such as when the
+// client disconnects, and returns the number of bytes written.
+//
+// Stream writes the response header. Errors after that can't change the
+// status, so they are only returned.
+func Stream(w http.ResponseWriter, r *http.Request, contentType string, audio io.Reader) (int64, error) {
+	h := w.Header()
+	h.Set("Content-Type", contentType)
+	h.Set("Cache-Control", "no-store")
+	h.Set("X-Content-Type-Options", "nosniff")
+	h.Del("Content-Length")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	ctx := r.Context()
+	buf := make([]byte, streamChunkSize)
+	var written int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return written, err
+		}
+		n, readErr := audio.Read(buf)
+		if n > 0 {
+			m, err := w.Write(buf[:n])
+			written += int64(m)
+			if err != nil {
+				return written, fmt.Errorf("writing audio: %w", err)
+			}
+			if err := rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+				return written, fmt.Errorf("flushing audio: %w", err)
+			}
+		}
+		if readErr == io.EOF {
+			return written, nil
+		}
+		if readErr != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil && errors.Is(readErr, context.Canceled) {
+				return written, ctxErr
+			}
+			return written, readErr
+		}
+	}
+}
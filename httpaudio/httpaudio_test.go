@@ -0,0 +1,54 @@
+package httpaudio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestContentType(t *testing.T) {
+	tests := map[string]string{
+		"":              "audio/mpeg",
+		"mp3_44100_128": "audio/mpeg",
+		"pcm_16000":     "audio/L16; rate=16000; channels=1",
+		"opus_48000_64": "audio/ogg; codecs=opus",
+		"ulaw_8000":     "audio/basic",
+	}
+	for format, want := range tests {
+		if got := ContentType(format); got != want {
+			t.Errorf("ContentType(%q) = %q, want %q", format, got, want)
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	audio := strings.Repeat("a", streamChunkSize*2+10)
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	n, err := Stream(rec, req, "audio/mpeg", strings.NewReader(audio))
+	if err != nil || n != int64(len(audio)) {
+		t.Fatalf("Stream() = %d, %v", n, err)
+	}
+	if rec.Body.String() != audio || !rec.Flushed {
+		t.Errorf("body length = %d, flushed = %v", rec.Body.Len(), rec.Flushed)
+	}
+	if rec.Header().Get("Content-Type") != "audio/mpeg" || rec.Header().Get("Cache-Control") != "no-store" {
+		t.Errorf("headers = %v", rec.Header())
+	}
+}
+
+func TestStreamCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+
+	_, err := Stream(httptest.NewRecorder(), req, "audio/mpeg", io.MultiReader(strings.NewReader("abc")))
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Stream() error = %v, want context.Canceled", err)
+	}
+}
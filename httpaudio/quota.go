@@ -0,0 +1,129 @@
+package httpaudio
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+// Quota limits how many characters each client may generate in a window
+// of time, so one visitor can't spend the account's character quota. Each
+// key, usually a client IP, gets Limit characters per Window, counted from
+// its first request in the window. The zero value of the unexported state
+// is ready to use, so a Quota can also be built as a struct literal. A
+// Quota is safe for concurrent use.
+type Quota struct {
+	// Limit is the number of characters allowed per window.
+	Limit int
+
+	// Window is the length of a quota window.
+	Window time.Duration
+
+	clock   clock.Clock
+	mu      sync.Mutex
+	windows map[string]*quotaWindow
+	swept   time.Time
+}
+
+type quotaWindow struct {
+	start time.Time
+	used  int
+}
+
+// NewQuota returns a quota of limit characters per window.
+func NewQuota(limit int, window time.Duration) *Quota {
+	return &Quota{Limit: limit, Window: window, clock: clock.Real{}}
+}
+
+// Take charges n characters to key. It returns false, and how long until
+// the key's window resets, if that would exceed the limit; nothing is
+// charged then.
+func (q *Quota) Take(key string, n int) (bool, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	q.sweep(now)
+	w := q.windows[key]
+	if w == nil || now.Sub(w.start) >= q.Window {
+		w = &quotaWindow{start: now}
+		q.windows[key] = w
+	}
+	if w.used+n > q.Limit {
+		return false, w.start.Add(q.Window).Sub(now)
+	}
+	w.used += n
+	return true, 0
+}
+
+// Remaining returns the characters key may still generate in its window.
+func (q *Quota) Remaining(key string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	w := q.windows[key]
+	if w == nil || q.now().Sub(w.start) >= q.Window {
+		return q.Limit
+	}
+	return max(q.Limit-w.used, 0)
+}
+
+// now returns the current time from the quota's clock, or the real clock
+// for quotas not made by NewQuota.
+func (q *Quota) now() time.Time {
+	if q.clock == nil {
+		return time.Now()
+	}
+	return q.clock.Now()
+}
+
+// sweep drops expired windows, at most once per window, so the map
+// doesn't grow with every client ever seen.
+func (q *Quota) sweep(now time.Time) {
+	if q.windows == nil {
+		q.windows = make(map[string]*quotaWindow)
+	}
+	if now.Sub(q.swept) < q.Window {
+		return
+	}
+	for key, w := range q.windows {
+		if now.Sub(w.start) >= q.Window {
+			delete(q.windows, key)
+		}
+	}
+	q.swept = now
+}
+
+// ClientIP returns the IP address of the client making r. With
+// trustedProxies set to the number of reverse proxies in front of the
+// server, it is the address the outermost of them saw, which it appended
+// to X-Forwarded-For: the trustedProxies-th entry from the right. Entries
+// to the left of it come from the client and are ignored, so clients
+// can't choose their quota key. With zero, or no X-Forwarded-For, it is
+// the address of the connection.
+func ClientIP(r *http.Request, trustedProxies int) string {
+	if trustedProxies > 0 {
+		var hops []string
+		for _, header := range r.Header.Values("X-Forwarded-For") {
+			for _, hop := range strings.Split(header, ",") {
+				if hop = strings.TrimSpace(hop); hop != "" {
+					hops = append(hops, hop)
+				}
+			}
+		}
+		if len(hops) > 0 {
+			// Fewer entries than proxies means the request skipped the
+			// outer ones; the leftmost is the furthest a trusted proxy saw
+			return hops[max(len(hops)-trustedProxies, 0)]
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
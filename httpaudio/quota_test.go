@@ -0,0 +1,82 @@
+package httpaudio
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+func TestQuota(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	q := NewQuota(100, time.Hour)
+	q.clock = fake
+
+	if ok, _ := q.Take("1.2.3.4", 60); !ok {
+		t.Fatal("Take(60) refused")
+	}
+	fake.Advance(10 * time.Minute)
+	ok, retryAfter := q.Take("1.2.3.4", 50)
+	if ok || retryAfter != 50*time.Minute {
+		t.Errorf("Take(50) = %v, %v, want refused for 50m", ok, retryAfter)
+	}
+	if got := q.Remaining("1.2.3.4"); got != 40 {
+		t.Errorf("Remaining() = %d, want 40", got)
+	}
+	if ok, _ := q.Take("5.6.7.8", 100); !ok {
+		t.Error("other key refused")
+	}
+
+	fake.Advance(time.Hour)
+	if ok, _ := q.Take("1.2.3.4", 100); !ok {
+		t.Error("Take() refused after the window reset")
+	}
+	if len(q.windows) != 1 {
+		t.Errorf("windows = %d, want expired ones swept", len(q.windows))
+	}
+}
+
+func TestQuotaLiteral(t *testing.T) {
+	q := &Quota{Limit: 10, Window: time.Hour}
+	if got := q.Remaining("1.2.3.4"); got != 10 {
+		t.Errorf("Remaining() = %d, want 10", got)
+	}
+	if ok, _ := q.Take("1.2.3.4", 8); !ok {
+		t.Fatal("Take(8) refused")
+	}
+	if ok, retryAfter := q.Take("1.2.3.4", 8); ok || retryAfter <= 0 {
+		t.Errorf("Take(8) = %v, %v, want refused", ok, retryAfter)
+	}
+	if got := q.Remaining("1.2.3.4"); got != 2 {
+		t.Errorf("Remaining() = %d, want 2", got)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest("GET", "/", nil)
+	req.RemoteAddr = "10.0.0.1:5555"
+	// The client sent a spoofed entry; the proxies appended the rest.
+	req.Header.Add("X-Forwarded-For", "1.1.1.1, 203.0.113.7")
+	req.Header.Add("X-Forwarded-For", "10.0.0.2")
+
+	tests := []struct {
+		proxies int
+		want    string
+	}{
+		{0, "10.0.0.1"},
+		{1, "10.0.0.2"},
+		{2, "203.0.113.7"},
+		{5, "1.1.1.1"},
+	}
+	for _, tt := range tests {
+		if got := ClientIP(req, tt.proxies); got != tt.want {
+			t.Errorf("ClientIP(%d) = %q, want %q", tt.proxies, got, tt.want)
+		}
+	}
+
+	req.Header.Del("X-Forwarded-For")
+	if got := ClientIP(req, 1); got != "10.0.0.1" {
+		t.Errorf("ClientIP() without X-Forwarded-For = %q", got)
+	}
+}
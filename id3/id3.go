@@ -0,0 +1,306 @@
+// Package id3 reads and writes ID3v2 tags on MP3 files without external
+// tools.
+//
+// It supports the text frames needed to label generated audio: title,
+// artist, album, comment, and user-defined text. Tags are written as ID3v2.3
+// with UTF-16 text, which is the most widely supported version; tags read
+// may be ID3v2.3 or ID3v2.4.
+//
+//	err := id3.WriteFile("output/slide01_seg01_en.mp3", &id3.Tag{
+//		Title:  "Introduction",
+//		Artist: "Rachel",
+//	})
+package id3
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"unicode/utf16"
+)
+
+// ErrNoTag is returned by Read when the data has no ID3v2 tag.
+var ErrNoTag = errors.New("id3: no ID3v2 tag")
+
+// Tag is the set of supported ID3 fields. Empty fields are not written.
+type Tag struct {
+	Title   string
+	Artist  string
+	Album   string
+	Comment string
+
+	// UserText holds user-defined text (TXXX) frames keyed by description.
+	UserText map[string]string
+}
+
+const headerSize = 10
+
+// Encode returns the tag as an ID3v2.3 tag.
+func Encode(tag *Tag) []byte {
+	var frames bytes.Buffer
+	writeFrame := func(id string, body []byte) {
+		frames.WriteString(id)
+		_ = binary.Write(&frames, binary.BigEndian, uint32(len(body)))
+		frames.Write([]byte{0, 0}) // flags
+		frames.Write(body)
+	}
+	text := func(id, value string) {
+		if value != "" {
+			writeFrame(id, append([]byte{encUTF16}, encodeUTF16(value, false)...))
+		}
+	}
+
+	text("TIT2", tag.Title)
+	text("TPE1", tag.Artist)
+	text("TALB", tag.Album)
+	if tag.Comment != "" {
+		body := []byte{encUTF16, 'e', 'n', 'g'}
+		body = append(body, encodeUTF16("", true)...) // empty description
+		body = append(body, encodeUTF16(tag.Comment, false)...)
+		writeFrame("COMM", body)
+	}
+	keys := make([]string, 0, len(tag.UserText))
+	for k := range tag.UserText {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		body := []byte{encUTF16}
+		body = append(body, encodeUTF16(k, true)...)
+		body = append(body, encodeUTF16(tag.UserText[k], false)...)
+		writeFrame("TXXX", body)
+	}
+
+	out := make([]byte, headerSize, headerSize+frames.Len())
+	copy(out, "ID3")
+	out[3] = 3 // version 2.3.0
+	putSynchsafe(out[6:10], frames.Len())
+	return append(out, frames.Bytes()...)
+}
+
+// Strip returns data without a leading ID3v2 tag.
+func Strip(data []byte) []byte {
+	size, ok := tagSize(data)
+	if !ok {
+		return data
+	}
+	return data[size:]
+}
+
+// WriteFile replaces any ID3v2 tag at the start of an MP3 file with tag.
+// The file is rewritten atomically.
+func WriteFile(path string, tag *Tag) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("id3: %w", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("id3: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".id3-*")
+	if err != nil {
+		return fmt.Errorf("id3: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(Encode(tag)); err == nil {
+		_, err = tmp.Write(Strip(data))
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), info.Mode().Perm())
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+	if err != nil {
+		return fmt.Errorf("id3: writing %s: %w", path, err)
+	}
+	return nil
+}
+
+// ReadFile reads the ID3v2 tag at the start of a file.
+func ReadFile(path string) (*Tag, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("id3: %w", err)
+	}
+	return Read(data)
+}
+
+// Read parses the ID3v2.3 or ID3v2.4 tag at the start of data. Unsupported
+// frames are ignored.
+func Read(data []byte) (*Tag, error) {
+	size, ok := tagSize(data)
+	if !ok {
+		return nil, ErrNoTag
+	}
+	version := data[3]
+	if version != 3 && version != 4 {
+		return nil, fmt.Errorf("id3: unsupported version 2.%d", version)
+	}
+	if data[5]&0x80 != 0 {
+		return nil, errors.New("id3: unsynchronised tags are not supported")
+	}
+
+	tag := &Tag{}
+	frames := data[headerSize:size]
+	if data[5]&0x40 != 0 && len(frames) >= 4 { // extended header
+		extSize := int(binary.BigEndian.Uint32(frames))
+		if version == 4 {
+			extSize = synchsafe(frames[:4])
+		} else {
+			extSize += 4
+		}
+		if extSize > len(frames) {
+			return nil, errors.New("id3: invalid extended header")
+		}
+		frames = frames[extSize:]
+	}
+
+	for len(frames) >= headerSize && frames[0] != 0 {
+		id := string(frames[:4])
+		frameSize := int(binary.BigEndian.Uint32(frames[4:8]))
+		if version == 4 {
+			frameSize = synchsafe(frames[4:8])
+		}
+		if frameSize < 0 || headerSize+frameSize > len(frames) {
+			return nil, fmt.Errorf("id3: frame %s exceeds tag", id)
+		}
+		body := frames[headerSize : headerSize+frameSize]
+		frames = frames[headerSize+frameSize:]
+		if len(body) == 0 {
+			continue
+		}
+
+		enc, body := body[0], body[1:]
+		switch id {
+		case "TIT2":
+			tag.Title, _ = decodeText(enc, body)
+		case "TPE1":
+			tag.Artist, _ = decodeText(enc, body)
+		case "TALB":
+			tag.Album, _ = decodeText(enc, body)
+		case "COMM":
+			if len(body) < 3 {
+				continue
+			}
+			desc, rest := decodeText(enc, body[3:])
+			if desc == "" && tag.Comment == "" {
+				tag.Comment, _ = decodeText(enc, rest)
+			}
+		case "TXXX":
+			desc, rest := decodeText(enc, body)
+			if tag.UserText == nil {
+				tag.UserText = make(map[string]string)
+			}
+			tag.UserText[desc], _ = decodeText(enc, rest)
+		}
+	}
+	return tag, nil
+}
+
+// tagSize returns the total size of a leading ID3v2 tag, including its
+// header and any footer.
+func tagSize(data []byte) (int, bool) {
+	if len(data) < headerSize || string(data[:3]) != "ID3" {
+		return 0, false
+	}
+	size := headerSize + synchsafe(data[6:10])
+	if data[5]&0x10 != 0 { // footer present
+		size += headerSize
+	}
+	if size > len(data) {
+		return 0, false
+	}
+	return size, true
+}
+
+func synchsafe(b []byte) int {
+	return int(b[0]&0x7f)<<21 | int(b[1]&0x7f)<<14 | int(b[2]&0x7f)<<7 | int(b[3]&0x7f)
+}
+
+func putSynchsafe(b []byte, n int) {
+	b[0] = byte(n>>21) & 0x7f
+	b[1] = byte(n>>14) & 0x7f
+	b[2] = byte(n>>7) & 0x7f
+	b[3] = byte(n) & 0x7f
+}
+
+// Text encodings.
+const (
+	encLatin1  = 0
+	encUTF16   = 1
+	encUTF16BE = 2
+	encUTF8    = 3
+)
+
+// encodeUTF16 encodes s as UTF-16 with a little-endian byte order mark,
+// optionally followed by a terminator.
+func encodeUTF16(s string, terminate bool) []byte {
+	units := utf16.Encode([]rune(s))
+	out := make([]byte, 0, 2+2*len(units)+2)
+	out = append(out, 0xFF, 0xFE)
+	for _, u := range units {
+		out = append(out, byte(u), byte(u>>8))
+	}
+	if terminate {
+		out = append(out, 0, 0)
+	}
+	return out
+}
+
+// decodeText decodes a string up to its terminator and returns the rest.
+func decodeText(enc byte, b []byte) (string, []byte) {
+	switch enc {
+	case encUTF16, encUTF16BE:
+		end := len(b)
+		rest := []byte(nil)
+		for i := 0; i+1 < len(b); i += 2 {
+			if b[i] == 0 && b[i+1] == 0 {
+				end, rest = i, b[i+2:]
+				break
+			}
+		}
+		s := b[:end]
+		bigEndian := enc == encUTF16BE
+		if len(s) >= 2 && s[0] == 0xFE && s[1] == 0xFF {
+			bigEndian, s = true, s[2:]
+		} else if len(s) >= 2 && s[0] == 0xFF && s[1] == 0xFE {
+			bigEndian, s = false, s[2:]
+		}
+		units := make([]uint16, 0, len(s)/2)
+		for i := 0; i+1 < len(s); i += 2 {
+			if bigEndian {
+				units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+			} else {
+				units = append(units, uint16(s[i+1])<<8|uint16(s[i]))
+			}
+		}
+		return string(utf16.Decode(units)), rest
+	default:
+		end := bytes.IndexByte(b, 0)
+		rest := []byte(nil)
+		if end < 0 {
+			end = len(b)
+		} else {
+			rest = b[end+1:]
+		}
+		if enc == encUTF8 {
+			return string(b[:end]), rest
+		}
+		runes := make([]rune, end)
+		for i, c := range b[:end] {
+			runes[i] = rune(c) // ISO-8859-1 maps directly to Unicode
+		}
+		return string(runes), rest
+	}
+}
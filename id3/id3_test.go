@@ -0,0 +1,75 @@
+package id3
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var audio = bytes.Repeat([]byte{0xFF, 0xFB, 0x90, 0xC4}, 8)
+
+func TestEncodeRead(t *testing.T) {
+	tag := &Tag{
+		Title:    "Überblick",
+		Artist:   "Rachel",
+		Album:    "Quarterly Review",
+		Comment:  "script=abc segment=slide01:1 lang=de",
+		UserText: map[string]string{"TTSSCRIPT_SEGMENT": "slide01:1"},
+	}
+	got, err := Read(append(Encode(tag), audio...))
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if got.Title != tag.Title || got.Artist != tag.Artist || got.Album != tag.Album || got.Comment != tag.Comment {
+		t.Errorf("Read() = %+v, want %+v", got, tag)
+	}
+	if got.UserText["TTSSCRIPT_SEGMENT"] != "slide01:1" {
+		t.Errorf("UserText = %v", got.UserText)
+	}
+}
+
+func TestReadNoTag(t *testing.T) {
+	if _, err := Read(audio); !errors.Is(err, ErrNoTag) {
+		t.Errorf("Read() error = %v, want ErrNoTag", err)
+	}
+}
+
+func TestReadV24(t *testing.T) {
+	// TIT2 frame with UTF-8 text and a synchsafe frame size.
+	frame := append([]byte("TIT2\x00\x00\x00\x06\x00\x00\x03"), "Hello"...)
+	data := append([]byte("ID3\x04\x00\x00\x00\x00\x00"), byte(len(frame)))
+	data = append(data, frame...)
+
+	tag, err := Read(data)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if tag.Title != "Hello" {
+		t.Errorf("Title = %q, want Hello", tag.Title)
+	}
+}
+
+func TestWriteFileReplacesTag(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.mp3")
+	if err := os.WriteFile(path, append(Encode(&Tag{Title: "old", Comment: "old"}), audio...), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := WriteFile(path, &Tag{Title: "new"}); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	tag, err := ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	if tag.Title != "new" || tag.Comment != "" {
+		t.Errorf("ReadFile() = %+v, want only the new tag", tag)
+	}
+
+	data, _ := os.ReadFile(path)
+	if !bytes.Equal(Strip(data), audio) {
+		t.Error("audio data changed")
+	}
+}
@@ -269,38 +269,6 @@ func (s *Server) decodeAddFromFileRequest(r *http.Request) (
 				}
 			}
 		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "file",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotFileVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotFileVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.File.SetTo(requestDotFileVal)
-					return nil
-				}); err != nil {
-					return req, rawBody, close, errors.Wrap(err, "decode \"file\"")
-				}
-			}
-		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
 				Name:    "name",
@@ -375,6 +343,30 @@ func (s *Server) decodeAddFromFileRequest(r *http.Request) (
 				}
 			}
 		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["file"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.File.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, rawBody, close, errors.Wrap(err, "decode \"file\"")
+			}
+		}
 		return &request, rawBody, close, nil
 	default:
 		return req, rawBody, close, validate.InvalidContentType(ct)
@@ -1014,38 +1006,6 @@ func (s *Server) decodeAddProjectRequest(r *http.Request) (
 				}
 			}
 		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "from_document",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotFromDocumentVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotFromDocumentVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.FromDocument.SetTo(requestDotFromDocumentVal)
-					return nil
-				}); err != nil {
-					return req, rawBody, close, errors.Wrap(err, "decode \"from_document\"")
-				}
-			}
-		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
 				Name:    "from_url",
@@ -1579,6 +1539,30 @@ func (s *Server) decodeAddProjectRequest(r *http.Request) (
 				}
 			}
 		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["from_document"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.FromDocument.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, rawBody, close, errors.Wrap(err, "decode \"from_document\"")
+			}
+		}
 		return &request, rawBody, close, nil
 	default:
 		return req, rawBody, close, validate.InvalidContentType(ct)
@@ -3345,70 +3329,6 @@ func (s *Server) decodeCreateDubbingRequest(r *http.Request) (
 			var optForm BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart
 			optForm.setDefaults()
 			q := uri.NewQueryDecoder(form)
-			{
-				cfg := uri.QueryParameterDecodingConfig{
-					Name:    "background_audio_file",
-					Style:   uri.QueryStyleForm,
-					Explode: true,
-				}
-				if err := q.HasParam(cfg); err == nil {
-					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-						var optFormDotBackgroundAudioFileVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							optFormDotBackgroundAudioFileVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						optForm.BackgroundAudioFile.SetTo(optFormDotBackgroundAudioFileVal)
-						return nil
-					}); err != nil {
-						return req, rawBody, close, errors.Wrap(err, "decode \"background_audio_file\"")
-					}
-				}
-			}
-			{
-				cfg := uri.QueryParameterDecodingConfig{
-					Name:    "csv_file",
-					Style:   uri.QueryStyleForm,
-					Explode: true,
-				}
-				if err := q.HasParam(cfg); err == nil {
-					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-						var optFormDotCsvFileVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							optFormDotCsvFileVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						optForm.CsvFile.SetTo(optFormDotCsvFileVal)
-						return nil
-					}); err != nil {
-						return req, rawBody, close, errors.Wrap(err, "decode \"csv_file\"")
-					}
-				}
-			}
 			{
 				cfg := uri.QueryParameterDecodingConfig{
 					Name:    "csv_fps",
@@ -3584,70 +3504,6 @@ func (s *Server) decodeCreateDubbingRequest(r *http.Request) (
 					}
 				}
 			}
-			{
-				cfg := uri.QueryParameterDecodingConfig{
-					Name:    "file",
-					Style:   uri.QueryStyleForm,
-					Explode: true,
-				}
-				if err := q.HasParam(cfg); err == nil {
-					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-						var optFormDotFileVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							optFormDotFileVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						optForm.File.SetTo(optFormDotFileVal)
-						return nil
-					}); err != nil {
-						return req, rawBody, close, errors.Wrap(err, "decode \"file\"")
-					}
-				}
-			}
-			{
-				cfg := uri.QueryParameterDecodingConfig{
-					Name:    "foreground_audio_file",
-					Style:   uri.QueryStyleForm,
-					Explode: true,
-				}
-				if err := q.HasParam(cfg); err == nil {
-					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-						var optFormDotForegroundAudioFileVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							optFormDotForegroundAudioFileVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						optForm.ForegroundAudioFile.SetTo(optFormDotForegroundAudioFileVal)
-						return nil
-					}); err != nil {
-						return req, rawBody, close, errors.Wrap(err, "decode \"foreground_audio_file\"")
-					}
-				}
-			}
 			{
 				cfg := uri.QueryParameterDecodingConfig{
 					Name:    "highest_resolution",
@@ -4015,6 +3871,102 @@ func (s *Server) decodeCreateDubbingRequest(r *http.Request) (
 					}
 				}
 			}
+			{
+				if err := func() error {
+					files, ok := r.MultipartForm.File["background_audio_file"]
+					if !ok || len(files) < 1 {
+						return nil
+					}
+					fh := files[0]
+
+					f, err := fh.Open()
+					if err != nil {
+						return errors.Wrap(err, "open")
+					}
+					closers = append(closers, f.Close)
+					optForm.BackgroundAudioFile.SetTo(ht.MultipartFile{
+						Name:   fh.Filename,
+						File:   f,
+						Size:   fh.Size,
+						Header: fh.Header,
+					})
+					return nil
+				}(); err != nil {
+					return req, rawBody, close, errors.Wrap(err, "decode \"background_audio_file\"")
+				}
+			}
+			{
+				if err := func() error {
+					files, ok := r.MultipartForm.File["csv_file"]
+					if !ok || len(files) < 1 {
+						return nil
+					}
+					fh := files[0]
+
+					f, err := fh.Open()
+					if err != nil {
+						return errors.Wrap(err, "open")
+					}
+					closers = append(closers, f.Close)
+					optForm.CsvFile.SetTo(ht.MultipartFile{
+						Name:   fh.Filename,
+						File:   f,
+						Size:   fh.Size,
+						Header: fh.Header,
+					})
+					return nil
+				}(); err != nil {
+					return req, rawBody, close, errors.Wrap(err, "decode \"csv_file\"")
+				}
+			}
+			{
+				if err := func() error {
+					files, ok := r.MultipartForm.File["file"]
+					if !ok || len(files) < 1 {
+						return nil
+					}
+					fh := files[0]
+
+					f, err := fh.Open()
+					if err != nil {
+						return errors.Wrap(err, "open")
+					}
+					closers = append(closers, f.Close)
+					optForm.File.SetTo(ht.MultipartFile{
+						Name:   fh.Filename,
+						File:   f,
+						Size:   fh.Size,
+						Header: fh.Header,
+					})
+					return nil
+				}(); err != nil {
+					return req, rawBody, close, errors.Wrap(err, "decode \"file\"")
+				}
+			}
+			{
+				if err := func() error {
+					files, ok := r.MultipartForm.File["foreground_audio_file"]
+					if !ok || len(files) < 1 {
+						return nil
+					}
+					fh := files[0]
+
+					f, err := fh.Open()
+					if err != nil {
+						return errors.Wrap(err, "open")
+					}
+					closers = append(closers, f.Close)
+					optForm.ForegroundAudioFile.SetTo(ht.MultipartFile{
+						Name:   fh.Filename,
+						File:   f,
+						Size:   fh.Size,
+						Header: fh.Header,
+					})
+					return nil
+				}(); err != nil {
+					return req, rawBody, close, errors.Wrap(err, "decode \"foreground_audio_file\"")
+				}
+			}
 			request = OptBodyDubAVideoOrAnAudioFileV1DubbingPostMultipart{
 				Value: optForm,
 				Set:   true,
@@ -5402,38 +5354,6 @@ func (s *Server) decodeEditProjectContentRequest(r *http.Request) (
 					}
 				}
 			}
-			{
-				cfg := uri.QueryParameterDecodingConfig{
-					Name:    "from_document",
-					Style:   uri.QueryStyleForm,
-					Explode: true,
-				}
-				if err := q.HasParam(cfg); err == nil {
-					if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-						var optFormDotFromDocumentVal string
-						if err := func() error {
-							val, err := d.DecodeValue()
-							if err != nil {
-								return err
-							}
-
-							c, err := conv.ToString(val)
-							if err != nil {
-								return err
-							}
-
-							optFormDotFromDocumentVal = c
-							return nil
-						}(); err != nil {
-							return err
-						}
-						optForm.FromDocument.SetTo(optFormDotFromDocumentVal)
-						return nil
-					}); err != nil {
-						return req, rawBody, close, errors.Wrap(err, "decode \"from_document\"")
-					}
-				}
-			}
 			{
 				cfg := uri.QueryParameterDecodingConfig{
 					Name:    "from_url",
@@ -5466,6 +5386,30 @@ func (s *Server) decodeEditProjectContentRequest(r *http.Request) (
 					}
 				}
 			}
+			{
+				if err := func() error {
+					files, ok := r.MultipartForm.File["from_document"]
+					if !ok || len(files) < 1 {
+						return nil
+					}
+					fh := files[0]
+
+					f, err := fh.Open()
+					if err != nil {
+						return errors.Wrap(err, "open")
+					}
+					closers = append(closers, f.Close)
+					optForm.FromDocument.SetTo(ht.MultipartFile{
+						Name:   fh.Filename,
+						File:   f,
+						Size:   fh.Size,
+						Header: fh.Header,
+					})
+					return nil
+				}(); err != nil {
+					return req, rawBody, close, errors.Wrap(err, "decode \"from_document\"")
+				}
+			}
 			request = OptBodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMultipart{
 				Value: optForm,
 				Set:   true,
@@ -9044,38 +8988,6 @@ func (s *Server) decodeSpeechToTextRequest(r *http.Request) (
 				}
 			}
 		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "file",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotFileVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotFileVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.File.SetTo(requestDotFileVal)
-					return nil
-				}); err != nil {
-					return req, rawBody, close, errors.Wrap(err, "decode \"file\"")
-				}
-			}
-		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
 				Name:    "file_format",
@@ -9555,6 +9467,30 @@ func (s *Server) decodeSpeechToTextRequest(r *http.Request) (
 				}
 			}
 		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["file"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.File.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, rawBody, close, errors.Wrap(err, "decode \"file\"")
+			}
+		}
 		return &request, rawBody, close, nil
 	default:
 		return req, rawBody, close, validate.InvalidContentType(ct)
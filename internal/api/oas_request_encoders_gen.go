@@ -98,22 +98,6 @@ func encodeAddFromFileRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "file" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.File.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "name" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -144,6 +128,11 @@ func encodeAddFromFileRequest(
 		}
 	}
 	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		if val, ok := request.File.Get(); ok {
+			if err := val.WriteMultipart("file", w); err != nil {
+				return errors.Wrap(err, "write \"file\"")
+			}
+		}
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
@@ -397,22 +386,6 @@ func encodeAddProjectRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "from_document" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "from_document",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.FromDocument.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "from_url" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -662,6 +635,11 @@ func encodeAddProjectRequest(
 		}
 	}
 	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		if val, ok := request.FromDocument.Get(); ok {
+			if err := val.WriteMultipart("from_document", w); err != nil {
+				return errors.Wrap(err, "write \"from_document\"")
+			}
+		}
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
@@ -1292,38 +1270,6 @@ func encodeCreateDubbingRequest(
 	request := req.Value
 
 	q := uri.NewFormEncoder(map[string]string{})
-	{
-		// Encode "background_audio_file" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "background_audio_file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.BackgroundAudioFile.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "csv_file" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "csv_file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.CsvFile.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "csv_fps" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -1404,38 +1350,6 @@ func encodeCreateDubbingRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "file" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.File.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
-	{
-		// Encode "foreground_audio_file" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "foreground_audio_file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.ForegroundAudioFile.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "highest_resolution" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -1613,6 +1527,26 @@ func encodeCreateDubbingRequest(
 		}
 	}
 	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		if val, ok := request.BackgroundAudioFile.Get(); ok {
+			if err := val.WriteMultipart("background_audio_file", w); err != nil {
+				return errors.Wrap(err, "write \"background_audio_file\"")
+			}
+		}
+		if val, ok := request.CsvFile.Get(); ok {
+			if err := val.WriteMultipart("csv_file", w); err != nil {
+				return errors.Wrap(err, "write \"csv_file\"")
+			}
+		}
+		if val, ok := request.File.Get(); ok {
+			if err := val.WriteMultipart("file", w); err != nil {
+				return errors.Wrap(err, "write \"file\"")
+			}
+		}
+		if val, ok := request.ForegroundAudioFile.Get(); ok {
+			if err := val.WriteMultipart("foreground_audio_file", w); err != nil {
+				return errors.Wrap(err, "write \"foreground_audio_file\"")
+			}
+		}
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
@@ -1927,22 +1861,6 @@ func encodeEditProjectContentRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "from_document" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "from_document",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.FromDocument.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "from_url" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -1960,6 +1878,11 @@ func encodeEditProjectContentRequest(
 		}
 	}
 	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		if val, ok := request.FromDocument.Get(); ok {
+			if err := val.WriteMultipart("from_document", w); err != nil {
+				return errors.Wrap(err, "write \"from_document\"")
+			}
+		}
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
@@ -2959,22 +2882,6 @@ func encodeSpeechToTextRequest(
 			return errors.Wrap(err, "encode query")
 		}
 	}
-	{
-		// Encode "file" form field.
-		cfg := uri.QueryParameterEncodingConfig{
-			Name:    "file",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-		if err := q.EncodeParam(cfg, func(e uri.Encoder) error {
-			if val, ok := request.File.Get(); ok {
-				return e.EncodeValue(conv.StringToString(val))
-			}
-			return nil
-		}); err != nil {
-			return errors.Wrap(err, "encode query")
-		}
-	}
 	{
 		// Encode "file_format" form field.
 		cfg := uri.QueryParameterEncodingConfig{
@@ -3168,6 +3075,11 @@ func encodeSpeechToTextRequest(
 		}
 	}
 	body, boundary := ht.CreateMultipartBody(func(w *multipart.Writer) error {
+		if val, ok := request.File.Get(); ok {
+			if err := val.WriteMultipart("file", w); err != nil {
+				return errors.Wrap(err, "write \"file\"")
+			}
+		}
 		if err := q.WriteMultipart(w); err != nil {
 			return errors.Wrap(err, "write multipart")
 		}
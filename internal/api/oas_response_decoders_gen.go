@@ -92,7 +92,9 @@ func decodeAddDocumentationToKnowledgeBaseResponse(resp *http.Response) (res Add
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -145,7 +147,9 @@ func decodeAddFromFileResponse(resp *http.Response) (res AddFromFileRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -189,7 +193,9 @@ func decodeAddFromFileResponse(resp *http.Response) (res AddFromFileRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -277,7 +283,9 @@ func decodeAddLanguageResponse(resp *http.Response) (res AddLanguageRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -365,7 +373,9 @@ func decodeAddMemberResponse(resp *http.Response) (res AddMemberRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -418,7 +428,9 @@ func decodeAddProjectResponse(resp *http.Response) (res AddProjectRes, _ error)
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -462,7 +474,9 @@ func decodeAddProjectResponse(resp *http.Response) (res AddProjectRes, _ error)
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -515,7 +529,9 @@ func decodeAddPvcVoiceSamplesResponse(resp *http.Response) (res AddPvcVoiceSampl
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -559,7 +575,9 @@ func decodeAddPvcVoiceSamplesResponse(resp *http.Response) (res AddPvcVoiceSampl
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -647,7 +665,9 @@ func decodeAddSharingVoiceResponse(resp *http.Response) (res AddSharingVoiceRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -735,7 +755,9 @@ func decodeAddVoiceResponse(resp *http.Response) (res AddVoiceRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -807,7 +829,9 @@ func decodeAudioIsolationResponse(resp *http.Response) (res AudioIsolationRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -879,7 +903,9 @@ func decodeAudioIsolationStreamResponse(resp *http.Response) (res AudioIsolation
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -967,7 +993,9 @@ func decodeAudioNativeProjectUpdateContentEndpointResponse(resp *http.Response)
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1020,7 +1048,9 @@ func decodeCancelBatchCallResponse(resp *http.Response) (res CancelBatchCallRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1064,7 +1094,9 @@ func decodeCancelBatchCallResponse(resp *http.Response) (res CancelBatchCallRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1176,7 +1208,9 @@ func decodeComposeDetailedResponse(resp *http.Response) (res ComposeDetailedRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1229,7 +1263,9 @@ func decodeComposePlanResponse(resp *http.Response) (res ComposePlanRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1273,7 +1309,9 @@ func decodeComposePlanResponse(resp *http.Response) (res ComposePlanRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1361,7 +1399,9 @@ func decodeConvertChapterEndpointResponse(resp *http.Response) (res ConvertChapt
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1449,7 +1489,9 @@ func decodeConvertProjectEndpointResponse(resp *http.Response) (res ConvertProje
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1537,7 +1579,9 @@ func decodeCreateAgentResponseTestRouteResponse(resp *http.Response) (res Create
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1625,7 +1669,9 @@ func decodeCreateAudioNativeProjectResponse(resp *http.Response) (res CreateAudi
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1678,7 +1724,9 @@ func decodeCreateBatchCallResponse(resp *http.Response) (res CreateBatchCallRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1722,7 +1770,9 @@ func decodeCreateBatchCallResponse(resp *http.Response) (res CreateBatchCallRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1810,7 +1860,9 @@ func decodeCreateClipResponse(resp *http.Response) (res CreateClipRes, _ error)
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1863,7 +1915,9 @@ func decodeCreateDubbingResponse(resp *http.Response) (res CreateDubbingRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1907,7 +1961,9 @@ func decodeCreateDubbingResponse(resp *http.Response) (res CreateDubbingRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -1995,7 +2051,9 @@ func decodeCreateFileDocumentRouteResponse(resp *http.Response) (res CreateFileD
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2083,7 +2141,9 @@ func decodeCreatePvcVoiceResponse(resp *http.Response) (res CreatePvcVoiceRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2136,7 +2196,9 @@ func decodeCreateSecretRouteResponse(resp *http.Response) (res CreateSecretRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2180,7 +2242,9 @@ func decodeCreateSecretRouteResponse(resp *http.Response) (res CreateSecretRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2268,7 +2332,9 @@ func decodeCreateServiceAccountAPIKeyResponse(resp *http.Response) (res CreateSe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2356,7 +2422,9 @@ func decodeCreateSpeakerResponse(resp *http.Response) (res CreateSpeakerRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2444,7 +2512,9 @@ func decodeCreateTextDocumentRouteResponse(resp *http.Response) (res CreateTextD
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2532,7 +2602,9 @@ func decodeCreateURLDocumentRouteResponse(resp *http.Response) (res CreateURLDoc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2585,7 +2657,9 @@ func decodeCreateVoiceResponse(resp *http.Response) (res CreateVoiceRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2629,7 +2703,9 @@ func decodeCreateVoiceResponse(resp *http.Response) (res CreateVoiceRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2682,7 +2758,9 @@ func decodeCreateVoiceOldResponse(resp *http.Response) (res CreateVoiceOldRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2726,7 +2804,9 @@ func decodeCreateVoiceOldResponse(resp *http.Response) (res CreateVoiceOldRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2814,7 +2894,9 @@ func decodeCreateWorkspaceWebhookRouteResponse(resp *http.Response) (res CreateW
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2873,7 +2955,9 @@ func decodeDeleteAgentRouteResponse(resp *http.Response) (res DeleteAgentRouteRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -2961,7 +3045,9 @@ func decodeDeleteChapterEndpointResponse(resp *http.Response) (res DeleteChapter
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3049,7 +3135,9 @@ func decodeDeleteChatResponseTestRouteResponse(resp *http.Response) (res DeleteC
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3137,7 +3225,9 @@ func decodeDeleteConversationRouteResponse(resp *http.Response) (res DeleteConve
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3225,7 +3315,9 @@ func decodeDeleteDubbingResponse(resp *http.Response) (res DeleteDubbingRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3313,7 +3405,9 @@ func decodeDeleteInviteResponse(resp *http.Response) (res DeleteInviteRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3401,7 +3495,9 @@ func decodeDeleteKnowledgeBaseDocumentResponse(resp *http.Response) (res DeleteK
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3489,7 +3585,9 @@ func decodeDeleteMcpServerRouteResponse(resp *http.Response) (res DeleteMcpServe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3577,7 +3675,9 @@ func decodeDeletePhoneNumberRouteResponse(resp *http.Response) (res DeletePhoneN
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3665,7 +3765,9 @@ func decodeDeleteProjectResponse(resp *http.Response) (res DeleteProjectRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3753,7 +3855,9 @@ func decodeDeletePvcVoiceSampleResponse(resp *http.Response) (res DeletePvcVoice
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3806,7 +3910,9 @@ func decodeDeleteRagIndexResponse(resp *http.Response) (res DeleteRagIndexRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3850,7 +3956,9 @@ func decodeDeleteRagIndexResponse(resp *http.Response) (res DeleteRagIndexRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3938,7 +4046,9 @@ func decodeDeleteSampleResponse(resp *http.Response) (res DeleteSampleRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -3994,7 +4104,9 @@ func decodeDeleteSecretRouteResponse(resp *http.Response) (res DeleteSecretRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4082,7 +4194,9 @@ func decodeDeleteSegmentResponse(resp *http.Response) (res DeleteSegmentRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4170,7 +4284,9 @@ func decodeDeleteServiceAccountAPIKeyResponse(resp *http.Response) (res DeleteSe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4258,7 +4374,9 @@ func decodeDeleteSpeechHistoryItemResponse(resp *http.Response) (res DeleteSpeec
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4346,7 +4464,9 @@ func decodeDeleteToolRouteResponse(resp *http.Response) (res DeleteToolRouteRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4469,7 +4589,9 @@ func decodeDeleteTranscriptByIDResponse(resp *http.Response) (res DeleteTranscri
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4557,7 +4679,9 @@ func decodeDeleteVoiceResponse(resp *http.Response) (res DeleteVoiceRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4645,7 +4769,9 @@ func decodeDeleteWhatsappAccountResponse(resp *http.Response) (res DeleteWhatsap
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4733,7 +4859,9 @@ func decodeDeleteWorkspaceWebhookRouteResponse(resp *http.Response) (res DeleteW
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4840,7 +4968,9 @@ func decodeDownloadSpeechHistoryItemsResponse(resp *http.Response) (res Download
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -4928,7 +5058,9 @@ func decodeDubResponse(resp *http.Response) (res DubRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5016,7 +5148,9 @@ func decodeDuplicateAgentRouteResponse(resp *http.Response) (res DuplicateAgentR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5069,7 +5203,9 @@ func decodeEditProjectResponse(resp *http.Response) (res EditProjectRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5113,7 +5249,9 @@ func decodeEditProjectResponse(resp *http.Response) (res EditProjectRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5166,7 +5304,9 @@ func decodeEditProjectContentResponse(resp *http.Response) (res EditProjectConte
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5210,7 +5350,9 @@ func decodeEditProjectContentResponse(resp *http.Response) (res EditProjectConte
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5298,7 +5440,9 @@ func decodeEditPvcVoiceResponse(resp *http.Response) (res EditPvcVoiceRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5386,7 +5530,9 @@ func decodeEditPvcVoiceSampleResponse(resp *http.Response) (res EditPvcVoiceSamp
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5474,7 +5620,9 @@ func decodeEditServiceAccountAPIKeyResponse(resp *http.Response) (res EditServic
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5562,7 +5710,9 @@ func decodeEditVoiceResponse(resp *http.Response) (res EditVoiceRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5650,7 +5800,9 @@ func decodeEditVoiceSettingsResponse(resp *http.Response) (res EditVoiceSettings
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5738,7 +5890,9 @@ func decodeEditWorkspaceWebhookRouteResponse(resp *http.Response) (res EditWorks
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5791,7 +5945,9 @@ func decodeForcedAlignmentResponse(resp *http.Response) (res ForcedAlignmentRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5835,7 +5991,9 @@ func decodeForcedAlignmentResponse(resp *http.Response) (res ForcedAlignmentRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -5979,7 +6137,9 @@ func decodeGenerateResponse(resp *http.Response) (res GenerateRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6051,7 +6211,9 @@ func decodeGenerateRandomVoiceResponse(resp *http.Response) (res GenerateRandomV
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6104,7 +6266,9 @@ func decodeGetAgentKnowledgeBaseSizeResponse(resp *http.Response) (res GetAgentK
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6148,7 +6312,9 @@ func decodeGetAgentKnowledgeBaseSizeResponse(resp *http.Response) (res GetAgentK
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6201,7 +6367,9 @@ func decodeGetAgentKnowledgeBaseSummariesRouteResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6245,7 +6413,9 @@ func decodeGetAgentKnowledgeBaseSummariesRouteResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6298,7 +6468,9 @@ func decodeGetAgentLinkRouteResponse(resp *http.Response) (res GetAgentLinkRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6342,7 +6514,9 @@ func decodeGetAgentLinkRouteResponse(resp *http.Response) (res GetAgentLinkRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6395,7 +6569,9 @@ func decodeGetAgentLlmExpectedCostCalculationResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6439,7 +6615,9 @@ func decodeGetAgentLlmExpectedCostCalculationResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6492,7 +6670,9 @@ func decodeGetAgentResponseTestRouteResponse(resp *http.Response) (res GetAgentR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6536,7 +6716,9 @@ func decodeGetAgentResponseTestRouteResponse(resp *http.Response) (res GetAgentR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6589,7 +6771,9 @@ func decodeGetAgentResponseTestsSummariesRouteResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6633,7 +6817,9 @@ func decodeGetAgentResponseTestsSummariesRouteResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6686,7 +6872,9 @@ func decodeGetAgentsRouteResponse(resp *http.Response) (res GetAgentsRouteRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6730,7 +6918,9 @@ func decodeGetAgentsRouteResponse(resp *http.Response) (res GetAgentsRouteRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6837,7 +7027,9 @@ func decodeGetAudioFromSampleResponse(resp *http.Response) (res GetAudioFromSamp
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6909,7 +7101,9 @@ func decodeGetAudioFullFromSpeechHistoryItemResponse(resp *http.Response) (res G
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -6962,7 +7156,9 @@ func decodeGetAudioNativeProjectSettingsEndpointResponse(resp *http.Response) (r
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7006,7 +7202,9 @@ func decodeGetAudioNativeProjectSettingsEndpointResponse(resp *http.Response) (r
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7059,7 +7257,9 @@ func decodeGetBatchCallResponse(resp *http.Response) (res GetBatchCallRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7103,7 +7303,9 @@ func decodeGetBatchCallResponse(resp *http.Response) (res GetBatchCallRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7156,7 +7358,9 @@ func decodeGetChapterSnapshotEndpointResponse(resp *http.Response) (res GetChapt
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7200,7 +7404,9 @@ func decodeGetChapterSnapshotEndpointResponse(resp *http.Response) (res GetChapt
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7253,7 +7459,9 @@ func decodeGetChapterSnapshotsResponse(resp *http.Response) (res GetChapterSnaps
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7297,7 +7505,9 @@ func decodeGetChapterSnapshotsResponse(resp *http.Response) (res GetChapterSnaps
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7350,7 +7560,9 @@ func decodeGetChaptersResponse(resp *http.Response) (res GetChaptersRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7394,7 +7606,9 @@ func decodeGetChaptersResponse(resp *http.Response) (res GetChaptersRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7466,7 +7680,9 @@ func decodeGetConversationAudioRouteResponse(resp *http.Response) (res GetConver
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7519,7 +7735,9 @@ func decodeGetConversationHistoriesRouteResponse(resp *http.Response) (res GetCo
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7563,7 +7781,9 @@ func decodeGetConversationHistoriesRouteResponse(resp *http.Response) (res GetCo
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7616,7 +7836,9 @@ func decodeGetConversationHistoryRouteResponse(resp *http.Response) (res GetConv
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7660,7 +7882,9 @@ func decodeGetConversationHistoryRouteResponse(resp *http.Response) (res GetConv
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7748,7 +7972,9 @@ func decodeGetConversationSignedLinkResponse(resp *http.Response) (res GetConver
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7801,7 +8027,9 @@ func decodeGetDashboardSettingsRouteResponse(resp *http.Response) (res GetDashbo
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7845,7 +8073,9 @@ func decodeGetDashboardSettingsRouteResponse(resp *http.Response) (res GetDashbo
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7933,7 +8163,9 @@ func decodeGetDocumentationChunkFromKnowledgeBaseResponse(resp *http.Response) (
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -7986,7 +8218,9 @@ func decodeGetDocumentationFromKnowledgeBaseResponse(resp *http.Response) (res G
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8030,7 +8264,9 @@ func decodeGetDocumentationFromKnowledgeBaseResponse(resp *http.Response) (res G
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8181,7 +8417,9 @@ func decodeGetDubbedFileResponse(resp *http.Response) (res GetDubbedFileRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8269,7 +8507,9 @@ func decodeGetDubbedMetadataResponse(resp *http.Response) (res GetDubbedMetadata
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8313,7 +8553,9 @@ func decodeGetDubbedMetadataResponse(resp *http.Response) (res GetDubbedMetadata
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8366,7 +8608,9 @@ func decodeGetDubbedTranscriptFileResponse(resp *http.Response) (res GetDubbedTr
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		case ct == "text/plain":
@@ -8489,7 +8733,9 @@ func decodeGetDubbedTranscriptFileResponse(resp *http.Response) (res GetDubbedTr
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8577,7 +8823,9 @@ func decodeGetDubbingResourceResponse(resp *http.Response) (res GetDubbingResour
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8621,7 +8869,9 @@ func decodeGetDubbingResourceResponse(resp *http.Response) (res GetDubbingResour
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8674,7 +8924,9 @@ func decodeGetGenerateVoiceParametersResponse(resp *http.Response) (res *VoiceGe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8746,7 +8998,9 @@ func decodeGetKnowledgeBaseContentResponse(resp *http.Response) (res GetKnowledg
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8799,7 +9053,9 @@ func decodeGetKnowledgeBaseDependentAgentsResponse(resp *http.Response) (res Get
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8843,7 +9099,9 @@ func decodeGetKnowledgeBaseDependentAgentsResponse(resp *http.Response) (res Get
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8896,7 +9154,9 @@ func decodeGetKnowledgeBaseListRouteResponse(resp *http.Response) (res GetKnowle
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8940,7 +9200,9 @@ func decodeGetKnowledgeBaseListRouteResponse(resp *http.Response) (res GetKnowle
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -8993,7 +9255,9 @@ func decodeGetLibraryVoicesResponse(resp *http.Response) (res GetLibraryVoicesRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9037,7 +9301,9 @@ func decodeGetLibraryVoicesResponse(resp *http.Response) (res GetLibraryVoicesRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9125,7 +9391,9 @@ func decodeGetLiveCountResponse(resp *http.Response) (res GetLiveCountRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9213,7 +9481,9 @@ func decodeGetLivekitTokenResponse(resp *http.Response) (res GetLivekitTokenRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9266,7 +9536,9 @@ func decodeGetMcpToolConfigOverrideRouteResponse(resp *http.Response) (res GetMc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9313,7 +9585,9 @@ func decodeGetMcpToolConfigOverrideRouteResponse(resp *http.Response) (res GetMc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9366,7 +9640,9 @@ func decodeGetModelsResponse(resp *http.Response) (res GetModelsRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9410,7 +9686,9 @@ func decodeGetModelsResponse(resp *http.Response) (res GetModelsRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9463,7 +9741,9 @@ func decodeGetOrCreateRagIndexesResponse(resp *http.Response) (res GetOrCreateRa
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9507,7 +9787,9 @@ func decodeGetOrCreateRagIndexesResponse(resp *http.Response) (res GetOrCreateRa
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9560,7 +9842,9 @@ func decodeGetPhoneNumberRouteResponse(resp *http.Response) (res GetPhoneNumberR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9604,7 +9888,9 @@ func decodeGetPhoneNumberRouteResponse(resp *http.Response) (res GetPhoneNumberR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9657,7 +9943,9 @@ func decodeGetProjectSnapshotEndpointResponse(resp *http.Response) (res GetProje
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9701,7 +9989,9 @@ func decodeGetProjectSnapshotEndpointResponse(resp *http.Response) (res GetProje
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9754,7 +10044,9 @@ func decodeGetProjectSnapshotsResponse(resp *http.Response) (res GetProjectSnaps
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9798,7 +10090,9 @@ func decodeGetProjectSnapshotsResponse(resp *http.Response) (res GetProjectSnaps
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9851,7 +10145,9 @@ func decodeGetProjectsResponse(resp *http.Response) (res GetProjectsRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9895,7 +10191,9 @@ func decodeGetProjectsResponse(resp *http.Response) (res GetProjectsRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9948,7 +10246,9 @@ func decodeGetPronunciationDictionariesMetadataResponse(resp *http.Response) (re
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -9992,7 +10292,9 @@ func decodeGetPronunciationDictionariesMetadataResponse(resp *http.Response) (re
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10045,7 +10347,9 @@ func decodeGetPronunciationDictionaryMetadataResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10089,7 +10393,9 @@ func decodeGetPronunciationDictionaryMetadataResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10201,7 +10507,9 @@ func decodeGetPronunciationDictionaryVersionPlsResponse(resp *http.Response) (re
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10254,7 +10562,9 @@ func decodeGetPublicLlmExpectedCostCalculationResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10298,7 +10608,9 @@ func decodeGetPublicLlmExpectedCostCalculationResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10351,7 +10663,9 @@ func decodeGetPvcSampleAudioResponse(resp *http.Response) (res GetPvcSampleAudio
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10395,7 +10709,9 @@ func decodeGetPvcSampleAudioResponse(resp *http.Response) (res GetPvcSampleAudio
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10448,7 +10764,9 @@ func decodeGetPvcSampleSpeakersResponse(resp *http.Response) (res GetPvcSampleSp
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10492,7 +10810,9 @@ func decodeGetPvcSampleSpeakersResponse(resp *http.Response) (res GetPvcSampleSp
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10545,7 +10865,9 @@ func decodeGetPvcSampleVisualWaveformResponse(resp *http.Response) (res GetPvcSa
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10589,7 +10911,9 @@ func decodeGetPvcSampleVisualWaveformResponse(resp *http.Response) (res GetPvcSa
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10645,7 +10969,9 @@ func decodeGetPvcVoiceCaptchaResponse(resp *http.Response) (res GetPvcVoiceCaptc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10698,7 +11024,9 @@ func decodeGetRagIndexOverviewResponse(resp *http.Response) (res GetRagIndexOver
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10742,7 +11070,9 @@ func decodeGetRagIndexOverviewResponse(resp *http.Response) (res GetRagIndexOver
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10795,7 +11125,9 @@ func decodeGetRagIndexesResponse(resp *http.Response) (res GetRagIndexesRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10839,7 +11171,9 @@ func decodeGetRagIndexesResponse(resp *http.Response) (res GetRagIndexesRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10892,7 +11226,9 @@ func decodeGetResourceMetadataResponse(resp *http.Response) (res GetResourceMeta
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10936,7 +11272,9 @@ func decodeGetResourceMetadataResponse(resp *http.Response) (res GetResourceMeta
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -10989,7 +11327,9 @@ func decodeGetSecretsRouteResponse(resp *http.Response) (res GetSecretsRouteRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11033,7 +11373,9 @@ func decodeGetSecretsRouteResponse(resp *http.Response) (res GetSecretsRouteRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11086,7 +11428,9 @@ func decodeGetServiceAccountAPIKeysRouteResponse(resp *http.Response) (res GetSe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11130,7 +11474,9 @@ func decodeGetServiceAccountAPIKeysRouteResponse(resp *http.Response) (res GetSe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11183,7 +11529,9 @@ func decodeGetSettingsRouteResponse(resp *http.Response) (res GetSettingsRouteRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11227,7 +11575,9 @@ func decodeGetSettingsRouteResponse(resp *http.Response) (res GetSettingsRouteRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11315,7 +11665,9 @@ func decodeGetSignedURLDeprecatedResponse(resp *http.Response) (res GetSignedURL
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11368,7 +11720,9 @@ func decodeGetSimilarLibraryVoicesResponse(resp *http.Response) (res GetSimilarL
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11412,7 +11766,9 @@ func decodeGetSimilarLibraryVoicesResponse(resp *http.Response) (res GetSimilarL
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11465,7 +11821,9 @@ func decodeGetSimilarVoicesForSpeakerResponse(resp *http.Response) (res GetSimil
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11509,7 +11867,9 @@ func decodeGetSimilarVoicesForSpeakerResponse(resp *http.Response) (res GetSimil
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11597,7 +11957,9 @@ func decodeGetSingleUseTokenResponse(resp *http.Response) (res GetSingleUseToken
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11650,7 +12012,9 @@ func decodeGetSpeakerAudioResponse(resp *http.Response) (res GetSpeakerAudioRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11694,7 +12058,9 @@ func decodeGetSpeakerAudioResponse(resp *http.Response) (res GetSpeakerAudioRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11747,7 +12113,9 @@ func decodeGetSpeechHistoryResponse(resp *http.Response) (res GetSpeechHistoryRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11791,7 +12159,9 @@ func decodeGetSpeechHistoryResponse(resp *http.Response) (res GetSpeechHistoryRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11844,7 +12214,9 @@ func decodeGetSpeechHistoryItemByIDResponse(resp *http.Response) (res GetSpeechH
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11888,7 +12260,9 @@ func decodeGetSpeechHistoryItemByIDResponse(resp *http.Response) (res GetSpeechH
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11941,7 +12315,9 @@ func decodeGetTestInvocationRouteResponse(resp *http.Response) (res GetTestInvoc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -11985,7 +12361,9 @@ func decodeGetTestInvocationRouteResponse(resp *http.Response) (res GetTestInvoc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12038,7 +12416,9 @@ func decodeGetToolDependentAgentsRouteResponse(resp *http.Response) (res GetTool
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12082,7 +12462,9 @@ func decodeGetToolDependentAgentsRouteResponse(resp *http.Response) (res GetTool
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12135,7 +12517,9 @@ func decodeGetTranscriptByIDResponse(resp *http.Response) (res GetTranscriptByID
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12249,7 +12633,9 @@ func decodeGetTranscriptByIDResponse(resp *http.Response) (res GetTranscriptByID
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12302,7 +12688,9 @@ func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12346,7 +12734,9 @@ func decodeGetUserInfoResponse(resp *http.Response) (res GetUserInfoRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12399,7 +12789,9 @@ func decodeGetUserVoicesV2Response(resp *http.Response) (res GetUserVoicesV2Res,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12443,7 +12835,9 @@ func decodeGetUserVoicesV2Response(resp *http.Response) (res GetUserVoicesV2Res,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12496,7 +12890,9 @@ func decodeGetVoiceByIDResponse(resp *http.Response) (res GetVoiceByIDRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12540,7 +12936,9 @@ func decodeGetVoiceByIDResponse(resp *http.Response) (res GetVoiceByIDRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12593,7 +12991,9 @@ func decodeGetVoiceSettingsResponse(resp *http.Response) (res GetVoiceSettingsRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12637,7 +13037,9 @@ func decodeGetVoiceSettingsResponse(resp *http.Response) (res GetVoiceSettingsRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12690,7 +13092,9 @@ func decodeGetVoiceSettingsDefaultResponse(resp *http.Response) (res *VoiceSetti
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12743,7 +13147,9 @@ func decodeGetVoicesResponse(resp *http.Response) (res GetVoicesRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12787,7 +13193,9 @@ func decodeGetVoicesResponse(resp *http.Response) (res GetVoicesRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12875,7 +13283,9 @@ func decodeGetWhatsappAccountResponse(resp *http.Response) (res GetWhatsappAccou
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12928,7 +13338,9 @@ func decodeGetWorkspaceBatchCallsResponse(resp *http.Response) (res GetWorkspace
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -12972,7 +13384,9 @@ func decodeGetWorkspaceBatchCallsResponse(resp *http.Response) (res GetWorkspace
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13025,7 +13439,9 @@ func decodeGetWorkspaceServiceAccountsResponse(resp *http.Response) (res GetWork
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13069,7 +13485,9 @@ func decodeGetWorkspaceServiceAccountsResponse(resp *http.Response) (res GetWork
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13122,7 +13540,9 @@ func decodeGetWorkspaceWebhooksRouteResponse(resp *http.Response) (res GetWorksp
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13166,7 +13586,9 @@ func decodeGetWorkspaceWebhooksRouteResponse(resp *http.Response) (res GetWorksp
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13254,7 +13676,9 @@ func decodeHandleSipTrunkOutboundCallResponse(resp *http.Response) (res HandleSi
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13342,7 +13766,9 @@ func decodeHandleTwilioOutboundCallResponse(resp *http.Response) (res HandleTwil
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13430,7 +13856,9 @@ func decodeImportWhatsappAccountResponse(resp *http.Response) (res ImportWhatsap
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13518,7 +13946,9 @@ func decodeInviteUserResponse(resp *http.Response) (res InviteUserRes, _ error)
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13606,7 +14036,9 @@ func decodeInviteUsersBulkResponse(resp *http.Response) (res InviteUsersBulkRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13659,7 +14091,9 @@ func decodeListChatResponseTestsRouteResponse(resp *http.Response) (res ListChat
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13703,7 +14137,9 @@ func decodeListChatResponseTestsRouteResponse(resp *http.Response) (res ListChat
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13756,7 +14192,9 @@ func decodeListDubsResponse(resp *http.Response) (res ListDubsRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13800,7 +14238,9 @@ func decodeListDubsResponse(resp *http.Response) (res ListDubsRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13853,7 +14293,9 @@ func decodeListMcpServerToolsRouteResponse(resp *http.Response) (res ListMcpServ
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13897,7 +14339,9 @@ func decodeListMcpServerToolsRouteResponse(resp *http.Response) (res ListMcpServ
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13950,7 +14394,9 @@ func decodeListPhoneNumbersRouteResponse(resp *http.Response) (res ListPhoneNumb
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -13994,7 +14440,9 @@ func decodeListPhoneNumbersRouteResponse(resp *http.Response) (res ListPhoneNumb
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14047,7 +14495,9 @@ func decodeListTestInvocationsRouteResponse(resp *http.Response) (res ListTestIn
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14091,7 +14541,9 @@ func decodeListTestInvocationsRouteResponse(resp *http.Response) (res ListTestIn
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14144,7 +14596,9 @@ func decodeListWhatsappAccountsResponse(resp *http.Response) (res ListWhatsappAc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14188,7 +14642,9 @@ func decodeListWhatsappAccountsResponse(resp *http.Response) (res ListWhatsappAc
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14276,7 +14732,9 @@ func decodeMigrateSegmentsResponse(resp *http.Response) (res MigrateSegmentsRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14329,7 +14787,9 @@ func decodePatchPronunciationDictionaryResponse(resp *http.Response) (res PatchP
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14373,7 +14833,9 @@ func decodePatchPronunciationDictionaryResponse(resp *http.Response) (res PatchP
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14461,7 +14923,9 @@ func decodePostAgentAvatarRouteResponse(resp *http.Response) (res PostAgentAvata
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14549,7 +15013,9 @@ func decodePostConversationFeedbackRouteResponse(resp *http.Response) (res PostC
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14602,7 +15068,9 @@ func decodeRagIndexStatusResponse(resp *http.Response) (res RagIndexStatusRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14646,7 +15114,9 @@ func decodeRagIndexStatusResponse(resp *http.Response) (res RagIndexStatusRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14764,7 +15234,9 @@ func decodeRegisterTwilioCallResponse(resp *http.Response) (res RegisterTwilioCa
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14852,7 +15324,9 @@ func decodeRemoveMemberResponse(resp *http.Response) (res RemoveMemberRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -14940,7 +15414,9 @@ func decodeRemoveRulesResponse(resp *http.Response) (res RemoveRulesRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15028,7 +15504,9 @@ func decodeRequestPvcManualVerificationResponse(resp *http.Response) (res Reques
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15081,7 +15559,9 @@ func decodeRetryBatchCallResponse(resp *http.Response) (res RetryBatchCallRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15125,7 +15605,9 @@ func decodeRetryBatchCallResponse(resp *http.Response) (res RetryBatchCallRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15213,7 +15695,9 @@ func decodeRunPvcVoiceTrainingResponse(resp *http.Response) (res RunPvcVoiceTrai
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15266,7 +15750,9 @@ func decodeSearchGroupsResponse(resp *http.Response) (res SearchGroupsRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15310,7 +15796,9 @@ func decodeSearchGroupsResponse(resp *http.Response) (res SearchGroupsRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15422,7 +15910,9 @@ func decodeSeparateSongStemsResponse(resp *http.Response) (res SeparateSongStems
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15510,7 +16000,9 @@ func decodeShareResourceEndpointResponse(resp *http.Response) (res ShareResource
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15622,7 +16114,9 @@ func decodeSoundGenerationResponse(resp *http.Response) (res SoundGenerationRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15694,7 +16188,9 @@ func decodeSpeechToSpeechFullResponse(resp *http.Response) (res SpeechToSpeechFu
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15766,7 +16262,9 @@ func decodeSpeechToSpeechStreamResponse(resp *http.Response) (res SpeechToSpeech
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15819,7 +16317,9 @@ func decodeSpeechToTextResponse(resp *http.Response) (res SpeechToTextRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15898,7 +16398,9 @@ func decodeSpeechToTextResponse(resp *http.Response) (res SpeechToTextRes, _ err
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -15986,7 +16488,9 @@ func decodeStartSpeakerSeparationResponse(resp *http.Response) (res StartSpeaker
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16058,7 +16562,9 @@ func decodeStreamChapterSnapshotAudioResponse(resp *http.Response) (res StreamCh
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16202,7 +16708,9 @@ func decodeStreamComposeResponse(resp *http.Response) (res StreamComposeRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16274,7 +16782,9 @@ func decodeStreamProjectSnapshotArchiveEndpointResponse(resp *http.Response) (re
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16330,7 +16840,9 @@ func decodeStreamProjectSnapshotAudioEndpointResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16402,7 +16914,9 @@ func decodeTextToDialogueResponse(resp *http.Response) (res TextToDialogueRes, _
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16455,7 +16969,9 @@ func decodeTextToDialogueFullWithTimestampsResponse(resp *http.Response) (res Te
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16499,7 +17015,9 @@ func decodeTextToDialogueFullWithTimestampsResponse(resp *http.Response) (res Te
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16571,7 +17089,9 @@ func decodeTextToDialogueStreamResponse(resp *http.Response) (res TextToDialogue
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16624,7 +17144,9 @@ func decodeTextToDialogueStreamWithTimestampsResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16668,7 +17190,9 @@ func decodeTextToDialogueStreamWithTimestampsResponse(resp *http.Response) (res
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16740,7 +17264,9 @@ func decodeTextToSpeechFullResponse(resp *http.Response) (res TextToSpeechFullRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16793,7 +17319,9 @@ func decodeTextToSpeechFullWithTimestampsResponse(resp *http.Response) (res Text
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16837,7 +17365,9 @@ func decodeTextToSpeechFullWithTimestampsResponse(resp *http.Response) (res Text
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16909,7 +17439,9 @@ func decodeTextToSpeechStreamResponse(resp *http.Response) (res TextToSpeechStre
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -16962,7 +17494,9 @@ func decodeTextToSpeechStreamWithTimestampsResponse(resp *http.Response) (res Te
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17006,7 +17540,9 @@ func decodeTextToSpeechStreamWithTimestampsResponse(resp *http.Response) (res Te
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17059,7 +17595,9 @@ func decodeTextToVoiceResponse(resp *http.Response) (res TextToVoiceRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17103,7 +17641,9 @@ func decodeTextToVoiceResponse(resp *http.Response) (res TextToVoiceRes, _ error
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17156,7 +17696,9 @@ func decodeTextToVoiceDesignResponse(resp *http.Response) (res TextToVoiceDesign
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17200,7 +17742,9 @@ func decodeTextToVoiceDesignResponse(resp *http.Response) (res TextToVoiceDesign
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17272,7 +17816,9 @@ func decodeTextToVoicePreviewStreamResponse(resp *http.Response) (res TextToVoic
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17325,7 +17871,9 @@ func decodeTextToVoiceRemixResponse(resp *http.Response) (res TextToVoiceRemixRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17369,7 +17917,9 @@ func decodeTextToVoiceRemixResponse(resp *http.Response) (res TextToVoiceRemixRe
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17457,7 +18007,9 @@ func decodeTranscribeResponse(resp *http.Response) (res TranscribeRes, _ error)
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17545,7 +18097,9 @@ func decodeTranslateResponse(resp *http.Response) (res TranslateRes, _ error) {
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17633,7 +18187,9 @@ func decodeUnshareResourceEndpointResponse(resp *http.Response) (res UnshareReso
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17686,7 +18242,9 @@ func decodeUpdateAgentResponseTestRouteResponse(resp *http.Response) (res Update
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17730,7 +18288,9 @@ func decodeUpdateAgentResponseTestRouteResponse(resp *http.Response) (res Update
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17783,7 +18343,9 @@ func decodeUpdateDashboardSettingsRouteResponse(resp *http.Response) (res Update
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17827,7 +18389,9 @@ func decodeUpdateDashboardSettingsRouteResponse(resp *http.Response) (res Update
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17880,7 +18444,9 @@ func decodeUpdateDocumentRouteResponse(resp *http.Response) (res UpdateDocumentR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17924,7 +18490,9 @@ func decodeUpdateDocumentRouteResponse(resp *http.Response) (res UpdateDocumentR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -17977,7 +18545,9 @@ func decodeUpdatePhoneNumberRouteResponse(resp *http.Response) (res UpdatePhoneN
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18021,7 +18591,9 @@ func decodeUpdatePhoneNumberRouteResponse(resp *http.Response) (res UpdatePhoneN
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18109,7 +18681,9 @@ func decodeUpdatePronunciationDictionariesResponse(resp *http.Response) (res Upd
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18162,7 +18736,9 @@ func decodeUpdateSecretRouteResponse(resp *http.Response) (res UpdateSecretRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18206,7 +18782,9 @@ func decodeUpdateSecretRouteResponse(resp *http.Response) (res UpdateSecretRoute
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18294,7 +18872,9 @@ func decodeUpdateSegmentLanguageResponse(resp *http.Response) (res UpdateSegment
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18347,7 +18927,9 @@ func decodeUpdateSettingsRouteResponse(resp *http.Response) (res UpdateSettingsR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18391,7 +18973,9 @@ func decodeUpdateSettingsRouteResponse(resp *http.Response) (res UpdateSettingsR
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18479,7 +19063,9 @@ func decodeUpdateSpeakerResponse(resp *http.Response) (res UpdateSpeakerRes, _ e
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18567,7 +19153,9 @@ func decodeUpdateWhatsappAccountResponse(resp *http.Response) (res UpdateWhatsap
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18655,7 +19243,9 @@ func decodeUpdateWorkspaceMemberResponse(resp *http.Response) (res UpdateWorkspa
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18708,7 +19298,9 @@ func decodeUsageCharactersResponse(resp *http.Response) (res UsageCharactersRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18752,7 +19344,9 @@ func decodeUsageCharactersResponse(resp *http.Response) (res UsageCharactersRes,
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18840,7 +19434,9 @@ func decodeVerifyPvcVoiceCaptchaResponse(resp *http.Response) (res VerifyPvcVoic
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
@@ -18928,7 +19524,9 @@ func decodeWhatsappOutboundCallResponse(resp *http.Response) (res WhatsappOutbou
 				}
 				return nil
 			}(); err != nil {
-				return res, errors.Wrap(err, "validate")
+				if err := validateResponse(resp, err); err != nil {
+					return res, errors.Wrap(err, "validate")
+				}
 			}
 			return &response, nil
 		default:
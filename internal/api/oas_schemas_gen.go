@@ -2091,7 +2091,7 @@ type BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePostMu
 	// A description of the pronunciation dictionary, used for identification only.
 	Description OptNilString `json:"description"`
 	// A lexicon .pls file which we will use to initialize the project with.
-	File OptNilString `json:"file"`
+	File OptMultipartFile `json:"file"`
 	// The name of the pronunciation dictionary, used for identification only.
 	Name string `json:"name"`
 	// Should be one of 'admin', 'editor' or 'viewer'. If not provided, defaults to no access.
@@ -2104,7 +2104,7 @@ func (s *BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePo
 }
 
 // GetFile returns the value of File.
-func (s *BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePostMultipart) GetFile() OptNilString {
+func (s *BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePostMultipart) GetFile() OptMultipartFile {
 	return s.File
 }
 
@@ -2124,7 +2124,7 @@ func (s *BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePo
 }
 
 // SetFile sets the value of File.
-func (s *BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePostMultipart) SetFile(val OptNilString) {
+func (s *BodyAddAPronunciationDictionaryV1PronunciationDictionariesAddFromFilePostMultipart) SetFile(val OptMultipartFile) {
 	s.File = val
 }
 
@@ -3766,7 +3766,7 @@ type BodyCreateStudioProjectV1StudioProjectsPostMultipart struct {
 	// Studio project with its content. If this is set, 'from_url' and 'from_content' must be null. If
 	// neither 'from_url', 'from_document', 'from_content' are provided we will initialize the Studio
 	// project as blank.
-	FromDocument OptNilString `json:"from_document"`
+	FromDocument OptMultipartFile `json:"from_document"`
 	// An optional URL from which we will extract content to initialize the Studio project. If this is
 	// set, 'from_url' and 'from_content' must be null. If neither 'from_url', 'from_document',
 	// 'from_content' are provided we will initialize the Studio project as blank.
@@ -3885,7 +3885,7 @@ func (s *BodyCreateStudioProjectV1StudioProjectsPostMultipart) GetFromContentJSO
 }
 
 // GetFromDocument returns the value of FromDocument.
-func (s *BodyCreateStudioProjectV1StudioProjectsPostMultipart) GetFromDocument() OptNilString {
+func (s *BodyCreateStudioProjectV1StudioProjectsPostMultipart) GetFromDocument() OptMultipartFile {
 	return s.FromDocument
 }
 
@@ -4025,7 +4025,7 @@ func (s *BodyCreateStudioProjectV1StudioProjectsPostMultipart) SetFromContentJSO
 }
 
 // SetFromDocument sets the value of FromDocument.
-func (s *BodyCreateStudioProjectV1StudioProjectsPostMultipart) SetFromDocument(val OptNilString) {
+func (s *BodyCreateStudioProjectV1StudioProjectsPostMultipart) SetFromDocument(val OptMultipartFile) {
 	s.FromDocument = val
 }
 
@@ -4751,9 +4751,9 @@ func (s *BodyDownloadHistoryItemsV1HistoryDownloadPost) SetOutputFormat(val OptN
 // Ref: #/components/schemas/Body_Dub_a_video_or_an_audio_file_v1_dubbing_post
 type BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart struct {
 	// For use only with csv input.
-	BackgroundAudioFile OptNilString `json:"background_audio_file"`
+	BackgroundAudioFile OptMultipartFile `json:"background_audio_file"`
 	// CSV file containing transcription/translation metadata.
-	CsvFile OptNilString `json:"csv_file"`
+	CsvFile OptMultipartFile `json:"csv_file"`
 	// Frames per second to use when parsing a CSV file for dubbing. If not provided, FPS will be
 	// inferred from timecodes.
 	CsvFps OptNilFloat64 `json:"csv_fps"`
@@ -4771,9 +4771,9 @@ type BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart struct {
 	// End time of the source video/audio file.
 	EndTime OptNilInt `json:"end_time"`
 	// A list of file paths to audio recordings intended for voice cloning.
-	File OptNilString `json:"file"`
+	File OptMultipartFile `json:"file"`
 	// For use only with csv input.
-	ForegroundAudioFile OptNilString `json:"foreground_audio_file"`
+	ForegroundAudioFile OptMultipartFile `json:"foreground_audio_file"`
 	// Whether to use the highest resolution available.
 	HighestResolution OptBool `json:"highest_resolution"`
 	// The mode in which to run this Dubbing job. Defaults to automatic, use manual if specifically
@@ -4801,12 +4801,12 @@ type BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart struct {
 }
 
 // GetBackgroundAudioFile returns the value of BackgroundAudioFile.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetBackgroundAudioFile() OptNilString {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetBackgroundAudioFile() OptMultipartFile {
 	return s.BackgroundAudioFile
 }
 
 // GetCsvFile returns the value of CsvFile.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetCsvFile() OptNilString {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetCsvFile() OptMultipartFile {
 	return s.CsvFile
 }
 
@@ -4836,12 +4836,12 @@ func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetEndTime() OptNilIn
 }
 
 // GetFile returns the value of File.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetFile() OptNilString {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetFile() OptMultipartFile {
 	return s.File
 }
 
 // GetForegroundAudioFile returns the value of ForegroundAudioFile.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetForegroundAudioFile() OptNilString {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetForegroundAudioFile() OptMultipartFile {
 	return s.ForegroundAudioFile
 }
 
@@ -4901,12 +4901,12 @@ func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) GetWatermark() OptBoo
 }
 
 // SetBackgroundAudioFile sets the value of BackgroundAudioFile.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetBackgroundAudioFile(val OptNilString) {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetBackgroundAudioFile(val OptMultipartFile) {
 	s.BackgroundAudioFile = val
 }
 
 // SetCsvFile sets the value of CsvFile.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetCsvFile(val OptNilString) {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetCsvFile(val OptMultipartFile) {
 	s.CsvFile = val
 }
 
@@ -4936,12 +4936,12 @@ func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetEndTime(val OptNil
 }
 
 // SetFile sets the value of File.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetFile(val OptNilString) {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetFile(val OptMultipartFile) {
 	s.File = val
 }
 
 // SetForegroundAudioFile sets the value of ForegroundAudioFile.
-func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetForegroundAudioFile(val OptNilString) {
+func (s *BodyDubAVideoOrAnAudioFileV1DubbingPostMultipart) SetForegroundAudioFile(val OptMultipartFile) {
 	s.ForegroundAudioFile = val
 }
 
@@ -6941,7 +6941,7 @@ type BodySpeechToTextV1SpeechToTextPostMultipart struct {
 	Diarize OptBool `json:"diarize"`
 	// The file to transcribe. All major audio and video formats are supported. Exactly one of the file
 	// or cloud_storage_url parameters must be provided. The file size must be less than 3.0GB.
-	File OptNilString `json:"file"`
+	File OptMultipartFile `json:"file"`
 	// The format of input audio. Options are 'pcm_s16le_16' or 'other' For `pcm_s16le_16`, the input
 	// audio must be 16-bit PCM at a 16kHz sample rate, single channel (mono), and little-endian byte
 	// order. Latency will be lower than with passing an encoded waveform.
@@ -7008,7 +7008,7 @@ func (s *BodySpeechToTextV1SpeechToTextPostMultipart) GetDiarize() OptBool {
 }
 
 // GetFile returns the value of File.
-func (s *BodySpeechToTextV1SpeechToTextPostMultipart) GetFile() OptNilString {
+func (s *BodySpeechToTextV1SpeechToTextPostMultipart) GetFile() OptMultipartFile {
 	return s.File
 }
 
@@ -7093,7 +7093,7 @@ func (s *BodySpeechToTextV1SpeechToTextPostMultipart) SetDiarize(val OptBool) {
 }
 
 // SetFile sets the value of File.
-func (s *BodySpeechToTextV1SpeechToTextPostMultipart) SetFile(val OptNilString) {
+func (s *BodySpeechToTextV1SpeechToTextPostMultipart) SetFile(val OptMultipartFile) {
 	s.File = val
 }
 
@@ -9654,7 +9654,7 @@ type BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMultipart
 	// Studio project with its content. If this is set, 'from_url' and 'from_content' must be null. If
 	// neither 'from_url', 'from_document', 'from_content' are provided we will initialize the Studio
 	// project as blank.
-	FromDocument OptNilString `json:"from_document"`
+	FromDocument OptMultipartFile `json:"from_document"`
 	// An optional URL from which we will extract content to initialize the Studio project. If this is
 	// set, 'from_url' and 'from_content' must be null. If neither 'from_url', 'from_document',
 	// 'from_content' are provided we will initialize the Studio project as blank.
@@ -9672,7 +9672,7 @@ func (s *BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMulti
 }
 
 // GetFromDocument returns the value of FromDocument.
-func (s *BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMultipart) GetFromDocument() OptNilString {
+func (s *BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMultipart) GetFromDocument() OptMultipartFile {
 	return s.FromDocument
 }
 
@@ -9692,7 +9692,7 @@ func (s *BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMulti
 }
 
 // SetFromDocument sets the value of FromDocument.
-func (s *BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMultipart) SetFromDocument(val OptNilString) {
+func (s *BodyUpdateStudioProjectContentV1StudioProjectsProjectIDContentPostMultipart) SetFromDocument(val OptMultipartFile) {
 	s.FromDocument = val
 }
 
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+	"net/http"
+)
+
+// This file is not generated. generate.sh post-processes the response
+// decoders to call validateResponse, so clients can accept responses that
+// decode but fail schema validation, such as an enum value added to the
+// API after this package was generated.
+
+type responseValidatorKey struct{}
+
+// ResponseValidator decides what to do with a response that decoded but
+// failed schema validation. It returns the error to report, or nil to
+// accept the response as decoded.
+type ResponseValidator func(err error) error
+
+// WithResponseValidator returns a context whose responses are checked by v.
+// HTTP clients attach it to the response's request, which the decoders
+// read.
+func WithResponseValidator(ctx context.Context, v ResponseValidator) context.Context {
+	return context.WithValue(ctx, responseValidatorKey{}, v)
+}
+
+// validateResponse applies the response's validator, if any, to a schema
+// validation error.
+func validateResponse(resp *http.Response, err error) error {
+	if resp.Request == nil {
+		return err
+	}
+	if v, ok := resp.Request.Context().Value(responseValidatorKey{}).(ResponseValidator); ok {
+		return v(err)
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeAfter(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := NewFake(start)
+
+	ch := f.After(10 * time.Second)
+	done := make(chan time.Time)
+	go func() { done <- <-ch }()
+
+	f.BlockUntil(1)
+	f.Advance(5 * time.Second)
+	select {
+	case <-done:
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	f.Advance(5 * time.Second)
+	got := <-done
+	if !got.Equal(start.Add(10 * time.Second)) {
+		t.Errorf("fired at %v, want %v", got, start.Add(10*time.Second))
+	}
+	if !f.Now().Equal(start.Add(10 * time.Second)) {
+		t.Errorf("Now() = %v", f.Now())
+	}
+}
+
+func TestFakeAfterZero(t *testing.T) {
+	f := NewFake(time.Unix(0, 0))
+	select {
+	case <-f.After(0):
+	default:
+		t.Fatal("zero duration timer should fire immediately")
+	}
+}
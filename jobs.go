@@ -0,0 +1,127 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultJobPollInterval is the polling interval used by WaitAll when none
+// is given.
+const DefaultJobPollInterval = 5 * time.Second
+
+// JobStatus is the status of a long-running job.
+type JobStatus string
+
+// Job statuses.
+const (
+	JobRunning   JobStatus = "running"
+	JobSucceeded JobStatus = "succeeded"
+	JobFailed    JobStatus = "failed"
+)
+
+// JobState is the state of a job when it was last polled.
+type JobState struct {
+	// Status is whether the job is running, succeeded, or failed.
+	Status JobStatus
+
+	// Progress is the fraction complete, from 0 to 1, or -1 if the
+	// endpoint doesn't report progress.
+	Progress float64
+
+	// Error explains why a failed job failed.
+	Error string
+}
+
+// Done reports whether the job has finished, successfully or not.
+func (s JobState) Done() bool {
+	return s.Status == JobSucceeded || s.Status == JobFailed
+}
+
+// Job is a long-running job on an asynchronous endpoint, such as dubbing
+// or Studio conversion, that is polled until it finishes. DubbingJob,
+// ProjectConversionJob, and ChapterConversionJob implement it; each also
+// provides a method to fetch its result once it has succeeded.
+type Job interface {
+	// ID identifies the job in errors and progress reports.
+	ID() string
+
+	// Poll fetches the job's current state.
+	Poll(ctx context.Context) (JobState, error)
+}
+
+// WaitOptions configures WaitAll.
+type WaitOptions struct {
+	// Interval is the time between polls of each job.
+	// Defaults to DefaultJobPollInterval.
+	Interval time.Duration
+
+	// Delay is the time before the first poll, for jobs that were just
+	// started and can't have finished yet. Zero polls at once.
+	Delay time.Duration
+
+	// OnProgress, if set, is called after each poll of a job with its new
+	// state.
+	OnProgress func(job Job, state JobState)
+}
+
+// WaitAll polls jobs until all of them have finished and returns their
+// final states, in the order given. Running jobs are polled in turn every
+// Interval; finished jobs are not polled again. If any job failed, the
+// error wraps ErrJobFailed for each one. If a poll returns an error, or
+// ctx is done first, WaitAll stops and returns the states polled so far
+// with the error.
+func (c *Client) WaitAll(ctx context.Context, jobs []Job, opts *WaitOptions) ([]JobState, error) {
+	var o WaitOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Interval <= 0 {
+		o.Interval = DefaultJobPollInterval
+	}
+
+	states := make([]JobState, len(jobs))
+	for i := range states {
+		states[i] = JobState{Status: JobRunning, Progress: -1}
+	}
+
+	wait := o.Delay
+	for {
+		if wait > 0 {
+			select {
+			case <-ctx.Done():
+				return states, ctx.Err()
+			case <-c.clock.After(wait):
+			}
+		}
+		wait = o.Interval
+
+		running := 0
+		for i, job := range jobs {
+			if states[i].Done() {
+				continue
+			}
+			state, err := job.Poll(ctx)
+			if err != nil {
+				return states, fmt.Errorf("polling job %s: %w", job.ID(), err)
+			}
+			states[i] = state
+			if o.OnProgress != nil {
+				o.OnProgress(job, state)
+			}
+			if !state.Done() {
+				running++
+			}
+		}
+		if running == 0 {
+			var errs []error
+			for i, state := range states {
+				if state.Status == JobFailed {
+					errs = append(errs, fmt.Errorf("%w: %s: %s", ErrJobFailed, jobs[i].ID(), state.Error))
+				}
+			}
+			return states, errors.Join(errs...)
+		}
+	}
+}
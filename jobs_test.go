@@ -0,0 +1,172 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+// scriptedJob returns its states in order, one per poll, repeating the last.
+type scriptedJob struct {
+	id     string
+	states []JobState
+	polls  int
+}
+
+func (j *scriptedJob) ID() string { return j.id }
+
+func (j *scriptedJob) Poll(ctx context.Context) (JobState, error) {
+	state := j.states[min(j.polls, len(j.states)-1)]
+	j.polls++
+	return state, nil
+}
+
+func TestWaitAll(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	client, err := NewClient(WithAPIKey("test-key"), withClock(fake))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	quick := &scriptedJob{id: "quick", states: []JobState{{Status: JobSucceeded, Progress: 1}}}
+	slow := &scriptedJob{id: "slow", states: []JobState{
+		{Status: JobRunning, Progress: 0.5},
+		{Status: JobSucceeded, Progress: 1},
+	}}
+	broken := &scriptedJob{id: "broken", states: []JobState{
+		{Status: JobRunning, Progress: -1},
+		{Status: JobRunning, Progress: -1},
+		{Status: JobFailed, Progress: -1, Error: "source audio is silent"},
+	}}
+
+	type result struct {
+		states []JobState
+		err    error
+	}
+	var progress []string
+	done := make(chan result, 1)
+	go func() {
+		states, err := client.WaitAll(context.Background(), []Job{quick, slow, broken}, &WaitOptions{
+			Interval: 10 * time.Second,
+			OnProgress: func(job Job, state JobState) {
+				progress = append(progress, fmt.Sprintf("%s %s %.1f", job.ID(), state.Status, state.Progress))
+			},
+		})
+		done <- result{states, err}
+	}()
+
+	for i := 0; i < 2; i++ {
+		fake.BlockUntil(1)
+		fake.Advance(10 * time.Second)
+	}
+	res := <-done
+
+	if !errors.Is(res.err, ErrJobFailed) || !strings.Contains(res.err.Error(), "broken: source audio is silent") {
+		t.Fatalf("WaitAll() error = %v, want ErrJobFailed for broken", res.err)
+	}
+	if res.states[0].Status != JobSucceeded || res.states[1].Status != JobSucceeded || res.states[2].Status != JobFailed {
+		t.Errorf("states = %+v", res.states)
+	}
+	if quick.polls != 1 || slow.polls != 2 || broken.polls != 3 {
+		t.Errorf("polls = %d, %d, %d; finished jobs should not be polled again", quick.polls, slow.polls, broken.polls)
+	}
+	want := "quick succeeded 1.0,slow running 0.5,broken running -1.0,slow succeeded 1.0,broken running -1.0,broken failed -1.0"
+	if got := strings.Join(progress, ","); got != want {
+		t.Errorf("progress = %s, want %s", got, want)
+	}
+}
+
+func TestWaitAllCanceled(t *testing.T) {
+	fake := clock.NewFake(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	client, err := NewClient(WithAPIKey("test-key"), withClock(fake))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	job := &scriptedJob{id: "j", states: []JobState{{Status: JobRunning, Progress: 0.2}}}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.WaitAll(ctx, []Job{job}, &WaitOptions{Delay: time.Minute})
+		done <- err
+	}()
+	fake.BlockUntil(1)
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("WaitAll() error = %v, want context.Canceled", err)
+	}
+	if job.polls != 0 {
+		t.Errorf("polls = %d, want none before Delay", job.polls)
+	}
+}
+
+func TestChapterConversionJob(t *testing.T) {
+	const snapshot = `{"chapter_snapshot_id": "%s", "project_id": "p1", "chapter_id": "c1", "name": "Snapshot", "created_at_unix": 1767322800}`
+	var snapshotLists, chapterLists int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v1/studio/projects/p1/chapters/c1/snapshots":
+			snapshotLists++
+			_, _ = fmt.Fprintf(w, `{"snapshots": [`+snapshot+`]}`, "s1")
+		case "POST /v1/studio/projects/p1/chapters/c1/convert":
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		case "GET /v1/studio/projects/p1/chapters":
+			chapterLists++
+			// A stale error from the last conversion, then converting, then failed
+			state, progress, lastError := "default", "null", `"old error"`
+			switch chapterLists {
+			case 2:
+				state, progress, lastError = "converting", "40", "null"
+			case 3:
+				lastError = `"quota exceeded"`
+			}
+			_, _ = fmt.Fprintf(w, `{"chapters": [{"chapter_id": "c2", "name": "Other", "state": "converting", "can_be_downloaded": false},
+				{"chapter_id": "c1", "name": "One", "state": "%s", "can_be_downloaded": false, "conversion_progress": %s, "last_conversion_error": %s}]}`,
+				state, progress, lastError)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	job, err := client.Projects().StartChapterConversion(ctx, "p1", "c1")
+	if err != nil {
+		t.Fatalf("StartChapterConversion() error = %v", err)
+	}
+	var progress []float64
+	states, err := client.WaitAll(ctx, []Job{job}, &WaitOptions{
+		Interval:   time.Millisecond,
+		OnProgress: func(_ Job, state JobState) { progress = append(progress, state.Progress) },
+	})
+	if !errors.Is(err, ErrJobFailed) || states[0].Error != "quota exceeded" {
+		t.Fatalf("WaitAll() = %+v, %v; want the conversion error", states, err)
+	}
+	if len(progress) != 3 || progress[0] != -1 || progress[1] != 0.4 {
+		t.Errorf("progress = %v", progress)
+	}
+	if job.ID() != "p1/c1" || job.Snapshot != nil {
+		t.Errorf("job = %+v", job)
+	}
+	if _, err := job.Result(ctx, nil); !isValidationError(err, nil) {
+		t.Errorf("Result() before success error = %v, want ValidationError", err)
+	}
+	if snapshotLists != 4 {
+		t.Errorf("snapshot lists = %d, want 4", snapshotLists)
+	}
+}
@@ -0,0 +1,201 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Default latency measurement settings.
+const (
+	// DefaultLatencyRuns is the default number of measured runs.
+	DefaultLatencyRuns = 5
+
+	// DefaultLatencyWarmupRuns is the default number of unmeasured runs
+	// made first to warm up connections and the voice.
+	DefaultLatencyWarmupRuns = 1
+)
+
+// LatencyOptions configures a latency measurement.
+type LatencyOptions struct {
+	// Runs is the number of measured runs. Defaults to DefaultLatencyRuns.
+	Runs int
+
+	// WarmupRuns is the number of unmeasured runs made first. Defaults to
+	// DefaultLatencyWarmupRuns; use a negative value to skip warm-up.
+	WarmupRuns int
+
+	// OnSample, if set, is called after each measured run.
+	OnSample func(run int, sample LatencySample)
+}
+
+// LatencySample is the timing of one streamed generation.
+type LatencySample struct {
+	// TTFB is the time from sending the request to the first audio byte.
+	TTFB time.Duration `json:"ttfb"`
+
+	// Total is the time from sending the request to the last audio byte.
+	Total time.Duration `json:"total"`
+
+	// Bytes is the size of the generated audio.
+	Bytes int64 `json:"bytes"`
+}
+
+// LatencyStats summarizes durations across runs.
+type LatencyStats struct {
+	Min  time.Duration `json:"min"`
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P90  time.Duration `json:"p90"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// LatencyReport is the result of a latency measurement.
+type LatencyReport struct {
+	// VoiceID is the voice measured.
+	VoiceID string `json:"voice_id"`
+
+	// ModelID is the model measured.
+	ModelID string `json:"model_id"`
+
+	// OutputFormat is the output format measured, if set.
+	OutputFormat string `json:"output_format,omitempty"`
+
+	// Samples are the measured runs, in order.
+	Samples []LatencySample `json:"samples"`
+
+	// TTFB summarizes the time to first byte.
+	TTFB LatencyStats `json:"ttfb"`
+
+	// Total summarizes the total generation time.
+	Total LatencyStats `json:"total"`
+}
+
+// MeasureLatency measures the time to first byte and total latency of
+// streamed generation for a voice, model, and output format. It makes the
+// warm-up runs, then the measured runs one after another, and reports
+// percentiles across them. Every run is a billed generation, so keep the
+// text short.
+//
+// Example:
+//
+//	report, err := client.TextToSpeech().MeasureLatency(ctx, &elevenlabs.TTSRequest{
+//	    VoiceID: voiceID,
+//	    Text:    "The quick brown fox jumps over the lazy dog.",
+//	    ModelID: "eleven_flash_v2_5",
+//	}, &elevenlabs.LatencyOptions{Runs: 10})
+//	fmt.Printf("TTFB p50 %v, p90 %v\n", report.TTFB.P50, report.TTFB.P90)
+func (s *TextToSpeechService) MeasureLatency(ctx context.Context, req *TTSRequest, opts *LatencyOptions) (*LatencyReport, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = &LatencyOptions{}
+	}
+	runs := opts.Runs
+	if runs <= 0 {
+		runs = DefaultLatencyRuns
+	}
+	warmup := opts.WarmupRuns
+	if warmup == 0 {
+		warmup = DefaultLatencyWarmupRuns
+	}
+
+	modelID := req.ModelID
+	if modelID == "" {
+		modelID = DefaultModelID
+	}
+	report := &LatencyReport{
+		VoiceID:      req.VoiceID,
+		ModelID:      modelID,
+		OutputFormat: req.OutputFormat,
+	}
+
+	for i := 0; i < warmup; i++ {
+		if _, err := s.measureStream(ctx, req); err != nil {
+			return nil, fmt.Errorf("warm-up run %d: %w", i+1, err)
+		}
+	}
+	for i := 0; i < runs; i++ {
+		sample, err := s.measureStream(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("run %d: %w", i+1, err)
+		}
+		report.Samples = append(report.Samples, sample)
+		if opts.OnSample != nil {
+			opts.OnSample(i+1, sample)
+		}
+	}
+
+	ttfb := make([]time.Duration, len(report.Samples))
+	total := make([]time.Duration, len(report.Samples))
+	for i, sample := range report.Samples {
+		ttfb[i] = sample.TTFB
+		total[i] = sample.Total
+	}
+	report.TTFB = latencyStats(ttfb)
+	report.Total = latencyStats(total)
+	return report, nil
+}
+
+// measureStream times one streaming generation.
+func (s *TextToSpeechService) measureStream(ctx context.Context, req *TTSRequest) (LatencySample, error) {
+	start := time.Now()
+	resp, err := s.openStream(ctx, req)
+	if err != nil {
+		return LatencySample{}, err
+	}
+	defer resp.Body.Close()
+
+	var sample LatencySample
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := resp.Body.Read(buf)
+		if n > 0 {
+			if sample.Bytes == 0 {
+				sample.TTFB = time.Since(start)
+			}
+			sample.Bytes += int64(n)
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return LatencySample{}, fmt.Errorf("failed to read response: %w", err)
+		}
+	}
+	sample.Total = time.Since(start)
+	if sample.Bytes == 0 {
+		return LatencySample{}, &APIError{StatusCode: resp.StatusCode, Message: "empty audio response"}
+	}
+	return sample, nil
+}
+
+// latencyStats summarizes durations using nearest-rank percentiles.
+func latencyStats(durations []time.Duration) LatencyStats {
+	if len(durations) == 0 {
+		return LatencyStats{}
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	percentile := func(p int) time.Duration {
+		rank := (p*len(sorted) + 99) / 100
+		return sorted[max(rank, 1)-1]
+	}
+	return LatencyStats{
+		Min:  sorted[0],
+		Mean: sum / time.Duration(len(sorted)),
+		P50:  percentile(50),
+		P90:  percentile(90),
+		P99:  percentile(99),
+		Max:  sorted[len(sorted)-1],
+	}
+}
@@ -0,0 +1,112 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMeasureLatency(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v1/text-to-speech/voice1/stream" {
+			t.Errorf("path = %s", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("output_format"); got != "mp3_22050_32" {
+			t.Errorf("output_format = %q", got)
+		}
+		if r.Header.Get("xi-api-key") != "test-key" {
+			t.Error("missing API key header")
+		}
+		var body map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("decoding body: %v", err)
+		}
+		if body["text"] != "Hello" || body["model_id"] != "eleven_flash_v2_5" {
+			t.Errorf("body = %v", body)
+		}
+
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("first"))
+		w.(http.Flusher).Flush()
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte("second"))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	var measured []int
+	report, err := client.TextToSpeech().MeasureLatency(context.Background(), &TTSRequest{
+		VoiceID:      "voice1",
+		Text:         "Hello",
+		ModelID:      "eleven_flash_v2_5",
+		OutputFormat: "mp3_22050_32",
+	}, &LatencyOptions{
+		Runs:     3,
+		OnSample: func(run int, _ LatencySample) { measured = append(measured, run) },
+	})
+	if err != nil {
+		t.Fatalf("MeasureLatency() error = %v", err)
+	}
+
+	if requests != 4 {
+		t.Errorf("requests = %d, want 3 runs after 1 warm-up", requests)
+	}
+	if len(report.Samples) != 3 || len(measured) != 3 {
+		t.Fatalf("samples = %d, callbacks = %d, want 3", len(report.Samples), len(measured))
+	}
+	for _, sample := range report.Samples {
+		if sample.Bytes != int64(len("firstsecond")) {
+			t.Errorf("Bytes = %d", sample.Bytes)
+		}
+		if sample.TTFB >= sample.Total || sample.Total < 20*time.Millisecond {
+			t.Errorf("TTFB = %v, Total = %v, want the first byte before the 20ms pause", sample.TTFB, sample.Total)
+		}
+	}
+	if report.ModelID != "eleven_flash_v2_5" || report.TTFB.Max < report.TTFB.P50 {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestMeasureLatencyAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"detail":"invalid api key"}`))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	_, err := client.TextToSpeech().MeasureLatency(context.Background(), &TTSRequest{
+		VoiceID: "voice1",
+		Text:    "Hello",
+	}, nil)
+	if !IsUnauthorizedError(err) {
+		t.Errorf("MeasureLatency() error = %v, want unauthorized", err)
+	}
+}
+
+func TestLatencyStats(t *testing.T) {
+	var durations []time.Duration
+	for i := 10; i >= 1; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+	stats := latencyStats(durations)
+	want := LatencyStats{
+		Min:  1 * time.Millisecond,
+		Mean: 5500 * time.Microsecond,
+		P50:  5 * time.Millisecond,
+		P90:  9 * time.Millisecond,
+		P99:  10 * time.Millisecond,
+		Max:  10 * time.Millisecond,
+	}
+	if stats != want {
+		t.Errorf("latencyStats() = %+v, want %+v", stats, want)
+	}
+	if got := latencyStats(nil); got != (LatencyStats{}) {
+		t.Errorf("latencyStats(nil) = %+v, want zero", got)
+	}
+}
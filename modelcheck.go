@@ -0,0 +1,120 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"unicode/utf8"
+)
+
+// CheckTTSRequest checks a TTS request against the model's capabilities,
+// returning a ValidationError for combinations the API rejects with an
+// opaque 422: a model that can't do text-to-speech, a style with a model
+// that doesn't support style, a language the model doesn't support, or
+// text over the model's per-request character limit.
+//
+// Speaker boost is not checked, since models that don't support it ignore
+// it; NormalizeTTSRequest clears it.
+func (m *Model) CheckTTSRequest(req *TTSRequest) error {
+	if !m.CanDoTextToSpeech {
+		return &ValidationError{
+			Field:   "ModelID",
+			Message: fmt.Sprintf("model %s does not support text-to-speech", m.ModelID),
+		}
+	}
+	if vs := req.VoiceSettings; vs != nil && vs.Style > 0 && !m.CanUseStyle {
+		return &ValidationError{
+			Field:   "VoiceSettings.Style",
+			Message: fmt.Sprintf("model %s does not support style, use 0", m.ModelID),
+		}
+	}
+	if req.LanguageCode != "" && len(m.Languages) > 0 && !m.SupportsLanguage(req.LanguageCode) {
+		return &ValidationError{
+			Field:   "LanguageCode",
+			Message: fmt.Sprintf("model %s does not support language %q", m.ModelID, req.LanguageCode),
+		}
+	}
+	if limit := m.MaxCharactersSubscribedUser; limit > 0 {
+		if n := utf8.RuneCountInString(req.Text); n > limit {
+			return &ValidationError{
+				Field:   "Text",
+				Message: fmt.Sprintf("%d characters exceeds the %d character limit of model %s", n, limit, m.ModelID),
+			}
+		}
+	}
+	return nil
+}
+
+// NormalizeTTSRequest returns a copy of a TTS request with the voice
+// settings the model doesn't support cleared: style is set to 0, speaker
+// boost is turned off, and neither is sent. Other fields are unchanged, so
+// the result may still fail CheckTTSRequest.
+func (m *Model) NormalizeTTSRequest(req *TTSRequest) *TTSRequest {
+	out := *req
+	if req.VoiceSettings != nil {
+		vs := *req.VoiceSettings
+		if !m.CanUseStyle {
+			vs.Style = 0
+			vs.unset(fieldStyle)
+		}
+		if !m.CanUseSpeakerBoost {
+			vs.UseSpeakerBoost = false
+			vs.unset(fieldSpeakerBoost)
+		}
+		out.VoiceSettings = &vs
+	}
+	return &out
+}
+
+// SupportsLanguage reports whether the model lists a language code.
+func (m *Model) SupportsLanguage(code string) bool {
+	for _, lang := range m.Languages {
+		if lang.LanguageID == code {
+			return true
+		}
+	}
+	return false
+}
+
+// modelCache holds the model list for WithModelChecks, fetched once.
+type modelCache struct {
+	mu     sync.Mutex
+	models map[string]*Model
+}
+
+// get returns a model by ID, fetching the model list on first use. It
+// returns nil if the model is unknown.
+func (c *modelCache) get(ctx context.Context, s *ModelsService, modelID string) (*Model, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.models == nil {
+		models, err := s.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.models = make(map[string]*Model, len(models))
+		for _, m := range models {
+			c.models[m.ModelID] = m
+		}
+	}
+	return c.models[modelID], nil
+}
+
+// checkModel checks a TTS request against its model's capabilities when
+// the client was created with WithModelChecks. Requests for models that
+// aren't in the model list, or made when the list can't be fetched, are
+// not checked and are left to the API.
+func (s *TextToSpeechService) checkModel(ctx context.Context, req *TTSRequest) error {
+	if s.client.modelCache == nil {
+		return nil
+	}
+	modelID := req.ModelID
+	if modelID == "" {
+		modelID = DefaultModelID
+	}
+	model, err := s.client.modelCache.get(ctx, s.client.models, modelID)
+	if err != nil || model == nil {
+		return nil
+	}
+	return model.CheckTTSRequest(req)
+}
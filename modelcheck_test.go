@@ -0,0 +1,109 @@
+package elevenlabs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestModelCheckTTSRequest(t *testing.T) {
+	model := &Model{
+		ModelID:                     "eleven_flash_v2_5",
+		CanDoTextToSpeech:           true,
+		Languages:                   []*Language{{LanguageID: "en"}, {LanguageID: "de"}},
+		MaxCharactersSubscribedUser: 10,
+	}
+	tests := []struct {
+		name  string
+		req   *TTSRequest
+		field string
+	}{
+		{"valid", &TTSRequest{Text: "hello", LanguageCode: "de", VoiceSettings: &VoiceSettings{UseSpeakerBoost: true}}, ""},
+		{"style", &TTSRequest{Text: "hello", VoiceSettings: &VoiceSettings{Style: 0.3}}, "VoiceSettings.Style"},
+		{"language", &TTSRequest{Text: "hello", LanguageCode: "ja"}, "LanguageCode"},
+		{"too long", &TTSRequest{Text: strings.Repeat("a", 11)}, "Text"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := model.CheckTTSRequest(tt.req)
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("CheckTTSRequest() error = %v", err)
+				}
+				return
+			}
+			ve, ok := err.(*ValidationError)
+			if !ok || ve.Field != tt.field {
+				t.Errorf("CheckTTSRequest() error = %v, want %s validation error", err, tt.field)
+			}
+		})
+	}
+
+	if err := (&Model{ModelID: "eleven_english_sts_v2"}).CheckTTSRequest(&TTSRequest{Text: "hi"}); !isValidationError(err, nil) {
+		t.Errorf("CheckTTSRequest() for speech-to-speech model = %v", err)
+	}
+}
+
+func TestModelNormalizeTTSRequest(t *testing.T) {
+	model := &Model{CanDoTextToSpeech: true}
+	req := &TTSRequest{Text: "hi", VoiceSettings: &VoiceSettings{Stability: 0.5, Style: 0.3, UseSpeakerBoost: true}}
+	out := model.NormalizeTTSRequest(req)
+	if out.VoiceSettings.Style != 0 || out.VoiceSettings.UseSpeakerBoost || out.VoiceSettings.Stability != 0.5 {
+		t.Errorf("normalized settings = %+v", out.VoiceSettings)
+	}
+	if req.VoiceSettings.Style != 0.3 {
+		t.Error("NormalizeTTSRequest() modified the request")
+	}
+	if err := model.CheckTTSRequest(out); err != nil {
+		t.Errorf("CheckTTSRequest() of normalized request = %v", err)
+	}
+}
+
+func TestWithModelChecks(t *testing.T) {
+	modelLists, ttsCalls := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/models" {
+			modelLists++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[{"model_id": "eleven_turbo_v2", "name": "Turbo", "description": "",
+				"can_be_finetuned": false, "can_do_text_to_speech": true, "can_do_voice_conversion": false,
+				"can_use_speaker_boost": false, "can_use_style": false, "concurrency_group": "standard",
+				"languages": [{"language_id": "en", "name": "English"}],
+				"max_characters_request_free_user": 2500, "max_characters_request_subscribed_user": 5000,
+				"maximum_text_length_per_request": 5000, "model_rates": {"character_cost_multiplier": 1},
+				"requires_alpha_access": false, "serves_pro_voices": false, "token_cost_factor": 1}]`))
+			return
+		}
+		ttsCalls++
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithModelChecks())
+	tts := client.TextToSpeech()
+	ctx := context.Background()
+
+	_, err := tts.Generate(ctx, &TTSRequest{
+		VoiceID: "voice", Text: "hello", ModelID: "eleven_turbo_v2",
+		VoiceSettings: &VoiceSettings{Stability: 0.5, SimilarityBoost: 0.75, Style: 0.2},
+	})
+	if !isValidationError(err, nil) {
+		t.Fatalf("Generate() with style error = %v, want validation error", err)
+	}
+	if _, err := tts.GenerateStream(ctx, &TTSRequest{VoiceID: "voice", Text: "hello", ModelID: "eleven_turbo_v2", LanguageCode: "fr"}); !isValidationError(err, nil) {
+		t.Errorf("GenerateStream() with unsupported language error = %v", err)
+	}
+	if _, err := tts.Generate(ctx, &TTSRequest{VoiceID: "voice", Text: "hello", ModelID: "eleven_turbo_v2"}); err != nil {
+		t.Errorf("Generate() error = %v", err)
+	}
+	// Unknown models are left to the API.
+	if _, err := tts.Generate(ctx, &TTSRequest{VoiceID: "voice", Text: "hello", ModelID: "eleven_future_v9"}); err != nil {
+		t.Errorf("Generate() with unknown model error = %v", err)
+	}
+	if modelLists != 1 || ttsCalls != 2 {
+		t.Errorf("model lists = %d, TTS calls = %d, want 1 and 2", modelLists, ttsCalls)
+	}
+}
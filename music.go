@@ -2,7 +2,9 @@ package elevenlabs
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"os"
 
 	ht "github.com/ogen-go/ogen/http"
 
@@ -29,6 +31,9 @@ type MusicRequest struct {
 
 	// Seed for deterministic generation (optional).
 	Seed int
+
+	// OutputFormat specifies the audio format (e.g., "mp3_44100_128").
+	OutputFormat string
 }
 
 // MusicResponse contains the music generation result.
@@ -47,6 +52,9 @@ func (s *MusicService) Generate(ctx context.Context, req *MusicRequest) (*MusicR
 	if req.Prompt == "" {
 		return nil, &ValidationError{Field: "prompt", Message: "cannot be empty"}
 	}
+	if err := s.client.checkOutputFormat(ctx, EndpointMusic, req.OutputFormat); err != nil {
+		return nil, err
+	}
 
 	body := &api.BodyComposeMusicV1MusicPost{
 		Prompt: api.NewOptNilString(req.Prompt),
@@ -62,7 +70,12 @@ func (s *MusicService) Generate(ctx context.Context, req *MusicRequest) (*MusicR
 		body.Seed = api.NewOptNilInt(req.Seed)
 	}
 
-	resp, err := s.client.apiClient.Generate(ctx, api.NewOptBodyComposeMusicV1MusicPost(*body), api.GenerateParams{})
+	params := api.GenerateParams{}
+	if req.OutputFormat != "" {
+		params.OutputFormat = api.NewOptGenerateOutputFormat(api.GenerateOutputFormat(req.OutputFormat))
+	}
+
+	resp, err := s.client.apiClient.Generate(ctx, api.NewOptBodyComposeMusicV1MusicPost(*body), params)
 	if err != nil {
 		return nil, err
 	}
@@ -85,6 +98,9 @@ func (s *MusicService) GenerateStream(ctx context.Context, req *MusicRequest) (*
 	if req.Prompt == "" {
 		return nil, &ValidationError{Field: "prompt", Message: "cannot be empty"}
 	}
+	if err := s.client.checkOutputFormat(ctx, EndpointMusic, req.OutputFormat); err != nil {
+		return nil, err
+	}
 
 	body := &api.BodyStreamComposedMusicV1MusicStreamPost{
 		Prompt: api.NewOptNilString(req.Prompt),
@@ -100,7 +116,12 @@ func (s *MusicService) GenerateStream(ctx context.Context, req *MusicRequest) (*
 		body.Seed = api.NewOptNilInt(req.Seed)
 	}
 
-	resp, err := s.client.apiClient.StreamCompose(ctx, api.NewOptBodyStreamComposedMusicV1MusicStreamPost(*body), api.StreamComposeParams{})
+	params := api.StreamComposeParams{}
+	if req.OutputFormat != "" {
+		params.OutputFormat = api.NewOptStreamComposeOutputFormat(api.StreamComposeOutputFormat(req.OutputFormat))
+	}
+
+	resp, err := s.client.apiClient.StreamCompose(ctx, api.NewOptBodyStreamComposedMusicV1MusicStreamPost(*body), params)
 	if err != nil {
 		return nil, err
 	}
@@ -138,35 +159,103 @@ func (s *MusicService) GenerateInstrumental(ctx context.Context, prompt string,
 	return resp.Audio, nil
 }
 
+// MusicBatchItem is one item of a music batch.
+type MusicBatchItem struct {
+	// ID names the output file, <OutputDir>/<ID>.<ext>. Defaults to
+	// "music_001", "music_002", ... by position.
+	ID string
+
+	// Request is the music to generate.
+	Request *MusicRequest
+}
+
+// BatchGenerate generates music for many items concurrently, writing each
+// to <OutputDir>/<ID>.<ext>. Rate limited and server errors are retried, and
+// BatchOptions.Log records each song with its song ID.
+//
+// All items are validated before any is generated. The returned error
+// reports invalid items or options; per-item failures are in the results,
+// which are in item order.
+func (s *MusicService) BatchGenerate(ctx context.Context, items []MusicBatchItem, opts *BatchOptions) ([]BatchResult, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	if opts.OutputDir == "" {
+		return nil, &ValidationError{Field: "output_dir", Message: "cannot be empty"}
+	}
+
+	jobs := make([]batchJob, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		id := item.ID
+		if id == "" {
+			id = fmt.Sprintf("music_%03d", i+1)
+		}
+		if err := checkBatchID(seen, id); err != nil {
+			return nil, err
+		}
+		req := item.Request
+		if req == nil {
+			return nil, fmt.Errorf("item %d (%s): %w", i+1, id, &ValidationError{Field: "request", Message: "cannot be nil"})
+		}
+		if req.Prompt == "" {
+			return nil, fmt.Errorf("item %d (%s): %w", i+1, id, &ValidationError{Field: "prompt", Message: "cannot be empty"})
+		}
+		record := NewMusicGenerationRecord(req)
+		jobs[i] = batchJob{
+			id:     id,
+			path:   batchOutputPath(opts.OutputDir, id, req.OutputFormat),
+			record: record,
+			generate: func(ctx context.Context) (io.Reader, error) {
+				resp, err := s.Generate(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				if resp.SongID != "" {
+					record.ResponseMeta = map[string]string{"song_id": resp.SongID}
+				}
+				return resp.Audio, nil
+			},
+		}
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0750); err != nil {
+		return nil, fmt.Errorf("create output directory: %w", err)
+	}
+	return s.client.runBatch(ctx, jobs, opts), nil
+}
+
 // CompositionPlan represents a detailed music composition plan.
 // This can be used with GenerateDetailed for fine-grained control over music generation.
+// Plans can be saved with WriteFile and loaded with PlanFromFile, or started
+// from a CompositionPlanTemplate.
 type CompositionPlan struct {
 	// PositiveGlobalStyles are styles that should be present throughout the song.
-	PositiveGlobalStyles []string
+	PositiveGlobalStyles []string `json:"positive_global_styles,omitempty"`
 
 	// NegativeGlobalStyles are styles that should NOT be present in the song.
-	NegativeGlobalStyles []string
+	NegativeGlobalStyles []string `json:"negative_global_styles,omitempty"`
 
 	// Sections defines the structure of the song with individual sections.
-	Sections []SongSection
+	Sections []SongSection `json:"sections"`
 }
 
 // SongSection represents a section of a song in a composition plan.
 type SongSection struct {
 	// SectionName is the name of the section (e.g., "intro", "verse", "chorus").
-	SectionName string
+	SectionName string `json:"section_name"`
 
 	// DurationMs is the duration in milliseconds (3000-120000).
-	DurationMs int
+	DurationMs int `json:"duration_ms"`
 
 	// Lines are the lyrics for this section (max 200 chars per line).
-	Lines []string
+	Lines []string `json:"lines,omitempty"`
 
 	// PositiveLocalStyles are styles for this specific section.
-	PositiveLocalStyles []string
+	PositiveLocalStyles []string `json:"positive_local_styles,omitempty"`
 
 	// NegativeLocalStyles are styles to avoid in this section.
-	NegativeLocalStyles []string
+	NegativeLocalStyles []string `json:"negative_local_styles,omitempty"`
 }
 
 // CompositionPlanRequest contains options for generating a composition plan.
@@ -280,6 +369,11 @@ func (s *MusicService) GenerateDetailed(ctx context.Context, req *MusicDetailedR
 	if req.Prompt != "" && req.CompositionPlan != nil {
 		return nil, &ValidationError{Field: "prompt", Message: "cannot use both prompt and composition_plan"}
 	}
+	if req.CompositionPlan != nil {
+		if err := req.CompositionPlan.Validate(); err != nil {
+			return nil, err
+		}
+	}
 
 	body := &api.BodyComposeMusicWithADetailedResponseV1MusicDetailedPost{}
 
@@ -334,6 +428,9 @@ type StemSeparationRequest struct {
 	// StemVariation specifies which stem variation to use.
 	// Options: "two_stems_v1" (vocals + music), "six_stems_v1" (vocals, drums, bass, other - default)
 	StemVariation string
+
+	// OnProgress is called as File is uploaded (optional).
+	OnProgress UploadProgressFunc
 }
 
 // SeparateStems separates a song into individual stems (vocals, instruments, etc.).
@@ -359,7 +456,7 @@ func (s *MusicService) SeparateStems(ctx context.Context, req *StemSeparationReq
 	body := &api.BodyStemSeparationV1MusicStemSeparationPostMultipart{
 		File: ht.MultipartFile{
 			Name: req.Filename,
-			File: req.File,
+			File: withUploadProgress(req.File, req.OnProgress),
 		},
 	}
 
@@ -1,8 +1,18 @@
 package elevenlabs
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
 )
 
 func TestMusicRequestValidation(t *testing.T) {
@@ -107,3 +117,65 @@ func TestGenerateInstrumentalValidation(t *testing.T) {
 		t.Error("GenerateInstrumental() with empty prompt should return error")
 	}
 }
+
+func TestMusicBatchGenerate(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	fake := clock.NewFake(start)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/music" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Prompt string `json:"prompt"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		// The song takes 1.5s to compose on the client's clock
+		fake.Advance(1500 * time.Millisecond)
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Header().Set("song-id", "song-"+body.Prompt)
+		_, _ = w.Write([]byte("audio:" + body.Prompt))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), withClock(fake))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	var valErr *ValidationError
+	if _, err := client.Music().BatchGenerate(ctx, []MusicBatchItem{{Request: &MusicRequest{}}},
+		&BatchOptions{OutputDir: dir}); !errors.As(err, &valErr) || valErr.Field != "prompt" {
+		t.Errorf("BatchGenerate() with empty prompt error = %v, want ValidationError", err)
+	}
+
+	var buf bytes.Buffer
+	results, err := client.Music().BatchGenerate(ctx, []MusicBatchItem{
+		{ID: "intro", Request: &MusicRequest{Prompt: "jazz", DurationMs: 10000}},
+	}, &BatchOptions{OutputDir: dir, Log: NewGenerationLog(&buf)})
+	if err != nil {
+		t.Fatalf("BatchGenerate() error = %v", err)
+	}
+	if results[0].Err != nil || results[0].OutputPath != filepath.Join(dir, "intro.mp3") {
+		t.Fatalf("results = %+v", results)
+	}
+	if data, _ := os.ReadFile(results[0].OutputPath); string(data) != "audio:jazz" {
+		t.Errorf("output = %q", data)
+	}
+
+	records, err := ReadGenerationRecords(&buf)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("records = %+v, %v", records, err)
+	}
+	rec := records[0]
+	if rec.Type != GenerationTypeMusic || rec.Text != "jazz" || rec.Params["duration_ms"] != float64(10000) {
+		t.Errorf("record = %+v", rec)
+	}
+	if rec.ResponseMeta["song_id"] != "song-jazz" {
+		t.Errorf("ResponseMeta = %v, want the song ID", rec.ResponseMeta)
+	}
+	if !rec.Timestamp.Equal(start) || rec.ElapsedMs != 1500 {
+		t.Errorf("Timestamp = %v, ElapsedMs = %d; want the client clock's %v and 1500", rec.Timestamp, rec.ElapsedMs, start)
+	}
+}
@@ -0,0 +1,167 @@
+package elevenlabs
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Composition plan limits.
+const (
+	maxPlanSections      = 30
+	maxPlanStyles        = 50
+	maxSectionLines      = 30
+	maxSectionLineLength = 200
+	maxSectionNameLength = 100
+	minSectionDurationMs = 3000
+	maxSectionDurationMs = 120000
+	maxCompositionPlanMs = 600000
+)
+
+// Validate checks the plan against the API limits, so invalid plans are
+// caught before a generation request.
+func (p *CompositionPlan) Validate() error {
+	if len(p.Sections) == 0 {
+		return &ValidationError{Field: "sections", Message: "at least one section is required"}
+	}
+	if len(p.Sections) > maxPlanSections {
+		return &ValidationError{Field: "sections", Message: fmt.Sprintf("at most %d sections are allowed", maxPlanSections)}
+	}
+	if len(p.PositiveGlobalStyles) > maxPlanStyles || len(p.NegativeGlobalStyles) > maxPlanStyles {
+		return &ValidationError{Field: "global_styles", Message: fmt.Sprintf("at most %d styles are allowed", maxPlanStyles)}
+	}
+	for i, s := range p.Sections {
+		field := fmt.Sprintf("sections[%d]", i)
+		if s.SectionName == "" || utf8.RuneCountInString(s.SectionName) > maxSectionNameLength {
+			return &ValidationError{Field: field + ".section_name", Message: fmt.Sprintf("must be 1 to %d characters", maxSectionNameLength)}
+		}
+		if s.DurationMs < minSectionDurationMs || s.DurationMs > maxSectionDurationMs {
+			return &ValidationError{Field: field + ".duration_ms", Message: fmt.Sprintf("must be between %d and %d", minSectionDurationMs, maxSectionDurationMs)}
+		}
+		if len(s.Lines) > maxSectionLines {
+			return &ValidationError{Field: field + ".lines", Message: fmt.Sprintf("at most %d lines are allowed", maxSectionLines)}
+		}
+		for _, line := range s.Lines {
+			if utf8.RuneCountInString(line) > maxSectionLineLength {
+				return &ValidationError{Field: field + ".lines", Message: fmt.Sprintf("lines must be at most %d characters", maxSectionLineLength)}
+			}
+		}
+		if len(s.PositiveLocalStyles) > maxPlanStyles || len(s.NegativeLocalStyles) > maxPlanStyles {
+			return &ValidationError{Field: field + ".local_styles", Message: fmt.Sprintf("at most %d styles are allowed", maxPlanStyles)}
+		}
+	}
+	if total := p.DurationMs(); total > maxCompositionPlanMs {
+		return &ValidationError{Field: "sections", Message: fmt.Sprintf("total duration %dms exceeds %dms", total, maxCompositionPlanMs)}
+	}
+	return nil
+}
+
+// DurationMs returns the total duration of the plan's sections.
+func (p *CompositionPlan) DurationMs() int {
+	total := 0
+	for _, s := range p.Sections {
+		total += s.DurationMs
+	}
+	return total
+}
+
+// PlanFromFile loads and validates a composition plan saved as JSON, in the
+// same format the API uses:
+//
+//	{
+//	  "positive_global_styles": ["synthwave", "driving"],
+//	  "sections": [
+//	    {"section_name": "Intro", "duration_ms": 8000},
+//	    {"section_name": "Chorus", "duration_ms": 20000, "lines": ["Neon lights"]}
+//	  ]
+//	}
+func PlanFromFile(path string) (*CompositionPlan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read composition plan: %w", err)
+	}
+	var plan CompositionPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("parse composition plan %s: %w", path, err)
+	}
+	if err := plan.Validate(); err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// WriteFile saves the plan as indented JSON, for loading with PlanFromFile.
+func (p *CompositionPlan) WriteFile(path string) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal composition plan: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0600); err != nil {
+		return fmt.Errorf("write composition plan: %w", err)
+	}
+	return nil
+}
+
+// planTemplates are song structure scaffolds. Sections have durations and
+// no lyrics or styles, to be filled in before generation.
+var planTemplates = map[string]func() *CompositionPlan{
+	"song": func() *CompositionPlan {
+		return &CompositionPlan{Sections: []SongSection{
+			section("Intro", 8000), section("Verse 1", 25000), section("Chorus", 20000),
+			section("Verse 2", 25000), section("Chorus", 20000), section("Bridge", 15000),
+			section("Chorus", 20000), section("Outro", 10000),
+		}}
+	},
+	"short-song": func() *CompositionPlan {
+		return &CompositionPlan{Sections: []SongSection{
+			section("Intro", 5000), section("Verse", 20000), section("Chorus", 20000), section("Outro", 5000),
+		}}
+	},
+	"jingle": func() *CompositionPlan {
+		return &CompositionPlan{Sections: []SongSection{
+			section("Hook", 10000), section("Tag", 5000),
+		}}
+	},
+	"instrumental": func() *CompositionPlan {
+		return &CompositionPlan{
+			PositiveGlobalStyles: []string{"instrumental"},
+			NegativeGlobalStyles: []string{"vocals"},
+			Sections: []SongSection{
+				section("Intro", 10000), section("Theme", 40000), section("Variation", 40000), section("Outro", 10000),
+			},
+		}
+	},
+}
+
+func section(name string, durationMs int) SongSection {
+	return SongSection{SectionName: name, DurationMs: durationMs}
+}
+
+// CompositionPlanTemplate returns a copy of a named song structure scaffold
+// (see CompositionPlanTemplateNames), to fill in with lyrics and styles:
+//
+//	plan, _ := elevenlabs.CompositionPlanTemplate("short-song")
+//	plan.PositiveGlobalStyles = []string{"indie pop", "upbeat"}
+//	plan.Sections[2].Lines = []string{"Chorus lyrics here"}
+//
+// Names are case-insensitive.
+func CompositionPlanTemplate(name string) (*CompositionPlan, bool) {
+	fn, ok := planTemplates[strings.ToLower(name)]
+	if !ok {
+		return nil, false
+	}
+	return fn(), true
+}
+
+// CompositionPlanTemplateNames returns the template names, sorted.
+func CompositionPlanTemplateNames() []string {
+	names := make([]string, 0, len(planTemplates))
+	for name := range planTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
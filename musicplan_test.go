@@ -0,0 +1,103 @@
+package elevenlabs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompositionPlanValidate(t *testing.T) {
+	valid := func() *CompositionPlan {
+		return &CompositionPlan{Sections: []SongSection{{SectionName: "Intro", DurationMs: 5000}}}
+	}
+	tests := []struct {
+		name   string
+		modify func(*CompositionPlan)
+	}{
+		{"no sections", func(p *CompositionPlan) { p.Sections = nil }},
+		{"empty section name", func(p *CompositionPlan) { p.Sections[0].SectionName = "" }},
+		{"section too short", func(p *CompositionPlan) { p.Sections[0].DurationMs = 1000 }},
+		{"line too long", func(p *CompositionPlan) { p.Sections[0].Lines = []string{strings.Repeat("a", 201)} }},
+		{"plan too long", func(p *CompositionPlan) {
+			for i := 0; i < 5; i++ {
+				p.Sections = append(p.Sections, SongSection{SectionName: "Verse", DurationMs: 120000})
+			}
+		}},
+	}
+
+	if err := valid().Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := valid()
+			tt.modify(plan)
+			if err := plan.Validate(); !isValidationError(err, nil) {
+				t.Errorf("Validate() error = %v, want ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestCompositionPlanFile(t *testing.T) {
+	plan, ok := CompositionPlanTemplate("Short-Song")
+	if !ok {
+		t.Fatal("CompositionPlanTemplate(short-song) not found")
+	}
+	plan.PositiveGlobalStyles = []string{"indie pop"}
+	plan.Sections[2].Lines = []string{"Chorus lyrics"}
+
+	path := filepath.Join(t.TempDir(), "plan.json")
+	if err := plan.WriteFile(path); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), `"section_name": "Chorus"`) {
+		t.Errorf("unexpected plan file:\n%s", data)
+	}
+
+	loaded, err := PlanFromFile(path)
+	if err != nil {
+		t.Fatalf("PlanFromFile() error = %v", err)
+	}
+	if loaded.DurationMs() != 50000 || loaded.Sections[2].Lines[0] != "Chorus lyrics" || loaded.PositiveGlobalStyles[0] != "indie pop" {
+		t.Errorf("PlanFromFile() = %+v", loaded)
+	}
+
+	// Templates are copies
+	again, _ := CompositionPlanTemplate("short-song")
+	if len(again.Sections[2].Lines) != 0 {
+		t.Error("CompositionPlanTemplate() returned a shared plan")
+	}
+
+	if err := os.WriteFile(path, []byte(`{"sections": []}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := PlanFromFile(path); !isValidationError(err, nil) {
+		t.Errorf("PlanFromFile() with empty plan error = %v, want ValidationError", err)
+	}
+}
+
+func TestCompositionPlanTemplatesValid(t *testing.T) {
+	for _, name := range CompositionPlanTemplateNames() {
+		plan, _ := CompositionPlanTemplate(name)
+		if err := plan.Validate(); err != nil {
+			t.Errorf("template %s: %v", name, err)
+		}
+	}
+}
+
+func TestGenerateDetailedValidatesPlan(t *testing.T) {
+	client, err := NewClient(WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	_, err = client.Music().GenerateDetailed(context.Background(), &MusicDetailedRequest{
+		CompositionPlan: &CompositionPlan{Sections: []SongSection{{SectionName: "Intro", DurationMs: 100}}},
+	})
+	if !isValidationError(err, nil) {
+		t.Errorf("GenerateDetailed() error = %v, want ValidationError", err)
+	}
+}
@@ -0,0 +1,300 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// OutputFormatEndpoint is an endpoint family that takes an output_format.
+type OutputFormatEndpoint string
+
+// Endpoints accepting an output format.
+const (
+	EndpointTextToSpeech   OutputFormatEndpoint = "text_to_speech"
+	EndpointTextToDialogue OutputFormatEndpoint = "text_to_dialogue"
+	EndpointSpeechToSpeech OutputFormatEndpoint = "speech_to_speech"
+	EndpointSoundEffects   OutputFormatEndpoint = "sound_effects"
+	EndpointMusic          OutputFormatEndpoint = "music"
+	EndpointVoiceDesign    OutputFormatEndpoint = "voice_design"
+)
+
+// endpointOutputFormats lists the formats each endpoint accepts, from the
+// output_format enum of its operation in the API schema.
+var endpointOutputFormats = map[OutputFormatEndpoint]map[string]bool{
+	EndpointTextToSpeech:   formatSet(api.TextToSpeechFullOutputFormat("").AllValues()),
+	EndpointTextToDialogue: formatSet(api.TextToDialogueOutputFormat("").AllValues()),
+	EndpointSpeechToSpeech: formatSet(api.SpeechToSpeechFullOutputFormat("").AllValues()),
+	EndpointSoundEffects:   formatSet(api.SoundGenerationOutputFormat("").AllValues()),
+	EndpointMusic:          formatSet(api.GenerateOutputFormat("").AllValues()),
+	EndpointVoiceDesign:    formatSet(api.TextToVoiceDesignOutputFormat("").AllValues()),
+}
+
+// formatSet converts a generated output_format enum to a set.
+func formatSet[T ~string](values []T) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[string(v)] = true
+	}
+	return set
+}
+
+// tierRanks orders subscription tiers for format restrictions.
+var tierRanks = map[string]int{
+	"free":             0,
+	"starter":          1,
+	"creator":          2,
+	"pro":              3,
+	"scale":            4,
+	"business":         5,
+	"growing_business": 5,
+	"enterprise":       6,
+}
+
+// OutputFormat is a parsed output format such as "mp3_44100_128".
+type OutputFormat struct {
+	// Codec is "mp3", "pcm", "opus", "ulaw", or "alaw".
+	Codec string
+
+	// SampleRate is the sample rate in Hz.
+	SampleRate int
+
+	// Bitrate is the bitrate in kbps, or 0 for uncompressed codecs.
+	Bitrate int
+}
+
+// ParseOutputFormat parses a codec_samplerate[_bitrate] format string.
+func ParseOutputFormat(format string) (OutputFormat, error) {
+	parts := strings.Split(format, "_")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" {
+		return OutputFormat{}, fmt.Errorf("invalid output format %q", format)
+	}
+	f := OutputFormat{Codec: parts[0]}
+	var err error
+	if f.SampleRate, err = strconv.Atoi(parts[1]); err != nil {
+		return OutputFormat{}, fmt.Errorf("invalid sample rate in output format %q", format)
+	}
+	if len(parts) == 3 {
+		if f.Bitrate, err = strconv.Atoi(parts[2]); err != nil {
+			return OutputFormat{}, fmt.Errorf("invalid bitrate in output format %q", format)
+		}
+	}
+	return f, nil
+}
+
+// String returns the format string, such as "mp3_44100_128".
+func (f OutputFormat) String() string {
+	if f.Bitrate > 0 {
+		return fmt.Sprintf("%s_%d_%d", f.Codec, f.SampleRate, f.Bitrate)
+	}
+	return fmt.Sprintf("%s_%d", f.Codec, f.SampleRate)
+}
+
+// MinimumTier returns the lowest subscription tier allowed to request
+// the format, or "" if every tier is. MP3 at 192kbps requires Creator and
+// PCM at 44.1kHz or more requires Pro.
+func (f OutputFormat) MinimumTier() string {
+	switch {
+	case f.Codec == "mp3" && f.Bitrate >= 192:
+		return "creator"
+	case f.Codec == "pcm" && f.SampleRate >= 44100:
+		return "pro"
+	}
+	return ""
+}
+
+// allowedForTier reports whether tier may request the format. Empty and
+// unrecognized tiers are not restricted; the API still enforces them.
+func (f OutputFormat) allowedForTier(tier string) bool {
+	minTier := f.MinimumTier()
+	if minTier == "" {
+		return true
+	}
+	rank, ok := tierRanks[strings.ToLower(tier)]
+	if !ok {
+		return true
+	}
+	return rank >= tierRanks[minTier]
+}
+
+// AllowedOutputFormats returns the formats an endpoint accepts for a
+// subscription tier, sorted. An empty tier is not restricted.
+func AllowedOutputFormats(endpoint OutputFormatEndpoint, tier string) ([]string, error) {
+	formats, ok := endpointOutputFormats[endpoint]
+	if !ok {
+		return nil, &ValidationError{Field: "endpoint", Message: fmt.Sprintf("unknown endpoint %q", endpoint)}
+	}
+	allowed := make([]string, 0, len(formats))
+	for format := range formats {
+		f, err := ParseOutputFormat(format)
+		if err == nil && f.allowedForTier(tier) {
+			allowed = append(allowed, format)
+		}
+	}
+	sort.Strings(allowed)
+	return allowed, nil
+}
+
+// ValidateOutputFormat checks that an endpoint accepts a format for a
+// subscription tier. It returns an *OutputFormatError listing the allowed
+// formats if not.
+func ValidateOutputFormat(endpoint OutputFormatEndpoint, tier, format string) error {
+	allowed, err := AllowedOutputFormats(endpoint, tier)
+	if err != nil {
+		return err
+	}
+	for _, a := range allowed {
+		if a == format {
+			return nil
+		}
+	}
+	return &OutputFormatError{Endpoint: endpoint, Tier: tier, Format: format, Allowed: allowed}
+}
+
+// OutputFormatPreference describes the desired output format.
+type OutputFormatPreference struct {
+	// Codec is the desired codec: "mp3", "pcm", "opus", "ulaw", or "alaw".
+	// Defaults to "mp3".
+	Codec string
+
+	// SampleRate is the desired sample rate in Hz. The closest rate not
+	// above it is chosen, or the lowest above it if there is none.
+	// Zero means the highest available.
+	SampleRate int
+
+	// Bitrate is the desired bitrate in kbps, chosen like SampleRate.
+	// Zero means the highest available.
+	Bitrate int
+}
+
+// NegotiateOutputFormat picks the best format an endpoint accepts for a
+// subscription tier, so a preference such as 44.1kHz PCM falls back to
+// the highest rate the tier allows instead of failing at request time.
+// It returns an *OutputFormatError if no allowed format has the codec.
+//
+// Example:
+//
+//	format, err := elevenlabs.NegotiateOutputFormat(elevenlabs.EndpointTextToSpeech, sub.Tier,
+//	    elevenlabs.OutputFormatPreference{Codec: "pcm", SampleRate: 44100})
+//	// "pcm_44100" on Pro and above, "pcm_32000" below
+func NegotiateOutputFormat(endpoint OutputFormatEndpoint, tier string, pref OutputFormatPreference) (string, error) {
+	allowed, err := AllowedOutputFormats(endpoint, tier)
+	if err != nil {
+		return "", err
+	}
+	codec := pref.Codec
+	if codec == "" {
+		codec = "mp3"
+	}
+
+	var best *OutputFormat
+	for _, format := range allowed {
+		f, err := ParseOutputFormat(format)
+		if err != nil || f.Codec != codec {
+			continue
+		}
+		if best == nil || betterOutputFormat(f, *best, pref) {
+			best = &f
+		}
+	}
+	if best == nil {
+		return "", &OutputFormatError{Endpoint: endpoint, Tier: tier, Format: codec, Allowed: allowed}
+	}
+	return best.String(), nil
+}
+
+// NegotiateOutputFormat is like the package-level NegotiateOutputFormat,
+// using the account's subscription tier. Keys not allowed to read the
+// subscription are negotiated without tier restrictions.
+func (c *Client) NegotiateOutputFormat(ctx context.Context, endpoint OutputFormatEndpoint, pref OutputFormatPreference) (string, error) {
+	tier, err := c.subscriptionTier(ctx)
+	if err != nil {
+		return "", err
+	}
+	return NegotiateOutputFormat(endpoint, tier, pref)
+}
+
+// subscriptionTier returns the account's subscription tier, or "" for
+// keys not allowed to read the subscription.
+func (c *Client) subscriptionTier(ctx context.Context) (string, error) {
+	sub, err := c.User().GetSubscription(ctx)
+	switch apiErr := ParseAPIError(err); {
+	case err == nil:
+		if sub != nil {
+			return sub.Tier, nil
+		}
+		return "", nil
+	case apiErr != nil && apiErr.StatusCode == 403:
+		// Scoped keys can't read the subscription; leave the tier unknown
+		return "", nil
+	default:
+		return "", fmt.Errorf("getting subscription: %w", err)
+	}
+}
+
+// tierCache holds the subscription tier for WithOutputFormatChecks,
+// fetched once.
+type tierCache struct {
+	mu      sync.Mutex
+	fetched bool
+	tier    string
+}
+
+// get returns the subscription tier, fetching it on first use.
+func (t *tierCache) get(ctx context.Context, c *Client) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.fetched {
+		tier, err := c.subscriptionTier(ctx)
+		if err != nil {
+			return "", err
+		}
+		t.tier, t.fetched = tier, true
+	}
+	return t.tier, nil
+}
+
+// checkOutputFormat checks a request's output format against the formats
+// the endpoint accepts for the account's tier when the client was created
+// with WithOutputFormatChecks. Requests without a format use the
+// endpoint's default and are not checked. If the tier can't be fetched,
+// the format is checked without tier restrictions.
+func (c *Client) checkOutputFormat(ctx context.Context, endpoint OutputFormatEndpoint, format string) error {
+	if c.tierCache == nil || format == "" {
+		return nil
+	}
+	tier, err := c.tierCache.get(ctx, c)
+	if err != nil {
+		tier = ""
+	}
+	return ValidateOutputFormat(endpoint, tier, format)
+}
+
+// betterOutputFormat reports whether a matches the preference better than
+// b, comparing sample rate and then bitrate.
+func betterOutputFormat(a, b OutputFormat, pref OutputFormatPreference) bool {
+	if sa, sb := preferenceScore(a.SampleRate, pref.SampleRate), preferenceScore(b.SampleRate, pref.SampleRate); sa != sb {
+		return sa < sb
+	}
+	return preferenceScore(a.Bitrate, pref.Bitrate) < preferenceScore(b.Bitrate, pref.Bitrate)
+}
+
+// preferenceScore ranks a value against a wanted value, lower is better:
+// values at or below want by closeness, then values above it. A zero want
+// ranks the highest value best.
+func preferenceScore(value, want int) int {
+	const above = 1 << 30
+	switch {
+	case want == 0:
+		return -value
+	case value <= want:
+		return want - value
+	default:
+		return above + value - want
+	}
+}
@@ -0,0 +1,152 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	f, err := ParseOutputFormat("mp3_44100_128")
+	if err != nil || f != (OutputFormat{Codec: "mp3", SampleRate: 44100, Bitrate: 128}) {
+		t.Errorf("ParseOutputFormat() = %+v, %v", f, err)
+	}
+	if f.String() != "mp3_44100_128" {
+		t.Errorf("String() = %q", f.String())
+	}
+	if f, _ := ParseOutputFormat("pcm_16000"); f.String() != "pcm_16000" || f.Bitrate != 0 {
+		t.Errorf("ParseOutputFormat(pcm_16000) = %+v", f)
+	}
+	for _, bad := range []string{"", "mp3", "mp3_x", "mp3_44100_x", "a_1_2_3"} {
+		if _, err := ParseOutputFormat(bad); err == nil {
+			t.Errorf("ParseOutputFormat(%q) expected error", bad)
+		}
+	}
+}
+
+func TestValidateOutputFormat(t *testing.T) {
+	tests := []struct {
+		tier    string
+		format  string
+		wantErr bool
+	}{
+		{"free", "mp3_44100_128", false},
+		{"free", "mp3_44100_192", true},
+		{"creator", "mp3_44100_192", false},
+		{"creator", "pcm_44100", true},
+		{"creator", "pcm_48000", true},
+		{"pro", "pcm_44100", false},
+		{"", "pcm_48000", false},
+		{"pro", "flac_44100", true},
+	}
+	for _, tt := range tests {
+		err := ValidateOutputFormat(EndpointTextToSpeech, tt.tier, tt.format)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateOutputFormat(%q, %q) = %v, wantErr %v", tt.tier, tt.format, err, tt.wantErr)
+			continue
+		}
+		var formatErr *OutputFormatError
+		if tt.wantErr && (!errors.Is(err, ErrUnsupportedOutputFormat) || !errors.As(err, &formatErr) || len(formatErr.Allowed) == 0) {
+			t.Errorf("ValidateOutputFormat(%q, %q) = %v, want OutputFormatError", tt.tier, tt.format, err)
+		}
+	}
+
+	if err := ValidateOutputFormat("nope", "", "mp3_44100_128"); !isValidationError(err, nil) {
+		t.Errorf("ValidateOutputFormat(unknown endpoint) = %v", err)
+	}
+}
+
+func TestNegotiateOutputFormat(t *testing.T) {
+	tests := []struct {
+		tier string
+		pref OutputFormatPreference
+		want string
+	}{
+		{"free", OutputFormatPreference{}, "mp3_44100_128"},
+		{"creator", OutputFormatPreference{}, "mp3_44100_192"},
+		{"creator", OutputFormatPreference{Codec: "pcm", SampleRate: 44100}, "pcm_32000"},
+		{"pro", OutputFormatPreference{Codec: "pcm", SampleRate: 44100}, "pcm_44100"},
+		{"free", OutputFormatPreference{Codec: "pcm", SampleRate: 16000}, "pcm_16000"},
+		{"free", OutputFormatPreference{Codec: "mp3", SampleRate: 24000, Bitrate: 64}, "mp3_24000_48"},
+		{"free", OutputFormatPreference{Codec: "opus", SampleRate: 16000, Bitrate: 64}, "opus_48000_64"},
+		{"free", OutputFormatPreference{Codec: "ulaw"}, "ulaw_8000"},
+	}
+	for _, tt := range tests {
+		got, err := NegotiateOutputFormat(EndpointMusic, tt.tier, tt.pref)
+		if err != nil || got != tt.want {
+			t.Errorf("NegotiateOutputFormat(%q, %+v) = %q, %v, want %q", tt.tier, tt.pref, got, err, tt.want)
+		}
+	}
+
+	if _, err := NegotiateOutputFormat(EndpointMusic, "pro", OutputFormatPreference{Codec: "flac"}); !errors.Is(err, ErrUnsupportedOutputFormat) {
+		t.Errorf("NegotiateOutputFormat(flac) = %v", err)
+	}
+}
+
+func TestClientNegotiateOutputFormat(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(pingUserJSON))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	got, err := client.NegotiateOutputFormat(context.Background(), EndpointTextToSpeech, OutputFormatPreference{Codec: "pcm", SampleRate: 48000})
+	if err != nil || got != "pcm_32000" {
+		t.Errorf("NegotiateOutputFormat() = %q, %v, want pcm_32000", got, err)
+	}
+}
+
+func TestWithOutputFormatChecks(t *testing.T) {
+	userCalls := 0
+	var formats []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/user" {
+			userCalls++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(pingUserJSON))
+			return
+		}
+		formats = append(formats, r.URL.Path+"?"+r.URL.Query().Get("output_format"))
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithOutputFormatChecks())
+	ctx := context.Background()
+
+	// pcm_44100 requires Pro; the account is on Creator
+	var formatErr *OutputFormatError
+	_, err := client.TextToSpeech().Generate(ctx, &TTSRequest{VoiceID: "v", Text: "hi", OutputFormat: "pcm_44100"})
+	if !errors.As(err, &formatErr) || formatErr.Endpoint != EndpointTextToSpeech || formatErr.Tier != "creator" {
+		t.Errorf("TTS Generate() error = %v, want OutputFormatError for creator", err)
+	}
+	if _, err := client.TextToSpeech().GenerateStream(ctx, &TTSRequest{VoiceID: "v", Text: "hi", OutputFormat: "pcm_48000"}); !errors.Is(err, ErrUnsupportedOutputFormat) {
+		t.Errorf("TTS GenerateStream() error = %v, want ErrUnsupportedOutputFormat", err)
+	}
+	if _, err := client.SoundEffects().Generate(ctx, &SoundEffectRequest{Text: "rain", OutputFormat: "pcm_48000"}); !errors.Is(err, ErrUnsupportedOutputFormat) {
+		t.Errorf("SoundEffects Generate() error = %v, want ErrUnsupportedOutputFormat", err)
+	}
+	if _, err := client.Music().Generate(ctx, &MusicRequest{Prompt: "jazz", OutputFormat: "pcm_44100"}); !errors.Is(err, ErrUnsupportedOutputFormat) {
+		t.Errorf("Music Generate() error = %v, want ErrUnsupportedOutputFormat", err)
+	}
+
+	// Allowed formats and requests without one are sent
+	if _, err := client.Music().Generate(ctx, &MusicRequest{Prompt: "jazz", OutputFormat: "mp3_44100_192"}); err != nil {
+		t.Errorf("Music Generate() error = %v", err)
+	}
+	if _, err := client.SoundEffects().Generate(ctx, &SoundEffectRequest{Text: "rain"}); err != nil {
+		t.Errorf("SoundEffects Generate() error = %v", err)
+	}
+	want := "/v1/music?mp3_44100_192,/v1/sound-generation?"
+	if got := strings.Join(formats, ","); got != want {
+		t.Errorf("requests = %s, want %s", got, want)
+	}
+	if userCalls != 1 {
+		t.Errorf("user calls = %d, want the tier fetched once", userCalls)
+	}
+}
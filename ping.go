@@ -0,0 +1,120 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Product is an ElevenLabs product whose availability Ping can check.
+type Product string
+
+// Products checked by Ping.
+const (
+	// ProductConvAI is Conversational AI agents.
+	ProductConvAI Product = "convai"
+
+	// ProductDubbing is dubbing.
+	ProductDubbing Product = "dubbing"
+
+	// ProductStudio is Studio projects.
+	ProductStudio Product = "studio"
+
+	// ProductMusic is music generation. The music API has no read-only
+	// endpoint, so its availability is inferred from a paid subscription.
+	ProductMusic Product = "music"
+)
+
+// productProbes are read-only requests that succeed when a product is
+// enabled for the API key.
+var productProbes = map[Product]string{
+	ProductConvAI:  "/v1/convai/agents?page_size=1",
+	ProductDubbing: "/v1/dubbing?page_size=1",
+	ProductStudio:  "/v1/studio/projects",
+}
+
+// PingResult is the outcome of Client.Ping.
+type PingResult struct {
+	// UserID is the account the API key belongs to. Empty if the key is
+	// not allowed to read user information.
+	UserID string
+
+	// Subscription is the account's tier and limits, or nil if the key is
+	// not allowed to read user information.
+	Subscription *Subscription
+
+	// Products reports whether each checked product is available to the
+	// API key.
+	Products map[Product]bool
+}
+
+// Require returns an error naming the products that are not available,
+// or nil if all are.
+func (r *PingResult) Require(products ...Product) error {
+	var missing []string
+	for _, p := range products {
+		if !r.Products[p] {
+			missing = append(missing, string(p))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return fmt.Errorf("%w: %s", ErrProductUnavailable, strings.Join(missing, ", "))
+}
+
+// Ping verifies the API key and returns the account's subscription, so
+// services can fail fast at startup with a clear error instead of at the
+// first request. Each product given is checked with a read-only request.
+// A rejected key returns an error matching ErrInvalidAPIKey.
+//
+// Example:
+//
+//	result, err := client.Ping(ctx, elevenlabs.ProductConvAI)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if err := result.Require(elevenlabs.ProductConvAI); err != nil {
+//	    log.Fatal(err)
+//	}
+func (c *Client) Ping(ctx context.Context, products ...Product) (*PingResult, error) {
+	result := &PingResult{Products: make(map[Product]bool)}
+
+	user, err := c.User().GetInfo(ctx)
+	switch apiErr := ParseAPIError(err); {
+	case err == nil:
+		result.UserID = user.UserID
+		result.Subscription = user.Subscription
+	case apiErr != nil && apiErr.StatusCode == 401:
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAPIKey, err)
+	case apiErr != nil && apiErr.StatusCode == 403:
+		// Keys scoped without user access are valid but can't read it
+	default:
+		return nil, fmt.Errorf("checking API key: %w", err)
+	}
+
+	for _, p := range products {
+		if p == ProductMusic {
+			result.Products[p] = result.Subscription != nil && result.Subscription.Tier != "free"
+			continue
+		}
+		path, ok := productProbes[p]
+		if !ok {
+			return nil, &ValidationError{Field: "products", Message: fmt.Sprintf("unknown product %q", p)}
+		}
+		var discard json.RawMessage
+		err := c.getJSON(ctx, path, &discard)
+		switch apiErr := ParseAPIError(err); {
+		case err == nil:
+			result.Products[p] = true
+		case apiErr != nil && (apiErr.StatusCode == 401 || apiErr.StatusCode == 402 || apiErr.StatusCode == 403):
+			result.Products[p] = false
+		default:
+			return nil, fmt.Errorf("checking %s: %w", p, err)
+		}
+	}
+	return result, nil
+}
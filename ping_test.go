@@ -0,0 +1,108 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const pingUserJSON = `{
+	"user_id": "user1",
+	"created_at": 1700000000,
+	"can_use_delayed_payment_methods": false,
+	"is_new_user": false,
+	"is_onboarding_completed": true,
+	"is_onboarding_checklist_completed": true,
+	"subscription": {
+		"tier": "creator",
+		"status": "active",
+		"character_count": 1000,
+		"character_limit": 100000,
+		"max_character_limit_extension": null,
+		"allowed_to_extend_character_limit": false,
+		"can_extend_character_limit": false,
+		"can_extend_voice_limit": false,
+		"can_use_instant_voice_cloning": true,
+		"can_use_professional_voice_cloning": true,
+		"professional_voice_limit": 1,
+		"professional_voice_slots_used": 0,
+		"voice_add_edit_counter": 0,
+		"voice_limit": 30,
+		"voice_slots_used": 2
+	}
+}`
+
+func newPingServer(t *testing.T, userStatus int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/user":
+			if userStatus != http.StatusOK {
+				w.WriteHeader(userStatus)
+				_, _ = w.Write([]byte(`{"detail": {"status": "invalid_api_key"}}`))
+				return
+			}
+			_, _ = w.Write([]byte(pingUserJSON))
+		case "/v1/convai/agents":
+			_, _ = w.Write([]byte(`{"agents": [], "has_more": false}`))
+		case "/v1/dubbing":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"detail": "missing permission"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestClientPing(t *testing.T) {
+	server := newPingServer(t, http.StatusOK)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	result, err := client.Ping(context.Background(), ProductConvAI, ProductDubbing, ProductMusic)
+	if err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if result.UserID != "user1" || result.Subscription == nil || result.Subscription.Tier != "creator" {
+		t.Errorf("result = %+v", result)
+	}
+	if !result.Products[ProductConvAI] || result.Products[ProductDubbing] || !result.Products[ProductMusic] {
+		t.Errorf("products = %v", result.Products)
+	}
+	if err := result.Require(ProductConvAI); err != nil {
+		t.Errorf("Require(convai) error = %v", err)
+	}
+	if err := result.Require(ProductConvAI, ProductDubbing); !errors.Is(err, ErrProductUnavailable) {
+		t.Errorf("Require(dubbing) = %v, want ErrProductUnavailable", err)
+	}
+
+	if _, err := client.Ping(context.Background(), Product("nope")); !isValidationError(err, nil) {
+		t.Errorf("Ping(unknown product) = %v", err)
+	}
+}
+
+func TestClientPingInvalidKey(t *testing.T) {
+	server := newPingServer(t, http.StatusUnauthorized)
+	client, _ := NewClient(WithAPIKey("bad-key"), WithBaseURL(server.URL))
+
+	if _, err := client.Ping(context.Background()); !errors.Is(err, ErrInvalidAPIKey) {
+		t.Errorf("Ping() error = %v, want ErrInvalidAPIKey", err)
+	}
+}
+
+func TestClientPingScopedKey(t *testing.T) {
+	server := newPingServer(t, http.StatusForbidden)
+	client, _ := NewClient(WithAPIKey("scoped-key"), WithBaseURL(server.URL))
+
+	result, err := client.Ping(context.Background(), ProductConvAI)
+	if err != nil {
+		t.Fatalf("Ping() error = %v", err)
+	}
+	if result.Subscription != nil || !result.Products[ProductConvAI] {
+		t.Errorf("result = %+v", result)
+	}
+}
@@ -1,8 +1,11 @@
 package elevenlabs
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
+	"net/url"
 	"time"
 
 	"github.com/agentplexus/go-elevenlabs/internal/api"
@@ -16,6 +19,37 @@ type ProjectsService struct {
 	client *Client
 }
 
+// QualityPreset is the output quality of a project.
+type QualityPreset string
+
+// Project quality presets. Higher presets cost more credits.
+const (
+	QualityStandard      QualityPreset = "standard"
+	QualityHigh          QualityPreset = "high"
+	QualityHighest       QualityPreset = "highest"
+	QualityUltra         QualityPreset = "ultra"
+	QualityUltraLossless QualityPreset = "ultra_lossless"
+)
+
+// ProjectContentType is the kind of content in a project. The API accepts
+// other values; these are the common ones.
+type ProjectContentType string
+
+// Project content types.
+const (
+	ContentTypeNovel      ProjectContentType = "Novel"
+	ContentTypeShortStory ProjectContentType = "Short Story"
+)
+
+// ChapterState is the conversion state of a chapter.
+type ChapterState string
+
+// Chapter states.
+const (
+	ChapterStateDefault    ChapterState = "default"
+	ChapterStateConverting ChapterState = "converting"
+)
+
 // Project represents a Studio project.
 type Project struct {
 	// ProjectID is the unique identifier.
@@ -42,8 +76,8 @@ type Project struct {
 	// DefaultTitleVoiceID is the default voice for titles.
 	DefaultTitleVoiceID string
 
-	// ContentType is the content type (e.g., "Novel", "Short Story").
-	ContentType string
+	// ContentType is the content type (e.g., ContentTypeNovel).
+	ContentType ProjectContentType
 
 	// CoverImageURL is the cover image URL.
 	CoverImageURL string
@@ -54,8 +88,22 @@ type Project struct {
 	// CanBeDownloaded indicates if the project can be downloaded.
 	CanBeDownloaded bool
 
-	// AccessLevel is the access level of the project.
-	AccessLevel string
+	// AccessLevel is the caller's access level on the project.
+	AccessLevel WorkspaceRole
+
+	// The following fields are only populated by Get.
+
+	// Title is the title added to downloaded audio metadata.
+	Title string
+
+	// ISBNNumber is the ISBN added to downloaded audio metadata.
+	ISBNNumber string
+
+	// QualityPreset is the output quality.
+	QualityPreset QualityPreset
+
+	// VolumeNormalization normalizes downloads to audiobook volume requirements.
+	VolumeNormalization bool
 }
 
 // Chapter represents a chapter within a project.
@@ -70,7 +118,7 @@ type Chapter struct {
 	ConversionProgress float64
 
 	// State is the current state.
-	State string
+	State ChapterState
 
 	// LastConversionError is the last conversion error if any.
 	LastConversionError string
@@ -135,14 +183,18 @@ type CreateProjectRequest struct {
 	// FromURL is a URL to extract content from.
 	FromURL string
 
-	// ContentType is the content type (e.g., "Novel", "Short Story").
-	ContentType string
+	// FromContentJSON is structured chapter content in the Studio
+	// "from_content_json" format (see ttsscript.StudioContentJSON).
+	FromContentJSON string
+
+	// ContentType is the content type (e.g., ContentTypeNovel).
+	ContentType ProjectContentType
 
 	// Genres is a list of genres.
 	Genres []string
 
-	// QualityPreset is the output quality: "standard", "high", "ultra", "ultra lossless".
-	QualityPreset string
+	// QualityPreset is the output quality (e.g., QualityHigh).
+	QualityPreset QualityPreset
 
 	// AutoConvert automatically converts the project to audio.
 	AutoConvert bool
@@ -225,14 +277,17 @@ func (s *ProjectsService) Create(ctx context.Context, req *CreateProjectRequest)
 	if req.FromURL != "" {
 		body.FromURL = api.NewOptNilString(req.FromURL)
 	}
+	if req.FromContentJSON != "" {
+		body.FromContentJSON = api.NewOptString(req.FromContentJSON)
+	}
 	if req.ContentType != "" {
-		body.ContentType = api.NewOptNilString(req.ContentType)
+		body.ContentType = api.NewOptNilString(string(req.ContentType))
 	}
 	if len(req.Genres) > 0 {
 		body.Genres = req.Genres
 	}
 	if req.QualityPreset != "" {
-		body.QualityPreset = api.NewOptString(req.QualityPreset)
+		body.QualityPreset = api.NewOptString(string(req.QualityPreset))
 	}
 	if req.AutoConvert {
 		body.AutoConvert = api.NewOptBool(true)
@@ -252,7 +307,9 @@ func (s *ProjectsService) Create(ctx context.Context, req *CreateProjectRequest)
 }
 
 // Update updates a project.
-// Note: Name, DefaultParagraphVoiceID, and DefaultTitleVoiceID are required fields.
+// Note: Name, DefaultParagraphVoiceID, and DefaultTitleVoiceID are required
+// fields, and optional fields left empty are cleared. Use UpdateMetadata to
+// change individual fields.
 func (s *ProjectsService) Update(ctx context.Context, projectID string, req *UpdateProjectRequest) error {
 	if projectID == "" {
 		return &ValidationError{Field: "project_id", Message: "cannot be empty"}
@@ -286,6 +343,135 @@ func (s *ProjectsService) Update(ctx context.Context, projectID string, req *Upd
 	return err
 }
 
+// projectDetails is the wire format of GET /v1/studio/projects/{project_id},
+// which is not covered by the generated client.
+type projectDetails struct {
+	ProjectID               string `json:"project_id"`
+	Name                    string `json:"name"`
+	Description             string `json:"description"`
+	Author                  string `json:"author"`
+	Title                   string `json:"title"`
+	ISBNNumber              string `json:"isbn_number"`
+	Language                string `json:"language"`
+	DefaultModelID          string `json:"default_model_id"`
+	DefaultParagraphVoiceID string `json:"default_paragraph_voice_id"`
+	DefaultTitleVoiceID     string `json:"default_title_voice_id"`
+	ContentType             string `json:"content_type"`
+	CoverImageURL           string `json:"cover_image_url"`
+	CreateDateUnix          int64  `json:"create_date_unix"`
+	CanBeDownloaded         bool   `json:"can_be_downloaded"`
+	AccessLevel             string `json:"access_level"`
+	QualityPreset           string `json:"quality_preset"`
+	VolumeNormalization     bool   `json:"volume_normalization"`
+}
+
+// Get returns a project with its full metadata.
+func (s *ProjectsService) Get(ctx context.Context, projectID string) (*Project, error) {
+	if projectID == "" {
+		return nil, &ValidationError{Field: "project_id", Message: "cannot be empty"}
+	}
+
+	var d projectDetails
+	if err := s.client.getJSON(ctx, "/v1/studio/projects/"+url.PathEscape(projectID), &d); err != nil {
+		return nil, err
+	}
+
+	return &Project{
+		ProjectID:               d.ProjectID,
+		Name:                    d.Name,
+		Description:             d.Description,
+		Author:                  d.Author,
+		Language:                d.Language,
+		DefaultModelID:          d.DefaultModelID,
+		DefaultParagraphVoiceID: d.DefaultParagraphVoiceID,
+		DefaultTitleVoiceID:     d.DefaultTitleVoiceID,
+		ContentType:             ProjectContentType(d.ContentType),
+		CoverImageURL:           d.CoverImageURL,
+		CreatedAt:               time.Unix(d.CreateDateUnix, 0),
+		CanBeDownloaded:         d.CanBeDownloaded,
+		AccessLevel:             WorkspaceRole(d.AccessLevel),
+		Title:                   d.Title,
+		ISBNNumber:              d.ISBNNumber,
+		QualityPreset:           QualityPreset(d.QualityPreset),
+		VolumeNormalization:     d.VolumeNormalization,
+	}, nil
+}
+
+// ProjectMetadata is the editable metadata of a project.
+type ProjectMetadata struct {
+	Name                    string
+	Author                  string
+	Title                   string
+	ISBNNumber              string
+	DefaultParagraphVoiceID string
+	DefaultTitleVoiceID     string
+	VolumeNormalization     bool
+}
+
+// UpdateMetadata changes only the metadata fields set by update. It fetches
+// the project's current metadata, applies update to it, and saves the result,
+// so fields the caller does not touch keep their values:
+//
+//	err := client.Projects().UpdateMetadata(ctx, projectID, func(m *elevenlabs.ProjectMetadata) {
+//		m.Author = "Jane Doe"
+//	})
+//
+// Setting a field to "" clears it. It returns the updated metadata.
+func (s *ProjectsService) UpdateMetadata(ctx context.Context, projectID string, update func(*ProjectMetadata)) (*ProjectMetadata, error) {
+	if update == nil {
+		return nil, &ValidationError{Field: "update", Message: "cannot be nil"}
+	}
+
+	current, err := s.Get(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	meta := &ProjectMetadata{
+		Name:                    current.Name,
+		Author:                  current.Author,
+		Title:                   current.Title,
+		ISBNNumber:              current.ISBNNumber,
+		DefaultParagraphVoiceID: current.DefaultParagraphVoiceID,
+		DefaultTitleVoiceID:     current.DefaultTitleVoiceID,
+		VolumeNormalization:     current.VolumeNormalization,
+	}
+	update(meta)
+
+	if meta.Name == "" {
+		return nil, &ValidationError{Field: "name", Message: "cannot be empty"}
+	}
+	if meta.DefaultParagraphVoiceID == "" {
+		return nil, &ValidationError{Field: "default_paragraph_voice_id", Message: "cannot be empty"}
+	}
+	if meta.DefaultTitleVoiceID == "" {
+		return nil, &ValidationError{Field: "default_title_voice_id", Message: "cannot be empty"}
+	}
+
+	body := &api.BodyUpdateStudioProjectV1StudioProjectsProjectIDPost{
+		Name:                    meta.Name,
+		DefaultParagraphVoiceID: meta.DefaultParagraphVoiceID,
+		DefaultTitleVoiceID:     meta.DefaultTitleVoiceID,
+		VolumeNormalization:     api.NewOptBool(meta.VolumeNormalization),
+	}
+	if meta.Author != "" {
+		body.Author = api.NewOptNilString(meta.Author)
+	}
+	if meta.Title != "" {
+		body.Title = api.NewOptNilString(meta.Title)
+	}
+	if meta.ISBNNumber != "" {
+		body.IsbnNumber = api.NewOptNilString(meta.ISBNNumber)
+	}
+
+	if _, err := s.client.apiClient.EditProject(ctx, body, api.EditProjectParams{
+		ProjectID: projectID,
+	}); err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
 // Delete deletes a project.
 func (s *ProjectsService) Delete(ctx context.Context, projectID string) error {
 	if projectID == "" {
@@ -310,6 +496,147 @@ func (s *ProjectsService) Convert(ctx context.Context, projectID string) error {
 	return err
 }
 
+// Chapter block sub-types.
+const (
+	BlockParagraph = "p"
+	BlockHeading1  = "h1"
+	BlockHeading2  = "h2"
+	BlockHeading3  = "h3"
+)
+
+// ChapterBlock is a paragraph or heading in a chapter.
+type ChapterBlock struct {
+	// SubType is BlockParagraph (the default) or a heading level.
+	SubType string
+
+	// Nodes are runs of text, each spoken by one voice.
+	Nodes []ChapterNode
+}
+
+// ChapterNode is a run of text spoken by one voice.
+type ChapterNode struct {
+	VoiceID string
+	Text    string
+}
+
+// Paragraph returns a paragraph block spoken by a single voice.
+func Paragraph(voiceID, text string) ChapterBlock {
+	return ChapterBlock{SubType: BlockParagraph, Nodes: []ChapterNode{{VoiceID: voiceID, Text: text}}}
+}
+
+// chapterDetails is the wire format of a chapter returned by the chapter
+// endpoints that are not covered by the generated client.
+type chapterDetails struct {
+	ChapterID           string  `json:"chapter_id"`
+	Name                string  `json:"name"`
+	State               string  `json:"state"`
+	ConversionProgress  float64 `json:"conversion_progress"`
+	LastConversionError string  `json:"last_conversion_error"`
+}
+
+func (d *chapterDetails) chapter() *Chapter {
+	return &Chapter{
+		ChapterID:           d.ChapterID,
+		Name:                d.Name,
+		State:               ChapterState(d.State),
+		ConversionProgress:  d.ConversionProgress,
+		LastConversionError: d.LastConversionError,
+	}
+}
+
+type chapterResponse struct {
+	Chapter chapterDetails `json:"chapter"`
+}
+
+type chapterContentNode struct {
+	Type    string `json:"type"`
+	VoiceID string `json:"voice_id"`
+	Text    string `json:"text"`
+}
+
+type chapterContentBlock struct {
+	SubType string               `json:"sub_type,omitempty"`
+	Nodes   []chapterContentNode `json:"nodes"`
+}
+
+type chapterContent struct {
+	Blocks []chapterContentBlock `json:"blocks"`
+}
+
+// validateChapterBlocks checks that every block has nodes with a voice.
+func validateChapterBlocks(blocks []ChapterBlock) error {
+	for _, b := range blocks {
+		if len(b.Nodes) == 0 {
+			return &ValidationError{Field: "blocks", Message: "each block needs at least one node"}
+		}
+		for _, n := range b.Nodes {
+			if n.VoiceID == "" {
+				return &ValidationError{Field: "blocks", Message: "each node needs a voice_id"}
+			}
+		}
+	}
+	return nil
+}
+
+// CreateChapter adds a chapter to a project. If blocks is non-empty, the
+// chapter content is set after the chapter is created; if that fails the
+// empty chapter remains in the project.
+func (s *ProjectsService) CreateChapter(ctx context.Context, projectID, name string, blocks []ChapterBlock) (*Chapter, error) {
+	if projectID == "" {
+		return nil, &ValidationError{Field: "project_id", Message: "cannot be empty"}
+	}
+	if name == "" {
+		return nil, &ValidationError{Field: "name", Message: "cannot be empty"}
+	}
+	if err := validateChapterBlocks(blocks); err != nil {
+		return nil, err
+	}
+
+	chapter, err := s.addChapter(ctx, projectID, map[string]any{"name": name})
+	if err != nil || len(blocks) == 0 {
+		return chapter, err
+	}
+
+	content := chapterContent{Blocks: make([]chapterContentBlock, 0, len(blocks))}
+	for _, b := range blocks {
+		cb := chapterContentBlock{SubType: b.SubType, Nodes: make([]chapterContentNode, 0, len(b.Nodes))}
+		for _, n := range b.Nodes {
+			cb.Nodes = append(cb.Nodes, chapterContentNode{Type: "tts_node", VoiceID: n.VoiceID, Text: n.Text})
+		}
+		content.Blocks = append(content.Blocks, cb)
+	}
+
+	var resp chapterResponse
+	path := "/v1/studio/projects/" + url.PathEscape(projectID) + "/chapters/" + url.PathEscape(chapter.ChapterID)
+	if err := s.client.postJSON(ctx, path, map[string]any{"content": content}, &resp); err != nil {
+		return nil, fmt.Errorf("setting content of chapter %s: %w", chapter.ChapterID, err)
+	}
+	return resp.Chapter.chapter(), nil
+}
+
+// CreateChapterFromURL adds a chapter to a project with content extracted
+// from a web page.
+func (s *ProjectsService) CreateChapterFromURL(ctx context.Context, projectID, name, fromURL string) (*Chapter, error) {
+	if projectID == "" {
+		return nil, &ValidationError{Field: "project_id", Message: "cannot be empty"}
+	}
+	if name == "" {
+		return nil, &ValidationError{Field: "name", Message: "cannot be empty"}
+	}
+	if fromURL == "" {
+		return nil, &ValidationError{Field: "from_url", Message: "cannot be empty"}
+	}
+	return s.addChapter(ctx, projectID, map[string]any{"name": name, "from_url": fromURL})
+}
+
+func (s *ProjectsService) addChapter(ctx context.Context, projectID string, body map[string]any) (*Chapter, error) {
+	var resp chapterResponse
+	if err := s.client.postJSON(ctx, "/v1/studio/projects/"+url.PathEscape(projectID)+"/chapters", body, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Chapter.chapter(), nil
+}
+
 // ListChapters returns all chapters in a project.
 func (s *ProjectsService) ListChapters(ctx context.Context, projectID string) ([]*Chapter, error) {
 	if projectID == "" {
@@ -330,7 +657,7 @@ func (s *ProjectsService) ListChapters(ctx context.Context, projectID string) ([
 			ch := &Chapter{
 				ChapterID: c.ChapterID,
 				Name:      c.Name,
-				State:     string(c.State),
+				State:     ChapterState(c.State),
 			}
 			if c.ConversionProgress.Set && !c.ConversionProgress.Null {
 				ch.ConversionProgress = c.ConversionProgress.Value
@@ -469,8 +796,15 @@ func (s *ProjectsService) ListChapterSnapshots(ctx context.Context, projectID, c
 	}
 }
 
-// StreamChapterAudio streams audio from a chapter snapshot.
+// StreamChapterAudio streams audio from a chapter snapshot in the
+// snapshot's default format.
 func (s *ProjectsService) StreamChapterAudio(ctx context.Context, projectID, chapterID, snapshotID string) (io.Reader, error) {
+	return s.StreamChapterAudioWithOptions(ctx, projectID, chapterID, snapshotID, nil)
+}
+
+// StreamChapterAudioWithOptions streams audio from a chapter snapshot.
+// A nil opts uses the snapshot's default format.
+func (s *ProjectsService) StreamChapterAudioWithOptions(ctx context.Context, projectID, chapterID, snapshotID string, opts *SnapshotAudioOptions) (io.Reader, error) {
 	if projectID == "" {
 		return nil, &ValidationError{Field: "project_id", Message: "cannot be empty"}
 	}
@@ -481,23 +815,241 @@ func (s *ProjectsService) StreamChapterAudio(ctx context.Context, projectID, cha
 		return nil, &ValidationError{Field: "snapshot_id", Message: "cannot be empty"}
 	}
 
-	resp, err := s.client.apiClient.StreamChapterSnapshotAudio(ctx,
-		api.OptBodyStreamChapterAudioV1StudioProjectsProjectIDChaptersChapterIDSnapshotsChapterSnapshotIDStreamPost{},
-		api.StreamChapterSnapshotAudioParams{
-			ProjectID:         projectID,
-			ChapterID:         chapterID,
-			ChapterSnapshotID: snapshotID,
-		})
+	path := "/v1/studio/projects/" + url.PathEscape(projectID) +
+		"/chapters/" + url.PathEscape(chapterID) +
+		"/snapshots/" + url.PathEscape(snapshotID) + "/stream"
+	data, err := s.client.postForBytes(ctx, path, snapshotAudioBody(opts))
 	if err != nil {
 		return nil, err
 	}
+	return bytes.NewReader(data), nil
+}
 
-	switch r := resp.(type) {
-	case *api.StreamChapterSnapshotAudioOK:
-		return r.Data, nil
-	default:
-		return nil, &APIError{Message: "unexpected response type"}
+// SnapshotAudioOptions controls the format of streamed snapshot audio.
+type SnapshotAudioOptions struct {
+	// ConvertToMPEG converts the audio to MP3 instead of the snapshot's
+	// default format.
+	ConvertToMPEG bool
+}
+
+// StreamSnapshotAudio streams the full audio of a project snapshot.
+// A nil opts uses the snapshot's default format. Use DownloadSnapshotArchive
+// for per-chapter files; the archive endpoint has no format options.
+func (s *ProjectsService) StreamSnapshotAudio(ctx context.Context, projectID, snapshotID string, opts *SnapshotAudioOptions) (io.Reader, error) {
+	if projectID == "" {
+		return nil, &ValidationError{Field: "project_id", Message: "cannot be empty"}
+	}
+	if snapshotID == "" {
+		return nil, &ValidationError{Field: "snapshot_id", Message: "cannot be empty"}
+	}
+
+	path := "/v1/studio/projects/" + url.PathEscape(projectID) +
+		"/snapshots/" + url.PathEscape(snapshotID) + "/stream"
+	data, err := s.client.postForBytes(ctx, path, snapshotAudioBody(opts))
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(data), nil
+}
+
+// snapshotAudioBody returns the request body of the snapshot stream
+// endpoints. They are called directly because the generated client drops
+// the project audio body and rejects chapter audio that is not MP3.
+func snapshotAudioBody(opts *SnapshotAudioOptions) map[string]any {
+	return map[string]any{"convert_to_mpeg": opts != nil && opts.ConvertToMPEG}
+}
+
+// DefaultSnapshotPollInterval is the polling interval used by CreateSnapshot
+// and CreateChapterSnapshot when none is given.
+const DefaultSnapshotPollInterval = 5 * time.Second
+
+// CreateSnapshot converts a project to audio and waits for the resulting
+// snapshot, so a release can pin the exact version it exports. Studio has
+// no separate snapshot endpoint; every conversion creates one. It returns
+// the context error if ctx is done first. An interval of 0 uses
+// DefaultSnapshotPollInterval.
+func (s *ProjectsService) CreateSnapshot(ctx context.Context, projectID string, interval time.Duration) (*ProjectSnapshot, error) {
+	job, err := s.StartConversion(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.waitForConversion(ctx, job, interval); err != nil {
+		return nil, err
+	}
+	return job.Snapshot, nil
+}
+
+// CreateChapterSnapshot converts a chapter to audio and waits for the
+// resulting snapshot. See CreateSnapshot. It returns an error wrapping
+// ErrJobFailed if the chapter reports a conversion error.
+func (s *ProjectsService) CreateChapterSnapshot(ctx context.Context, projectID, chapterID string, interval time.Duration) (*ChapterSnapshot, error) {
+	job, err := s.StartChapterConversion(ctx, projectID, chapterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.waitForConversion(ctx, job, interval); err != nil {
+		return nil, err
+	}
+	return job.Snapshot, nil
+}
+
+// waitForConversion waits for a conversion job, starting one interval
+// after it was started since no conversion finishes sooner.
+func (s *ProjectsService) waitForConversion(ctx context.Context, job Job, interval time.Duration) error {
+	if interval <= 0 {
+		interval = DefaultSnapshotPollInterval
+	}
+	_, err := s.client.WaitAll(ctx, []Job{job}, &WaitOptions{Interval: interval, Delay: interval})
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// ProjectConversionJob is a Studio project conversion as a Job. It
+// succeeds when the conversion's snapshot appears. Studio doesn't report
+// project conversion progress.
+type ProjectConversionJob struct {
+	service *ProjectsService
+	before  map[string]bool
+
+	// ProjectID is the project being converted.
+	ProjectID string
+
+	// Snapshot is the snapshot the conversion created, once it has
+	// succeeded.
+	Snapshot *ProjectSnapshot
+}
+
+// StartConversion converts a project to audio and returns a Job for the
+// conversion, for waiting on it with Client.WaitAll.
+func (s *ProjectsService) StartConversion(ctx context.Context, projectID string) (*ProjectConversionJob, error) {
+	before, err := s.ListSnapshots(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Convert(ctx, projectID); err != nil {
+		return nil, err
+	}
+	job := &ProjectConversionJob{service: s, ProjectID: projectID, before: make(map[string]bool, len(before))}
+	for _, snap := range before {
+		job.before[snap.ProjectSnapshotID] = true
+	}
+	return job, nil
+}
+
+// ID returns the project ID.
+func (j *ProjectConversionJob) ID() string {
+	return j.ProjectID
+}
+
+// Poll lists the project's snapshots and reports the job succeeded if one
+// is new.
+func (j *ProjectConversionJob) Poll(ctx context.Context) (JobState, error) {
+	snapshots, err := j.service.ListSnapshots(ctx, j.ProjectID)
+	if err != nil {
+		return JobState{}, err
+	}
+	for _, snap := range snapshots {
+		if !j.before[snap.ProjectSnapshotID] {
+			j.Snapshot = snap
+			return JobState{Status: JobSucceeded, Progress: 1}, nil
+		}
+	}
+	return JobState{Status: JobRunning, Progress: -1}, nil
+}
+
+// Result returns the audio of the conversion's snapshot once the job has
+// succeeded.
+func (j *ProjectConversionJob) Result(ctx context.Context, opts *SnapshotAudioOptions) (io.Reader, error) {
+	if j.Snapshot == nil {
+		return nil, &ValidationError{Field: "snapshot", Message: "conversion has not finished"}
+	}
+	return j.service.StreamSnapshotAudio(ctx, j.ProjectID, j.Snapshot.ProjectSnapshotID, opts)
+}
+
+// ChapterConversionJob is a Studio chapter conversion as a Job. It
+// succeeds when the conversion's snapshot appears and reports the
+// chapter's conversion progress until then. It fails if the chapter stops
+// converting with a conversion error.
+type ChapterConversionJob struct {
+	service    *ProjectsService
+	before     map[string]bool
+	converting bool
+
+	// ProjectID and ChapterID are the chapter being converted.
+	ProjectID string
+	ChapterID string
+
+	// Snapshot is the snapshot the conversion created, once it has
+	// succeeded.
+	Snapshot *ChapterSnapshot
+}
+
+// StartChapterConversion converts a chapter to audio and returns a Job for
+// the conversion, for waiting on it with Client.WaitAll.
+func (s *ProjectsService) StartChapterConversion(ctx context.Context, projectID, chapterID string) (*ChapterConversionJob, error) {
+	before, err := s.ListChapterSnapshots(ctx, projectID, chapterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ConvertChapter(ctx, projectID, chapterID); err != nil {
+		return nil, err
+	}
+	job := &ChapterConversionJob{service: s, ProjectID: projectID, ChapterID: chapterID, before: make(map[string]bool, len(before))}
+	for _, snap := range before {
+		job.before[snap.ChapterSnapshotID] = true
+	}
+	return job, nil
+}
+
+// ID returns the project and chapter IDs, as "project/chapter".
+func (j *ChapterConversionJob) ID() string {
+	return j.ProjectID + "/" + j.ChapterID
+}
+
+// Poll lists the chapter's snapshots, and reports the job succeeded if one
+// is new. Otherwise it reports the chapter's conversion state.
+func (j *ChapterConversionJob) Poll(ctx context.Context) (JobState, error) {
+	snapshots, err := j.service.ListChapterSnapshots(ctx, j.ProjectID, j.ChapterID)
+	if err != nil {
+		return JobState{}, err
+	}
+	for _, snap := range snapshots {
+		if !j.before[snap.ChapterSnapshotID] {
+			j.Snapshot = snap
+			return JobState{Status: JobSucceeded, Progress: 1}, nil
+		}
+	}
+
+	chapters, err := j.service.ListChapters(ctx, j.ProjectID)
+	if err != nil {
+		return JobState{}, err
+	}
+	for _, ch := range chapters {
+		if ch.ChapterID != j.ChapterID {
+			continue
+		}
+		if ch.State == ChapterStateConverting {
+			j.converting = true
+			return JobState{Status: JobRunning, Progress: ch.ConversionProgress / 100}, nil
+		}
+		// An error left from an earlier conversion counts only once this
+		// one has been seen converting
+		if j.converting && ch.LastConversionError != "" {
+			return JobState{Status: JobFailed, Progress: -1, Error: ch.LastConversionError}, nil
+		}
+	}
+	return JobState{Status: JobRunning, Progress: -1}, nil
+}
+
+// Result returns the audio of the conversion's snapshot once the job has
+// succeeded.
+func (j *ChapterConversionJob) Result(ctx context.Context, opts *SnapshotAudioOptions) (io.Reader, error) {
+	if j.Snapshot == nil {
+		return nil, &ValidationError{Field: "snapshot", Message: "conversion has not finished"}
 	}
+	return j.service.StreamChapterAudioWithOptions(ctx, j.ProjectID, j.ChapterID, j.Snapshot.ChapterSnapshotID, opts)
 }
 
 // projectFromAPI converts an API ProjectResponseModel to our Project type.
@@ -510,7 +1062,7 @@ func projectFromAPI(p *api.ProjectResponseModel) *Project {
 		DefaultTitleVoiceID:     p.DefaultTitleVoiceID,
 		CreatedAt:               time.Unix(int64(p.CreateDateUnix), 0),
 		CanBeDownloaded:         p.CanBeDownloaded,
-		AccessLevel:             string(p.AccessLevel),
+		AccessLevel:             WorkspaceRole(p.AccessLevel),
 	}
 
 	if p.Description.Set && !p.Description.Null {
@@ -523,7 +1075,7 @@ func projectFromAPI(p *api.ProjectResponseModel) *Project {
 		proj.Language = p.Language.Value
 	}
 	if p.ContentType.Set && !p.ContentType.Null {
-		proj.ContentType = p.ContentType.Value
+		proj.ContentType = ProjectContentType(p.ContentType.Value)
 	}
 	if p.CoverImageURL.Set && !p.CoverImageURL.Null {
 		proj.CoverImageURL = p.CoverImageURL.Value
@@ -2,6 +2,9 @@ package elevenlabs
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 )
@@ -192,3 +195,129 @@ func TestProjectsService(t *testing.T) {
 		}
 	})
 }
+
+func TestProjectsUpdateMetadata(t *testing.T) {
+	const project = `{"project_id": "p1", "name": "Course", "author": "Old Author", "title": "Course Title",
+		"isbn_number": null, "create_date_unix": 1767322800, "created_by_user_id": "u1",
+		"default_title_voice_id": "vt", "default_paragraph_voice_id": "vp", "default_model_id": "m1",
+		"can_be_downloaded": true, "volume_normalization": true, "state": "default", "access_level": "admin",
+		"quality_check_on": false, "quality_check_on_when_bulk_convert": false, "quality_preset": "high"}`
+
+	var edited map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/studio/projects/p1" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			_ = json.NewDecoder(r.Body).Decode(&edited)
+			_, _ = w.Write([]byte(`{"project": ` + project + `}`))
+			return
+		}
+		_, _ = w.Write([]byte(project))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	proj, err := client.Projects().Get(ctx, "p1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if proj.Title != "Course Title" || proj.QualityPreset != QualityHigh || proj.AccessLevel != RoleAdmin {
+		t.Errorf("unexpected project: %+v", proj)
+	}
+
+	meta, err := client.Projects().UpdateMetadata(ctx, "p1", func(m *ProjectMetadata) {
+		m.Author = "New Author"
+	})
+	if err != nil {
+		t.Fatalf("UpdateMetadata() error = %v", err)
+	}
+	if meta.Author != "New Author" || meta.DefaultParagraphVoiceID != "vp" {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+
+	// Untouched fields are sent with their current values.
+	want := map[string]any{
+		"name":                       "Course",
+		"author":                     "New Author",
+		"title":                      "Course Title",
+		"default_paragraph_voice_id": "vp",
+		"default_title_voice_id":     "vt",
+		"volume_normalization":       true,
+	}
+	for k, v := range want {
+		if edited[k] != v {
+			t.Errorf("edit %s = %v, want %v", k, edited[k], v)
+		}
+	}
+
+	_, err = client.Projects().UpdateMetadata(ctx, "p1", func(m *ProjectMetadata) {
+		m.DefaultTitleVoiceID = ""
+	})
+	if !isValidationError(err, nil) {
+		t.Errorf("UpdateMetadata() clearing a voice error = %v, want ValidationError", err)
+	}
+}
+
+func TestProjectsCreateChapter(t *testing.T) {
+	var requests []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"chapter": {"chapter_id": "c1", "name": "Chapter 1", "state": "default",
+			"can_be_downloaded": false, "conversion_progress": null, "content": {"blocks": []}}}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	chapter, err := client.Projects().CreateChapter(ctx, "p1", "Chapter 1", []ChapterBlock{
+		{SubType: BlockHeading1, Nodes: []ChapterNode{{VoiceID: "v1", Text: "Chapter One"}}},
+		Paragraph("v2", "It was a dark and stormy night."),
+	})
+	if err != nil {
+		t.Fatalf("CreateChapter() error = %v", err)
+	}
+	if chapter.ChapterID != "c1" || chapter.State != ChapterStateDefault {
+		t.Errorf("unexpected chapter: %+v", chapter)
+	}
+
+	if len(requests) != 2 || requests[0] != "POST /v1/studio/projects/p1/chapters" || requests[1] != "POST /v1/studio/projects/p1/chapters/c1" {
+		t.Fatalf("unexpected requests: %v", requests)
+	}
+	content, _ := bodies[1]["content"].(map[string]any)
+	blocks, _ := content["blocks"].([]any)
+	if len(blocks) != 2 {
+		t.Fatalf("unexpected content: %v", bodies[1])
+	}
+	node := blocks[1].(map[string]any)["nodes"].([]any)[0].(map[string]any)
+	if node["type"] != "tts_node" || node["voice_id"] != "v2" {
+		t.Errorf("unexpected node: %v", node)
+	}
+
+	if _, err := client.Projects().CreateChapterFromURL(ctx, "p1", "Chapter 2", "https://example.com/post"); err != nil {
+		t.Fatalf("CreateChapterFromURL() error = %v", err)
+	}
+	if bodies[2]["from_url"] != "https://example.com/post" {
+		t.Errorf("unexpected from_url request: %v", bodies[2])
+	}
+
+	_, err = client.Projects().CreateChapter(ctx, "p1", "Chapter 3", []ChapterBlock{Paragraph("", "No voice.")})
+	if !isValidationError(err, nil) {
+		t.Errorf("CreateChapter() without voice error = %v, want ValidationError", err)
+	}
+}
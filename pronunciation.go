@@ -3,8 +3,11 @@ package elevenlabs
 import (
 	"context"
 	"io"
+	"strings"
 	"time"
 
+	ht "github.com/ogen-go/ogen/http"
+
 	"github.com/agentplexus/go-elevenlabs/internal/api"
 )
 
@@ -214,7 +217,10 @@ func (s *PronunciationService) Create(ctx context.Context, req *CreatePronunciat
 	}
 
 	if plsContent != "" {
-		body.File = api.NewOptNilString(plsContent)
+		body.File = api.NewOptMultipartFile(ht.MultipartFile{
+			Name: "dictionary.pls",
+			File: strings.NewReader(plsContent),
+		})
 	}
 
 	resp, err := s.client.apiClient.AddFromFile(ctx, body, api.AddFromFileParams{})
@@ -0,0 +1,212 @@
+package elevenlabs
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+// Response headers used for rate limit and concurrency telemetry.
+const (
+	headerCurrentConcurrentRequests = "current-concurrent-requests"
+	headerMaximumConcurrentRequests = "maximum-concurrent-requests"
+	headerCharacterCost             = "character-cost"
+	headerRequestID                 = "request-id"
+	headerRetryAfter                = "Retry-After"
+	headerRateLimitLimit            = "X-RateLimit-Limit"
+	headerRateLimitRemaining        = "X-RateLimit-Remaining"
+	headerRateLimitReset            = "X-RateLimit-Reset"
+)
+
+// RateLimitState is a snapshot of rate limit and concurrency telemetry
+// parsed from the most recent API response. Fields are zero when the
+// corresponding header was not present.
+type RateLimitState struct {
+	// CurrentConcurrentRequests is the number of in-flight requests for the account.
+	CurrentConcurrentRequests int
+
+	// MaxConcurrentRequests is the concurrency limit for the account.
+	MaxConcurrentRequests int
+
+	// CharacterCost is the character cost of the last request.
+	CharacterCost int
+
+	// Limit is the request limit for the current window, if reported.
+	Limit int
+
+	// Remaining is the number of requests remaining in the current window, if reported.
+	Remaining int
+
+	// Reset is when the current window resets, if reported.
+	Reset time.Time
+
+	// RetryAfter is the server-requested backoff, typically on 429 responses.
+	RetryAfter time.Duration
+
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// RequestID is the ElevenLabs request ID.
+	RequestID string
+
+	// UpdatedAt is when this state was recorded.
+	UpdatedAt time.Time
+}
+
+// AvailableConcurrency returns how many more concurrent requests can be
+// started, or -1 if the concurrency limit is unknown.
+func (s RateLimitState) AvailableConcurrency() int {
+	if s.MaxConcurrentRequests == 0 {
+		return -1
+	}
+	avail := s.MaxConcurrentRequests - s.CurrentConcurrentRequests
+	if avail < 0 {
+		return 0
+	}
+	return avail
+}
+
+// RateLimitCallback is invoked after every API response with the parsed
+// telemetry. The context is the request context, so callers can correlate
+// telemetry with their own request-scoped values.
+type RateLimitCallback func(ctx context.Context, state RateLimitState)
+
+// rateLimitTracker records the most recent rate limit state.
+type rateLimitTracker struct {
+	mu       sync.RWMutex
+	state    RateLimitState
+	callback RateLimitCallback
+	clock    clock.Clock
+}
+
+// observe parses telemetry from resp and notifies the callback.
+func (t *rateLimitTracker) observe(ctx context.Context, resp *http.Response) {
+	state := parseRateLimitState(resp, t.clock.Now())
+
+	t.mu.Lock()
+	t.state = state
+	cb := t.callback
+	t.mu.Unlock()
+
+	if cb != nil {
+		cb(ctx, state)
+	}
+}
+
+// wrap returns a copy of client whose responses are observed by t, so
+// generated, hand-rolled, and streaming requests all update the state.
+func (t *rateLimitTracker) wrap(client *http.Client) *http.Client {
+	next := client.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	wrapped := *client
+	wrapped.Transport = &rateLimitTransport{tracker: t, next: next}
+	return &wrapped
+}
+
+// rateLimitTransport is an http.RoundTripper that records the rate limit
+// telemetry of every response with a rateLimitTracker.
+type rateLimitTransport struct {
+	tracker *rateLimitTracker
+	next    http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.tracker.observe(req.Context(), resp)
+	}
+	return resp, err
+}
+
+// get returns the most recent state.
+func (t *rateLimitTracker) get() RateLimitState {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state
+}
+
+// parseRateLimitState extracts telemetry from response headers received
+// at now.
+func parseRateLimitState(resp *http.Response, now time.Time) RateLimitState {
+	h := resp.Header
+	state := RateLimitState{
+		CurrentConcurrentRequests: headerInt(h, headerCurrentConcurrentRequests),
+		MaxConcurrentRequests:     headerInt(h, headerMaximumConcurrentRequests),
+		CharacterCost:             headerInt(h, headerCharacterCost),
+		Limit:                     headerInt(h, headerRateLimitLimit),
+		Remaining:                 headerInt(h, headerRateLimitRemaining),
+		RetryAfter:                parseRetryAfter(h.Get(headerRetryAfter), now),
+		StatusCode:                resp.StatusCode,
+		RequestID:                 h.Get(headerRequestID),
+		UpdatedAt:                 now,
+	}
+	if reset := headerInt(h, headerRateLimitReset); reset > 0 {
+		state.Reset = time.Unix(int64(reset), 0)
+	}
+	return state
+}
+
+// headerInt parses an integer header, returning 0 if absent or invalid.
+func headerInt(h http.Header, key string) int {
+	v, err := strconv.Atoi(h.Get(key))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// parseRetryAfter parses a Retry-After header in seconds or HTTP-date form,
+// measuring an HTTP-date from now.
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// requestIDKey is the context key for a *string that receives the request
+// ID of the response to a request made with the context.
+type requestIDKey struct{}
+
+// withRequestIDCapture returns a context that stores the request ID of
+// API responses to requests made with it in *id.
+func withRequestIDCapture(ctx context.Context, id *string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// captureRequestID stores the response's request ID if the request
+// context came from withRequestIDCapture.
+func captureRequestID(ctx context.Context, resp *http.Response) {
+	if id, ok := ctx.Value(requestIDKey{}).(*string); ok {
+		*id = resp.Header.Get(headerRequestID)
+	}
+}
+
+// RateLimitState returns the rate limit telemetry from the most recent
+// API response.
+func (c *Client) RateLimitState() RateLimitState {
+	return c.rateLimits.get()
+}
+
+// WithRateLimitCallback sets a callback invoked after every API response
+// with the parsed rate limit telemetry.
+func WithRateLimitCallback(cb RateLimitCallback) Option {
+	return func(o *clientOptions) {
+		o.rateLimitCallback = cb
+	}
+}
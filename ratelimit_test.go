@@ -0,0 +1,141 @@
+package elevenlabs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+func TestParseRateLimitState(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{},
+	}
+	resp.Header.Set("current-concurrent-requests", "3")
+	resp.Header.Set("maximum-concurrent-requests", "5")
+	resp.Header.Set("character-cost", "42")
+	resp.Header.Set("request-id", "req-123")
+	resp.Header.Set("Retry-After", "2")
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	state := parseRateLimitState(resp, now)
+	if state.CurrentConcurrentRequests != 3 || state.MaxConcurrentRequests != 5 {
+		t.Errorf("unexpected concurrency: %+v", state)
+	}
+	if state.AvailableConcurrency() != 2 {
+		t.Errorf("expected 2 available, got %d", state.AvailableConcurrency())
+	}
+	if state.CharacterCost != 42 || state.RequestID != "req-123" {
+		t.Errorf("unexpected cost/request ID: %+v", state)
+	}
+	if state.RetryAfter != 2*time.Second {
+		t.Errorf("expected 2s retry-after, got %v", state.RetryAfter)
+	}
+	if state.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("expected status 429, got %d", state.StatusCode)
+	}
+	if !state.UpdatedAt.Equal(now) {
+		t.Errorf("UpdatedAt = %v, want %v", state.UpdatedAt, now)
+	}
+
+	resp.Header.Set("Retry-After", now.Add(30*time.Second).Format(http.TimeFormat))
+	if got := parseRateLimitState(resp, now).RetryAfter; got != 30*time.Second {
+		t.Errorf("HTTP-date retry-after = %v, want 30s", got)
+	}
+
+	if (RateLimitState{}).AvailableConcurrency() != -1 {
+		t.Error("expected -1 when limit is unknown")
+	}
+}
+
+type ctxKey struct{}
+
+func TestClientRateLimitState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("current-concurrent-requests", "1")
+		w.Header().Set("maximum-concurrent-requests", "10")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	var got RateLimitState
+	var gotValue any
+	client, err := NewClient(
+		WithAPIKey("test-api-key"),
+		WithBaseURL(server.URL),
+		WithRateLimitCallback(func(ctx context.Context, state RateLimitState) {
+			got = state
+			gotValue = ctx.Value(ctxKey{})
+		}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "scheduler-1")
+	if _, err := client.Models().List(ctx); err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	if got.MaxConcurrentRequests != 10 || got.CurrentConcurrentRequests != 1 {
+		t.Errorf("callback got unexpected state: %+v", got)
+	}
+	if gotValue != "scheduler-1" {
+		t.Errorf("callback did not receive request context, got %v", gotValue)
+	}
+	if client.RateLimitState().MaxConcurrentRequests != 10 {
+		t.Errorf("RateLimitState() = %+v", client.RateLimitState())
+	}
+}
+
+func TestClientRateLimitStateHandRolled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("character-cost", "12")
+		w.Header().Set("request-id", r.URL.Path)
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var calls int
+	client, err := NewClient(
+		WithAPIKey("test-api-key"),
+		WithBaseURL(server.URL),
+		withClock(clock.NewFake(now)),
+		WithRateLimitCallback(func(context.Context, RateLimitState) { calls++ }),
+	)
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// Streaming TTS goes through the stream client, not the generated one
+	stream, err := client.TextToSpeech().GenerateStream(context.Background(), &TTSRequest{VoiceID: "v1", Text: "Hello."})
+	if err != nil {
+		t.Fatalf("GenerateStream() error = %v", err)
+	}
+	_, _ = io.Copy(io.Discard, stream)
+	_ = stream.Close()
+
+	state := client.RateLimitState()
+	if calls != 1 || state.CharacterCost != 12 || state.RequestID != "/v1/text-to-speech/v1/stream" {
+		t.Errorf("after stream: calls = %d, state = %+v", calls, state)
+	}
+	if !state.UpdatedAt.Equal(now) {
+		t.Errorf("UpdatedAt = %v, want the client clock's %v", state.UpdatedAt, now)
+	}
+
+	// Hand-rolled REST calls go through the raw client
+	if _, err := client.postForBytes(context.Background(), "/v1/raw", struct{}{}); err != nil {
+		t.Fatalf("postForBytes() error = %v", err)
+	}
+	if calls != 2 || client.RateLimitState().RequestID != "/v1/raw" {
+		t.Errorf("after raw request: calls = %d, state = %+v", calls, client.RateLimitState())
+	}
+}
@@ -0,0 +1,280 @@
+// Package recorder records and replays HTTP interactions as JSON fixtures
+// ("cassettes"), so code that calls the ElevenLabs API can be tested without
+// network access or an API key.
+//
+// Record once against the live API, then replay in tests:
+//
+//	rec, _ := recorder.New("testdata/voices.json", recorder.ModeRecord)
+//	client, _ := elevenlabs.NewClient(elevenlabs.WithRecorder(rec))
+//	// ... make calls ...
+//	rec.Stop() // writes the cassette
+//
+//	rec, _ = recorder.New("testdata/voices.json", recorder.ModeReplay)
+//	client, _ = elevenlabs.NewClient(elevenlabs.WithRecorder(rec))
+//
+// Sensitive headers such as xi-api-key are never written to cassettes.
+package recorder
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Mode selects whether the recorder records or replays.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette and never uses the network.
+	ModeReplay Mode = iota
+
+	// ModeRecord sends requests to the network and records them.
+	ModeRecord
+)
+
+// ErrNoInteraction is returned in replay mode when no recorded interaction
+// matches a request.
+var ErrNoInteraction = errors.New("recorder: no recorded interaction matches request")
+
+// defaultRedactedHeaders are never written to cassettes.
+var defaultRedactedHeaders = []string{"xi-api-key", "Authorization", "Cookie", "Set-Cookie"}
+
+// Cassette is the stored form of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request/response pair.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request. URL holds only the path and query, so
+// cassettes replay against any base URL.
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded body. It is stored as a JSON string when it is valid
+// UTF-8 and as {"base64": "..."} otherwise (e.g., audio).
+type Body []byte
+
+// MarshalJSON implements json.Marshaler.
+func (b Body) MarshalJSON() ([]byte, error) {
+	if utf8.Valid(b) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"base64": base64.StdEncoding.EncodeToString(b)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Body(s)
+		return nil
+	}
+	var enc struct {
+		Base64 string `json:"base64"`
+	}
+	if err := json.Unmarshal(data, &enc); err != nil {
+		return fmt.Errorf("recorder: invalid body: %w", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(enc.Base64)
+	if err != nil {
+		return fmt.Errorf("recorder: invalid base64 body: %w", err)
+	}
+	*b = decoded
+	return nil
+}
+
+// Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithTransport sets the transport used to reach the network in record mode.
+// Defaults to http.DefaultTransport.
+func WithTransport(t http.RoundTripper) Option {
+	return func(r *Recorder) {
+		r.transport = t
+	}
+}
+
+// WithRedactHeaders adds headers that are never written to cassettes.
+func WithRedactHeaders(headers ...string) Option {
+	return func(r *Recorder) {
+		r.redact = append(r.redact, headers...)
+	}
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	redact    []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	used     []bool
+}
+
+// New creates a recorder for the cassette at path. In replay mode the
+// cassette must exist.
+func New(path string, mode Mode, opts ...Option) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      mode,
+		transport: http.DefaultTransport,
+		redact:    append([]string(nil), defaultRedactedHeaders...),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	if mode == ModeReplay {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("recorder: reading cassette: %w", err)
+		}
+		if err := json.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("recorder: parsing cassette: %w", err)
+		}
+		r.used = make([]bool, len(r.cassette.Interactions))
+	}
+	return r, nil
+}
+
+// Mode returns the recorder mode.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("recorder: reading request body: %w", err)
+		}
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req)
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("recorder: reading response body: %w", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: Request{
+			Method:  req.Method,
+			URL:     req.URL.RequestURI(),
+			Headers: r.redacted(req.Header),
+			Body:    reqBody,
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    r.redacted(resp.Header),
+			Body:       respBody,
+		},
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// replay returns the first unused interaction with the same method and URL.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	uri := req.URL.RequestURI()
+	for i, in := range r.cassette.Interactions {
+		if r.used[i] || in.Request.Method != req.Method || in.Request.URL != uri {
+			continue
+		}
+		r.used[i] = true
+		header := in.Response.Headers.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		return &http.Response{
+			StatusCode:    in.Response.StatusCode,
+			Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          io.NopCloser(bytes.NewReader(in.Response.Body)),
+			ContentLength: int64(len(in.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, req.Method, uri)
+}
+
+// redacted returns a copy of h without redacted headers.
+func (r *Recorder) redacted(h http.Header) http.Header {
+	out := h.Clone()
+	for key := range out {
+		for _, name := range r.redact {
+			if strings.EqualFold(key, name) {
+				delete(out, key)
+			}
+		}
+	}
+	return out
+}
+
+// Stop writes the cassette in record mode. It is a no-op in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+	r.mu.Lock()
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("recorder: marshaling cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0750); err != nil {
+		return fmt.Errorf("recorder: creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(r.path, data, 0600); err != nil {
+		return fmt.Errorf("recorder: writing cassette: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package recorder
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	audio := []byte{0xFF, 0xFB, 0x90, 0x00}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/voices":
+			w.Header().Set("Set-Cookie", "session=secret")
+			_, _ = io.WriteString(w, `{"voices":[]}`)
+		case "/v1/audio":
+			_, _ = w.Write(audio)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassettes", "test.json")
+	rec, err := New(path, ModeRecord)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	client := &http.Client{Transport: rec}
+
+	req, _ := http.NewRequest("POST", server.URL+"/v1/voices", strings.NewReader(`{"q":1}`))
+	req.Header.Set("xi-api-key", "secret-key")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("record request error = %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"voices":[]}` {
+		t.Errorf("recorded response body = %q", body)
+	}
+	if resp, err = client.Get(server.URL + "/v1/audio"); err != nil {
+		t.Fatalf("record request error = %v", err)
+	}
+	resp.Body.Close()
+
+	if err := rec.Stop(); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("cassette contains redacted header values:\n%s", data)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(data, &cassette); err != nil {
+		t.Fatalf("parsing cassette: %v", err)
+	}
+	if len(cassette.Interactions) != 2 {
+		t.Fatalf("len(Interactions) = %d, want 2", len(cassette.Interactions))
+	}
+	if got := string(cassette.Interactions[0].Request.Body); got != `{"q":1}` {
+		t.Errorf("request body = %q", got)
+	}
+
+	// Replay without a server.
+	server.Close()
+	rec, err = New(path, ModeReplay)
+	if err != nil {
+		t.Fatalf("New() replay error = %v", err)
+	}
+	client = &http.Client{Transport: rec}
+
+	resp, err = client.Get("http://example.invalid/v1/audio")
+	if err != nil {
+		t.Fatalf("replay error = %v", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != string(audio) {
+		t.Errorf("replayed binary body = %x, want %x", body, audio)
+	}
+
+	// Each interaction is replayed once.
+	if _, err := client.Get("http://example.invalid/v1/audio"); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("second replay error = %v, want ErrNoInteraction", err)
+	}
+}
+
+func TestNewReplayMissingCassette(t *testing.T) {
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), ModeReplay); err == nil {
+		t.Error("New() should fail for a missing cassette in replay mode")
+	}
+}
@@ -0,0 +1,151 @@
+package elevenlabs
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+// ScriptEngineName is the name of the ElevenLabs engine in ttsscript
+// engine routes.
+const ScriptEngineName = "elevenlabs"
+
+// ScriptEngine synthesizes ttsscript segments with ElevenLabs
+// text-to-speech. It implements ttsscript.Engine.
+type ScriptEngine struct {
+	client *Client
+
+	// ModelID is the model to generate with, unless a job sets its own.
+	// Defaults to DefaultModelID.
+	ModelID string
+
+	// OutputFormat is the audio output format (e.g., "mp3_44100_128").
+	// Defaults to the API default, MP3.
+	OutputFormat string
+
+	// VoiceSettings are the base voice settings. A job's preset replaces
+	// them and its overrides are applied on top. Defaults to
+	// DefaultVoiceSettings().
+	VoiceSettings *VoiceSettings
+
+	// MaxRetries is the number of retries for rate limited (429) and
+	// server (5xx) errors, as in batch generation. Zero means no retries.
+	MaxRetries int
+
+	// RetryBackoff is the initial backoff between retries.
+	// Defaults to DefaultBatchRetryBackoff.
+	RetryBackoff time.Duration
+
+	// OnRetry, if set, is called before a job is retried, with the number
+	// of the failed attempt and its error.
+	OnRetry func(job ttsscript.SegmentJob, attempt int, err error)
+}
+
+var _ ttsscript.Engine = (*ScriptEngine)(nil)
+
+// NewScriptEngine creates a ttsscript engine backed by the client.
+//
+// Example:
+//
+//	engine := elevenlabs.NewScriptEngine(client)
+//	for _, job := range jobs {
+//	    audio, err := engine.Synthesize(ctx, ttsscript.NewSegmentJob(job, "en"))
+//	    if err != nil {
+//	        return err
+//	    }
+//	    audio.WriteFile(config.GenerateFilename(job, "en"))
+//	}
+func NewScriptEngine(client *Client) *ScriptEngine {
+	return &ScriptEngine{client: client}
+}
+
+// Request returns the TTS request the engine sends for a job, such as for
+// logging or cache keys.
+func (e *ScriptEngine) Request(job ttsscript.SegmentJob) *TTSRequest {
+	modelID := e.ModelID
+	if job.ModelID != "" {
+		modelID = job.ModelID
+	}
+	return &TTSRequest{
+		VoiceID:       job.VoiceID,
+		Text:          job.Text,
+		ModelID:       modelID,
+		VoiceSettings: e.voiceSettings(job.VoiceSettings),
+		OutputFormat:  e.OutputFormat,
+		Seed:          job.Seed,
+	}
+}
+
+// Synthesize generates the audio for a job, retrying rate limit and
+// server errors up to MaxRetries times.
+func (e *ScriptEngine) Synthesize(ctx context.Context, job ttsscript.SegmentJob) (ttsscript.Audio, error) {
+	var audio ttsscript.Audio
+	var attempts int
+	var lastErr error
+	_, err := e.client.retry(ctx, e.MaxRetries, e.RetryBackoff, func() error {
+		if attempts > 0 && e.OnRetry != nil {
+			e.OnRetry(job, attempts, lastErr)
+		}
+		attempts++
+		audio, lastErr = e.synthesize(ctx, job)
+		return lastErr
+	})
+	return audio, err
+}
+
+// synthesize makes one attempt at generating the audio for a job.
+func (e *ScriptEngine) synthesize(ctx context.Context, job ttsscript.SegmentJob) (ttsscript.Audio, error) {
+	resp, err := e.client.TextToSpeech().Generate(ctx, e.Request(job))
+	if err != nil {
+		return ttsscript.Audio{}, err
+	}
+	data, err := io.ReadAll(resp.Audio)
+	if err != nil {
+		return ttsscript.Audio{}, err
+	}
+	return ttsscript.Audio{Data: data, Format: e.format()}, nil
+}
+
+// format returns the file extension for the output format, e.g. "mp3" for
+// "mp3_44100_128".
+func (e *ScriptEngine) format() string {
+	if e.OutputFormat == "" {
+		return "mp3"
+	}
+	codec, _, _ := strings.Cut(e.OutputFormat, "_")
+	return codec
+}
+
+// voiceSettings applies a segment's preset and overrides to the base
+// voice settings.
+func (e *ScriptEngine) voiceSettings(overrides *ttsscript.VoiceSettings) *VoiceSettings {
+	vs := DefaultVoiceSettings()
+	if e.VoiceSettings != nil {
+		copied := *e.VoiceSettings
+		vs = &copied
+	}
+	if overrides == nil {
+		return vs
+	}
+	if overrides.Preset != "" {
+		if preset, ok := VoiceSettingsPreset(overrides.Preset); ok {
+			vs = preset
+		}
+	}
+	if overrides.Stability != nil {
+		vs.WithStability(*overrides.Stability)
+	}
+	if overrides.SimilarityBoost != nil {
+		vs.WithSimilarityBoost(*overrides.SimilarityBoost)
+	}
+	if overrides.Style != nil {
+		vs.WithStyle(*overrides.Style)
+	}
+	if overrides.Speed != nil {
+		vs.WithSpeed(*overrides.Speed)
+	}
+	return vs
+}
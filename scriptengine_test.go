@@ -0,0 +1,125 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agentplexus/go-elevenlabs/ttsscript"
+)
+
+func TestScriptEngineSynthesize(t *testing.T) {
+	var body map[string]any
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte{0xFF, 0xFB, 0x90, 0x00})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	engine := NewScriptEngine(client)
+	engine.ModelID = "eleven_turbo_v2_5"
+
+	speed := 1.1
+	var e ttsscript.Engine = engine
+	audio, err := e.Synthesize(context.Background(), ttsscript.SegmentJob{
+		Text:          "Hello",
+		VoiceID:       "voice1",
+		Language:      "en",
+		VoiceSettings: &ttsscript.VoiceSettings{Preset: "stable", Speed: &speed},
+	})
+	if err != nil {
+		t.Fatalf("Synthesize() error = %v", err)
+	}
+	if len(audio.Data) != 4 || audio.Format != "mp3" {
+		t.Errorf("audio = %+v", audio)
+	}
+	if !strings.HasSuffix(path, "/text-to-speech/voice1") {
+		t.Errorf("path = %s", path)
+	}
+	if body["text"] != "Hello" || body["model_id"] != "eleven_turbo_v2_5" {
+		t.Errorf("body = %v", body)
+	}
+	stable, _ := VoiceSettingsPreset("stable")
+	vs, _ := body["voice_settings"].(map[string]any)
+	if vs["stability"] != stable.Stability || vs["speed"] != 1.1 {
+		t.Errorf("voice_settings = %v, want the stable preset with speed 1.1", vs)
+	}
+}
+
+func TestScriptEngineRequest(t *testing.T) {
+	engine := NewScriptEngine(nil)
+	engine.VoiceSettings = &VoiceSettings{Stability: 0.3, SimilarityBoost: 0.6}
+	engine.OutputFormat = "pcm_16000"
+
+	req := engine.Request(ttsscript.SegmentJob{Text: "Hi", VoiceID: "voice1"})
+	if req.VoiceSettings.Stability != 0.3 || req.OutputFormat != "pcm_16000" {
+		t.Errorf("Request() = %+v", req)
+	}
+	// The base settings are not modified by overrides
+	style := 0.8
+	req = engine.Request(ttsscript.SegmentJob{Text: "Hi", VoiceID: "voice1", VoiceSettings: &ttsscript.VoiceSettings{Style: &style}})
+	if req.VoiceSettings.Style != 0.8 || engine.VoiceSettings.Style != 0 {
+		t.Errorf("override leaked into base settings: %+v", engine.VoiceSettings)
+	}
+	if engine.format() != "pcm" {
+		t.Errorf("format() = %q", engine.format())
+	}
+
+	// A job's model and seed take precedence over the engine's
+	engine.ModelID = "eleven_multilingual_v2"
+	req = engine.Request(ttsscript.SegmentJob{Text: "Hi", VoiceID: "voice1", ModelID: "eleven_turbo_v2_5", Seed: 42})
+	if req.ModelID != "eleven_turbo_v2_5" || req.Seed != 42 {
+		t.Errorf("Request() model = %q, seed = %d", req.ModelID, req.Seed)
+	}
+}
+
+func TestScriptEngineRetry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"detail": {"status": "busy", "message": "try again"}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte{0xFF, 0xFB, 0x90, 0x00})
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	engine := NewScriptEngine(client)
+	job := ttsscript.SegmentJob{Text: "Hello", VoiceID: "voice1", Language: "en"}
+
+	// No retries by default
+	if _, err := engine.Synthesize(context.Background(), job); err == nil {
+		t.Fatal("Synthesize() without retries succeeded, want error")
+	}
+
+	requests = 0
+	engine.MaxRetries = 3
+	engine.RetryBackoff = time.Millisecond
+	var retried []int
+	engine.OnRetry = func(_ ttsscript.SegmentJob, attempt int, err error) {
+		if err == nil {
+			t.Error("OnRetry called with nil error")
+		}
+		retried = append(retried, attempt)
+	}
+	audio, err := engine.Synthesize(context.Background(), job)
+	if err != nil {
+		t.Fatalf("Synthesize() error = %v", err)
+	}
+	if len(audio.Data) != 4 || requests != 3 || len(retried) != 2 || retried[1] != 2 {
+		t.Errorf("requests = %d, retries = %v", requests, retried)
+	}
+}
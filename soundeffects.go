@@ -1,8 +1,13 @@
 package elevenlabs
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
+	"os"
 
 	"github.com/agentplexus/go-elevenlabs/internal/api"
 )
@@ -59,6 +64,9 @@ func (s *SoundEffectsService) Generate(ctx context.Context, req *SoundEffectRequ
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
+	if err := s.client.checkOutputFormat(ctx, EndpointSoundEffects, req.OutputFormat); err != nil {
+		return nil, err
+	}
 
 	body := &api.BodySoundGenerationV1SoundGenerationPost{
 		Text: req.Text,
@@ -118,3 +126,120 @@ func (s *SoundEffectsService) GenerateLoop(ctx context.Context, description stri
 	}
 	return resp.Audio, nil
 }
+
+// SoundEffectBatchItem is one sound effect in a batch. Batches are usually
+// loaded from JSONL prompt files with LoadSoundEffectBatch.
+type SoundEffectBatchItem struct {
+	// ID names the output file (<ID>.mp3). Defaults to "sfx_<n>" by position.
+	ID string `json:"id,omitempty"`
+
+	// Text is the description of the sound effect.
+	Text string `json:"text"`
+
+	// DurationSeconds is the target duration (0.5 to 30 seconds).
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	// PromptInfluence controls how closely the generation follows the prompt.
+	PromptInfluence float64 `json:"prompt_influence,omitempty"`
+
+	// Loop creates a sound effect that loops smoothly.
+	Loop bool `json:"loop,omitempty"`
+
+	// OutputFormat specifies the audio format (e.g., "mp3_44100_128").
+	OutputFormat string `json:"output_format,omitempty"`
+}
+
+// Request returns the item as a SoundEffectRequest.
+func (i *SoundEffectBatchItem) Request() *SoundEffectRequest {
+	return &SoundEffectRequest{
+		Text:            i.Text,
+		DurationSeconds: i.DurationSeconds,
+		PromptInfluence: i.PromptInfluence,
+		Loop:            i.Loop,
+		OutputFormat:    i.OutputFormat,
+	}
+}
+
+// LoadSoundEffectBatch reads batch items from a JSONL file with one item
+// per line:
+//
+//	{"id": "door_creak", "text": "old wooden door creaking open", "duration_seconds": 2}
+//	{"id": "rain_loop", "text": "steady rain on a tin roof", "duration_seconds": 10, "loop": true}
+//
+// Blank lines and lines starting with # are ignored.
+func LoadSoundEffectBatch(path string) ([]SoundEffectBatchItem, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open sound effect batch: %w", err)
+	}
+	defer f.Close()
+
+	var items []SoundEffectBatchItem
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 || data[0] == '#' {
+			continue
+		}
+		var item SoundEffectBatchItem
+		if err := json.Unmarshal(data, &item); err != nil {
+			return nil, fmt.Errorf("parse sound effect batch line %d: %w", line, err)
+		}
+		items = append(items, item)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read sound effect batch: %w", err)
+	}
+	return items, nil
+}
+
+// BatchGenerate generates many sound effects concurrently, writing each to
+// <OutputDir>/<ID>.<ext>. Rate limited and server errors are retried.
+//
+// All items are validated before any is generated. The returned error
+// reports invalid items or options; per-item failures are in the results,
+// which are in item order.
+func (s *SoundEffectsService) BatchGenerate(ctx context.Context, items []SoundEffectBatchItem, opts *BatchOptions) ([]BatchResult, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	if opts.OutputDir == "" {
+		return nil, &ValidationError{Field: "output_dir", Message: "cannot be empty"}
+	}
+
+	jobs := make([]batchJob, len(items))
+	seen := make(map[string]bool, len(items))
+	for i := range items {
+		item := items[i]
+		if item.ID == "" {
+			item.ID = fmt.Sprintf("sfx_%03d", i+1)
+		}
+		if err := checkBatchID(seen, item.ID); err != nil {
+			return nil, err
+		}
+
+		req := item.Request()
+		if err := req.Validate(); err != nil {
+			return nil, fmt.Errorf("item %d (%s): %w", i+1, item.ID, err)
+		}
+		jobs[i] = batchJob{
+			id:     item.ID,
+			path:   batchOutputPath(opts.OutputDir, item.ID, item.OutputFormat),
+			record: NewSoundEffectGenerationRecord(req),
+			generate: func(ctx context.Context) (io.Reader, error) {
+				resp, err := s.Generate(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				return resp.Audio, nil
+			},
+		}
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0750); err != nil {
+		return nil, fmt.Errorf("create output directory: %w", err)
+	}
+	return s.client.runBatch(ctx, jobs, opts), nil
+}
@@ -1,9 +1,16 @@
 package elevenlabs
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestSoundEffectRequestValidate(t *testing.T) {
@@ -104,3 +111,92 @@ func TestSoundEffectsService(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadSoundEffectBatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prompts.jsonl")
+	data := "# door sounds\n{\"id\": \"creak\", \"text\": \"door creaking\", \"duration_seconds\": 2}\n\n{\"text\": \"rain\", \"loop\": true}\n"
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+	items, err := LoadSoundEffectBatch(path)
+	if err != nil {
+		t.Fatalf("LoadSoundEffectBatch() error = %v", err)
+	}
+	if len(items) != 2 || items[0].ID != "creak" || items[0].DurationSeconds != 2 || !items[1].Loop {
+		t.Errorf("LoadSoundEffectBatch() = %+v", items)
+	}
+}
+
+func TestSoundEffectsBatchGenerate(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		calls[body.Text]++
+		n := calls[body.Text]
+		mu.Unlock()
+
+		switch {
+		case body.Text == "flaky" && n == 1:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"detail": {"status": "too_many_concurrent_requests"}}`))
+		case body.Text == "rejected":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"detail": {"status": "invalid"}}`))
+		default:
+			w.Header().Set("Content-Type", "audio/mpeg")
+			_, _ = w.Write([]byte("audio:" + body.Text))
+		}
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	if _, err := client.SoundEffects().BatchGenerate(ctx, []SoundEffectBatchItem{{ID: "a", Text: "x"}, {ID: "a", Text: "y"}},
+		&BatchOptions{OutputDir: dir}); !isValidationError(err, nil) {
+		t.Errorf("BatchGenerate() with duplicate IDs error = %v, want ValidationError", err)
+	}
+
+	var manifest bytes.Buffer
+	results, err := client.SoundEffects().BatchGenerate(ctx, []SoundEffectBatchItem{
+		{ID: "door", Text: "door"},
+		{Text: "flaky", DurationSeconds: 5, Loop: true},
+		{ID: "bad", Text: "rejected"},
+	}, &BatchOptions{OutputDir: dir, RetryBackoff: time.Millisecond, Log: NewGenerationLog(&manifest)})
+	if err != nil {
+		t.Fatalf("BatchGenerate() error = %v", err)
+	}
+
+	if results[0].Err != nil || results[0].Attempts != 1 {
+		t.Errorf("door result = %+v", results[0])
+	}
+	if results[1].Err != nil || results[1].Attempts != 2 || results[1].ID != "sfx_002" {
+		t.Errorf("flaky result = %+v, want success after one retry", results[1])
+	}
+	if results[2].Err == nil || results[2].Attempts != 1 {
+		t.Errorf("rejected result = %+v, want failure without retry", results[2])
+	}
+
+	if data, _ := os.ReadFile(filepath.Join(dir, "sfx_002.mp3")); string(data) != "audio:flaky" {
+		t.Errorf("sfx_002.mp3 = %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "bad.mp3")); !os.IsNotExist(err) {
+		t.Errorf("failed item left a file: %v", err)
+	}
+
+	records, err := ReadGenerationRecords(&manifest)
+	if err != nil || len(records) != 3 {
+		t.Fatalf("manifest records = %d, %v", len(records), err)
+	}
+}
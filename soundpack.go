@@ -0,0 +1,251 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// SoundPackManifestFile is the name of the manifest written to a sound
+// pack's output directory.
+const SoundPackManifestFile = "pack.json"
+
+// SoundPack defines a set of related UI sounds, such as notification
+// sounds, that are generated together so they sound consistent:
+//
+//	{
+//	  "name": "chime",
+//	  "style": "soft glassy marimba, clean, no reverb tail",
+//	  "target_lufs": -18,
+//	  "sounds": {
+//	    "message": {"prompt": "two-note rising chime", "duration_seconds": 0.8},
+//	    "error": {"prompt": "low two-note falling tone", "duration_seconds": 1},
+//	    "typing": {"prompt": "gentle soft tapping", "duration_seconds": 2, "loop": true}
+//	  }
+//	}
+type SoundPack struct {
+	// Name identifies the pack.
+	Name string `json:"name"`
+
+	// Style is appended to every prompt, so the sounds share a timbre.
+	Style string `json:"style,omitempty"`
+
+	// PromptInfluence applies to sounds that don't set their own.
+	PromptInfluence float64 `json:"prompt_influence,omitempty"`
+
+	// OutputFormat is the audio format of every sound (e.g., "mp3_44100_128").
+	OutputFormat string `json:"output_format,omitempty"`
+
+	// TargetLUFS is the integrated loudness the sounds are normalized to,
+	// e.g. -18. Zero means no normalization. Normalization is done by
+	// SoundPackOptions.Normalize.
+	TargetLUFS float64 `json:"target_lufs,omitempty"`
+
+	// Sounds maps event names, which name the output files, to sounds.
+	Sounds map[string]SoundPackSound `json:"sounds"`
+}
+
+// SoundPackSound is one sound in a pack.
+type SoundPackSound struct {
+	// Prompt describes the sound.
+	Prompt string `json:"prompt"`
+
+	// DurationSeconds is the target duration (0.5 to 30 seconds).
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+
+	// Loop creates a sound that loops smoothly.
+	Loop bool `json:"loop,omitempty"`
+
+	// PromptInfluence overrides the pack's prompt influence.
+	PromptInfluence float64 `json:"prompt_influence,omitempty"`
+}
+
+// LoadSoundPack reads a pack definition from a JSON file.
+func LoadSoundPack(path string) (*SoundPack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read sound pack: %w", err)
+	}
+	var pack SoundPack
+	if err := json.Unmarshal(data, &pack); err != nil {
+		return nil, fmt.Errorf("parse sound pack: %w", err)
+	}
+	return &pack, nil
+}
+
+// Events returns the pack's event names in sorted order.
+func (p *SoundPack) Events() []string {
+	events := make([]string, 0, len(p.Sounds))
+	for event := range p.Sounds {
+		events = append(events, event)
+	}
+	sort.Strings(events)
+	return events
+}
+
+// Items returns the pack's sounds as batch items in event order, with the
+// pack style and defaults applied.
+func (p *SoundPack) Items() []SoundEffectBatchItem {
+	events := p.Events()
+	items := make([]SoundEffectBatchItem, len(events))
+	for i, event := range events {
+		sound := p.Sounds[event]
+		text := sound.Prompt
+		if p.Style != "" {
+			text = strings.TrimRight(text, " ,.") + ", " + p.Style
+		}
+		influence := sound.PromptInfluence
+		if influence == 0 {
+			influence = p.PromptInfluence
+		}
+		items[i] = SoundEffectBatchItem{
+			ID:              event,
+			Text:            text,
+			DurationSeconds: sound.DurationSeconds,
+			PromptInfluence: influence,
+			Loop:            sound.Loop,
+			OutputFormat:    p.OutputFormat,
+		}
+	}
+	return items
+}
+
+// Validate checks the pack and each of its sounds.
+func (p *SoundPack) Validate() error {
+	if len(p.Sounds) == 0 {
+		return &ValidationError{Field: "sounds", Message: "cannot be empty"}
+	}
+	if p.TargetLUFS > 0 {
+		return &ValidationError{Field: "target_lufs", Message: "must be negative"}
+	}
+	for _, item := range p.Items() {
+		if strings.ContainsAny(item.ID, `/\`) {
+			return &ValidationError{Field: "sounds", Message: fmt.Sprintf("event %q cannot contain path separators", item.ID)}
+		}
+		if err := item.Request().Validate(); err != nil {
+			return fmt.Errorf("sound %q: %w", item.ID, err)
+		}
+	}
+	return nil
+}
+
+// SoundPackOptions configures SoundEffectsService.GeneratePack.
+type SoundPackOptions struct {
+	// BatchOptions configures generation. OutputDir is required.
+	BatchOptions
+
+	// Normalize, if set, normalizes a generated file in place to the
+	// pack's TargetLUFS. It is called for each generated sound when the
+	// pack sets a target; this package has no audio processing, so callers
+	// typically run ffmpeg's loudnorm filter.
+	Normalize func(path string, targetLUFS float64) error
+}
+
+// SoundPackManifest describes a generated pack. It is written to
+// SoundPackManifestFile in the output directory.
+type SoundPackManifest struct {
+	// Name is the pack name.
+	Name string `json:"name"`
+
+	// TargetLUFS is the pack's loudness target, if any.
+	TargetLUFS float64 `json:"target_lufs,omitempty"`
+
+	// Sounds lists the sounds in event order.
+	Sounds []SoundPackEntry `json:"sounds"`
+}
+
+// SoundPackEntry is one generated sound in a pack manifest.
+type SoundPackEntry struct {
+	Event           string  `json:"event"`
+	File            string  `json:"file,omitempty"`
+	Prompt          string  `json:"prompt"`
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+	Loop            bool    `json:"loop,omitempty"`
+	Bytes           int64   `json:"bytes,omitempty"`
+	Normalized      bool    `json:"normalized,omitempty"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// Failed returns the number of sounds that failed to generate or
+// normalize.
+func (m *SoundPackManifest) Failed() int {
+	n := 0
+	for _, s := range m.Sounds {
+		if s.Error != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// GeneratePack generates every sound in a pack with BatchGenerate,
+// normalizes loudness if the pack sets a target and opts.Normalize is set,
+// and writes a SoundPackManifest to the output directory. Per-sound
+// failures are recorded in the manifest; the returned error reports an
+// invalid pack or a manifest that couldn't be written.
+//
+// Example:
+//
+//	pack, _ := elevenlabs.LoadSoundPack("chime.json")
+//	manifest, err := client.SoundEffects().GeneratePack(ctx, pack, &elevenlabs.SoundPackOptions{
+//	    BatchOptions: elevenlabs.BatchOptions{OutputDir: "sounds/chime"},
+//	})
+func (s *SoundEffectsService) GeneratePack(ctx context.Context, pack *SoundPack, opts *SoundPackOptions) (*SoundPackManifest, error) {
+	if pack == nil {
+		return nil, &ValidationError{Field: "pack", Message: "cannot be nil"}
+	}
+	if opts == nil {
+		opts = &SoundPackOptions{}
+	}
+	if err := pack.Validate(); err != nil {
+		return nil, err
+	}
+
+	items := pack.Items()
+	results, err := s.BatchGenerate(ctx, items, &opts.BatchOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &SoundPackManifest{Name: pack.Name, TargetLUFS: pack.TargetLUFS}
+	for i, res := range results {
+		item := items[i]
+		entry := SoundPackEntry{
+			Event:           item.ID,
+			Prompt:          item.Text,
+			DurationSeconds: item.DurationSeconds,
+			Loop:            item.Loop,
+		}
+		if res.Err != nil {
+			entry.Error = res.Err.Error()
+			manifest.Sounds = append(manifest.Sounds, entry)
+			continue
+		}
+		entry.File = filepath.Base(res.OutputPath)
+		entry.Bytes = res.Bytes
+		if pack.TargetLUFS != 0 && opts.Normalize != nil {
+			if err := opts.Normalize(res.OutputPath, pack.TargetLUFS); err != nil {
+				entry.Error = fmt.Sprintf("normalize: %v", err)
+			} else {
+				entry.Normalized = true
+				if info, err := os.Stat(res.OutputPath); err == nil {
+					entry.Bytes = info.Size()
+				}
+			}
+		}
+		manifest.Sounds = append(manifest.Sounds, entry)
+	}
+
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, err
+	}
+	if err := os.WriteFile(filepath.Join(opts.OutputDir, SoundPackManifestFile), data, 0600); err != nil {
+		return manifest, fmt.Errorf("write sound pack manifest: %w", err)
+	}
+	return manifest, nil
+}
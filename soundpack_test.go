@@ -0,0 +1,101 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSoundPackItems(t *testing.T) {
+	pack := &SoundPack{
+		Name:            "chime",
+		Style:           "soft marimba",
+		PromptInfluence: 0.4,
+		Sounds: map[string]SoundPackSound{
+			"message": {Prompt: "rising chime.", DurationSeconds: 1},
+			"error":   {Prompt: "falling tone", PromptInfluence: 0.8},
+		},
+	}
+	items := pack.Items()
+	if len(items) != 2 || items[0].ID != "error" || items[1].ID != "message" {
+		t.Fatalf("Items() = %+v, want event order", items)
+	}
+	if items[1].Text != "rising chime, soft marimba" {
+		t.Errorf("Text = %q", items[1].Text)
+	}
+	if items[0].PromptInfluence != 0.8 || items[1].PromptInfluence != 0.4 {
+		t.Errorf("prompt influence = %v, %v", items[0].PromptInfluence, items[1].PromptInfluence)
+	}
+
+	if err := (&SoundPack{}).Validate(); !isValidationError(err, nil) {
+		t.Errorf("Validate() of empty pack = %v", err)
+	}
+	pack.TargetLUFS = 3
+	if err := pack.Validate(); !isValidationError(err, nil) {
+		t.Errorf("Validate() with positive target = %v", err)
+	}
+}
+
+func TestSoundEffectsGeneratePack(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if strings.HasPrefix(body.Text, "broken") {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"detail": {"status": "invalid"}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio:" + body.Text))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	dir := t.TempDir()
+	pack := &SoundPack{
+		Name:       "chime",
+		TargetLUFS: -18,
+		Sounds: map[string]SoundPackSound{
+			"message": {Prompt: "rising chime"},
+			"error":   {Prompt: "broken tone"},
+		},
+	}
+
+	var normalized []string
+	manifest, err := client.SoundEffects().GeneratePack(context.Background(), pack, &SoundPackOptions{
+		BatchOptions: BatchOptions{OutputDir: dir, MaxRetries: -1},
+		Normalize: func(path string, target float64) error {
+			normalized = append(normalized, filepath.Base(path))
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("GeneratePack() error = %v", err)
+	}
+	if manifest.Failed() != 1 || manifest.Sounds[0].Error == "" {
+		t.Errorf("manifest = %+v, want error sound failed", manifest)
+	}
+	if s := manifest.Sounds[1]; s.File != "message.mp3" || !s.Normalized {
+		t.Errorf("message entry = %+v", s)
+	}
+	if len(normalized) != 1 || normalized[0] != "message.mp3" {
+		t.Errorf("normalized = %v", normalized)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, SoundPackManifestFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written SoundPackManifest
+	if err := json.Unmarshal(data, &written); err != nil || written.Name != "chime" || len(written.Sounds) != 2 {
+		t.Errorf("written manifest = %s (%v)", data, err)
+	}
+}
@@ -1,12 +1,11 @@
 package elevenlabs
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
-	"net/http"
 )
 
 // SpeechToSpeechService handles voice conversion operations.
@@ -43,6 +42,9 @@ type SpeechToSpeechRequest struct {
 
 	// SeedAudioFilename is the filename for the seed audio.
 	SeedAudioFilename string
+
+	// OnProgress is called as the source audio is uploaded (optional).
+	OnProgress UploadProgressFunc
 }
 
 // Validate validates the speech-to-speech request.
@@ -68,208 +70,86 @@ type SpeechToSpeechResponse struct {
 }
 
 // Convert converts speech from one voice to another.
+// The audio is streamed to the API without being buffered in memory.
 func (s *SpeechToSpeechService) Convert(ctx context.Context, req *SpeechToSpeechRequest) (*SpeechToSpeechResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
-	// Build multipart form
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
-	// Add audio file
-	audioFilename := req.AudioFilename
-	if audioFilename == "" {
-		audioFilename = "audio.mp3"
-	}
-	audioWriter, err := writer.CreateFormFile("audio", audioFilename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create audio form field: %w", err)
-	}
-	if _, err := io.Copy(audioWriter, req.Audio); err != nil {
-		return nil, fmt.Errorf("failed to write audio: %w", err)
-	}
-
-	// Add model ID
-	modelID := req.ModelID
-	if modelID == "" {
-		modelID = "eleven_english_sts_v2"
-	}
-	if err := writer.WriteField("model_id", modelID); err != nil {
-		return nil, fmt.Errorf("failed to write model_id: %w", err)
-	}
-
-	// Add voice settings if provided
-	if req.VoiceSettings != nil {
-		if err := writer.WriteField("stability", fmt.Sprintf("%.2f", req.VoiceSettings.Stability)); err != nil {
-			return nil, err
-		}
-		if err := writer.WriteField("similarity_boost", fmt.Sprintf("%.2f", req.VoiceSettings.SimilarityBoost)); err != nil {
-			return nil, err
-		}
-		if req.VoiceSettings.Style > 0 {
-			if err := writer.WriteField("style", fmt.Sprintf("%.2f", req.VoiceSettings.Style)); err != nil {
-				return nil, err
-			}
-		}
-		if req.VoiceSettings.UseSpeakerBoost {
-			if err := writer.WriteField("use_speaker_boost", "true"); err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	// Add remove background noise option
-	if req.RemoveBackgroundNoise {
-		if err := writer.WriteField("remove_background_noise", "true"); err != nil {
-			return nil, err
-		}
-	}
+	return s.convert(ctx, req, "", true)
+}
 
-	// Add seed audio if provided
-	if req.SeedAudio != nil {
-		seedFilename := req.SeedAudioFilename
-		if seedFilename == "" {
-			seedFilename = "seed.mp3"
-		}
-		seedWriter, err := writer.CreateFormFile("seed_audio", seedFilename)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create seed_audio form field: %w", err)
-		}
-		if _, err := io.Copy(seedWriter, req.SeedAudio); err != nil {
-			return nil, fmt.Errorf("failed to write seed audio: %w", err)
-		}
-	}
+// ConvertStream converts speech with streaming response.
+func (s *SpeechToSpeechService) ConvertStream(ctx context.Context, req *SpeechToSpeechRequest) (*SpeechToSpeechResponse, error) {
+	return s.convert(ctx, req, "/stream", false)
+}
 
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
+func (s *SpeechToSpeechService) convert(ctx context.Context, req *SpeechToSpeechRequest, suffix string, withSeed bool) (*SpeechToSpeechResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
 	}
 
-	// Build URL
-	url := fmt.Sprintf("%s/v1/speech-to-speech/%s", s.client.baseURL, req.VoiceID)
+	path := fmt.Sprintf("/v1/speech-to-speech/%s%s", req.VoiceID, suffix)
 	if req.OutputFormat != "" {
-		url += "?output_format=" + req.OutputFormat
+		path += "?output_format=" + req.OutputFormat
 	}
 
-	// Make request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, &buf)
+	resp, err := s.client.postMultipart(ctx, path, func(w *multipart.Writer) error {
+		return req.writeForm(w, withSeed)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
-
-	resp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(respBody),
-		}
-	}
-
 	return &SpeechToSpeechResponse{Audio: resp.Body}, nil
 }
 
-// ConvertStream converts speech with streaming response.
-func (s *SpeechToSpeechService) ConvertStream(ctx context.Context, req *SpeechToSpeechRequest) (*SpeechToSpeechResponse, error) {
-	if err := req.Validate(); err != nil {
-		return nil, err
-	}
-
-	// Build multipart form
-	var buf bytes.Buffer
-	writer := multipart.NewWriter(&buf)
-
+// writeForm writes the request as multipart form fields.
+func (r *SpeechToSpeechRequest) writeForm(writer *multipart.Writer, withSeed bool) error {
 	// Add audio file
-	audioFilename := req.AudioFilename
+	audioFilename := r.AudioFilename
 	if audioFilename == "" {
 		audioFilename = "audio.mp3"
 	}
-	audioWriter, err := writer.CreateFormFile("audio", audioFilename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create audio form field: %w", err)
-	}
-	if _, err := io.Copy(audioWriter, req.Audio); err != nil {
-		return nil, fmt.Errorf("failed to write audio: %w", err)
+	if err := writeFormFile(writer, "audio", audioFilename, withUploadProgress(r.Audio, r.OnProgress)); err != nil {
+		return err
 	}
 
 	// Add model ID
-	modelID := req.ModelID
+	modelID := r.ModelID
 	if modelID == "" {
 		modelID = "eleven_english_sts_v2"
 	}
 	if err := writer.WriteField("model_id", modelID); err != nil {
-		return nil, fmt.Errorf("failed to write model_id: %w", err)
+		return fmt.Errorf("failed to write model_id: %w", err)
 	}
 
-	// Add voice settings if provided
-	if req.VoiceSettings != nil {
-		if err := writer.WriteField("stability", fmt.Sprintf("%.2f", req.VoiceSettings.Stability)); err != nil {
-			return nil, err
-		}
-		if err := writer.WriteField("similarity_boost", fmt.Sprintf("%.2f", req.VoiceSettings.SimilarityBoost)); err != nil {
-			return nil, err
-		}
-		if req.VoiceSettings.Style > 0 {
-			if err := writer.WriteField("style", fmt.Sprintf("%.2f", req.VoiceSettings.Style)); err != nil {
-				return nil, err
-			}
+	// Add voice settings if provided, as the JSON string the API expects
+	if r.VoiceSettings != nil {
+		settings, err := json.Marshal(r.VoiceSettings.optional(legacySpeechToSpeech))
+		if err != nil {
+			return fmt.Errorf("failed to marshal voice_settings: %w", err)
 		}
-		if req.VoiceSettings.UseSpeakerBoost {
-			if err := writer.WriteField("use_speaker_boost", "true"); err != nil {
-				return nil, err
-			}
+		if err := writer.WriteField("voice_settings", string(settings)); err != nil {
+			return err
 		}
 	}
 
 	// Add remove background noise option
-	if req.RemoveBackgroundNoise {
+	if r.RemoveBackgroundNoise {
 		if err := writer.WriteField("remove_background_noise", "true"); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	if err := writer.Close(); err != nil {
-		return nil, fmt.Errorf("failed to close multipart writer: %w", err)
-	}
-
-	// Build URL for streaming endpoint
-	url := fmt.Sprintf("%s/v1/speech-to-speech/%s/stream", s.client.baseURL, req.VoiceID)
-	if req.OutputFormat != "" {
-		url += "?output_format=" + req.OutputFormat
-	}
-
-	// Make request
-	httpReq, err := http.NewRequestWithContext(ctx, "POST", url, &buf)
-	if err != nil {
-		return nil, err
-	}
-
-	httpReq.Header.Set("Content-Type", writer.FormDataContentType())
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
-
-	resp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
-		respBody, _ := io.ReadAll(resp.Body)
-		return nil, &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(respBody),
+	// Add seed audio if provided
+	if withSeed && r.SeedAudio != nil {
+		seedFilename := r.SeedAudioFilename
+		if seedFilename == "" {
+			seedFilename = "seed.mp3"
+		}
+		if err := writeFormFile(writer, "seed_audio", seedFilename, r.SeedAudio); err != nil {
+			return err
 		}
 	}
 
-	return &SpeechToSpeechResponse{Audio: resp.Body}, nil
+	return nil
 }
 
 // Simple is a convenience method for basic voice conversion.
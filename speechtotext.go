@@ -1,7 +1,13 @@
 package elevenlabs
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"math"
+	"strings"
+
+	ht "github.com/ogen-go/ogen/http"
 
 	"github.com/agentplexus/go-elevenlabs/internal/api"
 )
@@ -46,13 +52,29 @@ type TranscriptionResponse struct {
 	// LanguageCode is the detected language.
 	LanguageCode string
 
-	// Words contains word-level details with timestamps.
+	// LanguageProbability is the confidence (0-1) in the detected language.
+	LanguageProbability float64
+
+	// Words contains word-level details with timestamps, including
+	// spacing and audio event entries.
 	Words []TranscriptionWord
 
-	// Utterances contains speaker-labeled segments (when diarization is enabled).
+	// Utterances contains speaker-labeled segments (when diarization is
+	// enabled), assembled from consecutive words with the same speaker.
 	Utterances []TranscriptionUtterance
+
+	// AudioEvents contains the non-speech sounds tagged in the audio
+	// (when TagAudioEvents is enabled).
+	AudioEvents []TranscriptionAudioEvent
 }
 
+// Transcription word types.
+const (
+	TranscriptionWordTypeWord       = "word"
+	TranscriptionWordTypeSpacing    = "spacing"
+	TranscriptionWordTypeAudioEvent = "audio_event"
+)
+
 // TranscriptionWord represents a single word with timing.
 type TranscriptionWord struct {
 	// Text is the word text.
@@ -64,16 +86,27 @@ type TranscriptionWord struct {
 	// End is the end time in seconds.
 	End float64
 
-	// Confidence is the confidence score (0-1).
+	// Confidence is the confidence score (0-1), the probability with
+	// which the word was predicted.
 	Confidence float64
 
+	// Logprob is the log of Confidence, as returned by the API.
+	Logprob float64
+
 	// Speaker is the speaker ID (when diarization is enabled).
 	Speaker string
 
-	// Type is the word type (e.g., "word", "punctuation").
+	// Type is the word type: TranscriptionWordTypeWord, TranscriptionWordTypeSpacing,
+	// or TranscriptionWordTypeAudioEvent.
 	Type string
 }
 
+// IsAudioEvent reports whether the entry is a non-speech sound, such as
+// "(laughter)", rather than a spoken word.
+func (w TranscriptionWord) IsAudioEvent() bool {
+	return w.Type == TranscriptionWordTypeAudioEvent
+}
+
 // TranscriptionUtterance represents a speaker segment.
 type TranscriptionUtterance struct {
 	// Text is the utterance text.
@@ -87,6 +120,30 @@ type TranscriptionUtterance struct {
 
 	// Speaker is the speaker ID.
 	Speaker string
+
+	// Confidence is the mean confidence (0-1) of the utterance's words.
+	Confidence float64
+}
+
+// TranscriptionAudioEvent is a non-speech sound tagged in the audio.
+type TranscriptionAudioEvent struct {
+	// Event is the sound, such as "laughter" or "applause".
+	Event string
+
+	// Text is the event as transcribed, such as "(laughter)".
+	Text string
+
+	// Start is the start time in seconds.
+	Start float64
+
+	// End is the end time in seconds.
+	End float64
+
+	// Speaker is the speaker ID (when diarization is enabled).
+	Speaker string
+
+	// Confidence is the confidence score (0-1).
+	Confidence float64
 }
 
 // Transcribe transcribes audio to text.
@@ -101,7 +158,14 @@ func (s *SpeechToTextService) Transcribe(ctx context.Context, req *Transcription
 		body.CloudStorageURL = api.NewOptNilString(req.FileURL)
 	}
 	if req.FileContent != "" {
-		body.File = api.NewOptNilString(req.FileContent)
+		data, err := base64.StdEncoding.DecodeString(req.FileContent)
+		if err != nil {
+			return nil, &ValidationError{Field: "file_content", Message: "must be base64-encoded"}
+		}
+		body.File = api.NewOptMultipartFile(ht.MultipartFile{
+			Name: "audio",
+			File: bytes.NewReader(data),
+		})
 	}
 	if req.LanguageCode != "" {
 		body.LanguageCode = api.NewOptNilString(req.LanguageCode)
@@ -133,15 +197,18 @@ func (s *SpeechToTextService) Transcribe(ctx context.Context, req *Transcription
 		chunk := r.SpeechToTextChunkResponseModel
 
 		result := &TranscriptionResponse{
-			Text:         chunk.Text,
-			LanguageCode: chunk.LanguageCode,
+			Text:                chunk.Text,
+			LanguageCode:        chunk.LanguageCode,
+			LanguageProbability: chunk.LanguageProbability,
 		}
 
 		// Convert words
 		for _, w := range chunk.Words {
 			word := TranscriptionWord{
-				Text: w.Text,
-				Type: string(w.Type),
+				Text:       w.Text,
+				Type:       string(w.Type),
+				Logprob:    w.Logprob,
+				Confidence: math.Exp(w.Logprob),
 			}
 			if w.Start.Set && !w.Start.Null {
 				word.Start = w.Start.Value
@@ -153,7 +220,18 @@ func (s *SpeechToTextService) Transcribe(ctx context.Context, req *Transcription
 				word.Speaker = w.SpeakerID.Value
 			}
 			result.Words = append(result.Words, word)
+			if word.IsAudioEvent() {
+				result.AudioEvents = append(result.AudioEvents, TranscriptionAudioEvent{
+					Event:      audioEventName(word.Text),
+					Text:       word.Text,
+					Start:      word.Start,
+					End:        word.End,
+					Speaker:    word.Speaker,
+					Confidence: word.Confidence,
+				})
+			}
 		}
+		result.Utterances = buildUtterances(result.Words)
 
 		return result, nil
 	default:
@@ -173,3 +251,52 @@ func (s *SpeechToTextService) TranscribeWithDiarization(ctx context.Context, url
 		Diarize: true,
 	})
 }
+
+// buildUtterances groups consecutive words with the same speaker into
+// utterances. Audio events are left out. Words without a speaker, as
+// when diarization is off, produce no utterances.
+func buildUtterances(words []TranscriptionWord) []TranscriptionUtterance {
+	var utterances []TranscriptionUtterance
+	var text strings.Builder
+	var confidence float64
+	var spoken int
+	flush := func() {
+		if n := len(utterances); n > 0 {
+			utterances[n-1].Text = strings.TrimSpace(text.String())
+			if spoken > 0 {
+				utterances[n-1].Confidence = confidence / float64(spoken)
+			}
+		}
+		text.Reset()
+		confidence, spoken = 0, 0
+	}
+
+	for _, w := range words {
+		if w.Speaker == "" || w.IsAudioEvent() {
+			continue
+		}
+		n := len(utterances)
+		if n == 0 || utterances[n-1].Speaker != w.Speaker {
+			if w.Type == TranscriptionWordTypeSpacing {
+				continue
+			}
+			flush()
+			utterances = append(utterances, TranscriptionUtterance{Speaker: w.Speaker, Start: w.Start})
+			n++
+		}
+		text.WriteString(w.Text)
+		if w.Type != TranscriptionWordTypeSpacing {
+			utterances[n-1].End = w.End
+			confidence += w.Confidence
+			spoken++
+		}
+	}
+	flush()
+	return utterances
+}
+
+// audioEventName returns the sound of an audio event transcribed as
+// "(laughter)" or "[applause]".
+func audioEventName(text string) string {
+	return strings.ToLower(strings.TrimSpace(strings.Trim(strings.TrimSpace(text), "()[]")))
+}
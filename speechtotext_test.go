@@ -2,6 +2,10 @@ package elevenlabs
 
 import (
 	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 )
 
@@ -65,3 +69,52 @@ func isValidationError(err error, valErr **ValidationError) bool {
 	}
 	return ok
 }
+
+func TestTranscribeWordDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"language_code": "en", "language_probability": 0.98, "text": "Hi there. (laughter) Hello.",
+			"words": [
+				{"text": "Hi", "type": "word", "logprob": 0, "start": 0.0, "end": 0.3, "speaker_id": "speaker_0"},
+				{"text": " ", "type": "spacing", "logprob": 0, "start": 0.3, "end": 0.35, "speaker_id": "speaker_0"},
+				{"text": "there.", "type": "word", "logprob": -0.6931471805599453, "start": 0.35, "end": 0.8, "speaker_id": "speaker_0"},
+				{"text": " ", "type": "spacing", "logprob": 0, "start": 0.8, "end": 0.9, "speaker_id": "speaker_1"},
+				{"text": "(laughter)", "type": "audio_event", "logprob": -0.1, "start": 0.9, "end": 1.5, "speaker_id": "speaker_1"},
+				{"text": "Hello.", "type": "word", "logprob": 0, "start": 1.6, "end": 2.0, "speaker_id": "speaker_1"}
+			]}`))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	resp, err := client.SpeechToText().Transcribe(context.Background(), &TranscriptionRequest{
+		FileURL: "https://example.com/a.mp3", Diarize: true, TagAudioEvents: true,
+	})
+	if err != nil {
+		t.Fatalf("Transcribe() error = %v", err)
+	}
+	if resp.LanguageProbability != 0.98 {
+		t.Errorf("LanguageProbability = %v", resp.LanguageProbability)
+	}
+	if w := resp.Words[2]; math.Abs(w.Confidence-0.5) > 1e-9 || w.Logprob >= 0 {
+		t.Errorf("word = %+v, want confidence 0.5", w)
+	}
+	if len(resp.AudioEvents) != 1 || resp.AudioEvents[0].Event != "laughter" || resp.AudioEvents[0].Start != 0.9 ||
+		!resp.Words[4].IsAudioEvent() {
+		t.Errorf("AudioEvents = %+v", resp.AudioEvents)
+	}
+
+	want := []TranscriptionUtterance{
+		{Text: "Hi there.", Start: 0, End: 0.8, Speaker: "speaker_0", Confidence: 0.75},
+		{Text: "Hello.", Start: 1.6, End: 2.0, Speaker: "speaker_1", Confidence: 1},
+	}
+	if !reflect.DeepEqual(resp.Utterances, want) {
+		t.Errorf("Utterances = %+v, want %+v", resp.Utterances, want)
+	}
+}
+
+func TestBuildUtterancesWithoutSpeakers(t *testing.T) {
+	words := []TranscriptionWord{{Text: "Hello", Type: TranscriptionWordTypeWord}}
+	if got := buildUtterances(words); got != nil {
+		t.Errorf("buildUtterances() = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,98 @@
+package elevenlabs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StreamingLatency is an optimize_streaming_latency level, trading quality
+// for a shorter time to first audio. The best possible latency varies by
+// model. The API marks the parameter as deprecated in favor of choosing a
+// faster model, such as eleven_flash_v2_5, but still honors it.
+type StreamingLatency int
+
+// Streaming latency levels.
+const (
+	// LatencyDefault applies no latency optimizations.
+	LatencyDefault StreamingLatency = 0
+
+	// LatencyNormal gets about 50% of the improvement of LatencyMax.
+	LatencyNormal StreamingLatency = 1
+
+	// LatencyStrong gets about 75% of the improvement of LatencyMax.
+	LatencyStrong StreamingLatency = 2
+
+	// LatencyMax applies the maximum latency optimizations.
+	LatencyMax StreamingLatency = 3
+
+	// LatencyMaxNoNormalizer is LatencyMax with the text normalizer turned
+	// off. It has the best latency but can mispronounce numbers and dates,
+	// so spell them out in the text.
+	LatencyMaxNoNormalizer StreamingLatency = 4
+)
+
+// Validate checks that the level is one the API accepts.
+func (l StreamingLatency) Validate() error {
+	if l < LatencyDefault || l > LatencyMaxNoNormalizer {
+		return &ValidationError{
+			Field:   "OptimizeStreamingLatency",
+			Message: fmt.Sprintf("%d is out of range, use 0 (LatencyDefault) to 4 (LatencyMaxNoNormalizer)", l),
+		}
+	}
+	return nil
+}
+
+// IsPCMFormat reports whether an output format is raw PCM, which needs no
+// decoding and so has the lowest playback latency for real-time audio.
+func IsPCMFormat(format string) bool {
+	return strings.HasPrefix(format, "pcm_")
+}
+
+// Limits of WebSocket TTS options.
+const (
+	minChunkLength            = 50
+	maxChunkLength            = 500
+	maxWebSocketInactivity    = 180
+	webSocketUnsupportedModel = "eleven_v3"
+)
+
+// Validate checks the options for combinations the API rejects, so that a
+// misconfigured session fails before dialing instead of with an opaque
+// close from the server.
+func (o *WebSocketTTSOptions) Validate() error {
+	if err := o.OptimizeStreamingLatency.Validate(); err != nil {
+		return err
+	}
+	if o.OutputFormat != "" && !ValidOutputFormats[o.OutputFormat] {
+		return &ValidationError{
+			Field:   "OutputFormat",
+			Message: fmt.Sprintf("invalid format %q, use pcm_16000, pcm_24000, ulaw_8000, mp3_44100_128, etc.", o.OutputFormat),
+		}
+	}
+	if o.ModelID == webSocketUnsupportedModel {
+		return &ValidationError{
+			Field:   "ModelID",
+			Message: o.ModelID + " does not support WebSocket streaming, use eleven_flash_v2_5 or eleven_turbo_v2_5",
+		}
+	}
+	if o.VoiceSettings != nil {
+		if err := o.VoiceSettings.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, n := range o.ChunkLengthSchedule {
+		if n < minChunkLength || n > maxChunkLength {
+			return &ValidationError{
+				Field:   "ChunkLengthSchedule",
+				Message: fmt.Sprintf("%d is out of range, each value must be %d to %d characters", n, minChunkLength, maxChunkLength),
+			}
+		}
+	}
+	if o.InactivityTimeout < 0 || o.InactivityTimeout > maxWebSocketInactivity {
+		return &ValidationError{
+			Field:   "InactivityTimeout",
+			Message: fmt.Sprintf("must be 0 to %d seconds", maxWebSocketInactivity),
+		}
+	}
+	return nil
+}
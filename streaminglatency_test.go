@@ -0,0 +1,81 @@
+package elevenlabs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStreamingLatencyValidate(t *testing.T) {
+	for _, l := range []StreamingLatency{LatencyDefault, LatencyNormal, LatencyStrong, LatencyMax, LatencyMaxNoNormalizer} {
+		if err := l.Validate(); err != nil {
+			t.Errorf("Validate(%d) error = %v", l, err)
+		}
+	}
+	for _, l := range []StreamingLatency{-1, 5} {
+		if err := l.Validate(); !isValidationError(err, nil) {
+			t.Errorf("Validate(%d) = %v, want ValidationError", l, err)
+		}
+	}
+}
+
+func TestIsPCMFormat(t *testing.T) {
+	if !IsPCMFormat("pcm_16000") {
+		t.Error("IsPCMFormat(pcm_16000) = false")
+	}
+	if IsPCMFormat("mp3_44100_128") || IsPCMFormat("ulaw_8000") {
+		t.Error("IsPCMFormat() = true for an encoded format")
+	}
+}
+
+func TestWebSocketTTSOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    WebSocketTTSOptions
+		wantErr bool
+	}{
+		{"defaults", *DefaultWebSocketTTSOptions(), false},
+		{"zero value", WebSocketTTSOptions{}, false},
+		{"latency out of range", WebSocketTTSOptions{OptimizeStreamingLatency: 7}, true},
+		{"unknown format", WebSocketTTSOptions{OutputFormat: "wav"}, true},
+		{"eleven_v3", WebSocketTTSOptions{ModelID: "eleven_v3"}, true},
+		{"invalid voice settings", WebSocketTTSOptions{VoiceSettings: &VoiceSettings{Stability: 2}}, true},
+		{"chunk schedule", WebSocketTTSOptions{ChunkLengthSchedule: []int{120, 160, 250}}, false},
+		{"chunk too short", WebSocketTTSOptions{ChunkLengthSchedule: []int{10}}, true},
+		{"inactivity too long", WebSocketTTSOptions{InactivityTimeout: 600}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateStreamLatencyParam(t *testing.T) {
+	var latency string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		latency = r.URL.Query().Get("optimize_streaming_latency")
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	stream, err := client.TextToSpeech().GenerateStream(context.Background(), &TTSRequest{
+		VoiceID:                  "voice1",
+		Text:                     "Hello",
+		OutputFormat:             "pcm_16000",
+		OptimizeStreamingLatency: LatencyStrong,
+	})
+	if err != nil {
+		t.Fatalf("GenerateStream() error = %v", err)
+	}
+	stream.Close()
+	if latency != "2" {
+		t.Errorf("optimize_streaming_latency = %q, want 2", latency)
+	}
+}
@@ -2,7 +2,11 @@ package elevenlabs
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"io"
+	"os"
 
 	"github.com/agentplexus/go-elevenlabs/internal/api"
 )
@@ -13,6 +17,11 @@ type TextToSpeechService struct {
 }
 
 // VoiceSettings contains the voice configuration for text-to-speech.
+//
+// A zero Style, Speed, or UseSpeakerBoost can't be told apart from an
+// unset one. Use NewVoiceSettings and the With methods to send exactly
+// the fields you set, zeros included, or OptionalVoiceSettings to convert
+// settings with optional fields.
 type VoiceSettings struct {
 	// Stability determines how stable the voice is (0.0 to 1.0).
 	// Lower values introduce broader emotional range.
@@ -32,6 +41,12 @@ type VoiceSettings struct {
 
 	// UseSpeakerBoost boosts similarity to the original speaker.
 	UseSpeakerBoost bool
+
+	// set records the fields set with the With methods.
+	set voiceSettingsField
+
+	// cleared records the fields not to send at all.
+	cleared voiceSettingsField
 }
 
 // Validate validates the voice settings.
@@ -45,7 +60,7 @@ func (vs *VoiceSettings) Validate() error {
 	if vs.Style < 0 || vs.Style > 1 {
 		return ErrInvalidStyle
 	}
-	if vs.Speed != 0 && (vs.Speed < 0.25 || vs.Speed > 4.0) {
+	if (vs.Speed != 0 || vs.set&fieldSpeed != 0) && (vs.Speed < 0.25 || vs.Speed > 4.0) {
 		return ErrInvalidSpeed
 	}
 	return nil
@@ -83,6 +98,32 @@ type TTSRequest struct {
 
 	// LanguageCode is the ISO 639-1 language code for text normalization.
 	LanguageCode string
+
+	// Seed for deterministic generation (0-4294967295). With a seed, a
+	// retried request regenerates the same audio, so GenerateStream can
+	// resume an interrupted stream.
+	Seed int
+
+	// OptimizeStreamingLatency reduces the time to first audio at the cost
+	// of quality. It mainly matters for GenerateStream.
+	OptimizeStreamingLatency StreamingLatency
+
+	// PreviousText and NextText are the text around Text, so speech split
+	// across requests flows naturally when concatenated.
+	PreviousText string
+	NextText     string
+
+	// PreviousRequestIDs are the TTSResponse.RequestIDs of up to three
+	// earlier requests whose audio this request continues. They take
+	// precedence over PreviousText; results are best with the same model.
+	PreviousRequestIDs []string
+
+	// Metadata labels the generation for cost attribution, such as
+	// {"course": "go-101", "customer": "acme"}. The API has no request
+	// labels, so it is not sent: it is copied to the request's
+	// GenerationRecord, which BatchGenerate keys by history item ID (see
+	// GenerationMetadata and HistoryExportOptions.Metadata).
+	Metadata map[string]string
 }
 
 // ValidOutputFormats lists the valid audio output formats.
@@ -128,6 +169,15 @@ func (r *TTSRequest) Validate() error {
 			return err
 		}
 	}
+	if err := r.OptimizeStreamingLatency.Validate(); err != nil {
+		return err
+	}
+	if len(r.PreviousRequestIDs) > maxStitchRequestIDs {
+		return &ValidationError{
+			Field:   "PreviousRequestIDs",
+			Message: fmt.Sprintf("at most %d request IDs are allowed", maxStitchRequestIDs),
+		}
+	}
 	if r.OutputFormat != "" && !ValidOutputFormats[r.OutputFormat] {
 		return &ValidationError{
 			Field:   "OutputFormat",
@@ -141,6 +191,9 @@ func (r *TTSRequest) Validate() error {
 type TTSResponse struct {
 	// Audio is the generated audio data.
 	Audio io.Reader
+
+	// RequestID is the ElevenLabs request ID, for TTSRequest.PreviousRequestIDs.
+	RequestID string
 }
 
 // Generate generates speech from text.
@@ -148,8 +201,45 @@ func (s *TextToSpeechService) Generate(ctx context.Context, req *TTSRequest) (*T
 	if err := req.Validate(); err != nil {
 		return nil, err
 	}
+	if err := s.checkModel(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := s.client.checkOutputFormat(ctx, EndpointTextToSpeech, req.OutputFormat); err != nil {
+		return nil, err
+	}
+
+	body, params := ttsFullRequest(req)
+
+	// Make the API call, repeating it if the audio is cut off partway
+	var resp api.TextToSpeechFullRes
+	var requestID string
+	var err error
+	ctx = withRequestIDCapture(ctx, &requestID)
+	for attempt := 1; ; attempt++ {
+		resp, err = s.client.apiClient.TextToSpeechFull(ctx, body, params)
+		if err == nil {
+			break
+		}
+		if !isStreamInterruption(err) || ctx.Err() != nil {
+			return nil, err
+		}
+		if attempt > s.client.streamRetries {
+			return nil, &StreamInterruptedError{Attempts: attempt, Err: err}
+		}
+	}
+
+	// Handle response type
+	switch r := resp.(type) {
+	case *api.TextToSpeechFullOK:
+		return &TTSResponse{Audio: r.Data, RequestID: requestID}, nil
+	default:
+		return nil, &APIError{Message: "unexpected response type"}
+	}
+}
 
-	// Build request body
+// ttsFullRequest builds the generated client's body and parameters for a
+// text-to-speech request.
+func ttsFullRequest(req *TTSRequest) (*api.BodyTextToSpeechFull, api.TextToSpeechFullParams) {
 	body := &api.BodyTextToSpeechFull{
 		Text: req.Text,
 	}
@@ -163,21 +253,25 @@ func (s *TextToSpeechService) Generate(ctx context.Context, req *TTSRequest) (*T
 
 	// Set voice settings if provided
 	if req.VoiceSettings != nil {
-		vs := api.VoiceSettingsResponseModel{
-			Stability:       api.NewOptNilFloat64(req.VoiceSettings.Stability),
-			SimilarityBoost: api.NewOptNilFloat64(req.VoiceSettings.SimilarityBoost),
-			Style:           api.NewOptNilFloat64(req.VoiceSettings.Style),
-		}
-		if req.VoiceSettings.Speed != 0 {
-			vs.Speed = api.NewOptNilFloat64(req.VoiceSettings.Speed)
-		}
-		body.VoiceSettings = api.NewOptVoiceSettingsResponseModel(vs)
+		body.VoiceSettings = api.NewOptVoiceSettingsResponseModel(apiVoiceSettings(req.VoiceSettings, legacyTTS))
 	}
 
 	// Set language code if provided
 	if req.LanguageCode != "" {
 		body.LanguageCode = api.NewOptNilString(req.LanguageCode)
 	}
+	if req.Seed > 0 {
+		body.Seed = api.NewOptNilInt(req.Seed)
+	}
+	if req.PreviousText != "" {
+		body.PreviousText = api.NewOptNilString(req.PreviousText)
+	}
+	if req.NextText != "" {
+		body.NextText = api.NewOptNilString(req.NextText)
+	}
+	if len(req.PreviousRequestIDs) > 0 {
+		body.PreviousRequestIds = api.NewOptNilStringArray(req.PreviousRequestIDs)
+	}
 
 	// Build params
 	params := api.TextToSpeechFullParams{
@@ -190,20 +284,23 @@ func (s *TextToSpeechService) Generate(ctx context.Context, req *TTSRequest) (*T
 			api.TextToSpeechFullOutputFormat(req.OutputFormat),
 		)
 	}
-
-	// Make the API call
-	resp, err := s.client.apiClient.TextToSpeechFull(ctx, body, params)
-	if err != nil {
-		return nil, err
+	if req.OptimizeStreamingLatency > 0 {
+		params.OptimizeStreamingLatency = api.NewOptNilInt(int(req.OptimizeStreamingLatency))
 	}
+	return body, params
+}
 
-	// Handle response type
-	switch r := resp.(type) {
-	case *api.TextToSpeechFullOK:
-		return &TTSResponse{Audio: r.Data}, nil
-	default:
-		return nil, &APIError{Message: "unexpected response type"}
-	}
+// RequestKey returns a content hash of a request as it is sent: the voice,
+// the output format and latency parameters, and the serialized body,
+// including only the voice settings that are sent. Requests with the same
+// key ask for the same audio. Metadata is not sent, so it is not covered.
+func (r *TTSRequest) RequestKey() string {
+	body, params := ttsFullRequest(r)
+	data, _ := body.MarshalJSON()
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%d\n", params.VoiceID, r.OutputFormat, r.OptimizeStreamingLatency)
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
 }
 
 // GenerateToWriter generates speech and writes it to a writer.
@@ -228,3 +325,68 @@ func (s *TextToSpeechService) Simple(ctx context.Context, voiceID, text string)
 	}
 	return resp.Audio, nil
 }
+
+// TTSBatchItem is one item of a text-to-speech batch.
+type TTSBatchItem struct {
+	// ID names the output file, <OutputDir>/<ID>.<ext>. Defaults to
+	// "tts_001", "tts_002", ... by position.
+	ID string
+
+	// Request is the speech to generate.
+	Request *TTSRequest
+}
+
+// BatchGenerate generates speech for many items concurrently, writing each
+// to <OutputDir>/<ID>.<ext>. Rate limited and server errors are retried.
+// Set BatchOptions.Dedupe to generate repeated lines once, and
+// BatchOptions.Completed to skip items generated by an earlier run.
+//
+// All items are validated before any is generated. The returned error
+// reports invalid items or options; per-item failures are in the results,
+// which are in item order.
+func (s *TextToSpeechService) BatchGenerate(ctx context.Context, items []TTSBatchItem, opts *BatchOptions) ([]BatchResult, error) {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	if opts.OutputDir == "" {
+		return nil, &ValidationError{Field: "output_dir", Message: "cannot be empty"}
+	}
+
+	jobs := make([]batchJob, len(items))
+	seen := make(map[string]bool, len(items))
+	for i, item := range items {
+		id := item.ID
+		if id == "" {
+			id = fmt.Sprintf("tts_%03d", i+1)
+		}
+		if err := checkBatchID(seen, id); err != nil {
+			return nil, err
+		}
+		req := item.Request
+		if req == nil {
+			return nil, fmt.Errorf("item %d (%s): %w", i+1, id, &ValidationError{Field: "request", Message: "cannot be nil"})
+		}
+		if err := req.Validate(); err != nil {
+			return nil, fmt.Errorf("item %d (%s): %w", i+1, id, err)
+		}
+		record := NewTTSGenerationRecord(req)
+		jobs[i] = batchJob{
+			id:     id,
+			path:   batchOutputPath(opts.OutputDir, id, req.OutputFormat),
+			record: record,
+			generate: func(ctx context.Context) (io.Reader, error) {
+				resp, err := s.Generate(ctx, req)
+				if err != nil {
+					return nil, err
+				}
+				record.HistoryItemID = resp.RequestID
+				return resp.Audio, nil
+			},
+		}
+	}
+
+	if err := os.MkdirAll(opts.OutputDir, 0750); err != nil {
+		return nil, fmt.Errorf("create output directory: %w", err)
+	}
+	return s.client.runBatch(ctx, jobs, opts), nil
+}
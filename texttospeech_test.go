@@ -1,8 +1,17 @@
 package elevenlabs
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
 )
 
@@ -260,3 +269,126 @@ func TestTextToSpeechSimple_Live(t *testing.T) {
 		t.Error("Audio.Read() returned 0 bytes")
 	}
 }
+
+func TestTextToSpeechBatchGenerate(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Text string `json:"text"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		calls.Add(1)
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio:" + body.Text))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	ctx := context.Background()
+	dir := t.TempDir()
+	items := []TTSBatchItem{
+		{ID: "intro", Request: &TTSRequest{VoiceID: "v1", Text: "Hello."}},
+		{ID: "outro", Request: &TTSRequest{VoiceID: "v1", Text: "Hello."}},
+		{Request: &TTSRequest{VoiceID: "v2", Text: "Hello."}},
+	}
+
+	var valErr *ValidationError
+	if _, err := client.TextToSpeech().BatchGenerate(ctx, []TTSBatchItem{{ID: "a"}}, &BatchOptions{OutputDir: dir}); !errors.As(err, &valErr) {
+		t.Errorf("BatchGenerate() with nil request error = %v, want ValidationError", err)
+	}
+
+	var manifest bytes.Buffer
+	results, err := client.TextToSpeech().BatchGenerate(ctx, items, &BatchOptions{OutputDir: dir, Dedupe: true, Log: NewGenerationLog(&manifest)})
+	if err != nil {
+		t.Fatalf("BatchGenerate() error = %v", err)
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("requests = %d, want 2 with the duplicate deduplicated", n)
+	}
+	if results[1].Err != nil || results[1].DuplicateOf != "intro" || results[1].Attempts != 0 || results[2].ID != "tts_003" {
+		t.Errorf("results = %+v", results)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "outro.mp3")); string(data) != "audio:Hello." {
+		t.Errorf("outro.mp3 = %q", data)
+	}
+
+	records, err := ReadGenerationRecords(&manifest)
+	if err != nil || len(records) != 2 || records[0].IdempotencyKey == "" {
+		t.Fatalf("manifest = %+v, %v", records, err)
+	}
+
+	// A retried run reuses the recorded outputs instead of regenerating
+	calls.Store(0)
+	items = append(items, TTSBatchItem{ID: "new", Request: &TTSRequest{VoiceID: "v1", Text: "Goodbye."}})
+	results, err = client.TextToSpeech().BatchGenerate(ctx, items, &BatchOptions{OutputDir: dir, Completed: records})
+	if err != nil {
+		t.Fatalf("BatchGenerate() error = %v", err)
+	}
+	if n := calls.Load(); n != 1 {
+		t.Errorf("requests = %d, want 1 for the new item", n)
+	}
+	for i, res := range results[:3] {
+		if !res.Skipped || res.Err != nil || res.Bytes != int64(len("audio:Hello.")) {
+			t.Errorf("result %d = %+v, want skipped", i, res)
+		}
+	}
+	if results[3].Skipped || results[3].Err != nil {
+		t.Errorf("new result = %+v", results[3])
+	}
+}
+
+func TestTextToSpeechBatchGenerateMetadata(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("request-id", fmt.Sprintf("hist%d", calls.Add(1)))
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	items := []TTSBatchItem{
+		{ID: "a", Request: &TTSRequest{VoiceID: "v1", Text: "Hello.", Metadata: map[string]string{"course": "go-101"}}},
+	}
+	var log bytes.Buffer
+	if _, err := client.TextToSpeech().BatchGenerate(context.Background(), items, &BatchOptions{OutputDir: t.TempDir(), Log: NewGenerationLog(&log)}); err != nil {
+		t.Fatalf("BatchGenerate() error = %v", err)
+	}
+	records, err := ReadGenerationRecords(&log)
+	if err != nil || len(records) != 1 {
+		t.Fatalf("records = %+v, %v", records, err)
+	}
+	if rec := records[0]; rec.HistoryItemID != "hist1" || rec.Metadata["course"] != "go-101" {
+		t.Errorf("record = %+v", rec)
+	}
+	if got := GenerationMetadata(records); got["hist1"]["course"] != "go-101" || len(got) != 1 {
+		t.Errorf("GenerationMetadata() = %v", got)
+	}
+
+	// Metadata doesn't change the generation key
+	labeled := NewTTSGenerationRecord(items[0].Request)
+	plain := NewTTSGenerationRecord(&TTSRequest{VoiceID: "v1", Text: "Hello."})
+	if GenerationKey(labeled) != GenerationKey(plain) {
+		t.Error("metadata changed the generation key")
+	}
+}
+
+func TestTTSRequestKey(t *testing.T) {
+	literal := &TTSRequest{VoiceID: "v1", Text: "Hello.", VoiceSettings: &VoiceSettings{Stability: 0.5}}
+	built := &TTSRequest{VoiceID: "v1", Text: "Hello.", VoiceSettings: NewVoiceSettings().WithStability(0.5)}
+
+	// The literal also sends a zero similarity boost and style
+	if literal.RequestKey() == built.RequestKey() {
+		t.Error("requests sending different voice settings share a key")
+	}
+	labeled := *built
+	labeled.Metadata = map[string]string{"course": "go-101"}
+	if labeled.RequestKey() != built.RequestKey() {
+		t.Error("Metadata, which is not sent, changed the key")
+	}
+	other := *built
+	other.VoiceID = "v2"
+	if other.RequestKey() == built.RequestKey() {
+		t.Error("requests for different voices share a key")
+	}
+}
@@ -0,0 +1,247 @@
+package elevenlabs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// DefaultReaderChunkChars is the default maximum number of characters
+// GenerateFromReader sends per request.
+const DefaultReaderChunkChars = 2500
+
+// maxStitchRequestIDs is the most request IDs a request can continue.
+const maxStitchRequestIDs = 3
+
+// ReaderTTSOptions configures GenerateFromReader.
+type ReaderTTSOptions struct {
+	// ModelID is the model to use. Defaults to DefaultModelID.
+	ModelID string
+
+	// VoiceSettings configures the voice parameters.
+	// If nil, default settings will be used.
+	VoiceSettings *VoiceSettings
+
+	// OutputFormat specifies the audio output format. MP3 and PCM chunks
+	// concatenate cleanly.
+	OutputFormat string
+
+	// LanguageCode is the ISO 639-1 language code for text normalization.
+	LanguageCode string
+
+	// Seed for deterministic generation of every chunk.
+	Seed int
+
+	// MaxChunkChars is the maximum number of characters per request.
+	// Defaults to DefaultReaderChunkChars, or the model's character limit
+	// if it is lower and known (see WithModelChecks).
+	MaxChunkChars int
+
+	// OnChunk, if set, is called after each chunk's audio is generated,
+	// with the 0-based chunk index and its text, for progress reporting.
+	OnChunk func(index int, text string)
+}
+
+// GenerateFromReader generates speech for text too long for one request,
+// such as a book chapter. The text is read from r and split at sentence
+// boundaries into chunks under the character limit, which are generated
+// one after another. Each request continues the previous ones (see
+// TTSRequest.PreviousRequestIDs) so the speech flows across chunks.
+//
+// The returned reader yields the concatenated audio as chunks finish; a
+// failed chunk is returned as a read error. The caller must close it,
+// which stops generation.
+//
+// Example:
+//
+//	f, _ := os.Open("chapter1.txt")
+//	audio, err := client.TextToSpeech().GenerateFromReader(ctx, voiceID, f, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	defer audio.Close()
+//	_, err = io.Copy(out, audio)
+func (s *TextToSpeechService) GenerateFromReader(ctx context.Context, voiceID string, r io.Reader, opts *ReaderTTSOptions) (io.ReadCloser, error) {
+	if voiceID == "" {
+		return nil, ErrEmptyVoiceID
+	}
+	if opts == nil {
+		opts = &ReaderTTSOptions{}
+	}
+	if opts.MaxChunkChars < 0 {
+		return nil, &ValidationError{Field: "MaxChunkChars", Message: "cannot be negative"}
+	}
+	settings := opts.VoiceSettings
+	if settings == nil {
+		settings = DefaultVoiceSettings()
+	}
+
+	chunker := &sentenceChunker{r: bufio.NewReader(r), max: s.readerChunkChars(ctx, opts)}
+	first, err := chunker.next()
+	if err != nil {
+		return nil, fmt.Errorf("reading text: %w", err)
+	}
+	if first == "" {
+		return nil, ErrEmptyText
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	pr, pw := io.Pipe()
+	go func() {
+		defer cancel()
+		var previousText string
+		var previousIDs []string
+		for i, text := 0, first; text != ""; i++ {
+			next, err := chunker.next()
+			if err != nil {
+				pw.CloseWithError(fmt.Errorf("reading text: %w", err))
+				return
+			}
+			req := &TTSRequest{
+				VoiceID:            voiceID,
+				Text:               text,
+				ModelID:            opts.ModelID,
+				VoiceSettings:      settings,
+				OutputFormat:       opts.OutputFormat,
+				LanguageCode:       opts.LanguageCode,
+				Seed:               opts.Seed,
+				NextText:           next,
+				PreviousRequestIDs: previousIDs,
+			}
+			if len(previousIDs) == 0 {
+				req.PreviousText = previousText
+			}
+			resp, err := s.Generate(ctx, req)
+			if err != nil {
+				pw.CloseWithError(fmt.Errorf("chunk %d: %w", i+1, err))
+				return
+			}
+			if _, err := io.Copy(pw, resp.Audio); err != nil {
+				return // reader closed
+			}
+			if opts.OnChunk != nil {
+				opts.OnChunk(i, text)
+			}
+
+			if resp.RequestID != "" {
+				ids := append(append([]string(nil), previousIDs...), resp.RequestID)
+				if len(ids) > maxStitchRequestIDs {
+					ids = ids[len(ids)-maxStitchRequestIDs:]
+				}
+				previousIDs = ids
+			}
+			previousText, text = text, next
+		}
+		pw.Close()
+	}()
+	return &readerTTSStream{PipeReader: pr, cancel: cancel}, nil
+}
+
+// readerChunkChars returns the chunk size for GenerateFromReader.
+func (s *TextToSpeechService) readerChunkChars(ctx context.Context, opts *ReaderTTSOptions) int {
+	if opts.MaxChunkChars > 0 {
+		return opts.MaxChunkChars
+	}
+	size := DefaultReaderChunkChars
+	if s.client.modelCache != nil {
+		modelID := opts.ModelID
+		if modelID == "" {
+			modelID = DefaultModelID
+		}
+		model, err := s.client.modelCache.get(ctx, s.client.models, modelID)
+		if err == nil && model != nil && model.MaxCharactersSubscribedUser > 0 && model.MaxCharactersSubscribedUser < size {
+			size = model.MaxCharactersSubscribedUser
+		}
+	}
+	return size
+}
+
+// readerTTSStream is the audio of GenerateFromReader. Closing it stops
+// generation.
+type readerTTSStream struct {
+	*io.PipeReader
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer.
+func (s *readerTTSStream) Close() error {
+	s.cancel()
+	return s.PipeReader.Close()
+}
+
+// sentenceChunker splits text from a reader into chunks of at most max
+// characters, ending at sentence boundaries where possible.
+type sentenceChunker struct {
+	r   *bufio.Reader
+	max int
+	buf []rune
+	eof bool
+}
+
+// next returns the next chunk, or "" when the text is exhausted.
+func (c *sentenceChunker) next() (string, error) {
+	// Whitespace between chunks doesn't count toward max
+	for len(c.buf) > 0 && unicode.IsSpace(c.buf[0]) {
+		c.buf = c.buf[1:]
+	}
+
+	// Read one past max to see what follows a boundary at max
+	for !c.eof && len(c.buf) <= c.max {
+		r, _, err := c.r.ReadRune()
+		if err == io.EOF {
+			c.eof = true
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if len(c.buf) > 0 || !unicode.IsSpace(r) {
+			c.buf = append(c.buf, r)
+		}
+	}
+
+	n := len(c.buf)
+	if n > c.max {
+		n = chunkSplit(c.buf, c.max)
+	}
+	chunk := strings.TrimSpace(string(c.buf[:n]))
+	c.buf = c.buf[n:]
+	return chunk, nil
+}
+
+// chunkSplit returns where to end a chunk of at most max runes of text:
+// after the last sentence or line end, else at the last space, else at max.
+func chunkSplit(text []rune, max int) int {
+	for i := max - 1; i > 0; i-- {
+		if isSentenceEnd(text, i) {
+			return i + 1
+		}
+	}
+	for i := max; i > 0; i-- {
+		if unicode.IsSpace(text[i]) {
+			return i
+		}
+	}
+	return max
+}
+
+// isSentenceEnd reports whether text[i] ends a sentence: a line break,
+// full-width terminal punctuation, or terminal punctuation followed by
+// space, allowing closing quotes and brackets in between.
+func isSentenceEnd(text []rune, i int) bool {
+	switch text[i] {
+	case '\n', '。', '！', '？':
+		return true
+	}
+	j := i
+	for j > 0 && strings.ContainsRune(`"')]”’»`, text[j]) {
+		j--
+	}
+	if !strings.ContainsRune(".!?…", text[j]) {
+		return false
+	}
+	return i+1 < len(text) && unicode.IsSpace(text[i+1])
+}
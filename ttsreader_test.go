@@ -0,0 +1,123 @@
+package elevenlabs
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSentenceChunker(t *testing.T) {
+	text := "The first sentence is here. A second one follows! Does a third? " +
+		"\"Quoted,\" she said. Averyveryverylongwordwithoutanybreaksatallinit ends it."
+	c := &sentenceChunker{r: bufio.NewReader(strings.NewReader(text)), max: 40}
+	var chunks []string
+	for {
+		chunk, err := c.next()
+		if err != nil {
+			t.Fatalf("next() error = %v", err)
+		}
+		if chunk == "" {
+			break
+		}
+		if n := utf8.RuneCountInString(chunk); n > 40 {
+			t.Errorf("chunk %q has %d characters", chunk, n)
+		}
+		chunks = append(chunks, chunk)
+	}
+	want := []string{
+		"The first sentence is here.",
+		"A second one follows! Does a third?",
+		"\"Quoted,\" she said.",
+		"Averyveryverylongwordwithoutanybreaksata",
+		"llinit ends it.",
+	}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("chunks = %q, want %q", chunks, want)
+	}
+}
+
+func TestGenerateFromReader(t *testing.T) {
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Header().Set("request-id", fmt.Sprintf("req-%d", len(bodies)))
+		_, _ = fmt.Fprintf(w, "[audio %d]", len(bodies))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	text := "One fish. Two fish. Red fish. Blue fish. Old fish. New fish."
+	var progress []int
+	audio, err := client.TextToSpeech().GenerateFromReader(context.Background(), "voice1", strings.NewReader(text),
+		&ReaderTTSOptions{MaxChunkChars: 12, OnChunk: func(i int, _ string) { progress = append(progress, i) }})
+	if err != nil {
+		t.Fatalf("GenerateFromReader() error = %v", err)
+	}
+	data, err := io.ReadAll(audio)
+	_ = audio.Close()
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if got := string(data); got != "[audio 1][audio 2][audio 3][audio 4][audio 5][audio 6]" {
+		t.Errorf("audio = %q", got)
+	}
+	if len(progress) != 6 || progress[5] != 5 {
+		t.Errorf("OnChunk calls = %v", progress)
+	}
+
+	if bodies[0]["text"] != "One fish." || bodies[0]["next_text"] != "Two fish." || bodies[0]["previous_request_ids"] != nil {
+		t.Errorf("request 1 = %v", bodies[0])
+	}
+	if ids := fmt.Sprint(bodies[1]["previous_request_ids"]); ids != "[req-1]" || bodies[1]["previous_text"] != nil {
+		t.Errorf("request 2 = %v", bodies[1])
+	}
+	if ids := fmt.Sprint(bodies[5]["previous_request_ids"]); ids != "[req-3 req-4 req-5]" || bodies[5]["next_text"] != nil {
+		t.Errorf("request 6 = %v", bodies[5])
+	}
+
+	if _, err := client.TextToSpeech().GenerateFromReader(context.Background(), "voice1", strings.NewReader("  \n "), nil); err != ErrEmptyText {
+		t.Errorf("GenerateFromReader(blank) error = %v, want ErrEmptyText", err)
+	}
+}
+
+func TestGenerateFromReaderError(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 2 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"detail": [{"loc": ["body", "text"], "msg": "bad", "type": "value_error"}]}`))
+			return
+		}
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("[audio]"))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	audio, err := client.TextToSpeech().GenerateFromReader(context.Background(), "voice1",
+		strings.NewReader("First part. Second part. Third part."), &ReaderTTSOptions{MaxChunkChars: 12})
+	if err != nil {
+		t.Fatalf("GenerateFromReader() error = %v", err)
+	}
+	defer audio.Close()
+	data, err := io.ReadAll(audio)
+	if err == nil || !strings.Contains(err.Error(), "chunk 2") || string(data) != "[audio]" {
+		t.Errorf("ReadAll() = %q, %v, want first chunk and a chunk 2 error", data, err)
+	}
+	if requests != 2 {
+		t.Errorf("requests = %d, want 2", requests)
+	}
+}
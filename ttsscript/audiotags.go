@@ -0,0 +1,135 @@
+package ttsscript
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Audio tags are expressive directions for ElevenLabs v3 models, such as
+// [whispers] or [laughs]. Scripts mark them inline as islands, so they
+// aren't confused with bracketed text:
+//
+//	"text": {"en": "{{tag:whispers}} It's a secret. {{tag:laughs}} Not anymore."}
+//
+// or per segment with Segment.Directives, which apply before the text.
+// ElevenLabsFormatter writes them as v3 audio tags when the segment's model
+// supports them, and removes them otherwise. SSML and Studio output omit
+// them.
+
+// audioTagPattern matches an audio tag island and captures the tag.
+var audioTagPattern = regexp.MustCompile(`\{\{tag:([^{}]*)\}\}`)
+
+// KnownAudioTags are the ElevenLabs v3 audio tags that lint accepts
+// without a warning. v3 interprets other tags too, less predictably.
+var KnownAudioTags = []string{
+	// Emotions and delivery
+	"angry", "annoyed", "appalled", "calm", "cautiously", "cheerfully",
+	"crying", "curious", "excited", "happy", "impressed", "mischievously",
+	"nervous", "sad", "sarcastic", "serious", "surprised", "thoughtful",
+	"whispers", "shouting", "dramatically", "hesitant", "sorrowful",
+	// Non-verbal sounds
+	"laughs", "laughs harder", "starts laughing", "chuckles", "giggles",
+	"wheezing", "sighs", "exhales", "gasps", "snorts", "clears throat",
+	"gulps", "breathes",
+	// Pacing
+	"pause", "short pause", "long pause", "rushed", "slows down",
+}
+
+// SupportsAudioTags reports whether an ElevenLabs model reads audio tags.
+// Other models speak them, so they are removed for those.
+func SupportsAudioTags(modelID string) bool {
+	return strings.HasPrefix(modelID, "eleven_v3")
+}
+
+// HasAudioTags reports whether text contains an audio tag island.
+func HasAudioTags(text string) bool {
+	return audioTagPattern.MatchString(text)
+}
+
+// ValidateAudioTags returns an error if an audio tag in text is not closed
+// with "}}".
+func ValidateAudioTags(text string) error {
+	if strings.Contains(audioTagPattern.ReplaceAllString(text, ""), "{{tag:") {
+		return fmt.Errorf("unterminated audio tag")
+	}
+	return nil
+}
+
+// AudioTags returns the tags in text, in order.
+func AudioTags(text string) []string {
+	var tags []string
+	for _, m := range audioTagPattern.FindAllStringSubmatch(text, -1) {
+		tags = append(tags, strings.TrimSpace(m[1]))
+	}
+	return tags
+}
+
+// AudioTagText converts the audio tag islands in text to v3 tags
+// ("[whispers]") when keep is true, and removes them otherwise.
+func AudioTagText(text string, keep bool) string {
+	if !HasAudioTags(text) {
+		return text
+	}
+	result := audioTagPattern.ReplaceAllStringFunc(text, func(island string) string {
+		if !keep {
+			return ""
+		}
+		return "[" + strings.TrimSpace(audioTagPattern.FindStringSubmatch(island)[1]) + "]"
+	})
+	if !keep {
+		result = strings.Join(strings.Fields(result), " ")
+	}
+	return result
+}
+
+// withDirectives prepends a segment's directives to its text as audio tag
+// islands.
+func withDirectives(directives []string, text string) string {
+	var sb strings.Builder
+	for _, d := range directives {
+		if d = strings.TrimSpace(d); d != "" {
+			sb.WriteString("{{tag:" + d + "}} ")
+		}
+	}
+	return sb.String() + text
+}
+
+// isKnownAudioTag reports whether tag is in KnownAudioTags, ignoring case.
+func isKnownAudioTag(tag string) bool {
+	for _, known := range KnownAudioTags {
+		if strings.EqualFold(tag, known) {
+			return true
+		}
+	}
+	return false
+}
+
+// LintAudioTags warns about audio tags in a language that may not work:
+// empty or unknown tags, and tags in segments whose model doesn't support
+// them, which are removed. modelID is the model for segments and scripts
+// that don't set one.
+func LintAudioTags(script *Script, language, modelID string) []string {
+	var warnings []string
+	for i, slide := range script.Slides {
+		for j, seg := range slide.Segments {
+			tags := append(append([]string(nil), seg.Directives...), AudioTags(seg.Text[language])...)
+			if len(tags) == 0 {
+				continue
+			}
+			where := fmt.Sprintf("slide %d, segment %d", i+1, j+1)
+			for _, tag := range tags {
+				switch tag = strings.TrimSpace(tag); {
+				case tag == "":
+					warnings = append(warnings, where+": empty audio tag")
+				case !isKnownAudioTag(tag):
+					warnings = append(warnings, fmt.Sprintf("%s: unknown audio tag %q", where, tag))
+				}
+			}
+			if model := firstNonEmpty(seg.Model, script.DefaultModel, modelID); !SupportsAudioTags(model) {
+				warnings = append(warnings, fmt.Sprintf("%s: model %q doesn't support audio tags; they will be removed", where, model))
+			}
+		}
+	}
+	return warnings
+}
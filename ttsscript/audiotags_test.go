@@ -0,0 +1,52 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAudioTags(t *testing.T) {
+	script := &Script{
+		DefaultVoices:  map[string]string{"en": "v1"},
+		Pronunciations: map[string]map[string]string{"laughs": {"en": "LAFFS"}},
+		Slides: []Slide{{Segments: []Segment{
+			{Text: map[string]string{"en": "It's a secret. {{tag:laughs}} Not anymore."}, Directives: []string{"whispers"}},
+			{Text: map[string]string{"en": "Plain."}, Model: "eleven_v3"},
+		}}},
+	}
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if want := "{{tag:whispers}} It's a secret. {{tag:laughs}} Not anymore."; segments[0].Text != want {
+		t.Errorf("compiled text = %q, want %q", segments[0].Text, want)
+	}
+
+	formatter := NewElevenLabsFormatter()
+	if got := formatter.Format(segments)[0].Text; got != "It's a secret. Not anymore." {
+		t.Errorf("Format() without v3 = %q", got)
+	}
+	formatter.ModelID = "eleven_v3"
+	if got := formatter.Format(segments)[0].Text; got != "[whispers] It's a secret. [laughs] Not anymore." {
+		t.Errorf("Format() with v3 = %q", got)
+	}
+
+	ssml, _ := NewSSMLFormatter().FormatScript(script, "en")
+	if strings.Contains(ssml, "tag:") || strings.Contains(ssml, "whispers") {
+		t.Errorf("SSML contains audio tags: %s", ssml)
+	}
+
+	script.Slides[0].Segments[0].Directives = append(script.Slides[0].Segments[0].Directives, "yodels")
+	warnings := strings.Join(LintAudioTags(script, "en", "eleven_multilingual_v2"), "\n")
+	if !strings.Contains(warnings, `unknown audio tag "yodels"`) || !strings.Contains(warnings, "slide 1, segment 1: model") {
+		t.Errorf("LintAudioTags() = %s", warnings)
+	}
+	if strings.Contains(warnings, "segment 2") {
+		t.Errorf("LintAudioTags() warned about a segment without tags: %s", warnings)
+	}
+
+	script.Slides[0].Segments[1].Text["en"] = "Open {{tag:sighs"
+	if issues := strings.Join(script.Validate(), "\n"); !strings.Contains(issues, "unterminated audio tag") {
+		t.Errorf("Validate() = %s", issues)
+	}
+}
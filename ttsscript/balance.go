@@ -0,0 +1,162 @@
+package ttsscript
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// Slide balance flags.
+const (
+	// BalanceLong marks a slide with much more narration than most.
+	BalanceLong = "long"
+
+	// BalanceShort marks a slide with much less narration than most.
+	BalanceShort = "short"
+)
+
+// Default thresholds for AnalyzeBalance, as multiples of the median slide
+// length.
+const (
+	DefaultBalanceLongRatio  = 2.0
+	DefaultBalanceShortRatio = 0.5
+)
+
+// BalanceOptions configures AnalyzeBalance.
+type BalanceOptions struct {
+	// LongRatio flags slides longer than this multiple of the median.
+	// Defaults to DefaultBalanceLongRatio.
+	LongRatio float64
+
+	// ShortRatio flags slides shorter than this multiple of the median.
+	// Defaults to DefaultBalanceShortRatio.
+	ShortRatio float64
+}
+
+// SlideBalance describes the narration length of one slide.
+type SlideBalance struct {
+	SlideIndex int    `json:"slide_index"`
+	Title      string `json:"title,omitempty"`
+
+	// Segments is the number of spoken segments, including a spoken title.
+	Segments int `json:"segments"`
+
+	// Characters is the total narration length.
+	Characters int `json:"characters"`
+
+	// MinSegment, MedianSegment, and MaxSegment describe the distribution
+	// of segment lengths in characters.
+	MinSegment    int `json:"min_segment"`
+	MedianSegment int `json:"median_segment"`
+	MaxSegment    int `json:"max_segment"`
+
+	// EstimatedMs is the estimated speaking time, excluding pauses.
+	EstimatedMs int `json:"estimated_ms"`
+
+	// Ratio is Characters divided by the median slide length.
+	Ratio float64 `json:"ratio"`
+
+	// Flag is BalanceLong, BalanceShort, or empty for a balanced slide.
+	Flag string `json:"flag,omitempty"`
+}
+
+// BalanceReport describes how narration is spread across slides.
+type BalanceReport struct {
+	Language string `json:"language"`
+
+	// MedianCharacters is the median narration length of slides with
+	// narration.
+	MedianCharacters int `json:"median_characters"`
+
+	// Slides are all slides in order.
+	Slides []SlideBalance `json:"slides"`
+}
+
+// Outliers returns the flagged slides.
+func (r *BalanceReport) Outliers() []SlideBalance {
+	var outliers []SlideBalance
+	for _, s := range r.Slides {
+		if s.Flag != "" {
+			outliers = append(outliers, s)
+		}
+	}
+	return outliers
+}
+
+// AnalyzeBalance reports the narration length of each slide for a language
+// and flags slides that are dramatically longer or shorter than the median,
+// so pacing can be balanced before generation. Slides without narration
+// are listed but not flagged.
+func AnalyzeBalance(script *Script, language string, opts *BalanceOptions) *BalanceReport {
+	if opts == nil {
+		opts = &BalanceOptions{}
+	}
+	longRatio := opts.LongRatio
+	if longRatio <= 0 {
+		longRatio = DefaultBalanceLongRatio
+	}
+	shortRatio := opts.ShortRatio
+	if shortRatio <= 0 {
+		shortRatio = DefaultBalanceShortRatio
+	}
+
+	report := &BalanceReport{Language: language}
+	var totals []int
+	for slideIdx, slide := range script.Slides {
+		var texts []string
+		if slide.ShouldSpeakTitle() {
+			if title := slide.SpokenTitle(language); title != "" {
+				texts = append(texts, title)
+			}
+		}
+		for _, seg := range slide.Segments {
+			if text := seg.Text[language]; text != "" {
+				texts = append(texts, text)
+			}
+		}
+
+		sb := SlideBalance{SlideIndex: slideIdx, Title: slide.Title, Segments: len(texts)}
+		lengths := make([]int, len(texts))
+		for i, text := range texts {
+			lengths[i] = utf8.RuneCountInString(text)
+			sb.Characters += lengths[i]
+			sb.EstimatedMs += EstimateDuration(text, language)
+		}
+		if len(lengths) > 0 {
+			sort.Ints(lengths)
+			sb.MinSegment = lengths[0]
+			sb.MedianSegment = medianInt(lengths)
+			sb.MaxSegment = lengths[len(lengths)-1]
+			totals = append(totals, sb.Characters)
+		}
+		report.Slides = append(report.Slides, sb)
+	}
+
+	if len(totals) == 0 {
+		return report
+	}
+	sort.Ints(totals)
+	report.MedianCharacters = medianInt(totals)
+	for i := range report.Slides {
+		sb := &report.Slides[i]
+		if sb.Characters == 0 || report.MedianCharacters == 0 {
+			continue
+		}
+		sb.Ratio = float64(sb.Characters) / float64(report.MedianCharacters)
+		switch {
+		case sb.Ratio > longRatio:
+			sb.Flag = BalanceLong
+		case sb.Ratio < shortRatio:
+			sb.Flag = BalanceShort
+		}
+	}
+	return report
+}
+
+// medianInt returns the median of sorted values.
+func medianInt(sorted []int) int {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
@@ -0,0 +1,66 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalyzeBalance(t *testing.T) {
+	text := func(n int) map[string]string {
+		return map[string]string{"en": strings.Repeat("a", n)}
+	}
+	script := &Script{
+		Slides: []Slide{
+			{Title: "Intro", Segments: []Segment{{Text: text(100)}}},
+			{Title: "Setup", Segments: []Segment{{Text: text(40)}, {Text: text(60)}, {Text: text(20)}}},
+			{Title: "Deep dive", Segments: []Segment{{Text: text(150)}, {Text: text(150)}}},
+			{Title: "Recap", Segments: []Segment{{Text: text(30)}}},
+			{Title: "Diagram", Segments: []Segment{{Text: map[string]string{"es": "Hola"}}}},
+			{Title: "Part 2", IsSectionHeader: true, Segments: []Segment{{Text: text(94)}}},
+		},
+	}
+
+	report := AnalyzeBalance(script, "en", nil)
+	if len(report.Slides) != 6 {
+		t.Fatalf("slides = %d, want 6", len(report.Slides))
+	}
+	if report.MedianCharacters != 100 {
+		t.Errorf("MedianCharacters = %d, want 100", report.MedianCharacters)
+	}
+
+	setup := report.Slides[1]
+	if setup.Segments != 3 || setup.Characters != 120 || setup.MinSegment != 20 || setup.MedianSegment != 40 || setup.MaxSegment != 60 {
+		t.Errorf("setup slide = %+v", setup)
+	}
+	if setup.EstimatedMs <= 0 {
+		t.Errorf("EstimatedMs = %d, want an estimate", setup.EstimatedMs)
+	}
+	if header := report.Slides[5]; header.Segments != 2 || header.Characters != 100 {
+		t.Errorf("section header slide = %+v, want the spoken title counted", header)
+	}
+
+	var flags []string
+	for _, s := range report.Outliers() {
+		flags = append(flags, s.Title+":"+s.Flag)
+	}
+	if got := strings.Join(flags, ","); got != "Deep dive:long,Recap:short" {
+		t.Errorf("outliers = %s, want the long and short slides; empty slides are not flagged", got)
+	}
+}
+
+func TestAnalyzeBalanceOptions(t *testing.T) {
+	script := &Script{
+		Slides: []Slide{
+			{Segments: []Segment{{Text: map[string]string{"en": strings.Repeat("a", 100)}}}},
+			{Segments: []Segment{{Text: map[string]string{"en": strings.Repeat("a", 150)}}}},
+			{Segments: []Segment{{Text: map[string]string{"en": strings.Repeat("a", 100)}}}},
+		},
+	}
+	if outliers := AnalyzeBalance(script, "en", nil).Outliers(); len(outliers) != 0 {
+		t.Errorf("default outliers = %+v, want none", outliers)
+	}
+	outliers := AnalyzeBalance(script, "en", &BalanceOptions{LongRatio: 1.25}).Outliers()
+	if len(outliers) != 1 || outliers[0].SlideIndex != 1 {
+		t.Errorf("outliers = %+v, want slide 1", outliers)
+	}
+}
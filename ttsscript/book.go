@@ -0,0 +1,350 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// ChapterKind identifies where a chapter sits in a book.
+type ChapterKind string
+
+// Chapter kinds.
+const (
+	ChapterFrontMatter ChapterKind = "front"
+	ChapterBody        ChapterKind = "body"
+	ChapterBackMatter  ChapterKind = "back"
+)
+
+// Book is a long-form script organized as Parts → Chapters → Sections, with
+// optional front and back matter. Sections are Slides, so every slide
+// feature (titles, defaults, profiles) is available within a chapter.
+//
+// A Book is compiled by flattening it into a Script (see Book.Script), so the
+// regular compiler, formatters, and selectors apply unchanged.
+type Book struct {
+	// Title is the book title.
+	Title string `json:"title,omitempty"`
+
+	// Author is the book author.
+	Author string `json:"author,omitempty"`
+
+	// Description is an optional description.
+	Description string `json:"description,omitempty"`
+
+	// DefaultLanguage is the primary language code.
+	DefaultLanguage string `json:"default_language,omitempty"`
+
+	// DefaultVoices maps language codes to default voice IDs.
+	DefaultVoices map[string]string `json:"default_voices,omitempty"`
+
+	// Pronunciations maps terms to their pronunciation by language.
+	Pronunciations map[string]map[string]string `json:"pronunciations,omitempty"`
+
+	// Profiles are named prosody profiles.
+	Profiles map[string]ProsodyProfile `json:"profiles,omitempty"`
+
+	// FrontMatter are chapters before the body (e.g., dedication, preface).
+	FrontMatter []Chapter `json:"front_matter,omitempty"`
+
+	// Parts are the body of the book. A book without parts can use a single
+	// part with no title.
+	Parts []Part `json:"parts"`
+
+	// BackMatter are chapters after the body (e.g., afterword, credits).
+	BackMatter []Chapter `json:"back_matter,omitempty"`
+}
+
+// Part groups chapters. A part with a Title has it narrated before its
+// first chapter.
+type Part struct {
+	// Title is the part title (optional).
+	Title string `json:"title,omitempty"`
+
+	// TitleText is the spoken part title by language code.
+	TitleText map[string]string `json:"title_text,omitempty"`
+
+	// Chapters are the chapters in this part.
+	Chapters []Chapter `json:"chapters"`
+}
+
+// Chapter is a unit of per-chapter output, made up of sections.
+type Chapter struct {
+	// ID is a stable identifier used in output filenames (optional).
+	ID string `json:"id,omitempty"`
+
+	// Title is the chapter title.
+	Title string `json:"title,omitempty"`
+
+	// TitleText is the spoken chapter title by language code.
+	// If not set for a language, Title is spoken as-is.
+	TitleText map[string]string `json:"title_text,omitempty"`
+
+	// TitleVoice overrides the voice for the chapter heading, by language.
+	TitleVoice map[string]string `json:"title_voice,omitempty"`
+
+	// SpeakTitle controls whether the chapter heading is narrated.
+	// Defaults to true when Title is set.
+	SpeakTitle *bool `json:"speak_title,omitempty"`
+
+	// Sections are the chapter's sections.
+	Sections []Slide `json:"sections"`
+}
+
+// BookChapter describes where a chapter sits in the flattened script.
+type BookChapter struct {
+	// Index is the 0-based chapter index across the whole book.
+	Index int
+
+	// ID is the chapter ID, or "chNN" if the chapter has none.
+	ID string
+
+	// Title is the chapter title.
+	Title string
+
+	// Kind is front matter, body, or back matter.
+	Kind ChapterKind
+
+	// Part is the title of the containing part (body chapters only).
+	Part string
+
+	// FirstSlide is the index of the chapter's first slide in the flattened
+	// script, including any heading slides.
+	FirstSlide int
+
+	// SlideCount is the number of slides in the flattened script.
+	SlideCount int
+
+	// HeadingSlides is the number of narrated part and chapter heading
+	// slides at the start of the chapter.
+	HeadingSlides int
+}
+
+// CompiledChapter is a chapter with its compiled segments.
+type CompiledChapter struct {
+	BookChapter
+
+	// Segments are the chapter's compiled segments, in order.
+	Segments []CompiledSegment
+}
+
+// headingPause is the pause after a narrated part or chapter heading.
+const headingPause = "1s"
+
+// LoadBook loads a book from a JSON file.
+func LoadBook(filePath string) (*Book, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading book file: %w", err)
+	}
+	return ParseBook(data)
+}
+
+// ParseBook parses a book from JSON data.
+func ParseBook(data []byte) (*Book, error) {
+	var book Book
+	if err := json.Unmarshal(data, &book); err != nil {
+		return nil, fmt.Errorf("parsing book JSON: %w", err)
+	}
+	return &book, nil
+}
+
+// ParseBookLenient parses a book from JSON data, accepting comments and
+// trailing commas as ParseScriptLenient does.
+func ParseBookLenient(data []byte) (*Book, error) {
+	return ParseBook(stripJSONC(data))
+}
+
+// IsBook reports whether JSON data, which may contain comments, is a Book
+// rather than a Script: it has parts and no slides.
+func IsBook(data []byte) bool {
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(stripJSONC(data), &keys); err != nil {
+		return false
+	}
+	_, hasParts := keys["parts"]
+	_, hasSlides := keys["slides"]
+	return hasParts && !hasSlides
+}
+
+// Chapters returns all chapters in reading order with their kind and part.
+func (b *Book) Chapters() []BookChapter {
+	_, chapters := b.Script()
+	return chapters
+}
+
+// Script flattens the book into a Script and returns the location of each
+// chapter within it. Narrated part and chapter headings become section
+// header slides with a single segment, so they get their own audio files.
+func (b *Book) Script() (*Script, []BookChapter) {
+	script := &Script{
+		Title:           b.Title,
+		Description:     b.Description,
+		DefaultLanguage: b.DefaultLanguage,
+		DefaultVoices:   b.DefaultVoices,
+		Pronunciations:  b.Pronunciations,
+		Profiles:        b.Profiles,
+	}
+	languages := b.languages()
+
+	var chapters []BookChapter
+	addChapter := func(ch Chapter, kind ChapterKind, part string, heading *Slide) {
+		info := BookChapter{
+			Index:      len(chapters),
+			ID:         ch.ID,
+			Title:      ch.Title,
+			Kind:       kind,
+			Part:       part,
+			FirstSlide: len(script.Slides),
+		}
+		if info.ID == "" {
+			info.ID = fmt.Sprintf("ch%02d", info.Index+1)
+		}
+		if heading != nil {
+			script.Slides = append(script.Slides, *heading)
+			info.HeadingSlides++
+		}
+		speak := ch.Title != ""
+		if ch.SpeakTitle != nil {
+			speak = *ch.SpeakTitle
+		}
+		if speak {
+			script.Slides = append(script.Slides, headingSlide(info.ID+"-title", ch.Title, ch.TitleText, ch.TitleVoice, languages))
+			info.HeadingSlides++
+		}
+		script.Slides = append(script.Slides, ch.Sections...)
+		info.SlideCount = len(script.Slides) - info.FirstSlide
+		chapters = append(chapters, info)
+	}
+
+	for _, ch := range b.FrontMatter {
+		addChapter(ch, ChapterFrontMatter, "", nil)
+	}
+	for i, part := range b.Parts {
+		for j, ch := range part.Chapters {
+			var heading *Slide
+			if j == 0 && part.Title != "" {
+				slide := headingSlide(fmt.Sprintf("part%02d-title", i+1), part.Title, part.TitleText, nil, languages)
+				heading = &slide
+			}
+			addChapter(ch, ChapterBody, part.Title, heading)
+		}
+	}
+	for _, ch := range b.BackMatter {
+		addChapter(ch, ChapterBackMatter, "", nil)
+	}
+
+	return script, chapters
+}
+
+// headingSlide creates a section header slide narrating a heading in each language.
+func headingSlide(id, title string, titleText, voice map[string]string, languages []string) Slide {
+	text := make(map[string]string, len(languages))
+	for _, lang := range languages {
+		text[lang] = firstNonEmpty(titleText[lang], title)
+	}
+	for lang, t := range titleText {
+		text[lang] = t
+	}
+	speakTitle := false // the heading segment already narrates the title
+	return Slide{
+		Title:           title,
+		IsSectionHeader: true,
+		SpeakTitle:      &speakTitle,
+		Segments: []Segment{{
+			ID:         id,
+			Text:       text,
+			Voice:      voice,
+			PauseAfter: headingPause,
+		}},
+	}
+}
+
+// languages returns all language codes used in the book's sections.
+func (b *Book) languages() []string {
+	langs := make(map[string]bool)
+	addAll := func(chapters []Chapter) {
+		for _, ch := range chapters {
+			for _, slide := range ch.Sections {
+				for _, seg := range slide.Segments {
+					for lang := range seg.Text {
+						langs[lang] = true
+					}
+				}
+			}
+		}
+	}
+	addAll(b.FrontMatter)
+	for _, part := range b.Parts {
+		addAll(part.Chapters)
+	}
+	addAll(b.BackMatter)
+	if b.DefaultLanguage != "" {
+		langs[b.DefaultLanguage] = true
+	}
+	return sortedKeys(langs)
+}
+
+// Validate checks the book for common issues.
+func (b *Book) Validate() []string {
+	var issues []string
+
+	script, chapters := b.Script()
+	if len(chapters) == 0 {
+		issues = append(issues, "book has no chapters")
+	}
+
+	seen := make(map[string]bool)
+	for _, ch := range chapters {
+		if seen[ch.ID] {
+			issues = append(issues, fmt.Sprintf("duplicate chapter ID %q", ch.ID))
+		}
+		seen[ch.ID] = true
+	}
+
+	all := append(append(append([]Chapter{}, b.FrontMatter...), b.bodyChapters()...), b.BackMatter...)
+	for i, ch := range all {
+		if len(ch.Sections) == 0 {
+			issues = append(issues, fmt.Sprintf("chapter %s has no sections", chapters[i].ID))
+		}
+	}
+
+	if len(script.Slides) > 0 {
+		issues = append(issues, script.Validate()...)
+	}
+	return issues
+}
+
+// bodyChapters returns the chapters of all parts in order.
+func (b *Book) bodyChapters() []Chapter {
+	var chapters []Chapter
+	for _, part := range b.Parts {
+		chapters = append(chapters, part.Chapters...)
+	}
+	return chapters
+}
+
+// CompileBook compiles a book for a language and splits the result into
+// per-chapter segment lists. Slide indexes in the segments refer to the
+// flattened script, so output filenames stay unique across chapters.
+func (c *Compiler) CompileBook(book *Book, language string, opts ...CompileOption) ([]CompiledChapter, error) {
+	script, chapters := book.Script()
+	segments, err := c.Compile(script, language, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]CompiledChapter, len(chapters))
+	for i, ch := range chapters {
+		result[i].BookChapter = ch
+	}
+	for _, seg := range segments {
+		for i, ch := range chapters {
+			if seg.SlideIndex >= ch.FirstSlide && seg.SlideIndex < ch.FirstSlide+ch.SlideCount {
+				result[i].Segments = append(result[i].Segments, seg)
+				break
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,135 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testBookJSON = `{
+	"title": "The Book",
+	"default_voices": {"en": "narrator"},
+	"front_matter": [
+		{"id": "preface", "title": "Preface", "sections": [
+			{"segments": [{"text": {"en": "Why I wrote this."}}]}
+		]}
+	],
+	"parts": [
+		{"title": "Part One", "chapters": [
+			{"title": "Beginnings", "title_voice": {"en": "announcer"}, "sections": [
+				{"segments": [{"text": {"en": "It began."}}, {"text": {"en": "Then more."}}]},
+				{"title": "Later", "speak_title": true, "segments": [{"text": {"en": "Later on."}}]}
+			]},
+			{"title": "Middles", "sections": [
+				{"segments": [{"text": {"en": "In the middle."}}]}
+			]}
+		]}
+	],
+	"back_matter": [
+		{"id": "credits", "speak_title": false, "title": "Credits", "sections": [
+			{"segments": [{"text": {"en": "Thanks."}}]}
+		]}
+	]
+}`
+
+func TestBookScript(t *testing.T) {
+	book, err := ParseBook([]byte(testBookJSON))
+	if err != nil {
+		t.Fatalf("ParseBook failed: %v", err)
+	}
+	if issues := book.Validate(); len(issues) > 0 {
+		t.Fatalf("unexpected validation issues: %v", issues)
+	}
+
+	script, chapters := book.Script()
+	if len(chapters) != 4 {
+		t.Fatalf("expected 4 chapters, got %d", len(chapters))
+	}
+
+	wantKinds := []ChapterKind{ChapterFrontMatter, ChapterBody, ChapterBody, ChapterBackMatter}
+	wantIDs := []string{"preface", "ch02", "ch03", "credits"}
+	wantHeadings := []int{1, 2, 1, 0}
+	for i, ch := range chapters {
+		if ch.Kind != wantKinds[i] || ch.ID != wantIDs[i] || ch.HeadingSlides != wantHeadings[i] {
+			t.Errorf("chapter %d = %+v, want kind %s id %s headings %d", i, ch, wantKinds[i], wantIDs[i], wantHeadings[i])
+		}
+	}
+	if chapters[1].Part != "Part One" {
+		t.Errorf("expected part title, got %q", chapters[1].Part)
+	}
+
+	// preface heading + section, part heading + chapter heading + 2 sections,
+	// chapter heading + section, credits section
+	if len(script.Slides) != 9 {
+		t.Errorf("expected 9 slides, got %d", len(script.Slides))
+	}
+}
+
+func TestCompileBook(t *testing.T) {
+	book, err := ParseBook([]byte(testBookJSON))
+	if err != nil {
+		t.Fatalf("ParseBook failed: %v", err)
+	}
+
+	chapters, err := NewCompiler().CompileBook(book, "en")
+	if err != nil {
+		t.Fatalf("CompileBook failed: %v", err)
+	}
+
+	ch := chapters[1]
+	// part heading, chapter heading, 2 segments, section title, 1 segment
+	if len(ch.Segments) != 6 {
+		t.Fatalf("expected 6 segments in chapter 2, got %d", len(ch.Segments))
+	}
+	if ch.Segments[0].Text != "Part One" || ch.Segments[1].Text != "Beginnings" {
+		t.Errorf("unexpected headings: %q, %q", ch.Segments[0].Text, ch.Segments[1].Text)
+	}
+	if ch.Segments[1].VoiceID != "announcer" || ch.Segments[2].VoiceID != "narrator" {
+		t.Errorf("unexpected voices: %q, %q", ch.Segments[1].VoiceID, ch.Segments[2].VoiceID)
+	}
+	if ch.Segments[1].PauseAfterMs != 1000 {
+		t.Errorf("expected 1s pause after heading, got %dms", ch.Segments[1].PauseAfterMs)
+	}
+
+	if len(chapters[3].Segments) != 1 || chapters[3].Segments[0].Text != "Thanks." {
+		t.Errorf("expected credits without heading, got %+v", chapters[3].Segments)
+	}
+
+	content := StudioContent(chapters)
+	if len(content) != 4 || content[1].Name != "Beginnings" {
+		t.Fatalf("unexpected studio content: %+v", content)
+	}
+	var subTypes []string
+	for _, b := range content[1].Blocks {
+		subTypes = append(subTypes, b.SubType)
+	}
+	want := []string{"h1", "h1", "p", "p", "h2", "p"}
+	data, _ := json.Marshal(subTypes)
+	wantData, _ := json.Marshal(want)
+	if string(data) != string(wantData) {
+		t.Errorf("block sub types = %s, want %s", data, wantData)
+	}
+}
+
+func TestBookValidate(t *testing.T) {
+	book := &Book{
+		Parts: []Part{{Chapters: []Chapter{{ID: "a"}, {ID: "a", Sections: []Slide{{Segments: []Segment{{Text: map[string]string{"en": "x"}}}}}}}}},
+	}
+	issues := book.Validate()
+	if len(issues) != 2 {
+		t.Errorf("expected duplicate ID and empty chapter issues, got %v", issues)
+	}
+}
+
+func TestIsBook(t *testing.T) {
+	if !IsBook([]byte(testBookJSON)) {
+		t.Error("IsBook(book) = false")
+	}
+	if !IsBook([]byte("// audiobook\n{\"parts\": [],}")) {
+		t.Error("IsBook(book with comments) = false")
+	}
+	for _, data := range []string{`{"title": "Course", "slides": []}`, `not json`, `[]`} {
+		if IsBook([]byte(data)) {
+			t.Errorf("IsBook(%s) = true", data)
+		}
+	}
+}
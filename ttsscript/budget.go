@@ -0,0 +1,68 @@
+package ttsscript
+
+import "fmt"
+
+// Budget caps what one generation run may spend, so a script that grew
+// unexpectedly, such as after a bad merge, stops the run instead of using
+// the account's character quota. Only generated segments count: cached,
+// pre-recorded, and resumed audio is free. Zero fields mean no limit.
+type Budget struct {
+	// MaxCharacters is the most characters a run may send for generation.
+	MaxCharacters int
+
+	// MaxSegments is the most segments a run may generate.
+	MaxSegments int
+}
+
+// Exceeded returns why generating seg, as the given number of takes,
+// would exceed the budget after the usage recorded in r, or an empty
+// string if it fits.
+func (b Budget) Exceeded(r *RunReport, seg ElevenLabsSegment, takes int) string {
+	if takes < 1 {
+		takes = 1
+	}
+	if b.MaxSegments > 0 && r.Generated+1 > b.MaxSegments {
+		return fmt.Sprintf("segment budget %d reached", b.MaxSegments)
+	}
+	if b.MaxCharacters > 0 && r.Characters+len([]rune(seg.Text))*takes > b.MaxCharacters {
+		return fmt.Sprintf("character budget %d reached (%d used)", b.MaxCharacters, r.Characters)
+	}
+	return ""
+}
+
+// CompletedHashes returns the hash of each complete entry of a previous
+// run's manifest, by output file. A segment whose output file is listed
+// with its current hash was already generated, so a stopped run can be
+// resumed without generating it again.
+func CompletedHashes(previous []ManifestEntry) map[string]string {
+	hashes := make(map[string]string)
+	for _, e := range previous {
+		if e.Status == EntryComplete && e.Hash != "" {
+			hashes[e.OutputFile] = e.Hash
+		}
+	}
+	return hashes
+}
+
+// CarryOver copies the outcome of the previous run's entry for e's output
+// file to e: its status, hash, and the measured audio. Entries a run
+// doesn't process, such as segments outside a selector, keep the audio
+// they had, so the next resumed run doesn't generate them again. It
+// reports whether the previous run had an entry for the file.
+func (e *ManifestEntry) CarryOver(previous []ManifestEntry) bool {
+	for _, prev := range previous {
+		if prev.OutputFile != e.OutputFile {
+			continue
+		}
+		e.Status = prev.Status
+		e.MissingReason = prev.MissingReason
+		e.Hash = prev.Hash
+		e.DurationMs = prev.DurationMs
+		e.TrimmedStartMs = prev.TrimmedStartMs
+		e.TrimmedEndMs = prev.TrimmedEndMs
+		e.Take = prev.Take
+		e.Voice = prev.Voice
+		return true
+	}
+	return false
+}
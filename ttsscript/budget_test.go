@@ -0,0 +1,75 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBudgetExceeded(t *testing.T) {
+	report := NewRunReport(nil, "en")
+	report.RecordGenerated(ElevenLabsSegment{Text: "Hello", VoiceID: "v"})
+	report.RecordCached(ElevenLabsSegment{Text: "Cached text", VoiceID: "v"})
+	report.RecordResumed(ElevenLabsSegment{Text: "Resumed text", VoiceID: "v"})
+	next := ElevenLabsSegment{Text: "World", VoiceID: "v"}
+
+	tests := []struct {
+		name   string
+		budget Budget
+		takes  int
+		want   string
+	}{
+		{"no limits", Budget{}, 1, ""},
+		{"characters fit exactly", Budget{MaxCharacters: 10}, 1, ""},
+		{"characters exceeded", Budget{MaxCharacters: 9}, 1, "character budget 9 reached (5 used)"},
+		{"takes are billed", Budget{MaxCharacters: 14}, 2, "character budget 14 reached"},
+		{"segments fit", Budget{MaxSegments: 2}, 1, ""},
+		{"segments exceeded", Budget{MaxSegments: 1}, 1, "segment budget 1 reached"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.budget.Exceeded(report, next, tt.takes)
+			if tt.want == "" && got != "" {
+				t.Errorf("expected no stop, got %q", got)
+			}
+			if tt.want != "" && !strings.HasPrefix(got, tt.want) {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCompletedHashes(t *testing.T) {
+	previous := []ManifestEntry{
+		{OutputFile: "a.mp3", Hash: "h1", Status: EntryComplete},
+		{OutputFile: "b.mp3", Hash: "h2", Status: EntryFailed},
+		{OutputFile: "c.mp3", Hash: "h3"},
+		{OutputFile: "d.mp3", Status: EntryComplete},
+	}
+	got := CompletedHashes(previous)
+	if len(got) != 1 || got["a.mp3"] != "h1" {
+		t.Errorf("unexpected completed hashes: %v", got)
+	}
+}
+
+func TestManifestEntryCarryOver(t *testing.T) {
+	previous := []ManifestEntry{
+		{OutputFile: "a.mp3", Hash: "old", Status: EntryComplete, DurationMs: 1200, Take: 2},
+	}
+	entry := ManifestEntry{OutputFile: "a.mp3", Hash: "new"}
+	if !entry.CarryOver(previous) {
+		t.Fatal("CarryOver() = false, want true")
+	}
+	if entry.Status != EntryComplete || entry.Hash != "old" || entry.DurationMs != 1200 || entry.Take != 2 {
+		t.Errorf("carried over entry = %+v", entry)
+	}
+
+	// The previous audio is for the old text, so it is not resumed
+	if got := CompletedHashes([]ManifestEntry{entry}); got["a.mp3"] != "old" {
+		t.Errorf("CompletedHashes() = %v", got)
+	}
+
+	other := ManifestEntry{OutputFile: "b.mp3", Hash: "new"}
+	if other.CarryOver(previous) || other.Status != "" || other.Hash != "new" {
+		t.Errorf("entry without a previous run = %+v", other)
+	}
+}
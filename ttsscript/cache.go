@@ -0,0 +1,285 @@
+package ttsscript
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AudioCache is a content-addressed directory of generated audio that can
+// be shared across scripts and projects. Audio is stored under a hash of
+// everything that affects it (text, voice, model, and settings), so
+// identical segments, such as a legal disclaimer repeated across dozens of
+// courses, are generated once.
+//
+// Hashes are hex SHA-256 digests, and files are stored as
+// <dir>/<hash[:2]>/<hash><ext>. Stats and GC only count files laid out
+// that way. A file's modification time records when it was last used, so
+// GC removes the least recently used audio first. Concurrent runs may
+// share a cache.
+type AudioCache struct {
+	// Dir is the cache directory.
+	Dir string
+}
+
+// NewAudioCache creates an audio cache in dir. The directory is created
+// when audio is first stored.
+func NewAudioCache(dir string) *AudioCache {
+	return &AudioCache{Dir: dir}
+}
+
+// Path returns the cache path for a hash and file extension (e.g., ".mp3").
+func (c *AudioCache) Path(hash, ext string) string {
+	shard := hash
+	if len(shard) > 2 {
+		shard = shard[:2]
+	}
+	return filepath.Join(c.Dir, shard, hash+ext)
+}
+
+// Get copies the cached audio for a hash to dst. It returns false if the
+// audio is not cached.
+func (c *AudioCache) Get(hash, ext, dst string) (bool, error) {
+	src := c.Path(hash, ext)
+	in, err := os.Open(src)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("opening cached audio: %w", err)
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return false, fmt.Errorf("creating file: %w", err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return false, fmt.Errorf("copying cached audio: %w", err)
+	}
+	if err := out.Close(); err != nil {
+		return false, fmt.Errorf("writing file: %w", err)
+	}
+
+	// Mark as recently used; a failure only affects GC order
+	now := time.Now()
+	_ = os.Chtimes(src, now, now)
+	return true, nil
+}
+
+// Put stores a copy of the audio file src under a hash, replacing any
+// cached audio for it.
+func (c *AudioCache) Put(hash, ext, src string) error {
+	dst := c.Path(hash, ext)
+	if err := os.MkdirAll(filepath.Dir(dst), 0750); err != nil {
+		return fmt.Errorf("creating cache directory: %w", err)
+	}
+
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("opening audio: %w", err)
+	}
+	defer in.Close()
+
+	// Write to a temporary file first so readers never see partial audio
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("creating cache file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, in); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing cache file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing cache file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return fmt.Errorf("storing cache file: %w", err)
+	}
+	return nil
+}
+
+// CacheStats describes the contents of an audio cache.
+type CacheStats struct {
+	// Files is the number of cached audio files.
+	Files int `json:"files"`
+
+	// Bytes is the total size of the cached audio.
+	Bytes int64 `json:"bytes"`
+}
+
+// CacheGCResult is the outcome of an audio cache GC.
+type CacheGCResult struct {
+	// Removed is the number of files removed.
+	Removed int `json:"removed"`
+
+	// FreedBytes is the total size of the removed files.
+	FreedBytes int64 `json:"freed_bytes"`
+
+	// Remaining describes the cache after GC.
+	Remaining CacheStats `json:"remaining"`
+}
+
+type cacheFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+}
+
+// files returns the cached audio files: files named <hash><ext> in a shard
+// directory named <hash[:2]>, as Path lays them out. Anything else in the
+// directory is left alone, so pointing the cache at the wrong directory
+// can't delete unrelated files. A missing cache directory is empty.
+func (c *AudioCache) files() ([]cacheFile, error) {
+	shards, err := os.ReadDir(c.Dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("reading cache directory: %w", err)
+	}
+
+	var files []cacheFile
+	for _, shard := range shards {
+		if !shard.IsDir() || !isCacheShard(shard.Name()) {
+			continue
+		}
+		dir := filepath.Join(c.Dir, shard.Name())
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, fmt.Errorf("reading cache directory: %w", err)
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || !isCacheFile(shard.Name(), entry.Name()) {
+				continue
+			}
+			info, err := entry.Info()
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, fmt.Errorf("reading cache directory: %w", err)
+			}
+			files = append(files, cacheFile{path: filepath.Join(dir, entry.Name()), size: info.Size(), modTime: info.ModTime()})
+		}
+	}
+	return files, nil
+}
+
+// cacheHashLen is the length of a hex SHA-256 cache hash.
+const cacheHashLen = 64
+
+// isCacheShard reports whether name is a shard directory: two lowercase
+// hex digits.
+func isCacheShard(name string) bool {
+	return len(name) == 2 && isLowerHex(name)
+}
+
+// isCacheFile reports whether name is cached audio in a shard: a hash
+// starting with the shard, followed by a file extension.
+func isCacheFile(shard, name string) bool {
+	if len(name) <= cacheHashLen || !strings.HasPrefix(name, shard) {
+		return false
+	}
+	hash, ext := name[:cacheHashLen], name[cacheHashLen:]
+	return isLowerHex(hash) && len(ext) > 1 && ext[0] == '.' && !strings.ContainsAny(ext[1:], "./")
+}
+
+func isLowerHex(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// Stats returns the number and total size of the cached audio files.
+func (c *AudioCache) Stats() (CacheStats, error) {
+	files, err := c.files()
+	if err != nil {
+		return CacheStats{}, err
+	}
+	var stats CacheStats
+	for _, f := range files {
+		stats.Files++
+		stats.Bytes += f.size
+	}
+	return stats, nil
+}
+
+// GC removes the least recently used audio until the cache is at most
+// maxBytes. A maxBytes of 0 empties the cache.
+func (c *AudioCache) GC(maxBytes int64) (CacheGCResult, error) {
+	files, err := c.files()
+	if err != nil {
+		return CacheGCResult{}, err
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].modTime.Before(files[j].modTime)
+	})
+
+	var result CacheGCResult
+	for _, f := range files {
+		result.Remaining.Files++
+		result.Remaining.Bytes += f.size
+	}
+	for _, f := range files {
+		if result.Remaining.Bytes <= maxBytes {
+			break
+		}
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return result, fmt.Errorf("removing cache file: %w", err)
+		}
+		result.Removed++
+		result.FreedBytes += f.size
+		result.Remaining.Files--
+		result.Remaining.Bytes -= f.size
+	}
+	return result, nil
+}
+
+// byteSizeUnits are the suffixes accepted by ParseByteSize, longest first.
+var byteSizeUnits = []struct {
+	suffix string
+	scale  int64
+}{
+	{"TB", 1 << 40}, {"GB", 1 << 30}, {"MB", 1 << 20}, {"KB", 1 << 10},
+	{"T", 1 << 40}, {"G", 1 << 30}, {"M", 1 << 20}, {"K", 1 << 10},
+	{"B", 1},
+}
+
+// ParseByteSize parses a size such as "500MB", "2G", "1.5GB", or "1024".
+// Units are binary (1KB = 1024 bytes) and case-insensitive.
+func ParseByteSize(s string) (int64, error) {
+	str := strings.ToUpper(strings.TrimSpace(s))
+	scale := int64(1)
+	for _, u := range byteSizeUnits {
+		if strings.HasSuffix(str, u.suffix) {
+			str = strings.TrimSpace(strings.TrimSuffix(str, u.suffix))
+			scale = u.scale
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(str, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(n * float64(scale)), nil
+}
+
+// FormatByteSize formats a size with a binary unit, e.g. "1.5 GB".
+func FormatByteSize(n int64) string {
+	for _, u := range byteSizeUnits[:4] {
+		if n >= u.scale {
+			return strconv.FormatFloat(float64(n)/float64(u.scale), 'f', 1, 64) + " " + u.suffix
+		}
+	}
+	return strconv.FormatInt(n, 10) + " B"
+}
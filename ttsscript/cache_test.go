@@ -0,0 +1,169 @@
+package ttsscript
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAudioCache(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewAudioCache(filepath.Join(dir, "cache"))
+
+	dst := filepath.Join(dir, "out.mp3")
+	if ok, err := cache.Get("abcdef", ".mp3", dst); ok || err != nil {
+		t.Fatalf("Get() on empty cache = %v, %v", ok, err)
+	}
+	if stats, err := cache.Stats(); err != nil || stats.Files != 0 {
+		t.Fatalf("Stats() on missing dir = %+v, %v", stats, err)
+	}
+
+	src := filepath.Join(dir, "generated.mp3")
+	if err := os.WriteFile(src, []byte("audio"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Put("abcdef", ".mp3", src); err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if want := filepath.Join(dir, "cache", "ab", "abcdef.mp3"); cache.Path("abcdef", ".mp3") != want || !fileExistsForTest(want) {
+		t.Errorf("cached file not at %s", want)
+	}
+
+	ok, err := cache.Get("abcdef", ".mp3", dst)
+	if !ok || err != nil {
+		t.Fatalf("Get() = %v, %v", ok, err)
+	}
+	if data, _ := os.ReadFile(dst); string(data) != "audio" {
+		t.Errorf("restored audio = %q", data)
+	}
+}
+
+func TestAudioCacheGC(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewAudioCache(dir)
+	src := filepath.Join(t.TempDir(), "audio.mp3")
+	if err := os.WriteFile(src, make([]byte, 100), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Oldest first: aa, bb, cc
+	aa, bb, cc := testCacheHash("aa"), testCacheHash("bb"), testCacheHash("cc")
+	base := time.Now().Add(-time.Hour)
+	for i, hash := range []string{aa, bb, cc} {
+		if err := cache.Put(hash, ".mp3", src); err != nil {
+			t.Fatal(err)
+		}
+		at := base.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(cache.Path(hash, ".mp3"), at, at); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Using the oldest entry makes it the most recent
+	if _, err := cache.Get(aa, ".mp3", filepath.Join(t.TempDir(), "out.mp3")); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := cache.GC(150)
+	if err != nil {
+		t.Fatalf("GC() error = %v", err)
+	}
+	if result.Removed != 2 || result.FreedBytes != 200 || result.Remaining.Files != 1 || result.Remaining.Bytes != 100 {
+		t.Errorf("GC() = %+v", result)
+	}
+	if !fileExistsForTest(cache.Path(aa, ".mp3")) || fileExistsForTest(cache.Path(bb, ".mp3")) {
+		t.Error("GC() should keep the most recently used file")
+	}
+
+	if result, err := cache.GC(0); err != nil || result.Remaining.Files != 0 {
+		t.Errorf("GC(0) = %+v, %v", result, err)
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"1024", 1024},
+		{"500MB", 500 << 20},
+		{"2g", 2 << 30},
+		{"1.5 GB", 3 << 29},
+		{"10K", 10 << 10},
+		{"0", 0},
+	}
+	for _, tt := range tests {
+		got, err := ParseByteSize(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseByteSize(%q) = %d, %v, want %d", tt.input, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "MB", "-1GB", "lots"} {
+		if _, err := ParseByteSize(bad); err == nil {
+			t.Errorf("ParseByteSize(%q) should fail", bad)
+		}
+	}
+
+	if got := FormatByteSize(3 << 29); got != "1.5 GB" {
+		t.Errorf("FormatByteSize() = %q", got)
+	}
+	if got := FormatByteSize(512); got != "512 B" {
+		t.Errorf("FormatByteSize() = %q", got)
+	}
+}
+
+func fileExistsForTest(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+func TestAudioCacheGCKeepsForeignFiles(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewAudioCache(dir)
+	src := filepath.Join(t.TempDir(), "audio.mp3")
+	if err := os.WriteFile(src, make([]byte, 100), 0600); err != nil {
+		t.Fatal(err)
+	}
+	hash := testCacheHash("ab")
+	if err := cache.Put(hash, ".mp3", src); err != nil {
+		t.Fatal(err)
+	}
+
+	// Files a cache never writes, as if -dir pointed at an output directory
+	foreign := []string{
+		filepath.Join(dir, "notes.txt"),
+		filepath.Join(dir, "ab", "lecture.mp3"),
+		filepath.Join(dir, "cd", hash+".mp3"),
+		filepath.Join(dir, "slides", "ab", hash+".mp3"),
+	}
+	for _, path := range foreign {
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("keep"), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if stats, err := cache.Stats(); err != nil || stats.Files != 1 {
+		t.Errorf("Stats() = %+v, %v, want only the cached audio", stats, err)
+	}
+	result, err := cache.GC(0)
+	if err != nil || result.Removed != 1 {
+		t.Fatalf("GC(0) = %+v, %v", result, err)
+	}
+	if fileExistsForTest(cache.Path(hash, ".mp3")) {
+		t.Error("GC(0) kept the cached audio")
+	}
+	for _, path := range foreign {
+		if !fileExistsForTest(path) {
+			t.Errorf("GC(0) removed %s", path)
+		}
+	}
+}
+
+// testCacheHash returns a 64-digit hex hash starting with prefix.
+func testCacheHash(prefix string) string {
+	return prefix + strings.Repeat("0", 64-len(prefix))
+}
@@ -0,0 +1,76 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FFMetadata renders the track's title and chapters in ffmpeg's metadata
+// format. Passing it as an extra input with -map_chapters embeds the
+// chapters in MP3 (ID3 CHAP frames) and MP4 outputs.
+func (t *Track) FFMetadata(title string) string {
+	escape := strings.NewReplacer(`\`, `\\`, "=", `\=`, ";", `\;`, "#", `\#`, "\n", `\`+"\n")
+	var b strings.Builder
+	b.WriteString(";FFMETADATA1\n")
+	if title != "" {
+		fmt.Fprintf(&b, "title=%s\nalbum=%s\n", escape.Replace(title), escape.Replace(title))
+	}
+	for _, c := range t.Chapters {
+		fmt.Fprintf(&b, "\n[CHAPTER]\nTIMEBASE=1/1000\nSTART=%d\nEND=%d\ntitle=%s\n", c.StartMs, c.EndMs, escape.Replace(c.Title))
+	}
+	return b.String()
+}
+
+// CUE renders the chapters as a CUE sheet for audioFile, with one track per
+// slide. Paths in CUE sheets are relative to the sheet, so audioFile is
+// usually a base name.
+func (t *Track) CUE(audioFile, title string) string {
+	quote := func(s string) string {
+		return `"` + strings.ReplaceAll(s, `"`, "'") + `"`
+	}
+	var b strings.Builder
+	if title != "" {
+		fmt.Fprintf(&b, "TITLE %s\n", quote(title))
+	}
+	fmt.Fprintf(&b, "FILE %s MP3\n", quote(audioFile))
+	for i, c := range t.Chapters {
+		// CUE times are MM:SS:FF with 75 frames per second
+		frames := c.StartMs * 75 / 1000
+		fmt.Fprintf(&b, "  TRACK %02d AUDIO\n", i+1)
+		fmt.Fprintf(&b, "    TITLE %s\n", quote(c.Title))
+		fmt.Fprintf(&b, "    INDEX 01 %02d:%02d:%02d\n", frames/(75*60), frames/75%60, frames%75)
+	}
+	return b.String()
+}
+
+// podcastChapters is the Podcasting 2.0 chapters format
+// (https://github.com/Podcastindex-org/podcast-namespace/blob/main/chapters/jsonChapters.md).
+type podcastChapters struct {
+	Version  string           `json:"version"`
+	Chapters []podcastChapter `json:"chapters"`
+}
+
+type podcastChapter struct {
+	StartTime float64 `json:"startTime"`
+	EndTime   float64 `json:"endTime,omitempty"`
+	Title     string  `json:"title"`
+}
+
+// PodcastChapters renders the chapters in the Podcasting 2.0 JSON chapters
+// format, referenced from a feed's <podcast:chapters> tag.
+func (t *Track) PodcastChapters() ([]byte, error) {
+	out := podcastChapters{Version: "1.2.0", Chapters: make([]podcastChapter, len(t.Chapters))}
+	for i, c := range t.Chapters {
+		out.Chapters[i] = podcastChapter{
+			StartTime: float64(c.StartMs) / 1000,
+			EndTime:   float64(c.EndMs) / 1000,
+			Title:     c.Title,
+		}
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling podcast chapters: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,66 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func testTrack() *Track {
+	return &Track{
+		Language: "en",
+		Chapters: []ChapterMarker{
+			{SlideIndex: 0, Title: "Intro", StartMs: 0, EndMs: 3800},
+			{SlideIndex: 1, Title: `Q&A; "Live"`, StartMs: 64500, EndMs: 70000},
+		},
+		DurationMs: 70000,
+	}
+}
+
+func TestTrackFFMetadata(t *testing.T) {
+	got := testTrack().FFMetadata("Demo")
+	for _, want := range []string{
+		";FFMETADATA1\ntitle=Demo\n",
+		"[CHAPTER]\nTIMEBASE=1/1000\nSTART=0\nEND=3800\ntitle=Intro\n",
+		"START=64500\nEND=70000\ntitle=Q&A\\; \"Live\"\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("FFMetadata() missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestTrackCUE(t *testing.T) {
+	want := `TITLE "Demo"
+FILE "full_en.mp3" MP3
+  TRACK 01 AUDIO
+    TITLE "Intro"
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    TITLE "Q&A; 'Live'"
+    INDEX 01 01:04:37
+`
+	if got := testTrack().CUE("full_en.mp3", "Demo"); got != want {
+		t.Errorf("CUE() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestTrackPodcastChapters(t *testing.T) {
+	data, err := testTrack().PodcastChapters()
+	if err != nil {
+		t.Fatalf("PodcastChapters() error = %v", err)
+	}
+	var got struct {
+		Version  string `json:"version"`
+		Chapters []struct {
+			StartTime float64 `json:"startTime"`
+			Title     string  `json:"title"`
+		} `json:"chapters"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != "1.2.0" || len(got.Chapters) != 2 || got.Chapters[1].StartTime != 64.5 {
+		t.Errorf("PodcastChapters() = %s", data)
+	}
+}
@@ -0,0 +1,161 @@
+package ttsscript
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Cleaning rules for text that is charged by TTS engines but not spoken.
+// Scripts sourced from Markdown or chat tools often carry these.
+const (
+	// CleanMarkdown is Markdown formatting: headings, list bullets,
+	// emphasis markers, backticks, backslash escapes, and link syntax
+	// (the link text is kept).
+	CleanMarkdown = "markdown"
+
+	// CleanURLs is web addresses, which are either skipped or read out
+	// character by character.
+	CleanURLs = "urls"
+
+	// CleanEmoji is emoji and their modifiers.
+	CleanEmoji = "emoji"
+
+	// CleanWhitespace is repeated, leading, and trailing whitespace, and
+	// spaces before punctuation. Single line breaks are kept.
+	CleanWhitespace = "whitespace"
+)
+
+// CleanRules lists the cleaning rules in the order they are applied.
+var CleanRules = []string{CleanMarkdown, CleanURLs, CleanEmoji, CleanWhitespace}
+
+// maxIssueExamples limits the examples recorded per text issue.
+const maxIssueExamples = 3
+
+type cleanReplacement struct {
+	pattern *regexp.Regexp
+	replace string
+}
+
+var cleanReplacements = map[string][]cleanReplacement{
+	CleanMarkdown: {
+		{regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`), "$1"},
+		{regexp.MustCompile(`(?m)^[ \t]*#{1,6}[ \t]+`), ""},
+		{regexp.MustCompile(`(?m)^[ \t]*[-*+][ \t]+`), ""},
+		{regexp.MustCompile(`\\([\\` + "`" + `*_{}\[\]()#+\-.!])`), "$1"},
+		{regexp.MustCompile("\\*+|`+|~~|__"), ""},
+	},
+	CleanURLs: {
+		{regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']*[^\s<>"'.,;:!?)\]]`), ""},
+	},
+	CleanEmoji: {
+		{regexp.MustCompile(`[\x{1F000}-\x{1FAFF}\x{2600}-\x{27BF}\x{2B00}-\x{2BFF}\x{FE0F}\x{200D}\x{20E3}]+`), ""},
+	},
+	CleanWhitespace: {
+		{regexp.MustCompile(`[ \t\r\n\f\v]{2,}`), " "},
+		{regexp.MustCompile(`[ \t]+([.,;:!?])`), "$1"},
+		{regexp.MustCompile(`^\s+|\s+$`), ""},
+	},
+}
+
+// CleanText removes text that would be charged but not spoken, applying
+// every rule in CleanRules except those listed in skip.
+func CleanText(text string, skip ...string) string {
+	for _, rule := range CleanRules {
+		if !containsString(skip, rule) {
+			text = applyCleanRule(rule, text)
+		}
+	}
+	return text
+}
+
+func applyCleanRule(rule, text string) string {
+	for _, r := range cleanReplacements[rule] {
+		text = r.pattern.ReplaceAllString(text, r.replace)
+	}
+	return text
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// TextIssue is text in a segment or spoken title that a cleaning rule
+// would remove.
+type TextIssue struct {
+	// Rule is the cleaning rule, such as CleanMarkdown.
+	Rule string `json:"rule"`
+
+	SlideIndex int `json:"slide_index"`
+
+	// SegmentIndex is -1 for slide titles.
+	SegmentIndex int `json:"segment_index"`
+
+	// WastedChars is the number of characters the rule would remove.
+	WastedChars int `json:"wasted_chars"`
+
+	// Examples are the first matches, for display.
+	Examples []string `json:"examples"`
+}
+
+// String describes the issue, e.g. `slide 2, segment 1: urls wastes 23
+// characters ("https://example.com/docs")`.
+func (i TextIssue) String() string {
+	where := fmt.Sprintf("slide %d, segment %d", i.SlideIndex+1, i.SegmentIndex+1)
+	if i.SegmentIndex < 0 {
+		where = fmt.Sprintf("slide %d, title", i.SlideIndex+1)
+	}
+	quoted := make([]string, len(i.Examples))
+	for k, ex := range i.Examples {
+		quoted[k] = fmt.Sprintf("%q", ex)
+	}
+	return fmt.Sprintf("%s: %s wastes %d characters (%s)", where, i.Rule, i.WastedChars, strings.Join(quoted, ", "))
+}
+
+// LintText scans the script text for a language and reports text that the
+// cleaning rules would remove, except rules listed in skip. Each rule is
+// measured on its own, so the counts of overlapping rules may add up to
+// more than CleanText removes.
+func LintText(script *Script, language string, skip ...string) []TextIssue {
+	var issues []TextIssue
+	check := func(text string, slideIdx, segIdx int) {
+		for _, rule := range CleanRules {
+			if containsString(skip, rule) {
+				continue
+			}
+			wasted := utf8.RuneCountInString(text) - utf8.RuneCountInString(applyCleanRule(rule, text))
+			if wasted <= 0 {
+				continue
+			}
+			issue := TextIssue{Rule: rule, SlideIndex: slideIdx, SegmentIndex: segIdx, WastedChars: wasted}
+			for _, r := range cleanReplacements[rule] {
+				for _, m := range r.pattern.FindAllString(text, -1) {
+					if len(issue.Examples) < maxIssueExamples {
+						issue.Examples = append(issue.Examples, m)
+					}
+				}
+			}
+			issues = append(issues, issue)
+		}
+	}
+
+	for slideIdx, slide := range script.Slides {
+		if slide.ShouldSpeakTitle() {
+			if title := slide.SpokenTitle(language); title != "" {
+				check(title, slideIdx, -1)
+			}
+		}
+		for segIdx, seg := range slide.Segments {
+			if text := seg.Text[language]; text != "" {
+				check(text, slideIdx, segIdx)
+			}
+		}
+	}
+	return issues
+}
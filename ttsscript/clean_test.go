@@ -0,0 +1,119 @@
+package ttsscript
+
+import (
+	"testing"
+)
+
+func TestCleanText(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		skip []string
+		want string
+	}{
+		{"plain", "Hello world.", nil, "Hello world."},
+		{"whitespace", "  Hello   world.\n\n Next ", nil, "Hello world. Next"},
+		{"heading", "## Getting started\nInstall it.", nil, "Getting started\nInstall it."},
+		{"emphasis", "This is **really** `important`.", nil, "This is really important."},
+		{"escapes", `We are \#1 and \*proud\*.`, nil, "We are #1 and proud."},
+		{"link", "See [the docs](https://example.com/docs) for more.", nil, "See the docs for more."},
+		{"bullets", "- first\n- second", nil, "first\nsecond"},
+		{"url", "Visit https://example.com/a?b=c. Thanks!", nil, "Visit. Thanks!"},
+		{"www", "Go to www.example.com today", nil, "Go to today"},
+		{"emoji", "Launch day 🚀🎉! Thumbs 👍🏽 ❤️", nil, "Launch day! Thumbs"},
+		{"keep urls", "Visit https://example.com  now", []string{CleanURLs}, "Visit https://example.com now"},
+		{"keep markdown", "A **bold**  move", []string{CleanMarkdown, CleanWhitespace}, "A **bold**  move"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CleanText(tt.text, tt.skip...); got != tt.want {
+				t.Errorf("CleanText(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintText(t *testing.T) {
+	script := &Script{
+		Slides: []Slide{
+			{
+				Title:           "# Intro 🎉",
+				IsSectionHeader: true,
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Read https://example.com/docs for **details**."}},
+					{Text: map[string]string{"en": "Clean text."}},
+				},
+			},
+		},
+	}
+
+	issues := LintText(script, "en")
+	got := make(map[string]TextIssue)
+	for _, issue := range issues {
+		got[issue.Rule+":"+string(rune('0'+issue.SegmentIndex+1))] = issue
+	}
+	if len(issues) != 4 {
+		t.Fatalf("LintText() = %v", issues)
+	}
+	if issue := got["markdown:0"]; issue.WastedChars != 2 {
+		t.Errorf("title markdown issue = %+v", issue)
+	}
+	if issue := got["emoji:0"]; issue.WastedChars != 1 {
+		t.Errorf("title emoji issue = %+v", issue)
+	}
+	if issue := got["urls:1"]; issue.WastedChars != 24 || issue.Examples[0] != "https://example.com/docs" {
+		t.Errorf("segment url issue = %+v", issue)
+	}
+	if issue := got["markdown:1"]; issue.WastedChars != 4 {
+		t.Errorf("segment markdown issue = %+v", issue)
+	}
+	if want := `slide 1, segment 1: urls wastes 24 characters ("https://example.com/docs")`; got["urls:1"].String() != want {
+		t.Errorf("String() = %q, want %q", got["urls:1"].String(), want)
+	}
+
+	// Trailing whitespace left by a removed URL is only counted by its own rule
+	if issues := LintText(script, "en", CleanURLs, CleanMarkdown, CleanEmoji); len(issues) != 0 {
+		t.Errorf("LintText() with rules skipped = %v", issues)
+	}
+}
+
+func TestCompilerStripInaudible(t *testing.T) {
+	script := &Script{
+		Slides: []Slide{
+			{
+				Title:           "🎉",
+				IsSectionHeader: true,
+				Segments: []Segment{
+					{Text: map[string]string{"en": "See **the** [docs](https://example.com)."}},
+					{Text: map[string]string{"en": "👍"}},
+				},
+			},
+		},
+	}
+
+	compiler := NewCompiler()
+	compiler.StripInaudible = true
+	result, err := compiler.CompileWithResult(script, "en")
+	if err != nil {
+		t.Fatalf("CompileWithResult() error = %v", err)
+	}
+	if len(result.Segments) != 1 {
+		t.Fatalf("expected 1 segment, got %+v", result.Segments)
+	}
+	seg := result.Segments[0]
+	if seg.Text != "See the docs." || seg.OriginalText != "See **the** [docs](https://example.com)." {
+		t.Errorf("segment text = %q, original = %q", seg.Text, seg.OriginalText)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].SegmentIndex != 1 {
+		t.Errorf("Skipped = %+v", result.Skipped)
+	}
+
+	compiler.KeepInaudible = []string{CleanMarkdown, CleanURLs}
+	segments, err := compiler.Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if segments[0].Text != "See **the** [docs](https://example.com)." {
+		t.Errorf("Text with markdown kept = %q", segments[0].Text)
+	}
+}
@@ -1,8 +1,10 @@
 package ttsscript
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -17,6 +19,34 @@ type Compiler struct {
 
 	// DefaultPauseAfterSegment is the pause after each segment if not specified.
 	DefaultPauseAfterSegment string
+
+	// IncludeSlideTitles emits a title segment for every slide that has a
+	// title, not just section headers. Slides with SpeakTitle explicitly set
+	// to false are still skipped.
+	IncludeSlideTitles bool
+
+	// ErrOnMissingLanguage makes Compile return an error when a segment has
+	// no text for the requested language (or any fallback language) instead
+	// of skipping it.
+	ErrOnMissingLanguage bool
+
+	// Trace records which pronunciation rules fired for each compiled segment
+	// in CompiledSegment.PronunciationTrace.
+	Trace bool
+
+	// StripInaudible removes text that would be charged but not spoken
+	// (see CleanText) before pronunciations are applied. Segments left
+	// with no text are skipped.
+	StripInaudible bool
+
+	// KeepInaudible lists cleaning rules (e.g., CleanURLs) to skip when
+	// StripInaudible is set.
+	KeepInaudible []string
+
+	// Processors is the text processing pipeline run on each segment and
+	// spoken title, in order. Nil means DefaultProcessors. Set it to add
+	// custom processors; include the built-in ones to keep their effect.
+	Processors []TextProcessor
 }
 
 // NewCompiler creates a new script compiler with default settings.
@@ -28,6 +58,57 @@ func NewCompiler() *Compiler {
 	}
 }
 
+// ErrMissingLanguage is returned when a segment has no text for the requested
+// language and Compiler.ErrOnMissingLanguage is set.
+var ErrMissingLanguage = errors.New("ttsscript: segment missing language")
+
+// ErrUnknownProfile is returned when a segment references a prosody profile
+// that is not defined in Script.Profiles.
+var ErrUnknownProfile = errors.New("ttsscript: unknown prosody profile")
+
+// CompileOption configures a single Compile call.
+type CompileOption func(*compileOptions)
+
+// compileOptions holds per-call compile options.
+type compileOptions struct {
+	fallbacks     []string
+	processors    []TextProcessor
+	processorsSet bool
+}
+
+// WithFallback sets a chain of languages to use, in order, when a segment has
+// no text for the requested language.
+//
+// Example: Compile(script, "es", WithFallback("en"))
+func WithFallback(languages ...string) CompileOption {
+	return func(o *compileOptions) {
+		o.fallbacks = append(o.fallbacks, languages...)
+	}
+}
+
+// SkippedSegment references a segment that was omitted from compilation.
+type SkippedSegment struct {
+	// SlideIndex is the 0-based slide index.
+	SlideIndex int
+
+	// SegmentIndex is the 0-based segment index within the slide.
+	SegmentIndex int
+
+	// Reason describes why the segment was skipped.
+	Reason string
+}
+
+// CompileResult is the detailed result of compiling a script.
+type CompileResult struct {
+	// Segments are the compiled segments ready for TTS processing.
+	Segments []CompiledSegment
+
+	// Skipped lists segments that were omitted because they had no text
+	// for the requested language or any fallback language, or none left
+	// after cleaning.
+	Skipped []SkippedSegment
+}
+
 // CompiledSegment represents a compiled segment ready for TTS.
 type CompiledSegment struct {
 	// SlideIndex is the 0-based slide index.
@@ -37,6 +118,9 @@ type CompiledSegment struct {
 	// For title segments, this is -1.
 	SegmentIndex int
 
+	// ID is the segment ID from the script (empty for title segments).
+	ID string
+
 	// SlideTitle is the slide title (if any).
 	SlideTitle string
 
@@ -49,21 +133,43 @@ type CompiledSegment struct {
 	// Text is the processed text with pronunciations applied.
 	Text string
 
-	// OriginalText is the text before pronunciation substitutions.
+	// OriginalText is the script text, before cleaning and pronunciation
+	// substitutions.
 	OriginalText string
 
 	// VoiceID is the voice to use for this segment.
 	VoiceID string
 
+	// Engine is the TTS engine to generate with, from the segment or
+	// Script.EngineRoutes. Empty means the default engine.
+	Engine string
+
 	// Language is the language code.
 	Language string
 
+	// FallbackLanguage is set when the segment text was taken from a
+	// fallback language because the requested language was missing.
+	// Voice and pronunciations are resolved for this language.
+	FallbackLanguage string
+
 	// PauseBeforeMs is the pause before in milliseconds.
 	PauseBeforeMs int
 
 	// PauseAfterMs is the pause after in milliseconds.
 	PauseAfterMs int
 
+	// FadeInMs and FadeOutMs are post-processing fades in milliseconds.
+	FadeInMs  int
+	FadeOutMs int
+
+	// GainDB is the post-processing gain adjustment in decibels.
+	GainDB float64
+
+	// CrossfadeMs is the crossfade from the previous segment into this one
+	// when they are concatenated, in milliseconds. It is set only between
+	// back-to-back segments of the same voice (see Slide.Crossfade).
+	CrossfadeMs int
+
 	// Emphasis is the emphasis level.
 	Emphasis string
 
@@ -72,20 +178,116 @@ type CompiledSegment struct {
 
 	// Pitch is the pitch adjustment.
 	Pitch string
+
+	// Volume is the loudness adjustment (from the prosody profile).
+	Volume string
+
+	// Profile is the name of the resolved prosody profile, if any.
+	Profile string
+
+	// ModelID is the TTS model, from the segment or Script.DefaultModel.
+	// Empty means the engine's default.
+	ModelID string
+
+	// Seed is the generation seed from the segment (0 for none).
+	Seed int
+
+	// ProsodyProfile is the resolved prosody profile, if any.
+	// Formatters use it for engine-specific settings.
+	ProsodyProfile *ProsodyProfile
+
+	// PronunciationTrace lists the pronunciation rules applied to this segment,
+	// in order of occurrence. Only populated when Compiler.Trace is enabled.
+	PronunciationTrace []PronunciationHit
+
+	// Assets are the slide's assets resolved for the segment language.
+	Assets []LocalizedAsset
+
+	// AudioFile is the pre-recorded audio to use instead of generating
+	// speech, as given in the script. Text is its transcript, if any.
+	AudioFile string
+
+	// IsEarcon indicates this segment is the script's speaker change
+	// earcon, played before the segment at SegmentIndex. AudioFile is the
+	// earcon audio and Text is empty.
+	IsEarcon bool
+}
+
+// PronunciationHit records a single pronunciation substitution.
+type PronunciationHit struct {
+	// Term is the pronunciation rule term that matched.
+	Term string `json:"term"`
+
+	// Matched is the text as it appeared in the source.
+	Matched string `json:"matched"`
+
+	// Replacement is the substituted text.
+	Replacement string `json:"replacement"`
+
+	// Source is where the rule was defined ("compiler", "segment", or "script").
+	Source string `json:"source"`
 }
 
 // Compile compiles the script for the specified language.
 // Returns a slice of compiled segments ready for TTS processing.
-func (c *Compiler) Compile(script *Script, language string) ([]CompiledSegment, error) {
+// Use CompileWithResult to find out which segments were skipped.
+func (c *Compiler) Compile(script *Script, language string, opts ...CompileOption) ([]CompiledSegment, error) {
+	result, err := c.CompileWithResult(script, language, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return result.Segments, nil
+}
+
+// CompileWithResult compiles the script for the specified language and
+// reports segments that were skipped because of missing language text.
+func (c *Compiler) CompileWithResult(script *Script, language string, opts ...CompileOption) (*CompileResult, error) {
+	options := &compileOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	processors := c.Processors
+	if options.processorsSet {
+		processors = options.processors
+	} else if processors == nil {
+		processors = c.DefaultProcessors()
+	}
+
 	var segments []CompiledSegment
+	var skipped []SkippedSegment
 
 	for slideIdx, slide := range script.Slides {
-		// Check if we should speak the title
-		if slide.ShouldSpeakTitle() && slide.Title != "" {
-			titleText := slide.Title
+		// The voice of the previous segment in the slide, for earcons
+		prevVoice := ""
+		crossfade := ParseDuration(firstNonEmpty(slide.Crossfade, script.DefaultCrossfade))
 
-			// Apply pronunciations to title
-			titleText = c.applyPronunciations(titleText, language, script.Pronunciations, nil)
+		// Check if we should speak the title
+		speakTitle := slide.ShouldSpeakTitle()
+		if c.IncludeSlideTitles && slide.SpeakTitle == nil {
+			speakTitle = true
+		}
+		spokenTitle := slide.SpokenTitle(language)
+		titleText, titleTrace := "", []PronunciationHit(nil)
+		if speakTitle && spokenTitle != "" {
+			var err error
+			titleText, titleTrace, err = c.processText(processors, &TextContext{
+				Script:       script,
+				Slide:        &script.Slides[slideIdx],
+				SlideIndex:   slideIdx,
+				SegmentIndex: -1,
+				Language:     language,
+			}, spokenTitle)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if titleText != "" {
+			// Titles follow the language's engine route
+			engine := ""
+			if r := script.EngineRoute(language); r != nil {
+				engine = r.Engine
+			}
 
 			// Determine voice for title
 			voiceID := ""
@@ -98,7 +300,9 @@ func (c *Compiler) Compile(script *Script, language string) ([]CompiledSegment,
 				}
 			}
 			if voiceID == "" {
-				if v, ok := script.DefaultVoices[language]; ok {
+				if v, ok := slide.DefaultVoice[language]; ok {
+					voiceID = v
+				} else if v, ok := script.defaultVoice(language, engine); ok {
 					voiceID = v
 				}
 			}
@@ -121,42 +325,116 @@ func (c *Compiler) Compile(script *Script, language string) ([]CompiledSegment,
 			}
 
 			segments = append(segments, CompiledSegment{
-				SlideIndex:      slideIdx,
-				SegmentIndex:    -1, // Title segments use -1
-				SlideTitle:      slide.Title,
-				IsTitleSegment:  true,
-				IsSectionHeader: slide.IsSectionHeader,
-				Text:            titleText,
-				OriginalText:    slide.Title,
-				VoiceID:         voiceID,
-				Language:        language,
-				PauseBeforeMs:   pauseBefore,
-				PauseAfterMs:    titlePauseAfter,
+				SlideIndex:         slideIdx,
+				SegmentIndex:       -1, // Title segments use -1
+				SlideTitle:         slide.Title,
+				IsTitleSegment:     true,
+				IsSectionHeader:    slide.IsSectionHeader,
+				Text:               titleText,
+				OriginalText:       spokenTitle,
+				VoiceID:            voiceID,
+				Engine:             engine,
+				Language:           language,
+				ModelID:            script.DefaultModel,
+				PauseBeforeMs:      pauseBefore,
+				PauseAfterMs:       titlePauseAfter,
+				PronunciationTrace: titleTrace,
+				Assets:             slide.LocalizedAssets(language),
 			})
+			prevVoice = voiceID
 		}
 
 		for segIdx, seg := range slide.Segments {
-			text, ok := seg.Text[language]
+			textLang, ok := resolveLanguage(seg.Text, language, options.fallbacks)
+			audioLang, hasAudio := resolveLanguage(seg.AudioFile, language, options.fallbacks)
+			if hasAudio {
+				// Pre-recorded audio takes precedence; its text is a transcript
+				textLang, ok = audioLang, true
+			}
 			if !ok {
-				continue // Skip segments without this language
+				if c.ErrOnMissingLanguage {
+					return nil, fmt.Errorf("%w: slide %d, segment %d has no %q text",
+						ErrMissingLanguage, slideIdx+1, segIdx+1, language)
+				}
+				skipped = append(skipped, SkippedSegment{
+					SlideIndex:   slideIdx,
+					SegmentIndex: segIdx,
+					Reason:       fmt.Sprintf("no text for language %q", language),
+				})
+				continue
+			}
+			text := seg.Text[textLang]
+			if IsEncryptedText(text) {
+				return nil, fmt.Errorf("%w: slide %d, segment %d, %q",
+					ErrEncryptedText, slideIdx+1, segIdx+1, textLang)
+			}
+			fallbackLang := ""
+			if textLang != language {
+				fallbackLang = textLang
 			}
 
 			originalText := text
+			audioFile := ""
+			if hasAudio {
+				audioFile = seg.AudioFile[audioLang]
+			}
+			text, trace, err := c.processText(processors, &TextContext{
+				Script:       script,
+				Slide:        &script.Slides[slideIdx],
+				Segment:      &script.Slides[slideIdx].Segments[segIdx],
+				SlideIndex:   slideIdx,
+				SegmentIndex: segIdx,
+				Language:     textLang,
+			}, text)
+			if err != nil {
+				return nil, err
+			}
+			if text == "" && audioFile == "" && (c.StripInaudible || originalText != "") {
+				skipped = append(skipped, SkippedSegment{
+					SlideIndex:   slideIdx,
+					SegmentIndex: segIdx,
+					Reason:       "no speakable text after cleaning",
+				})
+				continue
+			}
+			if text != "" {
+				text = withDirectives(seg.Directives, text)
+			}
 
-			// Apply pronunciations
-			text = c.applyPronunciations(text, language, script.Pronunciations, seg.Pronunciations)
-
-			// Determine voice
+			// Determine engine and voice
+			engine := seg.Engine[textLang]
+			if r := script.EngineRoute(textLang); engine == "" && r != nil {
+				engine = r.Engine
+			}
 			voiceID := ""
-			if v, ok := seg.Voice[language]; ok {
+			if v, ok := seg.Voice[textLang]; ok {
+				voiceID = v
+			} else if v, ok := slide.DefaultVoice[textLang]; ok {
 				voiceID = v
-			} else if v, ok := script.DefaultVoices[language]; ok {
+			} else if v, ok := script.defaultVoice(textLang, engine); ok {
 				voiceID = v
 			}
 
+			// Resolve prosody: segment > profile > slide defaults
+			profileName := firstNonEmpty(seg.Profile, slide.DefaultProfile)
+			var profile *ProsodyProfile
+			var prof ProsodyProfile
+			if profileName != "" {
+				p, ok := script.Profiles[profileName]
+				if !ok {
+					return nil, fmt.Errorf("%w: slide %d, segment %d references %q",
+						ErrUnknownProfile, slideIdx+1, segIdx+1, profileName)
+				}
+				prof = p
+				profile = &p
+			}
+			rate := firstNonEmpty(seg.Rate, prof.Rate, slide.DefaultRate)
+			pitch := firstNonEmpty(seg.Pitch, prof.Pitch, slide.DefaultPitch)
+			emphasis := firstNonEmpty(seg.Emphasis, prof.Emphasis)
+
 			// Parse pauses
 			pauseBefore := ParseDuration(seg.PauseBefore)
-			pauseAfter := ParseDuration(seg.PauseAfter)
+			pauseAfter := ParseDuration(firstNonEmpty(seg.PauseAfter, slide.DefaultPauseAfter))
 
 			// Apply default segment pause
 			if pauseAfter == 0 && c.DefaultPauseAfterSegment != "" {
@@ -171,62 +449,175 @@ func (c *Compiler) Compile(script *Script, language string) ([]CompiledSegment,
 				}
 			}
 
+			if e := script.SpeakerChangeEarcon; e != nil && prevVoice != "" && voiceID != "" && voiceID != prevVoice {
+				segments = append(segments, CompiledSegment{
+					SlideIndex:      slideIdx,
+					SegmentIndex:    segIdx,
+					SlideTitle:      slide.Title,
+					IsSectionHeader: slide.IsSectionHeader,
+					Language:        language,
+					PauseAfterMs:    ParseDuration(e.PauseAfter),
+					GainDB:          e.GainDB,
+					Assets:          slide.LocalizedAssets(language),
+					AudioFile:       e.AudioFile,
+					IsEarcon:        true,
+				})
+			}
+			prevVoice = voiceID
+
+			crossfadeMs := 0
+			if n := len(segments); crossfade > 0 && n > 0 && pauseBefore == 0 {
+				prev := segments[n-1]
+				if prev.SlideIndex == slideIdx && !prev.IsEarcon && prev.PauseAfterMs == 0 && voiceID != "" && prev.VoiceID == voiceID {
+					crossfadeMs = crossfade
+				}
+			}
+
 			segments = append(segments, CompiledSegment{
-				SlideIndex:      slideIdx,
-				SegmentIndex:    segIdx,
-				SlideTitle:      slide.Title,
-				IsSectionHeader: slide.IsSectionHeader,
-				Text:            text,
-				OriginalText:    originalText,
-				VoiceID:         voiceID,
-				Language:        language,
-				PauseBeforeMs:   pauseBefore,
-				PauseAfterMs:    pauseAfter,
-				Emphasis:        seg.Emphasis,
-				Rate:            seg.Rate,
-				Pitch:           seg.Pitch,
+				SlideIndex:         slideIdx,
+				SegmentIndex:       segIdx,
+				ID:                 seg.ID,
+				SlideTitle:         slide.Title,
+				IsSectionHeader:    slide.IsSectionHeader,
+				Text:               text,
+				OriginalText:       originalText,
+				VoiceID:            voiceID,
+				Engine:             engine,
+				Language:           language,
+				FallbackLanguage:   fallbackLang,
+				ModelID:            firstNonEmpty(seg.Model, script.DefaultModel),
+				Seed:               seg.Seed,
+				PauseBeforeMs:      pauseBefore,
+				PauseAfterMs:       pauseAfter,
+				FadeInMs:           ParseDuration(seg.FadeIn),
+				FadeOutMs:          ParseDuration(seg.FadeOut),
+				GainDB:             seg.GainDB,
+				CrossfadeMs:        crossfadeMs,
+				Emphasis:           emphasis,
+				Rate:               rate,
+				Pitch:              pitch,
+				Volume:             prof.Volume,
+				Profile:            profileName,
+				ProsodyProfile:     profile,
+				PronunciationTrace: trace,
+				Assets:             slide.LocalizedAssets(language),
+				AudioFile:          audioFile,
 			})
 		}
 	}
 
-	return segments, nil
+	return &CompileResult{Segments: segments, Skipped: skipped}, nil
 }
 
-// applyPronunciations applies pronunciation substitutions to the text.
-func (c *Compiler) applyPronunciations(text, language string, scriptProns, segmentProns map[string]map[string]string) string {
-	// Build combined pronunciation map
-	// Priority: additional > segment > script
-	prons := make(map[string]string)
-
-	// Script-level pronunciations
-	for term, langMap := range scriptProns {
-		if replacement, ok := langMap[language]; ok {
-			prons[term] = replacement
+// firstNonEmpty returns the first non-empty string.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
 		}
 	}
+	return ""
+}
 
-	// Segment-level pronunciations (override script-level)
-	for term, langMap := range segmentProns {
-		if replacement, ok := langMap[language]; ok {
-			prons[term] = replacement
+// resolveLanguage returns the first language in the requested/fallback chain
+// for which text is present.
+func resolveLanguage(text map[string]string, language string, fallbacks []string) (string, bool) {
+	if _, ok := text[language]; ok {
+		return language, true
+	}
+	for _, lang := range fallbacks {
+		if _, ok := text[lang]; ok {
+			return lang, true
 		}
 	}
+	return "", false
+}
+
+// pronunciationRule is a resolved pronunciation rule for a single language.
+type pronunciationRule struct {
+	term        string
+	replacement string
+	source      string
+}
 
-	// Additional pronunciations from compiler (override all)
-	for term, langMap := range c.AdditionalPronunciations {
-		if replacement, ok := langMap[language]; ok {
-			prons[term] = replacement
+// resolvePronunciations builds the ordered rule list for a language.
+// Priority: additional > segment > script. Rules are sorted longest term
+// first so that overlapping terms ("Golang" vs "Go") resolve predictably,
+// with ties broken alphabetically.
+func (c *Compiler) resolvePronunciations(language string, scriptProns, segmentProns map[string]map[string]string) []pronunciationRule {
+	byTerm := make(map[string]pronunciationRule)
+
+	layers := []struct {
+		source string
+		prons  map[string]map[string]string
+	}{
+		{"script", scriptProns},
+		{"segment", segmentProns},
+		{"compiler", c.AdditionalPronunciations},
+	}
+	for _, layer := range layers {
+		for term, langMap := range layer.prons {
+			if term == "" {
+				continue
+			}
+			if replacement, ok := langMap[language]; ok {
+				byTerm[term] = pronunciationRule{term: term, replacement: replacement, source: layer.source}
+			}
 		}
 	}
 
-	// Apply substitutions (case-insensitive word boundary matching)
-	result := text
-	for term, replacement := range prons {
-		pattern := regexp.MustCompile(`(?i)\b` + regexp.QuoteMeta(term) + `\b`)
-		result = pattern.ReplaceAllString(result, replacement)
+	rules := make([]pronunciationRule, 0, len(byTerm))
+	for _, rule := range byTerm {
+		rules = append(rules, rule)
 	}
+	sort.Slice(rules, func(i, j int) bool {
+		li, lj := len([]rune(rules[i].term)), len([]rune(rules[j].term))
+		if li != lj {
+			return li > lj
+		}
+		return rules[i].term < rules[j].term
+	})
+	return rules
+}
 
-	return result
+// applyPronunciations applies pronunciation substitutions to the text.
+// All terms are matched in a single pass (case-insensitive, word boundaries),
+// preferring the longest term at each position. Replaced text is never
+// re-scanned, so a replacement cannot trigger another rule.
+func (c *Compiler) applyPronunciations(text, language string, scriptProns, segmentProns map[string]map[string]string) (string, []PronunciationHit) {
+	rules := c.resolvePronunciations(language, scriptProns, segmentProns)
+	if len(rules) == 0 {
+		return text, nil
+	}
+
+	alternates := make([]string, len(rules))
+	for i, rule := range rules {
+		alternates[i] = regexp.QuoteMeta(rule.term)
+	}
+	pattern := regexp.MustCompile(`(?i)\b(?:` + strings.Join(alternates, "|") + `)\b`)
+
+	var hits []PronunciationHit
+	replace := func(match string) string {
+		for _, rule := range rules {
+			if strings.EqualFold(match, rule.term) {
+				if c.Trace {
+					hits = append(hits, PronunciationHit{
+						Term:        rule.term,
+						Matched:     match,
+						Replacement: rule.replacement,
+						Source:      rule.source,
+					})
+				}
+				return rule.replacement
+			}
+		}
+		return match
+	}
+	result := withSSMLIslandsProtected(text, func(s string) string {
+		return pattern.ReplaceAllStringFunc(s, replace)
+	})
+
+	return result, hits
 }
 
 // AddPronunciation adds a pronunciation rule.
@@ -283,21 +674,30 @@ func FormatDuration(ms int) string {
 }
 
 // GroupByVoice groups compiled segments by voice ID.
-// Useful for batch processing with the same voice.
+// Useful for batch processing with the same voice. Each group is in script
+// order; iterate the groups with GroupKeys for a stable order.
 func GroupByVoice(segments []CompiledSegment) map[string][]CompiledSegment {
 	groups := make(map[string][]CompiledSegment)
 	for _, seg := range segments {
 		groups[seg.VoiceID] = append(groups[seg.VoiceID], seg)
 	}
+	for _, group := range groups {
+		sortCompiledSegments(group)
+	}
 	return groups
 }
 
-// GroupBySlide groups compiled segments by slide index.
+// GroupBySlide groups compiled segments by slide index. Each group is in
+// script order, title first; iterate the groups with GroupKeys for a stable
+// order.
 func GroupBySlide(segments []CompiledSegment) map[int][]CompiledSegment {
 	groups := make(map[int][]CompiledSegment)
 	for _, seg := range segments {
 		groups[seg.SlideIndex] = append(groups[seg.SlideIndex], seg)
 	}
+	for _, group := range groups {
+		sortCompiledSegments(group)
+	}
 	return groups
 }
 
@@ -0,0 +1,182 @@
+package ttsscript
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// PronunciationCoverage reports how one pronunciation term is defined and
+// used across a script's languages.
+type PronunciationCoverage struct {
+	// Term is the pronunciation term.
+	Term string `json:"term"`
+
+	// Scope is "script" for Script.Pronunciations, or the segment that
+	// defines it, such as "slide 2, segment 1".
+	Scope string `json:"scope"`
+
+	// Languages are the languages the term has a replacement for.
+	Languages []string `json:"languages"`
+
+	// Missing are the script languages with no replacement for the term.
+	Missing []string `json:"missing,omitempty"`
+
+	// Occurrences counts the term in the text of each script language,
+	// matched as the compiler matches it: case-insensitively, on word
+	// boundaries, with the longest term winning. Segment-scope terms are
+	// only counted in their segment.
+	Occurrences map[string]int `json:"occurrences"`
+}
+
+// Total returns the number of occurrences across all languages.
+func (c PronunciationCoverage) Total() int {
+	total := 0
+	for _, n := range c.Occurrences {
+		total += n
+	}
+	return total
+}
+
+// Unused reports whether the term never occurs, so its entry can be
+// removed.
+func (c PronunciationCoverage) Unused() bool {
+	return c.Total() == 0
+}
+
+// Gaps returns the languages whose text uses the term but that have no
+// replacement for it, so the term is read as written.
+func (c PronunciationCoverage) Gaps() []string {
+	var gaps []string
+	for _, lang := range c.Missing {
+		if c.Occurrences[lang] > 0 {
+			gaps = append(gaps, lang)
+		}
+	}
+	return gaps
+}
+
+// AnalyzePronunciationCoverage reports, for each script and segment
+// pronunciation term, which languages define it, which script languages
+// lack it, and how often it occurs in each language. Use it to prune dead
+// entries (Unused) and to find missing localized pronunciations (Gaps).
+// Script terms come first, then segment terms, each sorted by term.
+func AnalyzePronunciationCoverage(script *Script) []PronunciationCoverage {
+	languages := script.Languages()
+	sort.Strings(languages)
+
+	var result []PronunciationCoverage
+	if len(script.Pronunciations) > 0 {
+		counts := make(map[string]map[string]int)
+		for _, lang := range languages {
+			counts[lang] = countTerms(script.Pronunciations, scriptTexts(script, lang))
+		}
+		result = append(result, coverageEntries(script.Pronunciations, "script", languages, counts)...)
+	}
+
+	for i, slide := range script.Slides {
+		for j, seg := range slide.Segments {
+			if len(seg.Pronunciations) == 0 {
+				continue
+			}
+			counts := make(map[string]map[string]int)
+			for _, lang := range languages {
+				counts[lang] = countTerms(seg.Pronunciations, []string{seg.Text[lang]})
+			}
+			scope := fmt.Sprintf("slide %d, segment %d", i+1, j+1)
+			result = append(result, coverageEntries(seg.Pronunciations, scope, languages, counts)...)
+		}
+	}
+	return result
+}
+
+// coverageEntries builds the coverage of each term in prons, sorted by
+// term, from per-language occurrence counts.
+func coverageEntries(prons map[string]map[string]string, scope string, languages []string, counts map[string]map[string]int) []PronunciationCoverage {
+	terms := make([]string, 0, len(prons))
+	for term := range prons {
+		if term != "" {
+			terms = append(terms, term)
+		}
+	}
+	sort.Strings(terms)
+
+	entries := make([]PronunciationCoverage, 0, len(terms))
+	for _, term := range terms {
+		entry := PronunciationCoverage{
+			Term:        term,
+			Scope:       scope,
+			Languages:   []string{},
+			Occurrences: make(map[string]int, len(languages)),
+		}
+		for lang := range prons[term] {
+			entry.Languages = append(entry.Languages, lang)
+		}
+		sort.Strings(entry.Languages)
+		for _, lang := range languages {
+			if _, ok := prons[term][lang]; !ok {
+				entry.Missing = append(entry.Missing, lang)
+			}
+			entry.Occurrences[lang] = counts[lang][term]
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// scriptTexts returns the spoken text of a script in a language: segment
+// text and the titles of slides that speak them.
+func scriptTexts(script *Script, language string) []string {
+	var texts []string
+	for _, slide := range script.Slides {
+		if slide.ShouldSpeakTitle() {
+			texts = append(texts, slide.SpokenTitle(language))
+		}
+		for _, seg := range slide.Segments {
+			texts = append(texts, seg.Text[language])
+		}
+	}
+	return texts
+}
+
+// countTerms counts the occurrences of each term of prons in texts, in a
+// single pass with the longest term preferred, skipping SSML islands and
+// audio tags.
+func countTerms(prons map[string]map[string]string, texts []string) map[string]int {
+	var terms []string
+	byLower := make(map[string]string)
+	for term := range prons {
+		if term == "" {
+			continue
+		}
+		if _, dup := byLower[strings.ToLower(term)]; !dup {
+			byLower[strings.ToLower(term)] = term
+			terms = append(terms, term)
+		}
+	}
+	counts := make(map[string]int)
+	if len(terms) == 0 {
+		return counts
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		li, lj := len([]rune(terms[i])), len([]rune(terms[j]))
+		if li != lj {
+			return li > lj
+		}
+		return terms[i] < terms[j]
+	})
+	alternates := make([]string, len(terms))
+	for i, term := range terms {
+		alternates[i] = regexp.QuoteMeta(term)
+	}
+	pattern := regexp.MustCompile(`(?i)\b(?:` + strings.Join(alternates, "|") + `)\b`)
+
+	for _, text := range texts {
+		protected, _ := protectSSMLIslands(text)
+		for _, match := range pattern.FindAllString(protected, -1) {
+			counts[byLower[strings.ToLower(match)]]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,56 @@
+package ttsscript
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAnalyzePronunciationCoverage(t *testing.T) {
+	script := &Script{
+		Pronunciations: map[string]map[string]string{
+			"API":    {"en": "A P I", "es": "A P I"},
+			"Go":     {"en": "go"},
+			"Golang": {"en": "go lang"},
+			"COBOL":  {"en": "co bol", "es": "co bol"},
+		},
+		Slides: []Slide{{
+			Title:           "Golang and the API",
+			IsSectionHeader: true,
+			Segments: []Segment{
+				{Text: map[string]string{"en": "Call the api from Go.", "es": "Llama a la API desde Go."}},
+				{
+					Text:           map[string]string{"en": "Golang {{ssml:<sub alias=\"x\">API</sub>}} SQL", "es": "SQL"},
+					Pronunciations: map[string]map[string]string{"SQL": {"en": "sequel"}},
+				},
+			},
+		}},
+	}
+
+	coverage := AnalyzePronunciationCoverage(script)
+	byTerm := make(map[string]PronunciationCoverage)
+	for _, c := range coverage {
+		byTerm[c.Scope+"/"+c.Term] = c
+	}
+	if len(coverage) != 5 || coverage[0].Term != "API" || coverage[4].Scope != "slide 1, segment 2" {
+		t.Fatalf("coverage = %+v", coverage)
+	}
+
+	api := byTerm["script/API"]
+	if want := map[string]int{"en": 2, "es": 2}; !reflect.DeepEqual(api.Occurrences, want) {
+		t.Errorf("API occurrences = %v, want %v (title counted, islands skipped)", api.Occurrences, want)
+	}
+	golang := byTerm["script/Golang"]
+	if golang.Occurrences["en"] != 2 || byTerm["script/Go"].Occurrences["en"] != 1 {
+		t.Errorf("Golang = %v, Go = %v: longest term should win", golang.Occurrences, byTerm["script/Go"].Occurrences)
+	}
+	if gaps := byTerm["script/Go"].Gaps(); !reflect.DeepEqual(gaps, []string{"es"}) {
+		t.Errorf("Go gaps = %v, want [es]", gaps)
+	}
+	if !byTerm["script/COBOL"].Unused() || byTerm["script/API"].Unused() {
+		t.Error("Unused() wrong")
+	}
+	sql := byTerm["slide 1, segment 2/SQL"]
+	if !reflect.DeepEqual(sql.Missing, []string{"es"}) || sql.Occurrences["es"] != 1 || len(sql.Gaps()) != 1 {
+		t.Errorf("SQL = %+v", sql)
+	}
+}
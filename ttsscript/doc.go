@@ -53,6 +53,9 @@
 //	    // Save with pause information for post-processing
 //	}
 //
+// Hand-edited scripts can use ParseScriptLenient or LoadScriptLenient to
+// allow comments and trailing commas.
+//
 // Compile to SSML for Google TTS:
 //
 //	formatter := ttsscript.NewSSMLFormatter()
@@ -67,12 +70,26 @@
 //   - Global pronunciations
 //   - Slides/sections containing segments
 //
+// Each Slide may set default voice, rate, pitch, and pause-after values that
+// its segments inherit unless they override them. Slides can also list
+// visual Assets (image paths and alt text per language); these are resolved
+// for the compiled language and carried into manifest entries. The first
+// "image" asset is used as the slide's video slate by the ttsscript command.
+//
 // Each Segment contains:
 //   - Text in multiple languages
 //   - Voice overrides per language
 //   - Pause before/after
 //   - Prosody settings (rate, pitch, emphasis)
 //   - Segment-specific pronunciations
+//   - Post-processing fades and gain (fade_in, fade_out, gain_db), applied
+//     when segments are concatenated
+//
+// Slides can also set a short crossfade (Slide.Crossfade, or
+// Script.DefaultCrossfade for all slides) between back-to-back segments of
+// the same voice, to mask clicks where MP3 files are joined. The compiler
+// sets CrossfadeMs on segments that follow such a segment with no pause in
+// between, and BuildTrack overlaps them.
 //
 // # Compilation Process
 //
@@ -86,6 +103,151 @@
 // SSMLFormatter: Outputs W3C SSML compatible with Google, Amazon, Azure
 // ElevenLabsFormatter: Outputs segments ready for ElevenLabs TTS API
 //
+// SSMLFormatter.FormatWithBookmarks marks the start of each segment
+// (<bookmark> for Azure, <mark> for Google and Polly) and returns an
+// SSMLBookmarkMap from marks to segment IDs, for lip-sync and visual cues
+// driven by the engine's bookmark events.
+//
+// SSMLFormatter.Voices and SpeakerVoices wrap segments in <voice> elements
+// for multi-voice scripts, and SSMLFormatter.Lint checks prosody values
+// against the ranges of its Engine (Polly standard or neural, or Azure).
+//
+// Segment text can embed raw SSML for control the structured format lacks,
+// marked as an island:
+//
+//	"text": {"en": "Query it with {{ssml:<say-as interpret-as=\"characters\">SQL</say-as>}}."}
+//
+// SSMLFormatter writes islands verbatim. ElevenLabsFormatter converts them
+// with SSMLIslandText, keeping <break> and <phoneme> and reducing other tags
+// to plain text. Cleaning and pronunciation rules skip islands, and
+// Script.Validate reports malformed ones.
+//
+// ElevenLabs v3 audio tags such as [whispers] are written inline as
+// {{tag:whispers}} or listed in Segment.Directives. ElevenLabsFormatter
+// keeps them for models where SupportsAudioTags is true and removes them
+// otherwise; LintAudioTags warns about unknown tags and unsupported models.
+//
+// # Engines
+//
+// An Engine synthesizes SegmentJobs with a TTS provider, so the generation
+// pipeline is not tied to one. elevenlabs.NewScriptEngine provides the
+// ElevenLabs engine; implement Engine to plug in Google, Polly, Azure, or a
+// local model:
+//
+//	engine := elevenlabs.NewScriptEngine(client)
+//	for _, job := range jobs {
+//	    audio, _ := engine.Synthesize(ctx, ttsscript.NewSegmentJob(job, "en"))
+//	    audio.WriteFile(config.GenerateFilename(job, "en"))
+//	}
+//
+// Scripts can route languages to other engines with "engine_routes", and
+// segments can pick an engine per language with "engine":
+//
+//	"engine_routes": [{"language": "ja", "engine": "google", "voice": "ja-JP-Neural2-B"}]
+//
+// A Router sends each job to the engine it is routed to, and
+// ManifestsByEngine splits the manifest per engine:
+//
+//	router := ttsscript.NewRouter(elevenlabs.ScriptEngineName, engine)
+//	router.Register("google", googleEngine)
+//
+// # Prosody Profiles
+//
+// Define named profiles once at the script level and reference them from
+// segments (Segment.Profile) or slides (Slide.DefaultProfile):
+//
+//	"profiles": {
+//	  "narration": {"rate": "95%", "stability": 0.6},
+//	  "energetic": {"preset": "expressive", "style": 0.4}
+//	}
+//
+// Rate, Pitch, Emphasis, and Volume apply to all formatters. Preset,
+// Stability, SimilarityBoost, Style, and Speed are mapped by
+// ElevenLabsFormatter into ElevenLabsSegment.VoiceSettings; a percentage rate
+// becomes the speed when Speed is not set. Preset names an engine preset
+// (see elevenlabs.VoiceSettingsPreset) that the other fields refine.
+//
+// # Duration Estimates
+//
+// EstimateDuration estimates spoken duration from typical speaking rates.
+// A Calibration learns measured rates per voice and language from manifest
+// entries with DurationMs set, and is stored next to the script
+// (CalibrationPath) so estimates converge over runs:
+//
+//	cal, _ := ttsscript.LoadCalibration(ttsscript.CalibrationPath("course.json"))
+//	cal.ObserveManifest(entries)
+//	perSlide := ttsscript.EstimateSlideDurations(segments, cal)
+//
+// # Pause Tuning
+//
+// Generated audio has silence of its own around the speech. SuggestPauses
+// measures the silence between segments from each file's SpeechTiming,
+// built from forced alignment words by NewSpeechTiming, and suggests pause
+// after values that hit a target gap, by default the narrator's median
+// gap between sentences. ApplyPauseSuggestions writes them to the script.
+//
+// # Title Narration
+//
+// Section header slides speak their title by default. Set
+// Compiler.IncludeSlideTitles to narrate every slide title, and use
+// Slide.TitleText to provide a translated spoken title per language.
+// Title segments have SegmentIndex -1 and IsTitleSegment set.
+//
+// # Speaker Change Earcons
+//
+// Set Script.SpeakerChangeEarcon to play a short sound whenever the voice
+// changes between consecutive segments of a slide, so listeners can tell
+// speakers apart:
+//
+//	"speaker_change_earcon": {"audio_file": "assets/chime.mp3", "prompt": "soft two-note chime", "duration": "600ms"}
+//
+// The compiler inserts a pre-recorded segment with IsEarcon set before the
+// segment with the new voice, at the same SegmentIndex. With a prompt,
+// tooling such as cmd/ttsscript generates the audio file once if it is
+// missing; without one, the file is supplied. SSML output omits earcons.
+//
+// # Encrypted Scripts
+//
+// Scripts with unreleased product information can be kept in Git with
+// their segment text encrypted (AES-256-GCM) and everything else readable:
+//
+//	key, _ := ttsscript.ParseScriptKey(os.Getenv("TTSSCRIPT_KEY"))
+//	_ = script.Encrypt(key) // text becomes "enc:v2:..."
+//	script, _ = ttsscript.LoadScriptWithKey("script.json", key)
+//
+// Unchanged text encrypts to the same value, so diffs only show edited
+// segments. Each text is bound to its slide, segment, and language (see
+// TextLocation), so reordering encrypted segments needs the key. Compiling
+// text that is still encrypted fails with ErrEncryptedText.
+//
+// Jobs and manifests are built from the decrypted script, so
+// ManifestEntry.Text is plaintext. Keep manifests of encrypted scripts out
+// of Git, or share them only where the script's text may be read.
+//
+// # Books
+//
+// Long-form projects such as audiobooks can use Book instead of Script:
+// front matter, Parts → Chapters → Sections (sections are slides), and back
+// matter. Part and chapter titles are narrated as heading segments.
+//
+//	book, _ := ttsscript.LoadBook("book.json")
+//	chapters, _ := ttsscript.NewCompiler().CompileBook(book, "en")
+//	for _, ch := range chapters {
+//	    // ch.ID, ch.Kind, ch.Segments: one output per chapter
+//	}
+//
+// The ttsscript command detects books with IsBook and, with -chapters,
+// writes one audio file per chapter.
+//
+// StudioContentJSON converts compiled chapters into the content format
+// accepted by ElevenLabs Studio projects.
+// WriteStudioExportZip and WriteStudioExportDir instead write one HTML
+// document per chapter with voice hints, for import in the Studio UI.
+//
+// WriteTranscriptBundleZip exports a language's accessibility bundle after
+// generation: full and per-slide transcripts from the original text, and
+// WebVTT and SRT captions timed from the manifest's measured durations.
+//
 // # Pronunciation Handling
 //
 // Pronunciations are applied at compile time with this priority:
@@ -94,5 +256,32 @@
 // 3. Script-level (in script.pronunciations)
 //
 // This allows overrides at any level. Terms are matched case-insensitively
-// with word boundaries.
+// with word boundaries in a single pass: at each position the longest term
+// wins ("Golang" before "Go"), and substituted text is never re-scanned.
+// Set Compiler.Trace to record which rules fired in
+// CompiledSegment.PronunciationTrace.
+//
+// # Text Processors
+//
+// Segment and title text passes through Compiler.Processors, an ordered
+// pipeline of TextProcessor steps. The default is CleanProcessor (with
+// StripInaudible) followed by PronunciationProcessor. Insert custom steps,
+// such as profanity masking or glossary enforcement, without forking:
+//
+//	mask := ttsscript.TextProcessorFunc{ProcessorName: "mask", Func: maskWords}
+//	compiler.Processors = append([]ttsscript.TextProcessor{mask}, compiler.DefaultProcessors()...)
+//
+// WithProcessors replaces the pipeline for a single Compile call. A
+// processor error stops compilation with a *ProcessorError.
+//
+// AnalyzePronunciationCoverage reports the languages each pronunciation
+// term is defined for and its occurrences per language, to find unused
+// entries and languages missing a localized pronunciation.
+//
+// SuggestPronunciations finds likely acronyms, brand names, and proper
+// nouns that no pronunciation rule covers, with spelled-out suggestions:
+//
+//	for _, s := range ttsscript.SuggestPronunciations(script, "en") {
+//	    fmt.Println(s.Term, s.Kind, s.Suggestion)
+//	}
 package ttsscript
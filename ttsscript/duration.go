@@ -0,0 +1,215 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// DefaultCharsPerSecond is the default speaking rate, in spoken characters
+// (letters and digits) per second, for languages without a specific rate.
+const DefaultCharsPerSecond = 14.0
+
+// defaultCharsPerSecond are typical narration rates for languages that
+// differ notably from the default. Scripts written in logographic and
+// syllabic scripts carry more speech per character.
+var defaultCharsPerSecond = map[string]float64{
+	"ja": 8,
+	"zh": 5,
+	"ko": 7,
+}
+
+// spokenChars counts the letters and digits in text.
+func spokenChars(text string) int {
+	n := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// baseLanguage returns the primary subtag of a language code ("pt-BR" → "pt").
+func baseLanguage(language string) string {
+	base, _, _ := strings.Cut(strings.ToLower(language), "-")
+	return base
+}
+
+// EstimateDuration estimates the spoken duration of text in milliseconds
+// using default speaking rates. Use Calibration.EstimateDuration to refine
+// estimates with measured durations from previous runs.
+func EstimateDuration(text, language string) int {
+	rate, ok := defaultCharsPerSecond[baseLanguage(language)]
+	if !ok {
+		rate = DefaultCharsPerSecond
+	}
+	return charsToMs(spokenChars(text), rate)
+}
+
+func charsToMs(chars int, charsPerSecond float64) int {
+	if charsPerSecond <= 0 {
+		return 0
+	}
+	return int(float64(chars) / charsPerSecond * 1000)
+}
+
+// Calibration holds measured speaking rates per voice and language, learned
+// from generated audio. It is stored alongside the script (see
+// CalibrationPath) so estimates converge to the real timings of the voices
+// a script uses.
+type Calibration struct {
+	// Rates are sorted by language, then voice ID.
+	Rates []CalibrationRate `json:"rates"`
+}
+
+// CalibrationRate accumulates measurements for one voice and language.
+type CalibrationRate struct {
+	VoiceID  string `json:"voice_id"`
+	Language string `json:"language"`
+
+	// Chars is the total number of spoken characters measured.
+	Chars int `json:"chars"`
+
+	// DurationMs is the total measured audio duration.
+	DurationMs int `json:"duration_ms"`
+
+	// Samples is the number of measured segments.
+	Samples int `json:"samples"`
+}
+
+// CharsPerSecond returns the measured speaking rate.
+func (r CalibrationRate) CharsPerSecond() float64 {
+	if r.DurationMs <= 0 {
+		return 0
+	}
+	return float64(r.Chars) / (float64(r.DurationMs) / 1000)
+}
+
+// NewCalibration creates an empty calibration.
+func NewCalibration() *Calibration {
+	return &Calibration{Rates: []CalibrationRate{}}
+}
+
+// CalibrationPath returns the calibration file path for a script:
+// "course.json" → "course.calibration.json".
+func CalibrationPath(scriptPath string) string {
+	return strings.TrimSuffix(scriptPath, filepath.Ext(scriptPath)) + ".calibration.json"
+}
+
+// LoadCalibration loads calibration data from a JSON file.
+func LoadCalibration(filePath string) (*Calibration, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading calibration file: %w", err)
+	}
+	var c Calibration
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("parsing calibration JSON: %w", err)
+	}
+	return &c, nil
+}
+
+// Save writes the calibration as indented JSON to a file.
+func (c *Calibration) Save(filePath string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling calibration: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("writing calibration file: %w", err)
+	}
+	return nil
+}
+
+// Observe records the measured duration of generated text. Measurements
+// with no spoken characters or no duration are ignored.
+func (c *Calibration) Observe(voiceID, language, text string, durationMs int) {
+	chars := spokenChars(text)
+	if chars == 0 || durationMs <= 0 {
+		return
+	}
+	for i := range c.Rates {
+		r := &c.Rates[i]
+		if r.VoiceID == voiceID && r.Language == language {
+			r.Chars += chars
+			r.DurationMs += durationMs
+			r.Samples++
+			return
+		}
+	}
+	c.Rates = append(c.Rates, CalibrationRate{
+		VoiceID: voiceID, Language: language, Chars: chars, DurationMs: durationMs, Samples: 1,
+	})
+	sort.Slice(c.Rates, func(i, j int) bool {
+		if c.Rates[i].Language != c.Rates[j].Language {
+			return c.Rates[i].Language < c.Rates[j].Language
+		}
+		return c.Rates[i].VoiceID < c.Rates[j].VoiceID
+	})
+}
+
+// ObserveManifest records the measured durations of manifest entries.
+// Entries without a measured duration are skipped. It returns the number of
+// entries recorded.
+func (c *Calibration) ObserveManifest(entries []ManifestEntry) int {
+	n := 0
+	for _, e := range entries {
+		if e.DurationMs > 0 && spokenChars(e.Text) > 0 {
+			c.Observe(e.VoiceID, e.Language, e.Text, e.DurationMs)
+			n++
+		}
+	}
+	return n
+}
+
+// CharsPerSecond returns the speaking rate for a voice and language: the
+// voice's measured rate, else the measured rate of all voices in the
+// language, else the default rate. ok is false when no measurement applies.
+func (c *Calibration) CharsPerSecond(voiceID, language string) (rate float64, ok bool) {
+	var lang CalibrationRate
+	for _, r := range c.Rates {
+		if r.Language != language {
+			continue
+		}
+		if r.VoiceID == voiceID {
+			return r.CharsPerSecond(), true
+		}
+		lang.Chars += r.Chars
+		lang.DurationMs += r.DurationMs
+	}
+	if lang.DurationMs > 0 {
+		return lang.CharsPerSecond(), true
+	}
+	if rate, ok := defaultCharsPerSecond[baseLanguage(language)]; ok {
+		return rate, false
+	}
+	return DefaultCharsPerSecond, false
+}
+
+// EstimateDuration estimates the spoken duration of text in milliseconds
+// for a voice and language using the calibrated rate.
+func (c *Calibration) EstimateDuration(text, voiceID, language string) int {
+	rate, _ := c.CharsPerSecond(voiceID, language)
+	return charsToMs(spokenChars(text), rate)
+}
+
+// EstimateSlideDurations estimates the duration of each slide in
+// milliseconds, including pauses, keyed by slide index. A nil calibration
+// uses the default rates.
+func EstimateSlideDurations(segments []CompiledSegment, c *Calibration) map[int]int {
+	if c == nil {
+		c = NewCalibration()
+	}
+	slides := make(map[int]int)
+	for _, seg := range segments {
+		slides[seg.SlideIndex] += seg.PauseBeforeMs +
+			c.EstimateDuration(seg.Text, seg.VoiceID, seg.Language) +
+			seg.PauseAfterMs
+	}
+	return slides
+}
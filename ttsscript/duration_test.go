@@ -0,0 +1,89 @@
+package ttsscript
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestEstimateDuration(t *testing.T) {
+	// 14 spoken characters at the default 14 chars/second.
+	if got := EstimateDuration("Hello, world! Good.", "en"); got != 1000 {
+		t.Errorf("EstimateDuration(en) = %d, want 1000", got)
+	}
+	// Japanese uses a slower per-character rate; regional codes use the base language.
+	if got := EstimateDuration("こんにちは世界です", "ja-JP"); got != 1125 {
+		t.Errorf("EstimateDuration(ja-JP) = %d, want 1125", got)
+	}
+}
+
+func TestCalibration(t *testing.T) {
+	c := NewCalibration()
+	if _, ok := c.CharsPerSecond("v1", "en"); ok {
+		t.Error("empty calibration should not report a measured rate")
+	}
+
+	// v1 speaks 10 chars/second.
+	c.ObserveManifest([]ManifestEntry{
+		{VoiceID: "v1", Language: "en", Text: "abcdefghij", DurationMs: 1000},
+		{VoiceID: "v1", Language: "en", Text: "abcdefghijabcdefghij", DurationMs: 2000},
+		{VoiceID: "v1", Language: "en", Text: "not generated"},
+		{VoiceID: "v2", Language: "en", Text: "abcdefghijabcdefghij", DurationMs: 1000},
+	})
+
+	if len(c.Rates) != 2 || c.Rates[0].Samples != 2 || c.Rates[0].Chars != 30 {
+		t.Fatalf("unexpected rates: %+v", c.Rates)
+	}
+	if got := c.EstimateDuration("abcde", "v1", "en"); got != 500 {
+		t.Errorf("EstimateDuration(v1) = %d, want 500", got)
+	}
+	if got := c.EstimateDuration("abcdefghij", "v2", "en"); got != 500 {
+		t.Errorf("EstimateDuration(v2) = %d, want 500", got)
+	}
+
+	// Unknown voices use the language aggregate: 50 chars in 4s.
+	if rate, ok := c.CharsPerSecond("v3", "en"); !ok || rate != 12.5 {
+		t.Errorf("CharsPerSecond(v3) = %v, %v; want 12.5, true", rate, ok)
+	}
+	// Unmeasured languages use the default rate.
+	if rate, ok := c.CharsPerSecond("v1", "fr"); ok || rate != DefaultCharsPerSecond {
+		t.Errorf("CharsPerSecond(fr) = %v, %v", rate, ok)
+	}
+
+	path := filepath.Join(t.TempDir(), "course.calibration.json")
+	if err := c.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := LoadCalibration(path)
+	if err != nil {
+		t.Fatalf("LoadCalibration() error = %v", err)
+	}
+	if got := loaded.EstimateDuration("abcde", "v1", "en"); got != 500 {
+		t.Errorf("loaded EstimateDuration = %d, want 500", got)
+	}
+}
+
+func TestCalibrationPath(t *testing.T) {
+	if got := CalibrationPath("scripts/course.json"); got != "scripts/course.calibration.json" {
+		t.Errorf("CalibrationPath() = %q", got)
+	}
+}
+
+func TestEstimateSlideDurations(t *testing.T) {
+	segments := []CompiledSegment{
+		{SlideIndex: 0, Text: "abcdefghijklmn", VoiceID: "v1", Language: "en", PauseAfterMs: 500},
+		{SlideIndex: 0, Text: "abcdefghijklmn", VoiceID: "v1", Language: "en"},
+		{SlideIndex: 1, Text: "abcdefghij", VoiceID: "v1", Language: "en", PauseBeforeMs: 200},
+	}
+
+	got := EstimateSlideDurations(segments, nil)
+	if got[0] != 2500 {
+		t.Errorf("slide 0 default estimate = %d, want 2500", got[0])
+	}
+
+	c := NewCalibration()
+	c.Observe("v1", "en", "abcdefghij", 1000)
+	got = EstimateSlideDurations(segments, c)
+	if got[0] != 3300 || got[1] != 1200 {
+		t.Errorf("calibrated estimates = %v, want map[0:3300 1:1200]", got)
+	}
+}
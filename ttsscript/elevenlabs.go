@@ -1,7 +1,11 @@
 package ttsscript
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -13,6 +17,11 @@ type ElevenLabsFormatter struct {
 
 	// PauseMarkerFormat is the format for pause markers (default: "[pause:%s]").
 	PauseMarkerFormat string
+
+	// ModelID is the model for segments that don't set one. Audio tags are
+	// written as v3 tags for models that support them and removed for
+	// others (see SupportsAudioTags).
+	ModelID string
 }
 
 // NewElevenLabsFormatter creates a new ElevenLabs formatter.
@@ -31,12 +40,19 @@ type ElevenLabsSegment struct {
 	// VoiceID is the ElevenLabs voice ID.
 	VoiceID string
 
+	// Engine is the TTS engine to generate with. Empty means the default
+	// engine; for other engines, VoiceID is that engine's voice.
+	Engine string
+
 	// SlideIndex is the source slide index.
 	SlideIndex int
 
 	// SegmentIndex is the source segment index (-1 for title segments).
 	SegmentIndex int
 
+	// ID is the segment ID from the script, if any.
+	ID string
+
 	// SlideTitle is the slide title for reference.
 	SlideTitle string
 
@@ -52,8 +68,102 @@ type ElevenLabsSegment struct {
 	// PauseAfterMs is silence to add after this segment.
 	PauseAfterMs int
 
+	// FadeInMs and FadeOutMs are fades to apply in post-processing.
+	FadeInMs  int
+	FadeOutMs int
+
+	// GainDB is the gain to apply in post-processing, in decibels.
+	GainDB float64
+
+	// CrossfadeMs is the crossfade from the previous segment into this one
+	// when concatenating, in milliseconds.
+	CrossfadeMs int
+
 	// SuggestedFilename is a suggested output filename.
 	SuggestedFilename string
+
+	// VoiceSettings are ElevenLabs voice settings derived from the segment's
+	// prosody profile. Nil when no profile applies.
+	VoiceSettings *VoiceSettings
+
+	// ModelID is the model from the segment or Script.DefaultModel. Empty
+	// means the caller's default.
+	ModelID string
+
+	// LanguageCode is the language of Text: the compiled language, or the
+	// fallback language the text was taken from.
+	LanguageCode string
+
+	// Seed is the generation seed (0 for none).
+	Seed int
+
+	// Assets are the slide's assets resolved for the segment language.
+	Assets []LocalizedAsset
+
+	// PronunciationTrace lists the pronunciation substitutions applied to
+	// Text. Only populated when Compiler.Trace is enabled.
+	PronunciationTrace []PronunciationHit
+
+	// AudioFile is pre-recorded audio to use instead of generating speech.
+	// Text is its transcript, if any.
+	AudioFile string
+
+	// IsEarcon indicates AudioFile is the speaker change earcon played
+	// before the segment at SegmentIndex.
+	IsEarcon bool
+}
+
+// IsPrerecorded returns true if the segment uses pre-recorded audio.
+func (s ElevenLabsSegment) IsPrerecorded() bool {
+	return s.AudioFile != ""
+}
+
+// VoiceSettings holds ElevenLabs voice settings overrides for a segment.
+// Preset names the base settings (empty means the default); nil fields mean
+// "use the base value".
+type VoiceSettings struct {
+	Preset          string `json:",omitempty"`
+	Stability       *float64
+	SimilarityBoost *float64
+	Style           *float64
+	Speed           *float64
+}
+
+// voiceSettingsFromProfile maps a prosody profile to ElevenLabs voice settings.
+// A percentage rate (e.g., "95%") is mapped to speed when Speed is not set.
+func voiceSettingsFromProfile(profile *ProsodyProfile, rate string) *VoiceSettings {
+	if profile == nil {
+		return nil
+	}
+	vs := &VoiceSettings{
+		Preset:          profile.Preset,
+		Stability:       profile.Stability,
+		SimilarityBoost: profile.SimilarityBoost,
+		Style:           profile.Style,
+		Speed:           profile.Speed,
+	}
+	if vs.Speed == nil {
+		if speed, ok := rateToSpeed(rate); ok {
+			vs.Speed = &speed
+		}
+	}
+	if vs.Preset == "" && vs.Stability == nil && vs.SimilarityBoost == nil && vs.Style == nil && vs.Speed == nil {
+		return nil
+	}
+	return vs
+}
+
+// rateToSpeed converts a percentage rate like "95%" to a speed multiplier.
+func rateToSpeed(rate string) (float64, bool) {
+	rate = strings.TrimSpace(rate)
+	if !strings.HasSuffix(rate, "%") {
+		return 0, false
+	}
+	pct, err := strconv.ParseFloat(strings.TrimSuffix(rate, "%"), 64)
+	if err != nil || pct <= 0 {
+		return 0, false
+	}
+	return pct / 100, true
 }
 
 // Format formats compiled segments for ElevenLabs.
@@ -61,7 +171,8 @@ func (f *ElevenLabsFormatter) Format(segments []CompiledSegment) []ElevenLabsSeg
 	result := make([]ElevenLabsSegment, len(segments))
 
 	for i, seg := range segments {
-		text := seg.Text
+		text := SSMLIslandText(seg.Text)
+		text = AudioTagText(text, SupportsAudioTags(firstNonEmpty(seg.ModelID, f.ModelID)))
 
 		// Add pause markers if enabled
 		if f.UsePauseMarkers {
@@ -76,30 +187,62 @@ func (f *ElevenLabsFormatter) Format(segments []CompiledSegment) []ElevenLabsSeg
 		}
 
 		// Generate appropriate filename
-		var filename string
-		if seg.IsTitleSegment {
-			filename = fmt.Sprintf("slide%02d_title.mp3", seg.SlideIndex+1)
-		} else {
-			filename = fmt.Sprintf("slide%02d_seg%02d.mp3", seg.SlideIndex+1, seg.SegmentIndex+1)
-		}
+		filename := segmentBaseName(seg.SlideIndex, seg.SegmentIndex, seg.IsTitleSegment, seg.IsEarcon) +
+			audioExtension(seg.AudioFile)
 
 		result[i] = ElevenLabsSegment{
-			Text:              text,
-			VoiceID:           seg.VoiceID,
-			SlideIndex:        seg.SlideIndex,
-			SegmentIndex:      seg.SegmentIndex,
-			SlideTitle:        seg.SlideTitle,
-			IsTitleSegment:    seg.IsTitleSegment,
-			IsSectionHeader:   seg.IsSectionHeader,
-			PauseBeforeMs:     seg.PauseBeforeMs,
-			PauseAfterMs:      seg.PauseAfterMs,
-			SuggestedFilename: filename,
+			Text:               text,
+			VoiceID:            seg.VoiceID,
+			Engine:             seg.Engine,
+			SlideIndex:         seg.SlideIndex,
+			SegmentIndex:       seg.SegmentIndex,
+			ID:                 seg.ID,
+			SlideTitle:         seg.SlideTitle,
+			IsTitleSegment:     seg.IsTitleSegment,
+			IsSectionHeader:    seg.IsSectionHeader,
+			PauseBeforeMs:      seg.PauseBeforeMs,
+			PauseAfterMs:       seg.PauseAfterMs,
+			FadeInMs:           seg.FadeInMs,
+			FadeOutMs:          seg.FadeOutMs,
+			GainDB:             seg.GainDB,
+			CrossfadeMs:        seg.CrossfadeMs,
+			SuggestedFilename:  filename,
+			VoiceSettings:      voiceSettingsFromProfile(seg.ProsodyProfile, seg.Rate),
+			ModelID:            seg.ModelID,
+			LanguageCode:       firstNonEmpty(seg.FallbackLanguage, seg.Language),
+			Seed:               seg.Seed,
+			Assets:             seg.Assets,
+			PronunciationTrace: seg.PronunciationTrace,
+			AudioFile:          seg.AudioFile,
+			IsEarcon:           seg.IsEarcon,
 		}
 	}
 
 	return result
 }
 
+// segmentBaseName returns the output filename of a segment, without
+// language or extension.
+func segmentBaseName(slideIndex, segmentIndex int, isTitle, isEarcon bool) string {
+	switch {
+	case isTitle:
+		return fmt.Sprintf("slide%02d_title", slideIndex+1)
+	case isEarcon:
+		return fmt.Sprintf("slide%02d_seg%02d_earcon", slideIndex+1, segmentIndex+1)
+	default:
+		return fmt.Sprintf("slide%02d_seg%02d", slideIndex+1, segmentIndex+1)
+	}
+}
+
+// audioExtension returns the output file extension for a segment: the
+// pre-recorded file's extension, or ".mp3" for generated speech.
+func audioExtension(audioFile string) string {
+	if ext := strings.ToLower(filepath.Ext(audioFile)); ext != "" {
+		return ext
+	}
+	return ".mp3"
+}
+
 // FormatScript compiles and formats a script for ElevenLabs.
 func (f *ElevenLabsFormatter) FormatScript(script *Script, language string) ([]ElevenLabsSegment, error) {
 	compiler := NewCompiler()
@@ -121,35 +264,46 @@ func (f *ElevenLabsFormatter) CombineForSingleRequest(segments []ElevenLabsSegme
 	return strings.Join(parts, " ")
 }
 
-// GroupByVoice groups segments by voice ID for batch processing.
+// GroupByVoice groups segments by voice ID for batch processing. Each group
+// is in script order; iterate the groups with GroupKeys for a stable order.
 func (f *ElevenLabsFormatter) GroupByVoice(segments []ElevenLabsSegment) map[string][]ElevenLabsSegment {
 	groups := make(map[string][]ElevenLabsSegment)
 	for _, seg := range segments {
 		groups[seg.VoiceID] = append(groups[seg.VoiceID], seg)
 	}
+	for _, group := range groups {
+		sortElevenLabsSegments(group)
+	}
 	return groups
 }
 
 // TTSRequest represents a request to the ElevenLabs TTS API.
 // This is a simplified version for use with ttsscript.
 type TTSRequest struct {
-	VoiceID  string
-	Text     string
-	ModelID  string
-	Segment  ElevenLabsSegment
-	Language string
+	VoiceID       string
+	Text          string
+	ModelID       string
+	VoiceSettings *VoiceSettings
+	LanguageCode  string
+	Seed          int
+	Segment       ElevenLabsSegment
+	Language      string
 }
 
-// GenerateTTSRequests creates TTS requests from formatted segments.
+// GenerateTTSRequests creates TTS requests from formatted segments. modelID
+// is used for segments that don't set a model.
 func GenerateTTSRequests(segments []ElevenLabsSegment, modelID, language string) []TTSRequest {
 	requests := make([]TTSRequest, len(segments))
 	for i, seg := range segments {
 		requests[i] = TTSRequest{
-			VoiceID:  seg.VoiceID,
-			Text:     seg.Text,
-			ModelID:  modelID,
-			Segment:  seg,
-			Language: language,
+			VoiceID:       seg.VoiceID,
+			Text:          seg.Text,
+			ModelID:       firstNonEmpty(seg.ModelID, modelID),
+			VoiceSettings: seg.VoiceSettings,
+			LanguageCode:  seg.LanguageCode,
+			Seed:          seg.Seed,
+			Segment:       seg,
+			Language:      language,
 		}
 	}
 	return requests
@@ -168,6 +322,10 @@ type BatchConfig struct {
 
 	// IncludeLanguageInFilename adds language code to filename.
 	IncludeLanguageInFilename bool
+
+	// ModelID is the model used for generation. It is included in manifest
+	// hashes so that switching models invalidates existing audio.
+	ModelID string
 }
 
 // NewBatchConfig creates a batch config with defaults.
@@ -182,12 +340,7 @@ func NewBatchConfig(outputDir string) *BatchConfig {
 
 // GenerateFilename generates an output filename for a segment.
 func (c *BatchConfig) GenerateFilename(seg ElevenLabsSegment, language string) string {
-	var name string
-	if seg.IsTitleSegment {
-		name = fmt.Sprintf("slide%02d_title", seg.SlideIndex+1)
-	} else {
-		name = fmt.Sprintf("slide%02d_seg%02d", seg.SlideIndex+1, seg.SegmentIndex+1)
-	}
+	name := segmentBaseName(seg.SlideIndex, seg.SegmentIndex, seg.IsTitleSegment, seg.IsEarcon)
 
 	if c.FilePrefix != "" {
 		name = c.FilePrefix + "_" + name
@@ -201,22 +354,137 @@ func (c *BatchConfig) GenerateFilename(seg ElevenLabsSegment, language string) s
 		name = name + "_" + c.FileSuffix
 	}
 
-	return fmt.Sprintf("%s/%s.mp3", c.OutputDir, name)
+	return fmt.Sprintf("%s/%s%s", c.OutputDir, name, audioExtension(seg.AudioFile))
 }
 
-// ManifestEntry represents an entry in a generation manifest.
+// ManifestEntry represents an entry in a generation manifest. Text is
+// plaintext even for an encrypted script, since manifests are built from
+// the decrypted script.
 type ManifestEntry struct {
-	SlideIndex      int    `json:"slide_index"`
-	SegmentIndex    int    `json:"segment_index"`
-	SlideTitle      string `json:"slide_title,omitempty"`
-	IsTitleSegment  bool   `json:"is_title_segment,omitempty"`
-	IsSectionHeader bool   `json:"is_section_header,omitempty"`
-	Text            string `json:"text"`
-	VoiceID         string `json:"voice_id"`
-	Language        string `json:"language"`
-	OutputFile      string `json:"output_file"`
-	PauseBeforeMs   int    `json:"pause_before_ms,omitempty"`
-	PauseAfterMs    int    `json:"pause_after_ms,omitempty"`
+	SlideIndex      int     `json:"slide_index"`
+	SegmentIndex    int     `json:"segment_index"`
+	ID              string  `json:"id,omitempty"`
+	SlideTitle      string  `json:"slide_title,omitempty"`
+	IsTitleSegment  bool    `json:"is_title_segment,omitempty"`
+	IsSectionHeader bool    `json:"is_section_header,omitempty"`
+	Text            string  `json:"text"`
+	VoiceID         string  `json:"voice_id"`
+	Language        string  `json:"language"`
+	OutputFile      string  `json:"output_file"`
+	PauseBeforeMs   int     `json:"pause_before_ms,omitempty"`
+	PauseAfterMs    int     `json:"pause_after_ms,omitempty"`
+	FadeInMs        int     `json:"fade_in_ms,omitempty"`
+	FadeOutMs       int     `json:"fade_out_ms,omitempty"`
+	GainDB          float64 `json:"gain_db,omitempty"`
+	CrossfadeMs     int     `json:"crossfade_ms,omitempty"`
+	Hash            string  `json:"hash,omitempty"`
+
+	// Engine is the TTS engine the audio is generated with. Empty means
+	// the default engine.
+	Engine string `json:"engine,omitempty"`
+
+	// AudioFile is the pre-recorded audio copied to OutputFile, as given
+	// in the script. Empty for generated speech.
+	AudioFile string `json:"audio_file,omitempty"`
+
+	// IsEarcon marks the speaker change earcon played before the segment
+	// at SegmentIndex.
+	IsEarcon bool `json:"is_earcon,omitempty"`
+
+	// DurationMs is the measured duration of the generated audio file,
+	// filled in after generation (0 if not generated).
+	DurationMs int `json:"duration_ms,omitempty"`
+
+	// TrimmedStartMs and TrimmedEndMs are the leading and trailing
+	// silence removed from the generated audio, if it was trimmed.
+	TrimmedStartMs int `json:"trimmed_start_ms,omitempty"`
+	TrimmedEndMs   int `json:"trimmed_end_ms,omitempty"`
+
+	// Take is the active take copied to OutputFile, when several takes
+	// were generated (see TakeSelection).
+	Take int `json:"take,omitempty"`
+
+	Assets []LocalizedAsset `json:"assets,omitempty"`
+
+	// Status is EntryComplete, EntryMissing, or EntryFailed after a run,
+	// or empty if the entry was not processed.
+	Status string `json:"status,omitempty"`
+
+	// MissingReason explains why an EntryMissing entry has no audio.
+	MissingReason string `json:"missing_reason,omitempty"`
+
+	// Pronunciations lists the pronunciation substitutions applied to Text,
+	// when the script was compiled with Compiler.Trace.
+	Pronunciations []PronunciationHit `json:"pronunciations,omitempty"`
+
+	// Voice records the voice as it was when the audio was generated, so a
+	// later run can detect that it was renamed or deleted and suggest a
+	// replacement. Nil if the voice was not checked.
+	Voice *VoiceInfo `json:"voice,omitempty"`
+}
+
+// VoiceInfo is the name, category, and labels of a voice, as recorded in a
+// manifest.
+type VoiceInfo struct {
+	Name     string            `json:"name,omitempty"`
+	Category string            `json:"category,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+}
+
+// HasEffects returns true if the entry requires post-processing effects
+// (fades or gain) before concatenation.
+func (e ManifestEntry) HasEffects() bool {
+	return e.FadeInMs > 0 || e.FadeOutMs > 0 || e.GainDB != 0
+}
+
+// Image returns the entry's first image asset, if any.
+func (e ManifestEntry) Image() (LocalizedAsset, bool) {
+	for _, a := range e.Assets {
+		if a.Type == "image" && a.Path != "" {
+			return a, true
+		}
+	}
+	return LocalizedAsset{}, false
+}
+
+// LoadManifest loads manifest entries from a JSON file.
+func LoadManifest(filePath string) ([]ManifestEntry, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest file: %w", err)
+	}
+	var entries []ManifestEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing manifest JSON: %w", err)
+	}
+	return entries, nil
+}
+
+// ManifestVoiceIDs returns the voice IDs used in manifest entries, sorted and
+// deduplicated. Entries with pre-recorded audio are ignored.
+func ManifestVoiceIDs(entries []ManifestEntry) []string {
+	ids := make(map[string]bool)
+	for _, e := range entries {
+		if e.VoiceID != "" && e.AudioFile == "" {
+			ids[e.VoiceID] = true
+		}
+	}
+	return sortedKeys(ids)
+}
+
+// ManifestVoiceInfo returns the voice recorded for each voice ID in the
+// manifest entries. The first entry recording a voice wins.
+func ManifestVoiceInfo(entries []ManifestEntry) map[string]VoiceInfo {
+	voices := make(map[string]VoiceInfo)
+	for _, e := range entries {
+		if e.Voice == nil || e.VoiceID == "" {
+			continue
+		}
+		if _, ok := voices[e.VoiceID]; !ok {
+			voices[e.VoiceID] = *e.Voice
+		}
+	}
+	return voices
 }
 
 // GenerateManifest creates a manifest of all segments for tracking.
@@ -226,6 +494,7 @@ func GenerateManifest(segments []ElevenLabsSegment, config *BatchConfig, languag
 		entries[i] = ManifestEntry{
 			SlideIndex:      seg.SlideIndex,
 			SegmentIndex:    seg.SegmentIndex,
+			ID:              seg.ID,
 			SlideTitle:      seg.SlideTitle,
 			IsTitleSegment:  seg.IsTitleSegment,
 			IsSectionHeader: seg.IsSectionHeader,
@@ -235,7 +504,76 @@ func GenerateManifest(segments []ElevenLabsSegment, config *BatchConfig, languag
 			OutputFile:      config.GenerateFilename(seg, language),
 			PauseBeforeMs:   seg.PauseBeforeMs,
 			PauseAfterMs:    seg.PauseAfterMs,
+			FadeInMs:        seg.FadeInMs,
+			FadeOutMs:       seg.FadeOutMs,
+			GainDB:          seg.GainDB,
+			CrossfadeMs:     seg.CrossfadeMs,
+			Hash:            SegmentHash(seg, config.ModelID),
+			Engine:          seg.Engine,
+			Assets:          seg.Assets,
+			Pronunciations:  seg.PronunciationTrace,
+			AudioFile:       seg.AudioFile,
+			IsEarcon:        seg.IsEarcon,
 		}
 	}
 	return entries
 }
+
+// StudioChapter is a chapter in the ElevenLabs Studio "from_content_json"
+// project format.
+type StudioChapter struct {
+	Name   string        `json:"name"`
+	Blocks []StudioBlock `json:"blocks"`
+}
+
+// StudioBlock is a paragraph or heading in a Studio chapter.
+type StudioBlock struct {
+	// SubType is "h1" (part or chapter heading), "h2" (section title), or "p".
+	SubType string       `json:"sub_type"`
+	Nodes   []StudioNode `json:"nodes"`
+}
+
+// StudioNode is a run of text spoken by one voice.
+type StudioNode struct {
+	Type    string `json:"type"`
+	VoiceID string `json:"voice_id"`
+	Text    string `json:"text"`
+}
+
+// StudioContent converts compiled chapters to Studio project content, one
+// block per segment. Pass the JSON encoding as the project's content to
+// create a Studio project with the same chapters and voices.
+func StudioContent(chapters []CompiledChapter) []StudioChapter {
+	result := make([]StudioChapter, 0, len(chapters))
+	for _, ch := range chapters {
+		sc := StudioChapter{Name: firstNonEmpty(ch.Title, ch.ID), Blocks: []StudioBlock{}}
+		for _, seg := range ch.Segments {
+			subType := "p"
+			switch {
+			case seg.SlideIndex < ch.FirstSlide+ch.HeadingSlides:
+				subType = "h1"
+			case seg.IsTitleSegment:
+				subType = "h2"
+			}
+			sc.Blocks = append(sc.Blocks, StudioBlock{
+				SubType: subType,
+				Nodes: []StudioNode{{
+					Type:    "tts_node",
+					VoiceID: seg.VoiceID,
+					Text:    AudioTagText(seg.Text, false),
+				}},
+			})
+		}
+		result = append(result, sc)
+	}
+	return result
+}
+
+// StudioContentJSON returns the JSON encoding of StudioContent.
+func StudioContentJSON(chapters []CompiledChapter) (string, error) {
+	data, err := json.Marshal(StudioContent(chapters))
+	if err != nil {
+		return "", fmt.Errorf("marshaling studio content: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,228 @@
+package ttsscript
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// EncryptedTextPrefix starts an encrypted segment text value. The rest is
+// the base64 nonce and AES-256-GCM ciphertext.
+const EncryptedTextPrefix = "enc:v2:"
+
+// ScriptKeySize is the size in bytes of a script encryption key.
+const ScriptKeySize = 32
+
+// ErrEncryptedText is returned when compiling a script whose segment text
+// is still encrypted. Load it with its key first.
+var ErrEncryptedText = errors.New("ttsscript: segment text is encrypted")
+
+// ErrScriptKey is returned when a script key is malformed or does not
+// decrypt the script's text.
+var ErrScriptKey = errors.New("ttsscript: invalid script key")
+
+// GenerateScriptKey returns a new random script encryption key, base64
+// encoded for storage in a secret manager or environment variable.
+func GenerateScriptKey() (string, error) {
+	key := make([]byte, ScriptKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("generating script key: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// ParseScriptKey decodes a base64 script key from GenerateScriptKey.
+func ParseScriptKey(s string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrScriptKey, err)
+	}
+	if len(key) != ScriptKeySize {
+		return nil, fmt.Errorf("%w: got %d bytes, want %d", ErrScriptKey, len(key), ScriptKeySize)
+	}
+	return key, nil
+}
+
+// IsEncryptedText reports whether a text value was encrypted by EncryptText.
+func IsEncryptedText(s string) bool {
+	return strings.HasPrefix(s, EncryptedTextPrefix)
+}
+
+// TextLocation is where a segment text is in a script. Encrypted text is
+// bound to its location, so it doesn't decrypt if it is copied to another
+// segment or language. Moving encrypted segments therefore needs the key:
+// decrypt the script, move them, and encrypt it again.
+type TextLocation struct {
+	SlideIndex   int
+	SegmentIndex int
+	Language     string
+}
+
+// additionalData returns the AES-GCM additional data for a location.
+func (l TextLocation) additionalData() []byte {
+	return []byte(fmt.Sprintf("ttsscript slide %d segment %d language %s", l.SlideIndex, l.SegmentIndex, l.Language))
+}
+
+// EncryptText encrypts the text at loc with AES-256-GCM. The nonce is
+// derived from the location and text, so unchanged text encrypts to the
+// same value and script diffs only show edited segments. The cost is that
+// equal texts at the same location have equal ciphertexts.
+func EncryptText(key []byte, loc TextLocation, text string) (string, error) {
+	aead, nonceKey, err := scriptCipher(key)
+	if err != nil {
+		return "", err
+	}
+	ad := loc.additionalData()
+	mac := hmac.New(sha256.New, nonceKey)
+	_ = binary.Write(mac, binary.BigEndian, uint32(len(ad)))
+	mac.Write(ad)
+	mac.Write([]byte(text))
+	nonce := mac.Sum(nil)[:aead.NonceSize()]
+
+	sealed := aead.Seal(nonce, nonce, []byte(text), ad)
+	return EncryptedTextPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptText decrypts a value from EncryptText encrypted at loc. Values
+// that aren't encrypted are returned unchanged.
+func DecryptText(key []byte, loc TextLocation, value string) (string, error) {
+	if !IsEncryptedText(value) {
+		return value, nil
+	}
+	aead, _, err := scriptCipher(key)
+	if err != nil {
+		return "", err
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, EncryptedTextPrefix))
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("ttsscript: malformed encrypted text")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	text, err := aead.Open(nil, nonce, ciphertext, loc.additionalData())
+	if err != nil {
+		return "", fmt.Errorf("%w: text does not decrypt", ErrScriptKey)
+	}
+	return string(text), nil
+}
+
+// scriptCipher returns the AES-GCM cipher and nonce derivation key for a
+// script key. Each is derived from the script key with HMAC-SHA256 so that
+// neither is used for both purposes.
+func scriptCipher(key []byte) (cipher.AEAD, []byte, error) {
+	if len(key) != ScriptKeySize {
+		return nil, nil, fmt.Errorf("%w: got %d bytes, want %d", ErrScriptKey, len(key), ScriptKeySize)
+	}
+	derive := func(label string) []byte {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(label))
+		return mac.Sum(nil)
+	}
+	block, err := aes.NewCipher(derive("ttsscript text encryption"))
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, derive("ttsscript text nonce"), nil
+}
+
+// Encrypt encrypts the text of every segment, for scripts with unreleased
+// product information that are still kept in Git. Everything else,
+// including voices, timing, and pronunciations, stays readable so the
+// script can be reviewed and formatted without the key. Text that is
+// already encrypted is left as it is.
+//
+// Example:
+//
+//	key, _ := ttsscript.ParseScriptKey(os.Getenv("TTSSCRIPT_KEY"))
+//	if err := script.Encrypt(key); err != nil {
+//	    return err
+//	}
+//	err = script.Save("script.json")
+func (s *Script) Encrypt(key []byte) error {
+	return s.mapSegmentText(func(loc TextLocation, text string) (string, error) {
+		if IsEncryptedText(text) {
+			return text, nil
+		}
+		return EncryptText(key, loc, text)
+	})
+}
+
+// Decrypt decrypts the segment text encrypted by Encrypt. If any text
+// doesn't decrypt, the script is left unchanged.
+func (s *Script) Decrypt(key []byte) error {
+	return s.mapSegmentText(func(loc TextLocation, text string) (string, error) {
+		return DecryptText(key, loc, text)
+	})
+}
+
+// IsEncrypted reports whether any segment text is encrypted.
+func (s *Script) IsEncrypted() bool {
+	for _, slide := range s.Slides {
+		for _, seg := range slide.Segments {
+			for _, text := range seg.Text {
+				if IsEncryptedText(text) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// mapSegmentText replaces each segment text with fn's result. The results
+// are collected in copies of the text maps, which replace the script's
+// only once fn has succeeded for every text, so an error leaves the script
+// unchanged.
+func (s *Script) mapSegmentText(fn func(loc TextLocation, text string) (string, error)) error {
+	mapped := make([][]map[string]string, len(s.Slides))
+	for i, slide := range s.Slides {
+		mapped[i] = make([]map[string]string, len(slide.Segments))
+		for j, seg := range slide.Segments {
+			if seg.Text == nil {
+				continue
+			}
+			out := make(map[string]string, len(seg.Text))
+			for lang, text := range seg.Text {
+				loc := TextLocation{SlideIndex: i, SegmentIndex: j, Language: lang}
+				value, err := fn(loc, text)
+				if err != nil {
+					return fmt.Errorf("slide %d, segment %d, %q: %w", i+1, j+1, lang, err)
+				}
+				out[lang] = value
+			}
+			mapped[i][j] = out
+		}
+	}
+	for i := range s.Slides {
+		for j := range s.Slides[i].Segments {
+			if mapped[i][j] != nil {
+				s.Slides[i].Segments[j].Text = mapped[i][j]
+			}
+		}
+	}
+	return nil
+}
+
+// LoadScriptWithKey loads a script from a JSON file and decrypts its
+// segment text with key. Scripts without encrypted text load as with
+// LoadScript.
+func LoadScriptWithKey(filePath string, key []byte) (*Script, error) {
+	script, err := LoadScript(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if err := script.Decrypt(key); err != nil {
+		return nil, err
+	}
+	return script, nil
+}
@@ -0,0 +1,109 @@
+package ttsscript
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScriptEncryption(t *testing.T) {
+	encoded, err := GenerateScriptKey()
+	if err != nil {
+		t.Fatalf("GenerateScriptKey() error = %v", err)
+	}
+	key, err := ParseScriptKey(encoded)
+	if err != nil {
+		t.Fatalf("ParseScriptKey() error = %v", err)
+	}
+
+	script := &Script{
+		Title:         "Launch",
+		DefaultVoices: map[string]string{"en": "v1"},
+		Slides: []Slide{{Segments: []Segment{
+			{Text: map[string]string{"en": "Project Falcon ships in May.", "es": "Falcon sale en mayo."}},
+			{Text: map[string]string{"en": "Thanks."}},
+		}}},
+	}
+	if err := script.Encrypt(key); err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	first := script.Slides[0].Segments[0].Text["en"]
+	if !IsEncryptedText(first) || strings.Contains(first, "Falcon") || !script.IsEncrypted() {
+		t.Errorf("encrypted text = %q", first)
+	}
+
+	// Unchanged text keeps its ciphertext, and re-encrypting is a no-op
+	again, _ := EncryptText(key, TextLocation{Language: "en"}, "Project Falcon ships in May.")
+	if again != first {
+		t.Errorf("EncryptText() = %q, want %q", again, first)
+	}
+	_ = script.Encrypt(key)
+	if script.Slides[0].Segments[0].Text["en"] != first {
+		t.Error("Encrypt() re-encrypted encrypted text")
+	}
+
+	if issues := script.Validate(); len(issues) == 0 || !strings.Contains(strings.Join(issues, "\n"), "encrypted") {
+		t.Errorf("Validate() = %v, want encrypted text issue", issues)
+	}
+	if _, err := NewCompiler().Compile(script, "en"); !errors.Is(err, ErrEncryptedText) {
+		t.Errorf("Compile() error = %v, want ErrEncryptedText", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "script.json")
+	if err := script.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "Falcon") || !strings.Contains(string(data), `"title": "Launch"`) {
+		t.Errorf("saved script:\n%s", data)
+	}
+	loaded, err := LoadScriptWithKey(path, key)
+	if err != nil {
+		t.Fatalf("LoadScriptWithKey() error = %v", err)
+	}
+	if got := loaded.Slides[0].Segments[0].Text["es"]; got != "Falcon sale en mayo." || loaded.IsEncrypted() {
+		t.Errorf("decrypted text = %q", got)
+	}
+
+	other, _ := GenerateScriptKey()
+	otherKey, _ := ParseScriptKey(other)
+	if _, err := LoadScriptWithKey(path, otherKey); !errors.Is(err, ErrScriptKey) {
+		t.Errorf("LoadScriptWithKey(wrong key) error = %v, want ErrScriptKey", err)
+	}
+	if _, err := ParseScriptKey("c2hvcnQ="); !errors.Is(err, ErrScriptKey) {
+		t.Errorf("ParseScriptKey(short) error = %v, want ErrScriptKey", err)
+	}
+}
+
+func TestScriptEncryptionLocation(t *testing.T) {
+	encoded, _ := GenerateScriptKey()
+	key, _ := ParseScriptKey(encoded)
+
+	script := &Script{Slides: []Slide{{Segments: []Segment{
+		{Text: map[string]string{"en": "Falcon ships in May.", "es": "Falcon sale en mayo."}},
+		{Text: map[string]string{"en": "Falcon ships in May."}},
+	}}}}
+	if err := script.Encrypt(key); err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	segs := script.Slides[0].Segments
+	if segs[0].Text["en"] == segs[1].Text["en"] {
+		t.Error("equal texts in different segments have equal ciphertexts")
+	}
+
+	// Text copied to another segment doesn't decrypt, and the failed
+	// Decrypt leaves the script as it was
+	segs[1].Text["en"] = segs[0].Text["en"]
+	encrypted := segs[0].Text["es"]
+	if err := script.Decrypt(key); !errors.Is(err, ErrScriptKey) {
+		t.Fatalf("Decrypt(moved text) error = %v, want ErrScriptKey", err)
+	}
+	if got := script.Slides[0].Segments[0].Text["es"]; got != encrypted {
+		t.Errorf("after failed Decrypt, text = %q, want %q", got, encrypted)
+	}
+	if _, err := DecryptText(key, TextLocation{Language: "es"}, segs[0].Text["en"]); !errors.Is(err, ErrScriptKey) {
+		t.Errorf("DecryptText(other language) error = %v, want ErrScriptKey", err)
+	}
+}
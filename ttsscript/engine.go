@@ -0,0 +1,168 @@
+package ttsscript
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// ErrUnknownEngine is returned when a job is routed to an engine that is
+// not registered with the Router.
+var ErrUnknownEngine = errors.New("ttsscript: unknown TTS engine")
+
+// Engine synthesizes speech for segments. Implementations wrap a TTS
+// provider (ElevenLabs, Google Cloud TTS, Amazon Polly, Azure, or a local
+// model), so the same generation pipeline works with any of them.
+//
+// The go-elevenlabs package provides an ElevenLabs implementation
+// (elevenlabs.NewScriptEngine).
+type Engine interface {
+	// Synthesize generates the audio for a job.
+	Synthesize(ctx context.Context, job SegmentJob) (Audio, error)
+}
+
+// SegmentJob is a segment to synthesize.
+type SegmentJob struct {
+	// Text is the text to speak.
+	Text string
+
+	// VoiceID is the engine's voice identifier.
+	VoiceID string
+
+	// Engine is the engine named by the script's routing, used by Router.
+	// Empty means the default engine.
+	Engine string
+
+	// Language is the script language code (e.g., "en").
+	Language string
+
+	// VoiceSettings are the segment's voice settings, if any. Engines apply
+	// the settings they support and ignore the rest.
+	VoiceSettings *VoiceSettings
+
+	// ModelID is the segment's model, if any. Empty means the engine's
+	// configured model.
+	ModelID string
+
+	// Seed is the segment's generation seed (0 for none). Engines without
+	// seeds ignore it.
+	Seed int
+
+	// SlideIndex is the source slide index.
+	SlideIndex int
+
+	// SegmentIndex is the source segment index (-1 for title segments).
+	SegmentIndex int
+
+	// ID is the segment ID from the script, if any.
+	ID string
+}
+
+// NewSegmentJob creates a job for a formatted segment.
+func NewSegmentJob(seg ElevenLabsSegment, language string) SegmentJob {
+	return SegmentJob{
+		Text:          seg.Text,
+		VoiceID:       seg.VoiceID,
+		Engine:        seg.Engine,
+		Language:      language,
+		VoiceSettings: seg.VoiceSettings,
+		ModelID:       seg.ModelID,
+		Seed:          seg.Seed,
+		SlideIndex:    seg.SlideIndex,
+		SegmentIndex:  seg.SegmentIndex,
+		ID:            seg.ID,
+	}
+}
+
+// Audio is synthesized speech.
+type Audio struct {
+	// Data is the encoded audio.
+	Data []byte
+
+	// Format is the encoding, used as the file extension (e.g., "mp3").
+	Format string
+}
+
+// WriteFile writes the audio to a file.
+func (a Audio) WriteFile(filePath string) error {
+	if err := os.WriteFile(filePath, a.Data, 0600); err != nil {
+		return fmt.Errorf("writing audio file: %w", err)
+	}
+	return nil
+}
+
+// Router is an Engine that sends each job to a named engine, so one script
+// can mix providers, e.g. Japanese on Google and everything else on
+// ElevenLabs. A job goes to the engine named by its Engine field (from the
+// script's routes), then by Languages, then to Default.
+type Router struct {
+	// Engines are the available engines by name.
+	Engines map[string]Engine
+
+	// Languages routes languages to engine names for jobs whose script
+	// names no engine. Example: {"ja": "google"}
+	Languages map[string]string
+
+	// Default is the engine name for all other jobs.
+	Default string
+}
+
+var _ Engine = (*Router)(nil)
+
+// NewRouter creates a router whose default engine is the named engine.
+func NewRouter(name string, engine Engine) *Router {
+	return &Router{
+		Engines:   map[string]Engine{name: engine},
+		Languages: map[string]string{},
+		Default:   name,
+	}
+}
+
+// Register adds a named engine.
+func (r *Router) Register(name string, engine Engine) {
+	if r.Engines == nil {
+		r.Engines = make(map[string]Engine)
+	}
+	r.Engines[name] = engine
+}
+
+// EngineName returns the name of the engine a job is routed to.
+func (r *Router) EngineName(job SegmentJob) string {
+	if job.Engine != "" {
+		return job.Engine
+	}
+	if name, ok := r.Languages[job.Language]; ok {
+		return name
+	}
+	return r.Default
+}
+
+// Synthesize generates the audio for a job with the engine it is routed
+// to. It returns ErrUnknownEngine if that engine is not registered.
+func (r *Router) Synthesize(ctx context.Context, job SegmentJob) (Audio, error) {
+	name := r.EngineName(job)
+	engine, ok := r.Engines[name]
+	if !ok {
+		return Audio{}, fmt.Errorf("%w: %q", ErrUnknownEngine, name)
+	}
+	return engine.Synthesize(ctx, job)
+}
+
+// ManifestsByEngine splits manifest entries by engine, for one manifest
+// per engine. Entries with no engine are listed under defaultEngine;
+// pre-recorded entries are left out.
+func ManifestsByEngine(entries []ManifestEntry, defaultEngine string) map[string][]ManifestEntry {
+	manifests := make(map[string][]ManifestEntry)
+	for _, e := range entries {
+		if e.AudioFile != "" {
+			continue
+		}
+		name := e.Engine
+		if name == "" {
+			name = defaultEngine
+		}
+		manifests[name] = append(manifests[name], e)
+	}
+	return manifests
+}
@@ -0,0 +1,115 @@
+package ttsscript
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeEngine struct {
+	name string
+	jobs []SegmentJob
+}
+
+func (e *fakeEngine) Synthesize(_ context.Context, job SegmentJob) (Audio, error) {
+	e.jobs = append(e.jobs, job)
+	return Audio{Data: []byte(e.name + ":" + job.Text), Format: "mp3"}, nil
+}
+
+func TestCompileEngineRoutes(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "el-en", "ja": "el-ja"},
+		EngineRoutes: []EngineRoute{
+			{Language: "ja", Engine: "google", Voice: "ja-JP-Neural2-B"},
+		},
+		Slides: []Slide{
+			{
+				Title:           "はじめに",
+				IsSectionHeader: true,
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Hello", "ja": "こんにちは"}},
+					{Text: map[string]string{"en": "Disclaimer", "ja": "免責事項"}, Engine: map[string]string{"en": "polly"}, Voice: map[string]string{"en": "Joanna"}},
+					{Text: map[string]string{"ja": "特別"}, Engine: map[string]string{"ja": "elevenlabs"}},
+				},
+			},
+		},
+	}
+
+	ja, err := NewCompiler().Compile(script, "ja")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if ja[0].Engine != "google" || ja[0].VoiceID != "ja-JP-Neural2-B" {
+		t.Errorf("title = %s/%s, want the routed engine and voice", ja[0].Engine, ja[0].VoiceID)
+	}
+	if ja[1].Engine != "google" || ja[1].VoiceID != "ja-JP-Neural2-B" {
+		t.Errorf("segment = %s/%s, want the routed engine and voice", ja[1].Engine, ja[1].VoiceID)
+	}
+	// A segment override also falls back to the default voices
+	if ja[3].Engine != "elevenlabs" || ja[3].VoiceID != "el-ja" {
+		t.Errorf("override = %s/%s", ja[3].Engine, ja[3].VoiceID)
+	}
+
+	en, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if en[1].Engine != "" || en[1].VoiceID != "el-en" {
+		t.Errorf("unrouted segment = %s/%s", en[1].Engine, en[1].VoiceID)
+	}
+	if en[2].Engine != "polly" || en[2].VoiceID != "Joanna" {
+		t.Errorf("segment engine = %s/%s", en[2].Engine, en[2].VoiceID)
+	}
+
+	jobs := NewElevenLabsFormatter().Format(ja)
+	entries := GenerateManifest(jobs, NewBatchConfig("out"), "ja")
+	if entries[1].Engine != "google" || NewSegmentJob(jobs[1], "ja").Engine != "google" {
+		t.Errorf("engine not carried to manifest and jobs: %+v", entries[1])
+	}
+	byEngine := ManifestsByEngine(entries, "elevenlabs")
+	if len(byEngine["google"]) != 3 || len(byEngine["elevenlabs"]) != 1 {
+		t.Errorf("ManifestsByEngine() = %v", byEngine)
+	}
+}
+
+func TestEngineRouteCatchAll(t *testing.T) {
+	script := &Script{EngineRoutes: []EngineRoute{{Engine: "azure"}, {Language: "ja", Engine: "google"}}}
+	if r := script.EngineRoute("ja"); r == nil || r.Engine != "google" {
+		t.Errorf("EngineRoute(ja) = %+v", r)
+	}
+	if r := script.EngineRoute("fr"); r == nil || r.Engine != "azure" {
+		t.Errorf("EngineRoute(fr) = %+v", r)
+	}
+	if r := (&Script{}).EngineRoute("en"); r != nil {
+		t.Errorf("EngineRoute() with no routes = %+v", r)
+	}
+}
+
+func TestRouter(t *testing.T) {
+	eleven := &fakeEngine{name: "elevenlabs"}
+	google := &fakeEngine{name: "google"}
+	router := NewRouter("elevenlabs", eleven)
+	router.Register("google", google)
+	router.Languages["de"] = "google"
+
+	ctx := context.Background()
+	tests := []struct {
+		job  SegmentJob
+		want string
+	}{
+		{SegmentJob{Text: "a", Language: "en"}, "elevenlabs:a"},
+		{SegmentJob{Text: "b", Language: "de"}, "google:b"},
+		{SegmentJob{Text: "c", Language: "de", Engine: "elevenlabs"}, "elevenlabs:c"},
+		{SegmentJob{Text: "d", Language: "en", Engine: "google"}, "google:d"},
+	}
+	for _, tt := range tests {
+		audio, err := router.Synthesize(ctx, tt.job)
+		if err != nil || string(audio.Data) != tt.want {
+			t.Errorf("Synthesize(%+v) = %q, %v, want %q", tt.job, audio.Data, err, tt.want)
+		}
+	}
+
+	if _, err := router.Synthesize(ctx, SegmentJob{Engine: "polly"}); !errors.Is(err, ErrUnknownEngine) {
+		t.Errorf("Synthesize() with unknown engine error = %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package ttsscript
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Canonicalize normalizes the script's duration strings in place, so that
+// "0.5s" and "500ms" are both written as "500ms" and "1000ms" as "1s".
+// Durations that don't parse are left as they are.
+func (s *Script) Canonicalize() {
+	if e := s.SpeakerChangeEarcon; e != nil {
+		e.Duration = canonicalDuration(e.Duration)
+		e.PauseAfter = canonicalDuration(e.PauseAfter)
+	}
+	s.DefaultCrossfade = canonicalDuration(s.DefaultCrossfade)
+	for i := range s.Slides {
+		slide := &s.Slides[i]
+		slide.Crossfade = canonicalDuration(slide.Crossfade)
+		slide.TitlePauseAfter = canonicalDuration(slide.TitlePauseAfter)
+		slide.DefaultPauseAfter = canonicalDuration(slide.DefaultPauseAfter)
+		for j := range slide.Segments {
+			seg := &slide.Segments[j]
+			seg.PauseBefore = canonicalDuration(seg.PauseBefore)
+			seg.PauseAfter = canonicalDuration(seg.PauseAfter)
+			seg.FadeIn = canonicalDuration(seg.FadeIn)
+			seg.FadeOut = canonicalDuration(seg.FadeOut)
+		}
+	}
+}
+
+// Format returns the script as canonical JSON: fields in declaration
+// order, map keys sorted, two-space indentation, HTML characters such as
+// "<" in SSML left unescaped, and a trailing newline. Formatting a
+// canonicalized script is stable, so diffs only show content changes.
+func (s *Script) Format() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(s); err != nil {
+		return nil, fmt.Errorf("marshaling script: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// FormatScript parses a script and returns it canonicalized and
+// formatted. See Script.Canonicalize and Script.Format.
+func FormatScript(data []byte) ([]byte, error) {
+	script, err := ParseScript(data)
+	if err != nil {
+		return nil, err
+	}
+	script.Canonicalize()
+	return script.Format()
+}
+
+// canonicalDuration rewrites a duration string in the form FormatDuration
+// produces. An explicit zero is kept as "0ms", since it overrides defaults.
+func canonicalDuration(s string) string {
+	if ms := ParseDuration(s); ms > 0 {
+		return FormatDuration(ms)
+	}
+	t := strings.TrimSpace(strings.ToLower(s))
+	num := strings.TrimSuffix(strings.TrimSuffix(t, "ms"), "s")
+	if num != t {
+		if f, err := strconv.ParseFloat(num, 64); err == nil && f == 0 {
+			return "0ms"
+		}
+	}
+	return s
+}
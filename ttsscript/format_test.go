@@ -0,0 +1,70 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCanonicalDuration(t *testing.T) {
+	tests := map[string]string{
+		"":       "",
+		"500ms":  "500ms",
+		"0.5s":   "500ms",
+		"1000ms": "1s",
+		" 2S ":   "2s",
+		"0s":     "0ms",
+		"0ms":    "0ms",
+		"soon":   "soon",
+		"0.5ms":  "0.5ms",
+	}
+	for in, want := range tests {
+		if got := canonicalDuration(in); got != want {
+			t.Errorf("canonicalDuration(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFormatScript(t *testing.T) {
+	input := `{"slides": [{"segments": [{"voice": {"es": "v2", "en": "v1"},
+		"text": {"es": "Hola", "en": "Say <b>hi</b>"}, "pause_after": "1.5s"}],
+		"default_pause_after": "1000ms"}], "title": "Demo"}`
+
+	got, err := FormatScript([]byte(input))
+	if err != nil {
+		t.Fatalf("FormatScript() error = %v", err)
+	}
+	want := `{
+  "title": "Demo",
+  "slides": [
+    {
+      "default_pause_after": "1s",
+      "segments": [
+        {
+          "text": {
+            "en": "Say <b>hi</b>",
+            "es": "Hola"
+          },
+          "voice": {
+            "en": "v1",
+            "es": "v2"
+          },
+          "pause_after": "1500ms"
+        }
+      ]
+    }
+  ]
+}
+`
+	if string(got) != want {
+		t.Errorf("FormatScript() =\n%s\nwant\n%s", got, want)
+	}
+
+	again, err := FormatScript(got)
+	if err != nil || string(again) != string(got) {
+		t.Errorf("formatting is not stable:\n%s", again)
+	}
+
+	if _, err := FormatScript([]byte(`{"slides": [`)); err == nil || !strings.Contains(err.Error(), "line") {
+		t.Errorf("FormatScript() invalid JSON error = %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package ttsscript
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// ScriptHash returns a content hash of a script. It identifies the script
+// revision a file was generated from, independent of JSON formatting.
+func ScriptHash(s *Script) string {
+	data, _ := json.Marshal(s)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// SegmentRef returns a stable reference to the entry's segment: its ID if
+// set, otherwise its position in the -segments selector syntax
+// (e.g., "slide03:2" or "slide03:title").
+func (e ManifestEntry) SegmentRef() string {
+	if e.ID != "" {
+		return e.ID
+	}
+	if e.IsTitleSegment {
+		return fmt.Sprintf("slide%02d:title", e.SlideIndex+1)
+	}
+	if e.IsEarcon {
+		return fmt.Sprintf("slide%02d:%d:earcon", e.SlideIndex+1, e.SegmentIndex+1)
+	}
+	return fmt.Sprintf("slide%02d:%d", e.SlideIndex+1, e.SegmentIndex+1)
+}
+
+// Provenance returns a description of where the entry's audio came from,
+// suitable for embedding in the generated file's metadata:
+//
+//	script=<script hash> segment=slide03:2 lang=en
+func (e ManifestEntry) Provenance(scriptHash string) string {
+	return fmt.Sprintf("script=%s segment=%s lang=%s", scriptHash, e.SegmentRef(), e.Language)
+}
+
+// SegmentHash returns a content hash of everything that affects the generated
+// audio for a segment: text, engine, voice, model, seed, and voice
+// settings, or the pre-recorded audio file. The segment's model, if set,
+// takes precedence over modelID. Two segments with the same hash produce
+// interchangeable audio, so unchanged segments can be skipped on
+// regeneration.
+func SegmentHash(seg ElevenLabsSegment, modelID string) string {
+	data, _ := json.Marshal(struct {
+		Text          string         `json:"text"`
+		Engine        string         `json:"engine,omitempty"`
+		VoiceID       string         `json:"voice_id"`
+		ModelID       string         `json:"model_id"`
+		Seed          int            `json:"seed,omitempty"`
+		VoiceSettings *VoiceSettings `json:"voice_settings,omitempty"`
+		AudioFile     string         `json:"audio_file,omitempty"`
+	}{seg.Text, seg.Engine, seg.VoiceID, firstNonEmpty(seg.ModelID, modelID), seg.Seed, seg.VoiceSettings, seg.AudioFile})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ChangedEntries returns the entries in current whose output file is new or
+// whose hash differs from the entry with the same output file in previous.
+func ChangedEntries(previous, current []ManifestEntry) []ManifestEntry {
+	prev := make(map[string]string, len(previous))
+	for _, e := range previous {
+		prev[e.OutputFile] = e.Hash
+	}
+	var changed []ManifestEntry
+	for _, e := range current {
+		if h, ok := prev[e.OutputFile]; !ok || h == "" || h != e.Hash {
+			changed = append(changed, e)
+		}
+	}
+	return changed
+}
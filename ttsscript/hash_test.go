@@ -0,0 +1,77 @@
+package ttsscript
+
+import "testing"
+
+func TestSegmentHash(t *testing.T) {
+	seg := ElevenLabsSegment{Text: "Hello", VoiceID: "voice-1"}
+	base := SegmentHash(seg, "model-a")
+
+	if base != SegmentHash(seg, "model-a") {
+		t.Error("expected hash to be deterministic")
+	}
+	if base == SegmentHash(seg, "model-b") {
+		t.Error("expected model change to change hash")
+	}
+
+	seg.Text = "Hello!"
+	if base == SegmentHash(seg, "model-a") {
+		t.Error("expected text change to change hash")
+	}
+
+	seg.Text = "Hello"
+	seg.SlideIndex = 4
+	if base != SegmentHash(seg, "model-a") {
+		t.Error("expected position not to affect hash")
+	}
+}
+
+func TestChangedEntries(t *testing.T) {
+	previous := []ManifestEntry{
+		{OutputFile: "a.mp3", Hash: "1"},
+		{OutputFile: "b.mp3", Hash: "2"},
+		{OutputFile: "c.mp3", Hash: ""},
+	}
+	current := []ManifestEntry{
+		{OutputFile: "a.mp3", Hash: "1"},
+		{OutputFile: "b.mp3", Hash: "3"},
+		{OutputFile: "c.mp3", Hash: "4"},
+		{OutputFile: "d.mp3", Hash: "5"},
+	}
+
+	changed := ChangedEntries(previous, current)
+	if len(changed) != 3 {
+		t.Fatalf("expected 3 changed entries, got %d", len(changed))
+	}
+	for i, want := range []string{"b.mp3", "c.mp3", "d.mp3"} {
+		if changed[i].OutputFile != want {
+			t.Errorf("changed[%d] = %s, want %s", i, changed[i].OutputFile, want)
+		}
+	}
+}
+
+func TestScriptHash(t *testing.T) {
+	s := &Script{Title: "Demo"}
+	base := ScriptHash(s)
+	if base != ScriptHash(&Script{Title: "Demo"}) {
+		t.Error("ScriptHash not deterministic")
+	}
+	if base == ScriptHash(&Script{Title: "Other"}) {
+		t.Error("ScriptHash ignores content")
+	}
+}
+
+func TestManifestEntryProvenance(t *testing.T) {
+	tests := []struct {
+		entry ManifestEntry
+		want  string
+	}{
+		{ManifestEntry{SlideIndex: 2, SegmentIndex: 1, Language: "en"}, "script=h segment=slide03:2 lang=en"},
+		{ManifestEntry{SlideIndex: 2, IsTitleSegment: true, Language: "en"}, "script=h segment=slide03:title lang=en"},
+		{ManifestEntry{ID: "intro", Language: "de"}, "script=h segment=intro lang=de"},
+	}
+	for _, tt := range tests {
+		if got := tt.entry.Provenance("h"); got != tt.want {
+			t.Errorf("Provenance() = %q, want %q", got, tt.want)
+		}
+	}
+}
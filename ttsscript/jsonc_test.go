@@ -0,0 +1,74 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseScriptLenient(t *testing.T) {
+	data := `{
+		// Narration for the onboarding course
+		"title": "Onboarding",
+		"default_voices": {"en": "voice-1",},
+		/* Slides are reviewed
+		   by the product team */
+		"slides": [
+			{
+				"title": "Intro // not a comment",
+				"segments": [
+					{"text": {"en": "See https://example.com/* docs */"}}, // trailing
+				],
+			},
+		],
+	}`
+
+	script, err := ParseScriptLenient([]byte(data))
+	if err != nil {
+		t.Fatalf("ParseScriptLenient() error = %v", err)
+	}
+	if script.Title != "Onboarding" || script.DefaultVoices["en"] != "voice-1" {
+		t.Errorf("script = %+v", script)
+	}
+	if got := script.Slides[0].Title; got != "Intro // not a comment" {
+		t.Errorf("slide title = %q, want comment markers in strings kept", got)
+	}
+	if got := script.Slides[0].Segments[0].Text["en"]; got != "See https://example.com/* docs */" {
+		t.Errorf("segment text = %q", got)
+	}
+
+	if _, err := ParseScript([]byte(data)); err == nil {
+		t.Error("ParseScript() should reject comments")
+	}
+}
+
+func TestParseScriptErrorPosition(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "syntax error",
+			data: "{\n  \"title\": \"Test\"\n  \"slides\": []\n}",
+			want: "line 3, column 3",
+		},
+		{
+			name: "type error",
+			data: "{\n  \"title\": \"Test\",\n  \"slides\": {}\n}",
+			want: "line 3, column 13",
+		},
+		{
+			name: "position after removed comment",
+			data: "{\n  // comment\n  \"title\": 1\n}",
+			want: "line 3, column 12",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseScriptLenient([]byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
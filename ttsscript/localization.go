@@ -0,0 +1,114 @@
+package ttsscript
+
+import (
+	"fmt"
+)
+
+// Manifest entry statuses, recorded in ManifestEntry.Status.
+const (
+	// EntryComplete means the entry's audio was generated, reused, or
+	// copied from a pre-recorded file.
+	EntryComplete = "complete"
+
+	// EntryMissing means the entry has no audio because the segment has
+	// no text for the language or no voice or engine to generate it with.
+	EntryMissing = "missing"
+
+	// EntryFailed means generating the entry's audio failed.
+	EntryFailed = "failed"
+)
+
+// Available reports whether the entry has audio. Entries without a status,
+// such as those from older manifests, are assumed to have audio.
+func (e ManifestEntry) Available() bool {
+	return e.Status != EntryMissing && e.Status != EntryFailed
+}
+
+// MarkMissing records that the entry has no audio and why.
+func (e *ManifestEntry) MarkMissing(reason string) {
+	e.Status = EntryMissing
+	e.MissingReason = reason
+}
+
+// MissingManifestEntries returns manifest entries for segments skipped at
+// compile time, such as segments with no text for the language, so the
+// manifest lists every segment of the script.
+func MissingManifestEntries(script *Script, skipped []SkippedSegment, language string) []ManifestEntry {
+	entries := make([]ManifestEntry, 0, len(skipped))
+	for _, skip := range skipped {
+		entry := ManifestEntry{
+			SlideIndex:   skip.SlideIndex,
+			SegmentIndex: skip.SegmentIndex,
+			Language:     language,
+		}
+		if script != nil && skip.SlideIndex < len(script.Slides) {
+			slide := script.Slides[skip.SlideIndex]
+			entry.SlideTitle = slide.Title
+			entry.IsSectionHeader = slide.IsSectionHeader
+			if skip.SegmentIndex >= 0 && skip.SegmentIndex < len(slide.Segments) {
+				entry.ID = slide.Segments[skip.SegmentIndex].ID
+			}
+		}
+		entry.MarkMissing(skip.Reason)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// MergeManifest combines manifest entries, such as generated and missing
+// ones, in script order.
+func MergeManifest(entries ...[]ManifestEntry) []ManifestEntry {
+	var merged []ManifestEntry
+	for _, e := range entries {
+		merged = append(merged, e...)
+	}
+	SortManifest(merged)
+	return merged
+}
+
+// LocalizationStatus summarizes how much of a script has audio in one
+// language.
+type LocalizationStatus struct {
+	Language string `json:"language"`
+	Total    int    `json:"total"`
+	Complete int    `json:"complete"`
+	Missing  int    `json:"missing"`
+	Failed   int    `json:"failed"`
+}
+
+// NewLocalizationStatus counts manifest entries by status. Entries without
+// a status, such as those excluded by a selector, count toward the total
+// only.
+func NewLocalizationStatus(entries []ManifestEntry, language string) LocalizationStatus {
+	s := LocalizationStatus{Language: language, Total: len(entries)}
+	for _, e := range entries {
+		switch e.Status {
+		case EntryComplete:
+			s.Complete++
+		case EntryMissing:
+			s.Missing++
+		case EntryFailed:
+			s.Failed++
+		}
+	}
+	return s
+}
+
+// OK reports whether no entry is missing or failed.
+func (s LocalizationStatus) OK() bool {
+	return s.Missing == 0 && s.Failed == 0
+}
+
+// Badge returns a one-line status, e.g. "es: 12/14 complete (2 missing)".
+func (s LocalizationStatus) Badge() string {
+	badge := fmt.Sprintf("%s: %d/%d complete", s.Language, s.Complete, s.Total)
+	switch {
+	case s.Missing > 0 && s.Failed > 0:
+		badge += fmt.Sprintf(" (%d missing, %d failed)", s.Missing, s.Failed)
+	case s.Missing > 0:
+		badge += fmt.Sprintf(" (%d missing)", s.Missing)
+	case s.Failed > 0:
+		badge += fmt.Sprintf(" (%d failed)", s.Failed)
+	}
+	return badge
+}
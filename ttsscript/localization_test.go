@@ -0,0 +1,83 @@
+package ttsscript
+
+import "testing"
+
+func TestMissingManifestEntries(t *testing.T) {
+	script := &Script{
+		Slides: []Slide{
+			{Title: "Intro", Segments: []Segment{{Text: map[string]string{"en": "Hello"}}}},
+			{Title: "Setup", Segments: []Segment{
+				{Text: map[string]string{"en": "Install"}},
+				{ID: "config", Text: map[string]string{"en": "Configure"}},
+			}},
+		},
+	}
+	result, err := NewCompiler().CompileWithResult(script, "es")
+	if err != nil {
+		t.Fatalf("CompileWithResult() error = %v", err)
+	}
+
+	missing := MissingManifestEntries(script, result.Skipped, "es")
+	if len(missing) != 3 {
+		t.Fatalf("missing entries = %d, want 3", len(missing))
+	}
+	e := missing[2]
+	if e.SlideIndex != 1 || e.SegmentIndex != 1 || e.ID != "config" || e.SlideTitle != "Setup" || e.Language != "es" {
+		t.Errorf("entry = %+v", e)
+	}
+	if e.Status != EntryMissing || e.MissingReason == "" || e.Available() {
+		t.Errorf("entry status = %q (%q), want missing", e.Status, e.MissingReason)
+	}
+}
+
+func TestMergeManifest(t *testing.T) {
+	generated := []ManifestEntry{
+		{SlideIndex: 0, SegmentIndex: -1, Status: EntryComplete},
+		{SlideIndex: 1, SegmentIndex: 1, Status: EntryComplete},
+	}
+	missing := []ManifestEntry{
+		{SlideIndex: 1, SegmentIndex: 0, Status: EntryMissing},
+		{SlideIndex: 0, SegmentIndex: 0, Status: EntryMissing},
+	}
+	merged := MergeManifest(generated, missing)
+	want := [][2]int{{0, -1}, {0, 0}, {1, 0}, {1, 1}}
+	for i, w := range want {
+		if merged[i].SlideIndex != w[0] || merged[i].SegmentIndex != w[1] {
+			t.Errorf("merged[%d] = %d/%d, want %d/%d", i, merged[i].SlideIndex, merged[i].SegmentIndex, w[0], w[1])
+		}
+	}
+}
+
+func TestLocalizationStatus(t *testing.T) {
+	entries := []ManifestEntry{
+		{Status: EntryComplete},
+		{Status: EntryComplete},
+		{Status: EntryMissing},
+		{Status: EntryFailed},
+		{}, // not selected for this run
+	}
+	status := NewLocalizationStatus(entries, "es")
+	if status.OK() {
+		t.Error("OK() = true, want false")
+	}
+	if got, want := status.Badge(), "es: 2/5 complete (1 missing, 1 failed)"; got != want {
+		t.Errorf("Badge() = %q, want %q", got, want)
+	}
+
+	complete := NewLocalizationStatus(entries[:2], "en")
+	if !complete.OK() || complete.Badge() != "en: 2/2 complete" {
+		t.Errorf("complete status = %+v, badge %q", complete, complete.Badge())
+	}
+}
+
+func TestReviewPendingSkipsMissing(t *testing.T) {
+	r := NewReview("en")
+	entries := []ManifestEntry{
+		{OutputFile: "a.mp3", Status: EntryComplete},
+		{OutputFile: "b.mp3", Status: EntryMissing},
+		{OutputFile: "c.mp3"},
+	}
+	if pending := r.Pending(entries); len(pending) != 2 {
+		t.Errorf("Pending() = %+v, want entries with audio", pending)
+	}
+}
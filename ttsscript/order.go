@@ -0,0 +1,80 @@
+package ttsscript
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Script order is by slide, then segment, with a slide's title segment
+// (SegmentIndex -1) first and an earcon before the segment it introduces. Manifests and groups are kept in script order so
+// that manifests diff cleanly between runs and audio is concatenated
+// reproducibly.
+
+// compareScriptOrder compares two positions in script order.
+func compareScriptOrder(slideA, segA, slideB, segB int) int {
+	if c := cmp.Compare(slideA, slideB); c != 0 {
+		return c
+	}
+	return cmp.Compare(segA, segB)
+}
+
+// SortManifest sorts manifest entries in script order. Entries for the same
+// segment, such as from several languages, are ordered by language and then
+// output file.
+func SortManifest(entries []ManifestEntry) {
+	slices.SortStableFunc(entries, func(a, b ManifestEntry) int {
+		if c := compareScriptOrder(a.SlideIndex, a.SegmentIndex, b.SlideIndex, b.SegmentIndex); c != 0 {
+			return c
+		}
+		if a.IsEarcon != b.IsEarcon {
+			if a.IsEarcon {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(a.Language, b.Language); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.OutputFile, b.OutputFile)
+	})
+}
+
+// GroupManifestBySlide splits manifest entries into slides, in script order,
+// with each slide's entries in script order.
+func GroupManifestBySlide(entries []ManifestEntry) [][]ManifestEntry {
+	sorted := slices.Clone(entries)
+	SortManifest(sorted)
+	var slides [][]ManifestEntry
+	for i, e := range sorted {
+		if i == 0 || e.SlideIndex != sorted[i-1].SlideIndex {
+			slides = append(slides, nil)
+		}
+		slides[len(slides)-1] = append(slides[len(slides)-1], e)
+	}
+	return slides
+}
+
+// GroupKeys returns the keys of a group map, such as from GroupByVoice or
+// GroupBySlide, in sorted order, for iterating groups reproducibly.
+func GroupKeys[K cmp.Ordered, V any](groups map[K]V) []K {
+	keys := make([]K, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// sortCompiledSegments sorts compiled segments in script order.
+func sortCompiledSegments(segments []CompiledSegment) {
+	slices.SortStableFunc(segments, func(a, b CompiledSegment) int {
+		return compareScriptOrder(a.SlideIndex, a.SegmentIndex, b.SlideIndex, b.SegmentIndex)
+	})
+}
+
+// sortElevenLabsSegments sorts formatted segments in script order.
+func sortElevenLabsSegments(segments []ElevenLabsSegment) {
+	slices.SortStableFunc(segments, func(a, b ElevenLabsSegment) int {
+		return compareScriptOrder(a.SlideIndex, a.SegmentIndex, b.SlideIndex, b.SegmentIndex)
+	})
+}
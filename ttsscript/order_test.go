@@ -0,0 +1,66 @@
+package ttsscript
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortManifest(t *testing.T) {
+	entries := []ManifestEntry{
+		{SlideIndex: 1, SegmentIndex: 0, Language: "en"},
+		{SlideIndex: 0, SegmentIndex: 1, Language: "es"},
+		{SlideIndex: 0, SegmentIndex: 1, Language: "en"},
+		{SlideIndex: 1, SegmentIndex: -1, Language: "en"},
+		{SlideIndex: 0, SegmentIndex: 0, Language: "en"},
+	}
+	SortManifest(entries)
+
+	var got [][3]any
+	for _, e := range entries {
+		got = append(got, [3]any{e.SlideIndex, e.SegmentIndex, e.Language})
+	}
+	want := [][3]any{{0, 0, "en"}, {0, 1, "en"}, {0, 1, "es"}, {1, -1, "en"}, {1, 0, "en"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortManifest() order = %v, want %v", got, want)
+	}
+}
+
+func TestGroupManifestBySlide(t *testing.T) {
+	entries := []ManifestEntry{
+		{SlideIndex: 2, SegmentIndex: 0},
+		{SlideIndex: 0, SegmentIndex: 1},
+		{SlideIndex: 0, SegmentIndex: -1},
+	}
+	slides := GroupManifestBySlide(entries)
+	if len(slides) != 2 || len(slides[0]) != 2 || slides[0][0].SegmentIndex != -1 || slides[1][0].SlideIndex != 2 {
+		t.Errorf("GroupManifestBySlide() = %+v", slides)
+	}
+	if entries[0].SlideIndex != 2 {
+		t.Error("GroupManifestBySlide() reordered its input")
+	}
+}
+
+func TestGroupOrdering(t *testing.T) {
+	segments := []CompiledSegment{
+		{SlideIndex: 1, SegmentIndex: 0, VoiceID: "b"},
+		{SlideIndex: 0, SegmentIndex: 1, VoiceID: "a"},
+		{SlideIndex: 0, SegmentIndex: -1, VoiceID: "b"},
+		{SlideIndex: 0, SegmentIndex: 0, VoiceID: "a"},
+	}
+
+	byVoice := GroupByVoice(segments)
+	if keys := GroupKeys(byVoice); !reflect.DeepEqual(keys, []string{"a", "b"}) {
+		t.Errorf("GroupKeys(byVoice) = %v", keys)
+	}
+	if b := byVoice["b"]; b[0].SlideIndex != 0 || b[1].SlideIndex != 1 {
+		t.Errorf("voice group not in script order: %+v", b)
+	}
+
+	bySlide := GroupBySlide(segments)
+	if keys := GroupKeys(bySlide); !reflect.DeepEqual(keys, []int{0, 1}) {
+		t.Errorf("GroupKeys(bySlide) = %v", keys)
+	}
+	if s := bySlide[0]; s[0].SegmentIndex != -1 || s[1].SegmentIndex != 0 || s[2].SegmentIndex != 1 {
+		t.Errorf("slide group not in script order: %+v", s)
+	}
+}
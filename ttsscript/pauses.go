@@ -0,0 +1,235 @@
+package ttsscript
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Pause tuning defaults.
+const (
+	// DefaultPauseToleranceMs is how far a gap may be from the target
+	// before SuggestPauses suggests a new pause.
+	DefaultPauseToleranceMs = 100
+
+	// DefaultPauseStepMs is what suggested pauses are rounded to.
+	DefaultPauseStepMs = 50
+)
+
+// AlignedWord is a word with its position in a segment's audio, as
+// reported by forced alignment.
+type AlignedWord struct {
+	Text    string
+	StartMs int
+	EndMs   int
+}
+
+// SpeechTiming is where the speech is within a segment's audio.
+type SpeechTiming struct {
+	// DurationMs is the length of the audio.
+	DurationMs int `json:"duration_ms"`
+
+	// StartMs and EndMs are the start of the first word and the end of the
+	// last. The silence before StartMs and after EndMs adds to the pauses
+	// around the segment.
+	StartMs int `json:"start_ms"`
+	EndMs   int `json:"end_ms"`
+
+	// SentenceGapsMs are the silences between sentences within the
+	// segment: the narrator's own rhythm.
+	SentenceGapsMs []int `json:"sentence_gaps_ms,omitempty"`
+}
+
+// NewSpeechTiming measures speech timing from forced alignment words and
+// the audio duration. A word ending in ".", "!", or "?" ends a sentence.
+// Words that are only whitespace are ignored.
+func NewSpeechTiming(words []AlignedWord, durationMs int) SpeechTiming {
+	timing := SpeechTiming{DurationMs: durationMs, EndMs: durationMs}
+	var spoken []AlignedWord
+	for _, w := range words {
+		if strings.TrimSpace(w.Text) != "" {
+			spoken = append(spoken, w)
+		}
+	}
+	if len(spoken) == 0 {
+		return timing
+	}
+	timing.StartMs = spoken[0].StartMs
+	timing.EndMs = spoken[len(spoken)-1].EndMs
+	for i, w := range spoken[:len(spoken)-1] {
+		if endsSentence(w.Text) {
+			timing.SentenceGapsMs = append(timing.SentenceGapsMs, max(spoken[i+1].StartMs-w.EndMs, 0))
+		}
+	}
+	return timing
+}
+
+// endsSentence reports whether a word ends a sentence, ignoring closing
+// quotes and brackets.
+func endsSentence(word string) bool {
+	word = strings.TrimRight(word, `"')]`+"\u201d\u2019")
+	return strings.HasSuffix(word, ".") || strings.HasSuffix(word, "!") || strings.HasSuffix(word, "?")
+}
+
+// LeadingMs returns the silence before the speech.
+func (t SpeechTiming) LeadingMs() int {
+	return max(t.StartMs, 0)
+}
+
+// TrailingMs returns the silence after the speech.
+func (t SpeechTiming) TrailingMs() int {
+	return max(t.DurationMs-t.EndMs, 0)
+}
+
+// PauseTuning configures SuggestPauses.
+type PauseTuning struct {
+	// TargetMs is the silence wanted between segments. Zero means the
+	// median sentence gap within segments, so pauses match the
+	// narrator's rhythm.
+	TargetMs int
+
+	// ToleranceMs is how far a gap may be from the target without a
+	// suggestion. Zero means DefaultPauseToleranceMs.
+	ToleranceMs int
+
+	// StepMs is what suggestions are rounded to. Zero means
+	// DefaultPauseStepMs.
+	StepMs int
+}
+
+// PauseSuggestion is a suggested pause after a segment, so the silence to
+// the next segment hits the target.
+type PauseSuggestion struct {
+	SlideIndex   int    `json:"slide_index"`
+	SegmentIndex int    `json:"segment_index"`
+	ID           string `json:"id,omitempty"`
+
+	// PauseAfterMs is the segment's current pause after.
+	PauseAfterMs int `json:"pause_after_ms"`
+
+	// GapMs is the measured silence to the next segment: the trailing
+	// silence of this segment's audio, the pauses between them, and the
+	// leading silence of the next.
+	GapMs int `json:"gap_ms"`
+
+	// SuggestedMs is the pause after that gives the target gap.
+	SuggestedMs int `json:"suggested_ms"`
+}
+
+// SuggestPauses measures the silence between consecutive segments of each
+// slide and suggests pause after values that bring it to the target.
+// timings holds the speech timing of each entry's audio by output file;
+// pairs with an entry missing from it, or an earcon between them, are
+// skipped, as is the gap after a slide's last segment. It returns the
+// suggestions, in script order, and the target used. With no TargetMs and
+// no sentence gaps to take it from, nothing is suggested.
+func SuggestPauses(entries []ManifestEntry, timings map[string]SpeechTiming, tuning PauseTuning) ([]PauseSuggestion, int) {
+	target := tuning.TargetMs
+	if target <= 0 {
+		target = medianSentenceGap(timings)
+	}
+	if target <= 0 {
+		return nil, 0
+	}
+	tolerance := tuning.ToleranceMs
+	if tolerance <= 0 {
+		tolerance = DefaultPauseToleranceMs
+	}
+	step := tuning.StepMs
+	if step <= 0 {
+		step = DefaultPauseStepMs
+	}
+
+	sorted := append([]ManifestEntry(nil), entries...)
+	SortManifest(sorted)
+
+	var suggestions []PauseSuggestion
+	for i := 0; i+1 < len(sorted); i++ {
+		cur, next := sorted[i], sorted[i+1]
+		if cur.SlideIndex != next.SlideIndex || cur.IsEarcon || next.IsEarcon {
+			continue
+		}
+		curTiming, ok := timings[cur.OutputFile]
+		if !ok {
+			continue
+		}
+		nextTiming, ok := timings[next.OutputFile]
+		if !ok {
+			continue
+		}
+		fixed := curTiming.TrailingMs() + next.PauseBeforeMs + nextTiming.LeadingMs()
+		gap := fixed + cur.PauseAfterMs
+		if abs(gap-target) <= tolerance {
+			continue
+		}
+		suggested := max(target-fixed, 0)
+		suggested = (suggested + step/2) / step * step
+		if suggested == cur.PauseAfterMs {
+			continue
+		}
+		suggestions = append(suggestions, PauseSuggestion{
+			SlideIndex:   cur.SlideIndex,
+			SegmentIndex: cur.SegmentIndex,
+			ID:           cur.ID,
+			PauseAfterMs: cur.PauseAfterMs,
+			GapMs:        gap,
+			SuggestedMs:  suggested,
+		})
+	}
+	return suggestions, target
+}
+
+// ApplyPauseSuggestions sets the pause after of each suggested segment in
+// the script, or the title pause after for title segments, and returns
+// the number changed.
+func ApplyPauseSuggestions(script *Script, suggestions []PauseSuggestion) int {
+	changed := 0
+	for _, s := range suggestions {
+		if s.SlideIndex < 0 || s.SlideIndex >= len(script.Slides) {
+			continue
+		}
+		// An empty pause means the default, so zero is written explicitly
+		pause := FormatDuration(s.SuggestedMs)
+		if pause == "" {
+			pause = "0ms"
+		}
+		slide := &script.Slides[s.SlideIndex]
+		switch {
+		case s.SegmentIndex < 0:
+			slide.TitlePauseAfter = pause
+		case s.SegmentIndex < len(slide.Segments):
+			slide.Segments[s.SegmentIndex].PauseAfter = pause
+		default:
+			continue
+		}
+		changed++
+	}
+	return changed
+}
+
+// String describes the suggestion, like "slide 2, segment 1: 300ms -> 450ms
+// (gap 520ms)".
+func (s PauseSuggestion) String() string {
+	return fmt.Sprintf("slide %d, %s: %dms -> %dms (gap %dms)", s.SlideIndex+1, segmentLabel(s.SegmentIndex), s.PauseAfterMs, s.SuggestedMs, s.GapMs)
+}
+
+// medianSentenceGap returns the median sentence gap across timings, or 0
+// if there are none.
+func medianSentenceGap(timings map[string]SpeechTiming) int {
+	var gaps []int
+	for _, t := range timings {
+		gaps = append(gaps, t.SentenceGapsMs...)
+	}
+	if len(gaps) == 0 {
+		return 0
+	}
+	sort.Ints(gaps)
+	return medianInt(gaps)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
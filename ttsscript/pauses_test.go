@@ -0,0 +1,77 @@
+package ttsscript
+
+import "testing"
+
+func TestNewSpeechTiming(t *testing.T) {
+	timing := NewSpeechTiming([]AlignedWord{
+		{Text: "Hello.", StartMs: 120, EndMs: 500},
+		{Text: " ", StartMs: 500, EndMs: 900},
+		{Text: "Welcome", StartMs: 900, EndMs: 1300},
+		{Text: "back!”", StartMs: 1350, EndMs: 1700},
+		{Text: "Ready?", StartMs: 2300, EndMs: 2800},
+	}, 3100)
+
+	if timing.LeadingMs() != 120 || timing.TrailingMs() != 300 {
+		t.Errorf("leading/trailing = %d/%d, want 120/300", timing.LeadingMs(), timing.TrailingMs())
+	}
+	if len(timing.SentenceGapsMs) != 2 || timing.SentenceGapsMs[0] != 400 || timing.SentenceGapsMs[1] != 600 {
+		t.Errorf("SentenceGapsMs = %v, want [400 600]", timing.SentenceGapsMs)
+	}
+
+	if empty := NewSpeechTiming(nil, 800); empty.LeadingMs() != 0 || empty.TrailingMs() != 0 {
+		t.Errorf("timing without words = %+v", empty)
+	}
+}
+
+func TestSuggestPauses(t *testing.T) {
+	entries := []ManifestEntry{
+		{SlideIndex: 0, SegmentIndex: 1, OutputFile: "s1_2.mp3", PauseAfterMs: 300},
+		{SlideIndex: 0, SegmentIndex: -1, IsTitleSegment: true, OutputFile: "s1_t.mp3", PauseAfterMs: 500},
+		{SlideIndex: 0, SegmentIndex: 0, ID: "intro", OutputFile: "s1_1.mp3", PauseAfterMs: 0},
+		{SlideIndex: 0, SegmentIndex: 2, OutputFile: "s1_3.mp3", PauseBeforeMs: 200},
+		{SlideIndex: 1, SegmentIndex: 0, OutputFile: "s2_1.mp3"},
+	}
+	timings := map[string]SpeechTiming{
+		"s1_t.mp3": {DurationMs: 1000, StartMs: 50, EndMs: 900},
+		"s1_1.mp3": {DurationMs: 3000, StartMs: 50, EndMs: 2900, SentenceGapsMs: []int{500, 700}},
+		"s1_2.mp3": {DurationMs: 2000, StartMs: 100, EndMs: 1950, SentenceGapsMs: []int{600}},
+		"s1_3.mp3": {DurationMs: 2000, StartMs: 80, EndMs: 1900},
+		"s2_1.mp3": {DurationMs: 2000, StartMs: 50, EndMs: 1900},
+	}
+
+	// Title -> intro: 100 + 500 + 50 = 650, within tolerance of 600.
+	// Intro -> segment 2: 100 + 0 + 100 = 200, short by 400.
+	// Segment 2 -> 3: 50 + 300 + 200 + 80 = 630, within tolerance.
+	suggestions, target := SuggestPauses(entries, timings, PauseTuning{})
+	if target != 600 {
+		t.Errorf("target = %d, want the median sentence gap 600", target)
+	}
+	if len(suggestions) != 1 {
+		t.Fatalf("suggestions = %v", suggestions)
+	}
+	if s := suggestions[0]; s.ID != "intro" || s.GapMs != 200 || s.SuggestedMs != 400 {
+		t.Errorf("suggestion = %+v", s)
+	}
+
+	suggestions, _ = SuggestPauses(entries, timings, PauseTuning{TargetMs: 1000, ToleranceMs: 50})
+	if len(suggestions) != 3 || suggestions[0].SegmentIndex != -1 || suggestions[0].SuggestedMs != 850 {
+		t.Fatalf("suggestions = %v", suggestions)
+	}
+	if suggestions[2].String() != "slide 1, segment 2: 300ms -> 650ms (gap 630ms)" {
+		t.Errorf("String() = %q", suggestions[2].String())
+	}
+
+	script := &Script{Slides: []Slide{{Segments: []Segment{{}, {}, {}}}}}
+	suggestions = append(suggestions, PauseSuggestion{SlideIndex: 0, SegmentIndex: 2, SuggestedMs: 0})
+	if n := ApplyPauseSuggestions(script, suggestions); n != 4 {
+		t.Errorf("ApplyPauseSuggestions() = %d, want 4", n)
+	}
+	slide := script.Slides[0]
+	if slide.TitlePauseAfter != "850ms" || slide.Segments[0].PauseAfter != "800ms" || slide.Segments[2].PauseAfter != "0ms" {
+		t.Errorf("applied pauses = %q, %+v", slide.TitlePauseAfter, slide.Segments)
+	}
+
+	if none, target := SuggestPauses(entries, map[string]SpeechTiming{}, PauseTuning{}); none != nil || target != 0 {
+		t.Errorf("without a target = %v, %d", none, target)
+	}
+}
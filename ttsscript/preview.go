@@ -0,0 +1,79 @@
+package ttsscript
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// PreviewText renders compiled segments as plain text, one line per
+// segment grouped by slide, showing exactly what will be spoken:
+// pronunciations applied, pauses inline as [pause 500ms], and the voice.
+// Pre-recorded segments show their audio file instead. A final line totals
+// the characters that will be charged.
+//
+// Example output:
+//
+//	Slide 1: Welcome
+//	  title  (voice-1) Welcome [pause 500ms]
+//	  1      (voice-1) Welcome to the A P I course. [pause 300ms]
+//	  2      (pre-recorded: assets/ceo_intro.mp3)
+//
+//	3 segments, 37 characters
+func PreviewText(segments []CompiledSegment) string {
+	var sb strings.Builder
+	slide := -1
+	chars := 0
+	for _, seg := range segments {
+		if seg.SlideIndex != slide {
+			if slide >= 0 {
+				sb.WriteString("\n")
+			}
+			slide = seg.SlideIndex
+			fmt.Fprintf(&sb, "Slide %d", slide+1)
+			if seg.SlideTitle != "" {
+				fmt.Fprintf(&sb, ": %s", seg.SlideTitle)
+			}
+			sb.WriteString("\n")
+		}
+
+		label := "title"
+		if seg.IsEarcon {
+			label = "earcon"
+		} else if !seg.IsTitleSegment {
+			label = fmt.Sprintf("%d", seg.SegmentIndex+1)
+		}
+		fmt.Fprintf(&sb, "  %-6s ", label)
+
+		if seg.AudioFile != "" {
+			fmt.Fprintf(&sb, "(pre-recorded: %s)\n", seg.AudioFile)
+			continue
+		}
+		chars += utf8.RuneCountInString(seg.Text)
+
+		voice := seg.VoiceID
+		if voice == "" {
+			voice = "no voice"
+		}
+		if seg.Engine != "" {
+			voice = seg.Engine + ":" + voice
+		}
+		fmt.Fprintf(&sb, "(%s) ", voice)
+		if seg.FallbackLanguage != "" {
+			fmt.Fprintf(&sb, "[%s] ", seg.FallbackLanguage)
+		}
+		if seg.PauseBeforeMs > 0 {
+			fmt.Fprintf(&sb, "[pause %s] ", FormatDuration(seg.PauseBeforeMs))
+		}
+		sb.WriteString(strings.Join(strings.Fields(seg.Text), " "))
+		if seg.PauseAfterMs > 0 {
+			fmt.Fprintf(&sb, " [pause %s]", FormatDuration(seg.PauseAfterMs))
+		}
+		sb.WriteString("\n")
+	}
+	if len(segments) > 0 {
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "%d segments, %d characters\n", len(segments), chars)
+	return sb.String()
+}
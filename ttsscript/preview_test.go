@@ -0,0 +1,44 @@
+package ttsscript
+
+import "testing"
+
+func TestPreviewText(t *testing.T) {
+	script := &Script{
+		DefaultVoices:  map[string]string{"en": "voice-1"},
+		Pronunciations: map[string]map[string]string{"API": {"en": "A P I"}},
+		Slides: []Slide{
+			{
+				Title:           "Welcome",
+				IsSectionHeader: true,
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Welcome to the API\ncourse."}, PauseAfter: "300ms"},
+					{AudioFile: map[string]string{"en": "assets/ceo_intro.mp3"}},
+				},
+			},
+			{
+				Title: "Next",
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Bye."}, PauseBefore: "1s", Voice: map[string]string{"en": "voice-2"}},
+				},
+			},
+		},
+	}
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	want := `Slide 1: Welcome
+  title  (voice-1) Welcome [pause 500ms]
+  1      (voice-1) Welcome to the A P I course. [pause 300ms]
+  2      (pre-recorded: assets/ceo_intro.mp3)
+
+Slide 2: Next
+  1      (voice-2) [pause 1s] Bye. [pause 800ms]
+
+4 segments, 39 characters
+`
+	if got := PreviewText(segments); got != want {
+		t.Errorf("PreviewText() =\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,154 @@
+package ttsscript
+
+import "fmt"
+
+// TextProcessor transforms the text of a segment or spoken title during
+// compilation. The Compiler runs its processors in order, each receiving
+// the previous one's output, so custom steps such as profanity masking or
+// glossary enforcement can be inserted before or after the built-in ones.
+//
+// Text may contain SSML islands ({{ssml:...}}); processors that rewrite
+// words should leave them alone, as the built-in processors do.
+type TextProcessor interface {
+	// Name identifies the processor in errors.
+	Name() string
+
+	// Process returns the transformed text.
+	Process(ctx *TextContext, text string) (string, error)
+}
+
+// TextContext describes the text being processed.
+type TextContext struct {
+	// Script is the script being compiled.
+	Script *Script
+
+	// Slide is the slide the text belongs to.
+	Slide *Slide
+
+	// Segment is the segment the text belongs to, or nil for a spoken
+	// slide title.
+	Segment *Segment
+
+	// SlideIndex is the 0-based slide index.
+	SlideIndex int
+
+	// SegmentIndex is the 0-based segment index, or -1 for a title.
+	SegmentIndex int
+
+	// Language is the language of the text, which is the fallback
+	// language when the requested one is missing.
+	Language string
+
+	compiler *Compiler
+	trace    []PronunciationHit
+}
+
+// TextProcessorFunc adapts a function to a TextProcessor.
+type TextProcessorFunc struct {
+	// ProcessorName is returned by Name.
+	ProcessorName string
+
+	// Func transforms the text.
+	Func func(ctx *TextContext, text string) (string, error)
+}
+
+// Name returns the processor name.
+func (f TextProcessorFunc) Name() string { return f.ProcessorName }
+
+// Process calls f.Func.
+func (f TextProcessorFunc) Process(ctx *TextContext, text string) (string, error) {
+	return f.Func(ctx, text)
+}
+
+// Built-in text processors.
+var (
+	// CleanProcessor removes text that would be charged but not spoken
+	// (see CleanText), skipping the rules in Compiler.KeepInaudible.
+	CleanProcessor TextProcessor = cleanProcessor{}
+
+	// PronunciationProcessor applies the script, segment, and compiler
+	// pronunciation rules, and records them when Compiler.Trace is set.
+	PronunciationProcessor TextProcessor = pronunciationProcessor{}
+)
+
+type cleanProcessor struct{}
+
+func (cleanProcessor) Name() string { return "clean" }
+
+func (cleanProcessor) Process(ctx *TextContext, text string) (string, error) {
+	return withSSMLIslandsProtected(text, func(s string) string {
+		return CleanText(s, ctx.compiler.KeepInaudible...)
+	}), nil
+}
+
+type pronunciationProcessor struct{}
+
+func (pronunciationProcessor) Name() string { return "pronunciations" }
+
+func (pronunciationProcessor) Process(ctx *TextContext, text string) (string, error) {
+	var segmentProns map[string]map[string]string
+	if ctx.Segment != nil {
+		segmentProns = ctx.Segment.Pronunciations
+	}
+	result, hits := ctx.compiler.applyPronunciations(text, ctx.Language, ctx.Script.Pronunciations, segmentProns)
+	ctx.trace = append(ctx.trace, hits...)
+	return result, nil
+}
+
+// DefaultProcessors returns the pipeline used when Compiler.Processors is
+// nil: CleanProcessor if StripInaudible is set, then
+// PronunciationProcessor. Use it to build a pipeline with extra steps:
+//
+//	compiler.Processors = append([]ttsscript.TextProcessor{mask}, compiler.DefaultProcessors()...)
+func (c *Compiler) DefaultProcessors() []TextProcessor {
+	if c.StripInaudible {
+		return []TextProcessor{CleanProcessor, PronunciationProcessor}
+	}
+	return []TextProcessor{PronunciationProcessor}
+}
+
+// WithProcessors replaces the compiler's text processors for one Compile
+// call.
+func WithProcessors(processors ...TextProcessor) CompileOption {
+	return func(o *compileOptions) {
+		o.processors = processors
+		o.processorsSet = true
+	}
+}
+
+// processText runs the processor pipeline on text and returns the result
+// and the pronunciation rules applied.
+func (c *Compiler) processText(processors []TextProcessor, ctx *TextContext, text string) (string, []PronunciationHit, error) {
+	ctx.compiler = c
+	for _, p := range processors {
+		var err error
+		text, err = p.Process(ctx, text)
+		if err != nil {
+			return "", nil, &ProcessorError{Processor: p.Name(), SlideIndex: ctx.SlideIndex, SegmentIndex: ctx.SegmentIndex, Err: err}
+		}
+	}
+	return text, ctx.trace, nil
+}
+
+// ProcessorError is returned by Compile when a text processor fails.
+type ProcessorError struct {
+	// Processor is the name of the failed processor.
+	Processor string
+
+	// SlideIndex and SegmentIndex locate the text (SegmentIndex is -1 for
+	// a title).
+	SlideIndex   int
+	SegmentIndex int
+
+	// Err is the processor's error.
+	Err error
+}
+
+func (e *ProcessorError) Error() string {
+	if e.SegmentIndex < 0 {
+		return fmt.Sprintf("ttsscript: %s processor: slide %d title: %v", e.Processor, e.SlideIndex+1, e.Err)
+	}
+	return fmt.Sprintf("ttsscript: %s processor: slide %d, segment %d: %v", e.Processor, e.SlideIndex+1, e.SegmentIndex+1, e.Err)
+}
+
+func (e *ProcessorError) Unwrap() error { return e.Err }
@@ -0,0 +1,100 @@
+package ttsscript
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func processorTestScript() *Script {
+	return &Script{
+		Pronunciations: map[string]map[string]string{"API": {"en": "A P I"}},
+		Slides: []Slide{{
+			Title:           "Intro",
+			IsSectionHeader: true,
+			Segments: []Segment{
+				{ID: "one", Text: map[string]string{"en": "The darn API is **fast**."}},
+			},
+		}},
+	}
+}
+
+var maskProcessor = TextProcessorFunc{
+	ProcessorName: "mask",
+	Func: func(ctx *TextContext, text string) (string, error) {
+		return strings.ReplaceAll(text, "darn", "bleep"), nil
+	},
+}
+
+func TestCompilerProcessors(t *testing.T) {
+	compiler := NewCompiler()
+	compiler.StripInaudible = true
+	compiler.Trace = true
+	compiler.Processors = append([]TextProcessor{maskProcessor}, compiler.DefaultProcessors()...)
+
+	segments, err := compiler.Compile(processorTestScript(), "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if len(segments) != 2 {
+		t.Fatalf("segments = %d, want title and segment", len(segments))
+	}
+	if got := segments[1].Text; got != "The bleep A P I is fast." {
+		t.Errorf("text = %q", got)
+	}
+	if len(segments[1].PronunciationTrace) != 1 {
+		t.Errorf("trace = %+v", segments[1].PronunciationTrace)
+	}
+	if segments[1].OriginalText != "The darn API is **fast**." {
+		t.Errorf("original text = %q", segments[1].OriginalText)
+	}
+}
+
+func TestCompilerWithProcessors(t *testing.T) {
+	var seen []string
+	record := TextProcessorFunc{
+		ProcessorName: "record",
+		Func: func(ctx *TextContext, text string) (string, error) {
+			if ctx.Segment == nil {
+				seen = append(seen, "title")
+			} else {
+				seen = append(seen, ctx.Segment.ID)
+			}
+			return text, nil
+		},
+	}
+
+	segments, err := NewCompiler().Compile(processorTestScript(), "en", WithProcessors(record))
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	// Only the given processors run, so pronunciations are not applied
+	if got := segments[1].Text; got != "The darn API is **fast**." {
+		t.Errorf("text = %q", got)
+	}
+	if strings.Join(seen, ",") != "title,one" {
+		t.Errorf("processed = %v", seen)
+	}
+}
+
+func TestCompilerProcessorError(t *testing.T) {
+	errGlossary := errors.New("term not in glossary")
+	fail := TextProcessorFunc{
+		ProcessorName: "glossary",
+		Func: func(ctx *TextContext, text string) (string, error) {
+			if ctx.Segment != nil {
+				return "", errGlossary
+			}
+			return text, nil
+		},
+	}
+
+	_, err := NewCompiler().Compile(processorTestScript(), "en", WithProcessors(fail))
+	var procErr *ProcessorError
+	if !errors.As(err, &procErr) || !errors.Is(err, errGlossary) {
+		t.Fatalf("Compile() error = %v, want ProcessorError", err)
+	}
+	if procErr.Processor != "glossary" || procErr.SegmentIndex != 0 {
+		t.Errorf("error = %+v", procErr)
+	}
+}
@@ -0,0 +1,274 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Segment outcomes recorded in a RunReport.
+const (
+	OutcomeGenerated = "generated"
+	OutcomeSkipped   = "skipped"
+	OutcomeFailed    = "failed"
+)
+
+// RunReport summarizes a generation run. Its JSON form is stable:
+// voices are sorted by ID and failures keep the order they were recorded.
+type RunReport struct {
+	// Script is the script title.
+	Script string `json:"script,omitempty"`
+
+	// Language is the generated language.
+	Language string `json:"language"`
+
+	// StartedAt is when the run started.
+	StartedAt time.Time `json:"started_at"`
+
+	// ElapsedMs is the run duration in milliseconds.
+	ElapsedMs int64 `json:"elapsed_ms"`
+
+	// Total is the number of segments considered.
+	Total int `json:"total"`
+
+	// Generated is the number of segments generated successfully.
+	Generated int `json:"generated"`
+
+	// Prerecorded is the number of segments that used pre-recorded audio.
+	Prerecorded int `json:"prerecorded,omitempty"`
+
+	// Cached is the number of segments reused from the shared audio cache.
+	Cached int `json:"cached,omitempty"`
+
+	// Resumed is the number of segments kept from a previous run that
+	// already generated them.
+	Resumed int `json:"resumed,omitempty"`
+
+	// Approved is the number of segments whose audio was kept because it
+	// was approved in review.
+	Approved int `json:"approved,omitempty"`
+
+	// Skipped is the number of segments skipped.
+	Skipped int `json:"skipped"`
+
+	// Failed is the number of segments that failed.
+	Failed int `json:"failed"`
+
+	// Characters is the total number of characters sent for generation.
+	Characters int `json:"characters"`
+
+	// Voices is the per-voice usage, sorted by voice ID.
+	Voices []VoiceUsage `json:"voices"`
+
+	// Failures lists failed and skipped segments with details.
+	Failures []SegmentFailure `json:"failures,omitempty"`
+
+	// Stopped is why the run stopped before considering every segment,
+	// such as reaching a failure limit. Empty if the run completed.
+	Stopped string `json:"stopped,omitempty"`
+
+	voices map[string]*VoiceUsage
+}
+
+// VoiceUsage is per-voice usage within a run.
+type VoiceUsage struct {
+	VoiceID    string `json:"voice_id"`
+	Segments   int    `json:"segments"`
+	Characters int    `json:"characters"`
+}
+
+// SegmentFailure describes a segment that was not generated.
+type SegmentFailure struct {
+	SlideIndex   int    `json:"slide_index"`
+	SegmentIndex int    `json:"segment_index"`
+	Outcome      string `json:"outcome"`
+	Error        string `json:"error"`
+}
+
+// NewRunReport creates a report for a run of the given script and language.
+func NewRunReport(script *Script, language string) *RunReport {
+	r := &RunReport{
+		Language:  language,
+		StartedAt: time.Now().UTC(),
+		Voices:    []VoiceUsage{},
+		voices:    make(map[string]*VoiceUsage),
+	}
+	if script != nil {
+		r.Script = script.Title
+	}
+	return r
+}
+
+// RecordGenerated records a successfully generated segment.
+func (r *RunReport) RecordGenerated(seg ElevenLabsSegment) {
+	r.RecordGeneratedTakes(seg, 1)
+}
+
+// RecordGeneratedTakes records a segment generated as several takes (see
+// TakeSelection). It counts as one generated segment, but each take's
+// characters are counted, since each is billed.
+func (r *RunReport) RecordGeneratedTakes(seg ElevenLabsSegment, takes int) {
+	r.Total++
+	r.Generated++
+	chars := len([]rune(seg.Text)) * takes
+	r.Characters += chars
+
+	usage, ok := r.voices[seg.VoiceID]
+	if !ok {
+		usage = &VoiceUsage{VoiceID: seg.VoiceID}
+		r.voices[seg.VoiceID] = usage
+	}
+	usage.Segments++
+	usage.Characters += chars
+}
+
+// RecordPrerecorded records a segment that used pre-recorded audio. It is
+// not counted as generated and uses no characters.
+func (r *RunReport) RecordPrerecorded(seg ElevenLabsSegment) {
+	r.Total++
+	r.Prerecorded++
+}
+
+// RecordCached records a segment whose audio was reused from the shared
+// audio cache. It is not counted as generated and uses no characters.
+func (r *RunReport) RecordCached(seg ElevenLabsSegment) {
+	r.Total++
+	r.Cached++
+}
+
+// RecordResumed records a segment whose audio was kept from a previous
+// run. It is not counted as generated and uses no characters.
+func (r *RunReport) RecordResumed(seg ElevenLabsSegment) {
+	r.Total++
+	r.Resumed++
+}
+
+// RecordApproved records a segment whose audio was kept because it was
+// approved in review. It is not counted as generated and uses no
+// characters.
+func (r *RunReport) RecordApproved(seg ElevenLabsSegment) {
+	r.Total++
+	r.Approved++
+}
+
+// RecordSkipped records a segment that was intentionally not generated.
+func (r *RunReport) RecordSkipped(slideIndex, segmentIndex int, reason string) {
+	r.Total++
+	r.Skipped++
+	r.Failures = append(r.Failures, SegmentFailure{
+		SlideIndex:   slideIndex,
+		SegmentIndex: segmentIndex,
+		Outcome:      OutcomeSkipped,
+		Error:        reason,
+	})
+}
+
+// RecordFailed records a segment whose generation failed.
+func (r *RunReport) RecordFailed(seg ElevenLabsSegment, err error) {
+	r.Total++
+	r.Failed++
+	msg := ""
+	if err != nil {
+		msg = err.Error()
+	}
+	r.Failures = append(r.Failures, SegmentFailure{
+		SlideIndex:   seg.SlideIndex,
+		SegmentIndex: seg.SegmentIndex,
+		Outcome:      OutcomeFailed,
+		Error:        msg,
+	})
+}
+
+// Stop records that the run stopped early, and why.
+func (r *RunReport) Stop(reason string) {
+	r.Stopped = reason
+}
+
+// Finish records the elapsed time and finalizes per-voice usage.
+func (r *RunReport) Finish() {
+	r.ElapsedMs = time.Since(r.StartedAt).Milliseconds()
+
+	r.Voices = make([]VoiceUsage, 0, len(r.voices))
+	for _, usage := range r.voices {
+		r.Voices = append(r.Voices, *usage)
+	}
+	sort.Slice(r.Voices, func(i, j int) bool {
+		return r.Voices[i].VoiceID < r.Voices[j].VoiceID
+	})
+}
+
+// Success returns true if no segments failed.
+func (r *RunReport) Success() bool {
+	return r.Failed == 0
+}
+
+// WriteJSON writes the report as indented JSON to a file.
+func (r *RunReport) WriteJSON(filePath string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling report: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("writing report file: %w", err)
+	}
+	return nil
+}
+
+// Summary returns a human-readable summary table.
+func (r *RunReport) Summary() string {
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Generated\t%d\n", r.Generated)
+	if r.Prerecorded > 0 {
+		fmt.Fprintf(tw, "Pre-recorded\t%d\n", r.Prerecorded)
+	}
+	if r.Cached > 0 {
+		fmt.Fprintf(tw, "Cached\t%d\n", r.Cached)
+	}
+	if r.Resumed > 0 {
+		fmt.Fprintf(tw, "Resumed\t%d\n", r.Resumed)
+	}
+	if r.Approved > 0 {
+		fmt.Fprintf(tw, "Approved\t%d\n", r.Approved)
+	}
+	fmt.Fprintf(tw, "Skipped\t%d\n", r.Skipped)
+	fmt.Fprintf(tw, "Failed\t%d\n", r.Failed)
+	fmt.Fprintf(tw, "Characters\t%d\n", r.Characters)
+	fmt.Fprintf(tw, "Elapsed\t%s\n", time.Duration(r.ElapsedMs)*time.Millisecond)
+	if r.Stopped != "" {
+		fmt.Fprintf(tw, "Stopped\t%s\n", r.Stopped)
+	}
+	tw.Flush()
+
+	if len(r.Voices) > 0 {
+		sb.WriteString("\n")
+		tw = tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "VOICE\tSEGMENTS\tCHARACTERS")
+		for _, v := range r.Voices {
+			fmt.Fprintf(tw, "%s\t%d\t%d\n", v.VoiceID, v.Segments, v.Characters)
+		}
+		tw.Flush()
+	}
+
+	if len(r.Failures) > 0 {
+		sb.WriteString("\n")
+		for _, f := range r.Failures {
+			fmt.Fprintf(&sb, "  [%s] slide %d, %s: %s\n", f.Outcome, f.SlideIndex+1, segmentLabel(f.SegmentIndex), f.Error)
+		}
+	}
+
+	return sb.String()
+}
+
+// segmentLabel returns a human label for a segment index.
+func segmentLabel(segmentIndex int) string {
+	if segmentIndex < 0 {
+		return "title"
+	}
+	return fmt.Sprintf("segment %d", segmentIndex+1)
+}
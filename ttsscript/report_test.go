@@ -0,0 +1,65 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunReport(t *testing.T) {
+	report := NewRunReport(&Script{Title: "Course"}, "en")
+	report.RecordGenerated(ElevenLabsSegment{Text: "Hello", VoiceID: "voice-b"})
+	report.RecordGenerated(ElevenLabsSegment{Text: "World!", VoiceID: "voice-a"})
+	report.RecordGenerated(ElevenLabsSegment{Text: "Again", VoiceID: "voice-b"})
+	report.RecordPrerecorded(ElevenLabsSegment{Text: "Recorded intro", VoiceID: "voice-a", AudioFile: "intro.mp3"})
+	report.RecordCached(ElevenLabsSegment{Text: "Disclaimer", VoiceID: "voice-a"})
+	report.RecordApproved(ElevenLabsSegment{Text: "Reviewed", VoiceID: "voice-a"})
+	report.RecordSkipped(1, 0, "no voice ID configured")
+	report.RecordFailed(ElevenLabsSegment{SlideIndex: 2, SegmentIndex: -1}, errors.New("quota exceeded"))
+	report.Stop("1 segment failed (-fail-fast)")
+	report.Finish()
+
+	if report.Total != 8 || report.Generated != 3 || report.Prerecorded != 1 || report.Cached != 1 || report.Approved != 1 || report.Skipped != 1 || report.Failed != 1 {
+		t.Errorf("unexpected counts: %+v", report)
+	}
+	if report.Characters != 16 {
+		t.Errorf("expected 16 characters, got %d", report.Characters)
+	}
+	if report.Success() {
+		t.Error("expected Success() to be false with a failure")
+	}
+	if len(report.Voices) != 2 || report.Voices[0].VoiceID != "voice-a" || report.Voices[1].Segments != 2 {
+		t.Errorf("unexpected voice usage: %+v", report.Voices)
+	}
+
+	summary := report.Summary()
+	for _, want := range []string{"Generated", "Pre-recorded", "Cached", "Approved", "voice-b", "[failed] slide 3, title: quota exceeded", "1 segment failed (-fail-fast)"} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("summary missing %q:\n%s", want, summary)
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "report.json")
+	if err := report.WriteJSON(path); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+}
+
+func TestRunReportJSONIsStable(t *testing.T) {
+	build := func() []byte {
+		report := NewRunReport(nil, "en")
+		for _, v := range []string{"c", "a", "b"} {
+			report.RecordGenerated(ElevenLabsSegment{Text: "x", VoiceID: v})
+		}
+		report.Finish()
+		report.StartedAt = report.StartedAt.Truncate(0)
+		report.ElapsedMs = 0
+		data, _ := json.Marshal(report.Voices)
+		return data
+	}
+	if string(build()) != string(build()) {
+		t.Error("expected deterministic voice ordering")
+	}
+}
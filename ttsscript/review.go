@@ -0,0 +1,140 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// Review decisions.
+const (
+	// DecisionApprove keeps the generated audio on later runs.
+	DecisionApprove = "approve"
+
+	// DecisionRegenerate regenerates the audio on the next run.
+	DecisionRegenerate = "regenerate"
+)
+
+// Review records approve/regenerate decisions for generated segments.
+// A decision applies to the audio with a specific content hash: once the
+// segment changes, the decision no longer applies and the segment needs
+// review again.
+type Review struct {
+	// Language is the reviewed language.
+	Language string `json:"language"`
+
+	// Decisions are sorted by output file.
+	Decisions []ReviewDecision `json:"decisions"`
+}
+
+// ReviewDecision is the decision for one generated audio file.
+type ReviewDecision struct {
+	// OutputFile is the manifest output file the decision applies to.
+	OutputFile string `json:"output_file"`
+
+	// Hash is the manifest hash of the reviewed audio.
+	Hash string `json:"hash"`
+
+	// Decision is DecisionApprove or DecisionRegenerate.
+	Decision string `json:"decision"`
+
+	// Note is an optional reviewer comment, e.g. what to fix.
+	Note string `json:"note,omitempty"`
+
+	// ReviewedAt is when the decision was made.
+	ReviewedAt time.Time `json:"reviewed_at"`
+}
+
+// NewReview creates an empty review for a language.
+func NewReview(language string) *Review {
+	return &Review{Language: language, Decisions: []ReviewDecision{}}
+}
+
+// LoadReview loads a review from a JSON file.
+func LoadReview(filePath string) (*Review, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading review file: %w", err)
+	}
+	var r Review
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing review JSON: %w", err)
+	}
+	return &r, nil
+}
+
+// WriteJSON writes the review as indented JSON to a file.
+func (r *Review) WriteJSON(filePath string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling review: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("writing review file: %w", err)
+	}
+	return nil
+}
+
+// Set records a decision for an entry, replacing any earlier decision.
+func (r *Review) Set(entry ManifestEntry, decision, note string, at time.Time) {
+	d := ReviewDecision{
+		OutputFile: entry.OutputFile,
+		Hash:       entry.Hash,
+		Decision:   decision,
+		Note:       note,
+		ReviewedAt: at,
+	}
+	if i := r.index(entry.OutputFile); i >= 0 {
+		r.Decisions[i] = d
+		return
+	}
+	r.Decisions = append(r.Decisions, d)
+	sort.Slice(r.Decisions, func(i, j int) bool {
+		return r.Decisions[i].OutputFile < r.Decisions[j].OutputFile
+	})
+}
+
+// Clear removes the decision for an output file.
+func (r *Review) Clear(outputFile string) {
+	if i := r.index(outputFile); i >= 0 {
+		r.Decisions = append(r.Decisions[:i], r.Decisions[i+1:]...)
+	}
+}
+
+// Decision returns the decision that applies to an entry, or nil if the
+// entry has not been reviewed or has changed since it was reviewed.
+func (r *Review) Decision(entry ManifestEntry) *ReviewDecision {
+	i := r.index(entry.OutputFile)
+	if i < 0 || r.Decisions[i].Hash != entry.Hash {
+		return nil
+	}
+	return &r.Decisions[i]
+}
+
+// Approved returns true if the entry's current audio was approved.
+func (r *Review) Approved(entry ManifestEntry) bool {
+	d := r.Decision(entry)
+	return d != nil && d.Decision == DecisionApprove
+}
+
+// Pending returns the entries with audio that have no applicable decision.
+func (r *Review) Pending(entries []ManifestEntry) []ManifestEntry {
+	var pending []ManifestEntry
+	for _, e := range entries {
+		if e.Available() && r.Decision(e) == nil {
+			pending = append(pending, e)
+		}
+	}
+	return pending
+}
+
+func (r *Review) index(outputFile string) int {
+	for i, d := range r.Decisions {
+		if d.OutputFile == outputFile {
+			return i
+		}
+	}
+	return -1
+}
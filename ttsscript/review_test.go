@@ -0,0 +1,92 @@
+package ttsscript
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestReviewDecisions(t *testing.T) {
+	a := ManifestEntry{OutputFile: "out/slide01_seg01_en.mp3", Hash: "h1"}
+	b := ManifestEntry{OutputFile: "out/slide01_seg02_en.mp3", Hash: "h2"}
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	r := NewReview("en")
+	if got := r.Pending([]ManifestEntry{a, b}); len(got) != 2 {
+		t.Fatalf("Pending() = %d entries, want 2", len(got))
+	}
+
+	r.Set(b, DecisionRegenerate, "mispronounces API", at)
+	r.Set(a, DecisionApprove, "", at)
+	if r.Decisions[0].OutputFile != a.OutputFile {
+		t.Errorf("decisions not sorted by output file: %+v", r.Decisions)
+	}
+	if !r.Approved(a) || r.Approved(b) {
+		t.Errorf("Approved(a)=%v Approved(b)=%v, want true, false", r.Approved(a), r.Approved(b))
+	}
+	if got := r.Pending([]ManifestEntry{a, b}); len(got) != 0 {
+		t.Errorf("Pending() = %v, want none", got)
+	}
+
+	// A changed segment needs review again.
+	changed := a
+	changed.Hash = "h1-new"
+	if r.Approved(changed) || r.Decision(changed) != nil {
+		t.Error("decision should not apply after the hash changes")
+	}
+
+	// Replacing a decision keeps one entry per file.
+	r.Set(b, DecisionApprove, "", at)
+	if len(r.Decisions) != 2 || !r.Approved(b) {
+		t.Errorf("unexpected decisions after replace: %+v", r.Decisions)
+	}
+
+	r.Clear(a.OutputFile)
+	if len(r.Decisions) != 1 || r.Decision(a) != nil {
+		t.Errorf("Clear() did not remove decision: %+v", r.Decisions)
+	}
+}
+
+func TestReviewRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "review_en.json")
+	entry := ManifestEntry{OutputFile: "slide01_title_en.mp3", Hash: "abc"}
+
+	r := NewReview("en")
+	r.Set(entry, DecisionRegenerate, "too fast", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err := r.WriteJSON(path); err != nil {
+		t.Fatalf("WriteJSON() error = %v", err)
+	}
+
+	loaded, err := LoadReview(path)
+	if err != nil {
+		t.Fatalf("LoadReview() error = %v", err)
+	}
+	d := loaded.Decision(entry)
+	if loaded.Language != "en" || d == nil || d.Decision != DecisionRegenerate || d.Note != "too fast" {
+		t.Errorf("unexpected review after round trip: %+v", loaded)
+	}
+}
+
+func TestManifestPronunciations(t *testing.T) {
+	script := &Script{
+		DefaultVoices:  map[string]string{"en": "voice1"},
+		Pronunciations: map[string]map[string]string{"API": {"en": "A P I"}},
+		Slides: []Slide{
+			{Segments: []Segment{{Text: map[string]string{"en": "Call the API"}}}},
+		},
+	}
+
+	compiler := NewCompiler()
+	compiler.Trace = true
+	segments, err := compiler.Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	jobs := NewElevenLabsFormatter().Format(segments)
+	entries := GenerateManifest(jobs, NewBatchConfig("out"), "en")
+
+	hits := entries[0].Pronunciations
+	if len(hits) != 1 || hits[0].Matched != "API" || hits[0].Replacement != "A P I" {
+		t.Errorf("unexpected manifest pronunciations: %+v", hits)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 )
 
 // Script represents a multilingual TTS script with slides/segments.
@@ -26,14 +27,136 @@ type Script struct {
 	// DefaultVoices maps language codes to default voice IDs.
 	DefaultVoices map[string]string `json:"default_voices,omitempty"`
 
+	// DefaultModel is the TTS model for segments that don't set one.
+	// Empty means the engine's default.
+	DefaultModel string `json:"default_model,omitempty"`
+
 	// Pronunciations maps terms to their pronunciation by language.
 	// Example: {"ADK": {"en": "A D K", "es": "A D K"}}
 	Pronunciations map[string]map[string]string `json:"pronunciations,omitempty"`
 
+	// Profiles are named prosody profiles that slides and segments can
+	// reference by name.
+	// Example: {"narration": {"rate": "95%", "stability": 0.6}}
+	Profiles map[string]ProsodyProfile `json:"profiles,omitempty"`
+
+	// EngineRoutes send languages to TTS engines other than the default,
+	// for languages that sound better on another provider.
+	// Example: [{"language": "ja", "engine": "google", "voice": "ja-JP-Neural2-B"}]
+	EngineRoutes []EngineRoute `json:"engine_routes,omitempty"`
+
+	// SpeakerChangeEarcon is a short sound played whenever the voice
+	// changes between consecutive segments of a slide, so listeners can
+	// tell speakers apart. Nil means no earcons.
+	// Example: {"audio_file": "assets/chime.mp3", "prompt": "soft two-note chime", "duration": "600ms"}
+	SpeakerChangeEarcon *Earcon `json:"speaker_change_earcon,omitempty"`
+
+	// DefaultCrossfade is the crossfade between back-to-back segments of
+	// the same voice for slides that do not set Crossfade (e.g., "50ms").
+	DefaultCrossfade string `json:"default_crossfade,omitempty"`
+
 	// Slides contains the ordered list of slides/sections.
 	Slides []Slide `json:"slides"`
 }
 
+// EngineRoute routes a language to a TTS engine.
+type EngineRoute struct {
+	// Language is the language code. Empty matches every language without
+	// a route of its own.
+	Language string `json:"language,omitempty"`
+
+	// Engine is the engine name, as registered with a Router.
+	Engine string `json:"engine"`
+
+	// Voice is the engine's default voice for the language. It replaces
+	// DefaultVoices; slide and segment voices still take precedence.
+	Voice string `json:"voice,omitempty"`
+}
+
+// EngineRoute returns the route for a language, or nil if the language
+// uses the default engine.
+func (s *Script) EngineRoute(language string) *EngineRoute {
+	var catchAll *EngineRoute
+	for i, r := range s.EngineRoutes {
+		if r.Language == language {
+			return &s.EngineRoutes[i]
+		}
+		if r.Language == "" && catchAll == nil {
+			catchAll = &s.EngineRoutes[i]
+		}
+	}
+	return catchAll
+}
+
+// defaultVoice returns the default voice for a language on an engine: the
+// route's voice when the engine is the routed one, otherwise DefaultVoices.
+func (s *Script) defaultVoice(language, engine string) (string, bool) {
+	if r := s.EngineRoute(language); r != nil && r.Engine == engine && r.Voice != "" {
+		return r.Voice, true
+	}
+	v, ok := s.DefaultVoices[language]
+	return v, ok
+}
+
+// Earcon is a short non-speech sound inserted between segments.
+type Earcon struct {
+	// AudioFile is the earcon audio, relative to the script file. When
+	// Prompt is set, tooling generates the file from it once if it does
+	// not exist, and reuses it afterwards.
+	AudioFile string `json:"audio_file"`
+
+	// Prompt describes a sound effect to generate as the earcon
+	// (e.g., "soft two-note chime"). Empty means AudioFile is supplied.
+	Prompt string `json:"prompt,omitempty"`
+
+	// Duration is the length of the generated sound effect (e.g., "600ms").
+	// Empty lets the engine choose.
+	Duration string `json:"duration,omitempty"`
+
+	// PauseAfter is the pause between the earcon and the next segment
+	// (e.g., "200ms").
+	PauseAfter string `json:"pause_after,omitempty"`
+
+	// GainDB adjusts the loudness of the earcon during post-processing,
+	// in decibels (e.g., -6.0).
+	GainDB float64 `json:"gain_db,omitempty"`
+}
+
+// ProsodyProfile is a named set of prosody and voice tuning values.
+// Engine-agnostic fields (Rate, Pitch, Emphasis, Volume) are used by all
+// formatters; the remaining fields are mapped by engine-specific formatters.
+type ProsodyProfile struct {
+	// Rate is the speaking rate ("slow", "medium", "fast", or percentage like "95%").
+	Rate string `json:"rate,omitempty"`
+
+	// Pitch adjusts the pitch ("low", "medium", "high", or percentage like "+10%").
+	Pitch string `json:"pitch,omitempty"`
+
+	// Emphasis indicates the emphasis level ("strong", "moderate", "reduced").
+	Emphasis string `json:"emphasis,omitempty"`
+
+	// Volume adjusts loudness ("soft", "medium", "loud", or dB like "+3dB").
+	Volume string `json:"volume,omitempty"`
+
+	// Preset names a voice settings preset (e.g., "narration", "stable")
+	// that the stability, similarity, style, and speed fields below refine.
+	// Presets are resolved by the TTS engine integration.
+	Preset string `json:"preset,omitempty"`
+
+	// Stability is the ElevenLabs voice stability (0.0 to 1.0).
+	Stability *float64 `json:"stability,omitempty"`
+
+	// SimilarityBoost is the ElevenLabs similarity boost (0.0 to 1.0).
+	SimilarityBoost *float64 `json:"similarity_boost,omitempty"`
+
+	// Style is the ElevenLabs style exaggeration (0.0 to 1.0).
+	Style *float64 `json:"style,omitempty"`
+
+	// Speed is the ElevenLabs speaking speed (0.7 to 1.2 recommended).
+	// If unset, ElevenLabs formatters derive it from a percentage Rate.
+	Speed *float64 `json:"speed,omitempty"`
+}
+
 // Slide represents a slide or section of the script.
 type Slide struct {
 	// Title is the slide title (optional).
@@ -50,6 +173,10 @@ type Slide struct {
 	// If true, the title is converted to a segment. Defaults to true for section headers.
 	SpeakTitle *bool `json:"speak_title,omitempty"`
 
+	// TitleText is the spoken title by language code.
+	// If not set for a language, Title is spoken as-is.
+	TitleText map[string]string `json:"title_text,omitempty"`
+
 	// TitleVoice overrides the voice used for speaking the title, by language.
 	// If not set, uses the segment voice or default voice.
 	TitleVoice map[string]string `json:"title_voice,omitempty"`
@@ -58,20 +185,113 @@ type Slide struct {
 	// Defaults to "500ms" for section headers, "300ms" for regular slides.
 	TitlePauseAfter string `json:"title_pause_after,omitempty"`
 
+	// DefaultVoice is the voice by language for segments in this slide that
+	// do not set their own. Overrides Script.DefaultVoices.
+	DefaultVoice map[string]string `json:"default_voice,omitempty"`
+
+	// DefaultRate is the speaking rate for segments that do not set Rate.
+	DefaultRate string `json:"default_rate,omitempty"`
+
+	// DefaultPitch is the pitch for segments that do not set Pitch.
+	DefaultPitch string `json:"default_pitch,omitempty"`
+
+	// DefaultProfile is the prosody profile name for segments that do not
+	// set Profile.
+	DefaultProfile string `json:"default_profile,omitempty"`
+
+	// DefaultPauseAfter is the pause after each segment that does not set
+	// PauseAfter (e.g., "300ms").
+	DefaultPauseAfter string `json:"default_pause_after,omitempty"`
+
+	// Crossfade is a short crossfade (e.g., "50ms") applied when
+	// concatenating back-to-back segments of the same voice, to mask clicks
+	// at MP3 frame boundaries. Overrides Script.DefaultCrossfade; "0ms"
+	// turns crossfades off for the slide.
+	Crossfade string `json:"crossfade,omitempty"`
+
+	// Assets are non-audio assets shown with this slide (images, video),
+	// with per-language paths and alt text. They are passed through to
+	// compiled segments and manifests for downstream video and QA tooling.
+	Assets []Asset `json:"assets,omitempty"`
+
 	// Segments are the audio segments for this slide.
 	Segments []Segment `json:"segments"`
 }
 
+// Asset is a visual asset associated with a slide.
+type Asset struct {
+	// ID is an optional stable identifier for the asset.
+	ID string `json:"id,omitempty"`
+
+	// Type is the asset type (e.g., "image", "video").
+	Type string `json:"type,omitempty"`
+
+	// Path is the default asset path or URL.
+	Path string `json:"path"`
+
+	// LocalizedPaths overrides Path by language code (e.g., a screenshot
+	// with translated UI).
+	LocalizedPaths map[string]string `json:"localized_paths,omitempty"`
+
+	// AltText is the accessible description by language code.
+	AltText map[string]string `json:"alt_text,omitempty"`
+}
+
+// LocalizedAsset is an asset resolved for a single language.
+type LocalizedAsset struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Path    string `json:"path"`
+	AltText string `json:"alt_text,omitempty"`
+}
+
+// Localize resolves the asset's path and alt text for a language.
+func (a Asset) Localize(language string) LocalizedAsset {
+	return LocalizedAsset{
+		ID:      a.ID,
+		Type:    a.Type,
+		Path:    firstNonEmpty(a.LocalizedPaths[language], a.Path),
+		AltText: a.AltText[language],
+	}
+}
+
+// LocalizedAssets returns the slide's assets resolved for a language,
+// or nil if the slide has no assets.
+func (s *Slide) LocalizedAssets(language string) []LocalizedAsset {
+	if len(s.Assets) == 0 {
+		return nil
+	}
+	result := make([]LocalizedAsset, len(s.Assets))
+	for i, a := range s.Assets {
+		result[i] = a.Localize(language)
+	}
+	return result
+}
+
 // Segment represents a single audio segment within a slide.
 type Segment struct {
+	// ID is an optional stable identifier for the segment (e.g., "intro").
+	// It can be used to select segments for partial generation.
+	ID string `json:"id,omitempty"`
+
 	// Text contains the text content by language code.
 	// Example: {"en": "Hello world", "es": "Hola mundo"}
 	Text map[string]string `json:"text"`
 
+	// AudioFile references pre-recorded audio by language code, relative
+	// to the script file. For those languages the file is used as-is
+	// instead of generating speech; Text, if present, is the transcript.
+	// Example: {"en": "assets/ceo_intro_en.mp3"}
+	AudioFile map[string]string `json:"audio_file,omitempty"`
+
 	// Voice overrides the default voice for this segment by language.
 	// Example: {"en": "voice-id-1", "es": "voice-id-2"}
 	Voice map[string]string `json:"voice,omitempty"`
 
+	// Engine overrides Script.EngineRoutes for this segment by language.
+	// Example: {"ja": "google"}
+	Engine map[string]string `json:"engine,omitempty"`
+
 	// PauseBefore is the pause duration before this segment (e.g., "500ms", "1s").
 	PauseBefore string `json:"pause_before,omitempty"`
 
@@ -87,8 +307,37 @@ type Segment struct {
 	// Pitch adjusts the pitch ("low", "medium", "high", or percentage like "+10%").
 	Pitch string `json:"pitch,omitempty"`
 
+	// Profile is the name of a prosody profile defined in Script.Profiles.
+	// Explicit Rate, Pitch, and Emphasis values take precedence over the profile.
+	Profile string `json:"profile,omitempty"`
+
 	// Pronunciations are segment-specific pronunciation overrides.
 	Pronunciations map[string]map[string]string `json:"pronunciations,omitempty"`
+
+	// FadeIn is a fade-in applied to the generated audio during
+	// post-processing (e.g., "200ms").
+	FadeIn string `json:"fade_in,omitempty"`
+
+	// FadeOut is a fade-out applied to the end of the generated audio during
+	// post-processing (e.g., "1s").
+	FadeOut string `json:"fade_out,omitempty"`
+
+	// GainDB adjusts the loudness of the generated audio during
+	// post-processing, in decibels (e.g., -3.0).
+	GainDB float64 `json:"gain_db,omitempty"`
+
+	// Model overrides Script.DefaultModel for this segment
+	// (e.g., "eleven_turbo_v2_5").
+	Model string `json:"model,omitempty"`
+
+	// Seed makes generation repeatable on engines that support it.
+	// Zero means no seed.
+	Seed int `json:"seed,omitempty"`
+
+	// Directives are ElevenLabs v3 audio tags applied before the text in
+	// every language, such as ["whispers"]. Tags can also be placed inline
+	// with {{tag:laughs}}. See SupportsAudioTags.
+	Directives []string `json:"directives,omitempty"`
 }
 
 // LoadScript loads a script from a JSON file.
@@ -100,20 +349,38 @@ func LoadScript(filePath string) (*Script, error) {
 	return ParseScript(data)
 }
 
-// ParseScript parses a script from JSON data.
+// LoadScriptLenient loads a script from a JSON file that may contain
+// comments and trailing commas. See ParseScriptLenient.
+func LoadScriptLenient(filePath string) (*Script, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading script file: %w", err)
+	}
+	return ParseScriptLenient(data)
+}
+
+// ParseScript parses a script from JSON data. Errors report the line and
+// column of the problem.
 func ParseScript(data []byte) (*Script, error) {
 	var script Script
 	if err := json.Unmarshal(data, &script); err != nil {
-		return nil, fmt.Errorf("parsing script JSON: %w", err)
+		return nil, fmt.Errorf("parsing script JSON: %w", jsonPositionError(data, err))
 	}
 	return &script, nil
 }
 
-// Save saves a script to a JSON file.
+// ParseScriptLenient parses a script from JSON data, accepting // and
+// /* */ comments and trailing commas, as in hand-edited JSONC and JSON5
+// files. Other JSON5 extensions, such as unquoted keys, are not supported.
+func ParseScriptLenient(data []byte) (*Script, error) {
+	return ParseScript(stripJSONC(data))
+}
+
+// Save saves a script to a JSON file, formatted with Format.
 func (s *Script) Save(filePath string) error {
-	data, err := json.MarshalIndent(s, "", "  ")
+	data, err := s.Format()
 	if err != nil {
-		return fmt.Errorf("marshaling script: %w", err)
+		return err
 	}
 	if err := os.WriteFile(filePath, data, 0600); err != nil {
 		return fmt.Errorf("writing script file: %w", err)
@@ -138,6 +405,49 @@ func (s *Script) Languages() []string {
 	return result
 }
 
+// VoiceIDs returns all voice IDs referenced by the script (default, slide,
+// title, and segment voices) across all languages, sorted and deduplicated.
+func (s *Script) VoiceIDs() []string {
+	ids := make(map[string]bool)
+	add := func(m map[string]string) {
+		for _, id := range m {
+			if id != "" {
+				ids[id] = true
+			}
+		}
+	}
+	add(s.DefaultVoices)
+	for _, slide := range s.Slides {
+		add(slide.DefaultVoice)
+		add(slide.TitleVoice)
+		for _, seg := range slide.Segments {
+			add(seg.Voice)
+		}
+	}
+	return sortedKeys(ids)
+}
+
+// slideLanguages returns the languages used by a slide's segments, sorted.
+func slideLanguages(slide Slide) []string {
+	langs := make(map[string]bool)
+	for _, seg := range slide.Segments {
+		for lang := range seg.Text {
+			langs[lang] = true
+		}
+	}
+	return sortedKeys(langs)
+}
+
+// sortedKeys returns the keys of a set in sorted order.
+func sortedKeys(set map[string]bool) []string {
+	result := make([]string, 0, len(set))
+	for k := range set {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
 // SlideCount returns the number of slides.
 func (s *Script) SlideCount() int {
 	return len(s.Slides)
@@ -163,6 +473,15 @@ func (s *Slide) ShouldSpeakTitle() bool {
 	return s.IsSectionHeader
 }
 
+// SpokenTitle returns the title text to narrate for the given language,
+// preferring TitleText and falling back to Title.
+func (s *Slide) SpokenTitle(language string) string {
+	if text, ok := s.TitleText[language]; ok && text != "" {
+		return text
+	}
+	return s.Title
+}
+
 // Validate checks the script for common issues.
 func (s *Script) Validate() []string {
 	var issues []string
@@ -171,16 +490,101 @@ func (s *Script) Validate() []string {
 		issues = append(issues, "script has no slides")
 	}
 
+	for i, r := range s.EngineRoutes {
+		if r.Engine == "" {
+			issues = append(issues, fmt.Sprintf("engine route %d has no engine", i+1))
+		}
+	}
+
+	if e := s.SpeakerChangeEarcon; e != nil {
+		if e.AudioFile == "" {
+			issues = append(issues, "speaker change earcon has no audio file")
+		}
+		if d := ParseDuration(e.Duration); e.Duration != "" && (d < 500 || d > 30000) {
+			issues = append(issues, fmt.Sprintf("speaker change earcon has invalid duration %q (must be 500ms to 30s)", e.Duration))
+		}
+		if e.PauseAfter != "" && ParseDuration(e.PauseAfter) <= 0 {
+			issues = append(issues, fmt.Sprintf("speaker change earcon has invalid pause_after %q", e.PauseAfter))
+		}
+	}
+	if !validCrossfade(s.DefaultCrossfade) {
+		issues = append(issues, fmt.Sprintf("invalid default_crossfade %q (must be 0ms to %dms)", s.DefaultCrossfade, MaxCrossfadeMs))
+	}
+
 	for i, slide := range s.Slides {
 		if len(slide.Segments) == 0 {
 			issues = append(issues, fmt.Sprintf("slide %d has no segments", i+1))
 		}
+		if !validCrossfade(slide.Crossfade) {
+			issues = append(issues, fmt.Sprintf("slide %d has invalid crossfade %q (must be 0ms to %dms)", i+1, slide.Crossfade, MaxCrossfadeMs))
+		}
+		if slide.DefaultProfile != "" {
+			if _, ok := s.Profiles[slide.DefaultProfile]; !ok {
+				issues = append(issues, fmt.Sprintf("slide %d references unknown profile %q", i+1, slide.DefaultProfile))
+			}
+		}
+		for k, asset := range slide.Assets {
+			if asset.Path == "" && len(asset.LocalizedPaths) == 0 {
+				issues = append(issues, fmt.Sprintf("slide %d, asset %d has no path", i+1, k+1))
+			}
+			if len(asset.AltText) > 0 {
+				for _, lang := range slideLanguages(slide) {
+					if asset.AltText[lang] == "" {
+						issues = append(issues, fmt.Sprintf("slide %d, asset %d has no alt text for %q", i+1, k+1, lang))
+					}
+				}
+			}
+		}
 		for j, seg := range slide.Segments {
-			if len(seg.Text) == 0 {
+			if len(seg.Text) == 0 && len(seg.AudioFile) == 0 {
 				issues = append(issues, fmt.Sprintf("slide %d, segment %d has no text", i+1, j+1))
 			}
+			for _, lang := range slideLanguages(slide) {
+				if IsEncryptedText(seg.Text[lang]) {
+					issues = append(issues, fmt.Sprintf("slide %d, segment %d, %q is encrypted; load the script with its key", i+1, j+1, lang))
+					continue
+				}
+				if err := ValidateSSMLIslands(seg.Text[lang]); err != nil {
+					issues = append(issues, fmt.Sprintf("slide %d, segment %d, %q: %v", i+1, j+1, lang, err))
+				}
+				if err := ValidateAudioTags(seg.Text[lang]); err != nil {
+					issues = append(issues, fmt.Sprintf("slide %d, segment %d, %q: %v", i+1, j+1, lang, err))
+				}
+			}
+			for lang, path := range seg.AudioFile {
+				if path == "" {
+					issues = append(issues, fmt.Sprintf("slide %d, segment %d has an empty audio file for %q", i+1, j+1, lang))
+				}
+			}
+			if seg.Profile != "" {
+				if _, ok := s.Profiles[seg.Profile]; !ok {
+					issues = append(issues, fmt.Sprintf("slide %d, segment %d references unknown profile %q", i+1, j+1, seg.Profile))
+				}
+			}
+			if seg.Seed < 0 {
+				issues = append(issues, fmt.Sprintf("slide %d, segment %d has a negative seed", i+1, j+1))
+			}
+			for name, d := range map[string]string{"fade_in": seg.FadeIn, "fade_out": seg.FadeOut} {
+				if d != "" && ParseDuration(d) <= 0 {
+					issues = append(issues, fmt.Sprintf("slide %d, segment %d has invalid %s %q", i+1, j+1, name, d))
+				}
+			}
 		}
 	}
 
 	return issues
 }
+
+// MaxCrossfadeMs is the longest crossfade between segments. Longer
+// overlaps would blur words rather than mask clicks.
+const MaxCrossfadeMs = 500
+
+// validCrossfade reports whether a crossfade duration is empty, zero, or
+// a duration up to MaxCrossfadeMs.
+func validCrossfade(s string) bool {
+	if s == "" {
+		return true
+	}
+	d := ParseDuration(s)
+	return d <= MaxCrossfadeMs && (d > 0 || canonicalDuration(s) == "0ms")
+}
@@ -0,0 +1,146 @@
+package ttsscript
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Selector selects a subset of slides and segments for partial generation.
+// A nil or empty Selector matches everything.
+type Selector struct {
+	slides   []indexRange
+	segments []segmentRange
+	ids      map[string]bool
+}
+
+// indexRange is an inclusive range of 0-based indexes. Ranges are stored
+// rather than expanded, so "1-1000000000" costs no more than "1".
+type indexRange struct {
+	lo, hi int
+}
+
+func (r indexRange) contains(i int) bool {
+	return i >= r.lo && i <= r.hi
+}
+
+// segmentRange is a range of segments of one slide.
+type segmentRange struct {
+	slide    int
+	segments indexRange
+}
+
+// ParseSelector builds a Selector from slide, segment, and ID expressions.
+// All numbers are 1-based, matching output filenames.
+//
+//	slides:   "3,5-7"
+//	segments: "slide03:2,4:title,4:1-3"
+//	ids:      "intro,outro"
+//
+// A segment matches if it matches any of the expressions.
+func ParseSelector(slides, segments, ids string) (*Selector, error) {
+	sel := &Selector{}
+
+	for _, part := range splitList(slides) {
+		lo, hi, err := parseRange(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid slide selector %q: %w", part, err)
+		}
+		sel.slides = append(sel.slides, indexRange{lo - 1, hi - 1})
+	}
+
+	for _, part := range splitList(segments) {
+		slidePart, segPart, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid segment selector %q: expected SLIDE:SEGMENT", part)
+		}
+		slideNum, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(slidePart), "slide"))
+		if err != nil || slideNum < 1 {
+			return nil, fmt.Errorf("invalid segment selector %q: bad slide number", part)
+		}
+		if strings.EqualFold(segPart, "title") {
+			sel.segments = append(sel.segments, segmentRange{slideNum - 1, indexRange{-1, -1}})
+			continue
+		}
+		lo, hi, err := parseRange(strings.TrimPrefix(strings.ToLower(segPart), "seg"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid segment selector %q: %w", part, err)
+		}
+		sel.segments = append(sel.segments, segmentRange{slideNum - 1, indexRange{lo - 1, hi - 1}})
+	}
+
+	for _, id := range splitList(ids) {
+		if sel.ids == nil {
+			sel.ids = make(map[string]bool)
+		}
+		sel.ids[strings.TrimPrefix(id, "id=")] = true
+	}
+
+	return sel, nil
+}
+
+// IsEmpty returns true if the selector has no expressions and matches everything.
+func (s *Selector) IsEmpty() bool {
+	return s == nil || (len(s.slides) == 0 && len(s.segments) == 0 && len(s.ids) == 0)
+}
+
+// Matches returns true if the 0-based slide/segment (or segment ID) is selected.
+// Title segments use segmentIndex -1.
+func (s *Selector) Matches(slideIndex, segmentIndex int, id string) bool {
+	if s.IsEmpty() {
+		return true
+	}
+	for _, r := range s.slides {
+		if r.contains(slideIndex) {
+			return true
+		}
+	}
+	for _, r := range s.segments {
+		if r.slide == slideIndex && r.segments.contains(segmentIndex) {
+			return true
+		}
+	}
+	return id != "" && s.ids[id]
+}
+
+// FilterSegments returns the compiled segments matched by the selector.
+func (s *Selector) FilterSegments(segments []CompiledSegment) []CompiledSegment {
+	if s.IsEmpty() {
+		return segments
+	}
+	var result []CompiledSegment
+	for _, seg := range segments {
+		if s.Matches(seg.SlideIndex, seg.SegmentIndex, seg.ID) {
+			result = append(result, seg)
+		}
+	}
+	return result
+}
+
+// splitList splits a comma-separated list, trimming blanks.
+func splitList(s string) []string {
+	var result []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// parseRange parses "N" or "N-M" (1-based, inclusive).
+func parseRange(s string) (int, int, error) {
+	loStr, hiStr, isRange := strings.Cut(s, "-")
+	lo, err := strconv.Atoi(strings.TrimSpace(loStr))
+	if err != nil || lo < 1 {
+		return 0, 0, fmt.Errorf("expected a positive number")
+	}
+	if !isRange {
+		return lo, lo, nil
+	}
+	hi, err := strconv.Atoi(strings.TrimSpace(hiStr))
+	if err != nil || hi < lo {
+		return 0, 0, fmt.Errorf("invalid range")
+	}
+	return lo, hi, nil
+}
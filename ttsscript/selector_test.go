@@ -0,0 +1,72 @@
+package ttsscript
+
+import "testing"
+
+func TestParseSelector(t *testing.T) {
+	sel, err := ParseSelector("3,5-7", "slide01:2,2:title,9:1-2", "id=intro")
+	if err != nil {
+		t.Fatalf("ParseSelector failed: %v", err)
+	}
+
+	tests := []struct {
+		slide, segment int
+		id             string
+		want           bool
+	}{
+		{2, 0, "", true},       // slide 3
+		{5, 4, "", true},       // slide 6 via range
+		{7, 0, "", false},      // slide 8
+		{0, 1, "", true},       // slide01:2
+		{0, 0, "", false},      // slide01:1
+		{1, -1, "", true},      // 2:title
+		{1, 0, "", false},      // 2:1
+		{8, 1, "", true},       // 9:2
+		{8, 2, "", false},      // 9:3
+		{10, 3, "intro", true}, // by ID
+	}
+	for _, tt := range tests {
+		if got := sel.Matches(tt.slide, tt.segment, tt.id); got != tt.want {
+			t.Errorf("Matches(%d, %d, %q) = %v, want %v", tt.slide, tt.segment, tt.id, got, tt.want)
+		}
+	}
+}
+
+func TestParseSelectorEmpty(t *testing.T) {
+	sel, err := ParseSelector("", "", "")
+	if err != nil {
+		t.Fatalf("ParseSelector failed: %v", err)
+	}
+	if !sel.IsEmpty() || !sel.Matches(4, 2, "") {
+		t.Error("expected empty selector to match everything")
+	}
+
+	segments := []CompiledSegment{{SlideIndex: 0}, {SlideIndex: 1}}
+	if len(sel.FilterSegments(segments)) != 2 {
+		t.Error("expected empty selector to keep all segments")
+	}
+}
+
+func TestParseSelectorErrors(t *testing.T) {
+	for _, tt := range []struct{ slides, segments string }{
+		{"0", ""},
+		{"5-3", ""},
+		{"abc", ""},
+		{"", "3"},
+		{"", "x:1"},
+	} {
+		if _, err := ParseSelector(tt.slides, tt.segments, ""); err == nil {
+			t.Errorf("expected error for slides=%q segments=%q", tt.slides, tt.segments)
+		}
+	}
+}
+
+func TestParseSelectorHugeRange(t *testing.T) {
+	// Ranges are not expanded, so a huge upper bound is cheap
+	sel, err := ParseSelector("2-2000000000", "1:1-2000000000", "")
+	if err != nil {
+		t.Fatalf("ParseSelector failed: %v", err)
+	}
+	if !sel.Matches(1999999999, 0, "") || sel.Matches(0, -1, "") || !sel.Matches(0, 1999999999, "") {
+		t.Error("unexpected matches for huge ranges")
+	}
+}
@@ -16,6 +16,25 @@ type SSMLFormatter struct {
 
 	// IndentSpaces is the number of spaces for indentation.
 	IndentSpaces int
+
+	// Bookmarks adds a mark named by SSMLBookmarkName before each
+	// segment, in the given style. See FormatWithBookmarks.
+	Bookmarks BookmarkStyle
+
+	// Voices maps languages to engine voice names, such as
+	// {"en": "en-US-JennyNeural", "es": "es-ES-ElviraNeural"} for Azure.
+	// When a segment has a voice, it is wrapped in <voice name="...">, so
+	// one document can switch voices and languages.
+	Voices map[string]string
+
+	// SpeakerVoices maps segment voice IDs to engine voice names, for
+	// scripts with several speakers in one language. They take
+	// precedence over Voices.
+	SpeakerVoices map[string]string
+
+	// Engine is the engine the output is for, checked by Lint. Voice
+	// elements are left out for Amazon Polly, which doesn't support them.
+	Engine SSMLEngine
 }
 
 // NewSSMLFormatter creates a new SSML formatter with default settings.
@@ -41,6 +60,11 @@ func (f *SSMLFormatter) Format(segments []CompiledSegment, language string) stri
 
 	currentSlide := -1
 	for _, seg := range segments {
+		// Earcons are audio files, not speech
+		if seg.IsEarcon {
+			continue
+		}
+
 		// Add slide comment when slide changes
 		if f.IncludeComments && seg.SlideIndex != currentSlide {
 			currentSlide = seg.SlideIndex
@@ -51,20 +75,42 @@ func (f *SSMLFormatter) Format(segments []CompiledSegment, language string) stri
 			}
 		}
 
+		// The whole segment, pauses included, is spoken by its voice.
+		// Polly takes the voice per request instead.
+		segIndent := indent
+		voice := f.voiceName(seg)
+		if f.Engine.isPolly() {
+			voice = ""
+		}
+		if voice != "" {
+			sb.WriteString(fmt.Sprintf(`%s<voice name="%s">`, indent, EscapeSSML(voice)))
+			sb.WriteString("\n")
+			segIndent += indent
+		}
+
 		// Add pause before
 		if seg.PauseBeforeMs > 0 {
-			sb.WriteString(fmt.Sprintf(`%s<break time="%s"/>`, indent, FormatDuration(seg.PauseBeforeMs)))
+			sb.WriteString(fmt.Sprintf(`%s<break time="%s"/>`, segIndent, FormatDuration(seg.PauseBeforeMs)))
 			sb.WriteString("\n")
 		}
 
+		// Mark the segment start after its pause, where its speech begins
+		if f.Bookmarks != BookmarkNone {
+			sb.WriteString(segIndent + f.Bookmarks.element(SSMLBookmarkName(seg)) + "\n")
+		}
+
 		// Build the segment with optional prosody/emphasis
-		f.writeSegmentContent(&sb, seg, indent)
+		f.writeSegmentContent(&sb, seg, segIndent)
 
 		// Add pause after
 		if seg.PauseAfterMs > 0 {
-			sb.WriteString(fmt.Sprintf(`%s<break time="%s"/>`, indent, FormatDuration(seg.PauseAfterMs)))
+			sb.WriteString(fmt.Sprintf(`%s<break time="%s"/>`, segIndent, FormatDuration(seg.PauseAfterMs)))
 			sb.WriteString("\n")
 		}
+
+		if voice != "" {
+			sb.WriteString(indent + "</voice>\n")
+		}
 	}
 
 	sb.WriteString("</speak>\n")
@@ -74,7 +120,7 @@ func (f *SSMLFormatter) Format(segments []CompiledSegment, language string) stri
 
 // writeSegmentContent writes the segment content with prosody/emphasis wrappers.
 func (f *SSMLFormatter) writeSegmentContent(sb *strings.Builder, seg CompiledSegment, indent string) {
-	hasProsody := seg.Rate != "" || seg.Pitch != ""
+	hasProsody := seg.Rate != "" || seg.Pitch != "" || seg.Volume != ""
 	hasEmphasis := seg.Emphasis != ""
 
 	sb.WriteString(indent)
@@ -88,6 +134,9 @@ func (f *SSMLFormatter) writeSegmentContent(sb *strings.Builder, seg CompiledSeg
 		if seg.Pitch != "" {
 			sb.WriteString(fmt.Sprintf(` pitch="%s"`, seg.Pitch))
 		}
+		if seg.Volume != "" {
+			sb.WriteString(fmt.Sprintf(` volume="%s"`, seg.Volume))
+		}
 		sb.WriteString(">")
 	}
 
@@ -97,7 +146,7 @@ func (f *SSMLFormatter) writeSegmentContent(sb *strings.Builder, seg CompiledSeg
 	}
 
 	// Write text content
-	sb.WriteString(EscapeSSML(seg.Text))
+	sb.WriteString(escapeSSMLWithIslands(AudioTagText(seg.Text, false)))
 
 	// Close emphasis tag
 	if hasEmphasis {
@@ -0,0 +1,141 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// BookmarkStyle selects the element SSMLFormatter uses to mark the start
+// of each segment. Engines report reaching a mark as an event with its
+// audio offset, which locates segments in the audio for lip-sync and
+// visual cues.
+type BookmarkStyle string
+
+// Bookmark styles.
+const (
+	// BookmarkNone adds no marks.
+	BookmarkNone BookmarkStyle = ""
+
+	// BookmarkMark adds W3C <mark name="..."/> elements, reported by
+	// Google Cloud TTS (timepoints) and Amazon Polly (ssml speech marks).
+	BookmarkMark BookmarkStyle = "mark"
+
+	// BookmarkAzure adds <bookmark mark="..."/> elements, reported by
+	// Azure TTS as BookmarkReached events alongside its word boundary and
+	// viseme events.
+	BookmarkAzure BookmarkStyle = "azure"
+)
+
+// element returns the SSML element marking name.
+func (s BookmarkStyle) element(name string) string {
+	switch s {
+	case BookmarkMark:
+		return fmt.Sprintf(`<mark name="%s"/>`, EscapeSSML(name))
+	case BookmarkAzure:
+		return fmt.Sprintf(`<bookmark mark="%s"/>`, EscapeSSML(name))
+	}
+	return ""
+}
+
+// SSMLBookmarkName returns the mark name for a segment: "slide03_seg02",
+// or "slide03_title" for a spoken title. Names follow output filenames
+// and are unique within a compiled script.
+func SSMLBookmarkName(seg CompiledSegment) string {
+	if seg.IsTitleSegment {
+		return fmt.Sprintf("slide%02d_title", seg.SlideIndex+1)
+	}
+	return fmt.Sprintf("slide%02d_seg%02d", seg.SlideIndex+1, seg.SegmentIndex+1)
+}
+
+// SSMLBookmark maps a mark in SSML output to its segment.
+type SSMLBookmark struct {
+	Mark           string `json:"mark"`
+	SlideIndex     int    `json:"slide_index"`
+	SegmentIndex   int    `json:"segment_index"`
+	ID             string `json:"id,omitempty"`
+	SlideTitle     string `json:"slide_title,omitempty"`
+	IsTitleSegment bool   `json:"is_title_segment,omitempty"`
+	Text           string `json:"text"`
+}
+
+// SSMLBookmarkMap is the mapping from marks to segments for SSML formatted
+// with bookmarks, saved next to the SSML for downstream tools.
+type SSMLBookmarkMap struct {
+	Language  string         `json:"language"`
+	Style     BookmarkStyle  `json:"style"`
+	Bookmarks []SSMLBookmark `json:"bookmarks"`
+}
+
+// NewSSMLBookmarkMap returns the bookmark map for compiled segments, in
+// the order their marks appear in the SSML.
+func NewSSMLBookmarkMap(segments []CompiledSegment, language string, style BookmarkStyle) *SSMLBookmarkMap {
+	m := &SSMLBookmarkMap{Language: language, Style: style, Bookmarks: make([]SSMLBookmark, 0, len(segments))}
+	for _, seg := range segments {
+		if seg.IsEarcon {
+			continue
+		}
+		m.Bookmarks = append(m.Bookmarks, SSMLBookmark{
+			Mark:           SSMLBookmarkName(seg),
+			SlideIndex:     seg.SlideIndex,
+			SegmentIndex:   seg.SegmentIndex,
+			ID:             seg.ID,
+			SlideTitle:     seg.SlideTitle,
+			IsTitleSegment: seg.IsTitleSegment,
+			Text:           seg.OriginalText,
+		})
+	}
+	return m
+}
+
+// Lookup returns the bookmark with a mark name, as reported by an engine's
+// bookmark event.
+func (m *SSMLBookmarkMap) Lookup(mark string) (SSMLBookmark, bool) {
+	for _, b := range m.Bookmarks {
+		if b.Mark == mark {
+			return b, true
+		}
+	}
+	return SSMLBookmark{}, false
+}
+
+// Save writes the bookmark map to a JSON file.
+func (m *SSMLBookmarkMap) Save(filePath string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling bookmark map: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("writing bookmark map: %w", err)
+	}
+	return nil
+}
+
+// LoadSSMLBookmarkMap loads a bookmark map from a JSON file.
+func LoadSSMLBookmarkMap(filePath string) (*SSMLBookmarkMap, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading bookmark map: %w", err)
+	}
+	var m SSMLBookmarkMap
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("parsing bookmark map: %w", err)
+	}
+	return &m, nil
+}
+
+// FormatWithBookmarks formats compiled segments as SSML with a mark at the
+// start of each segment, in the formatter's Bookmarks style (BookmarkAzure
+// if unset), and returns the map from marks to segments.
+//
+// Example:
+//
+//	ssml, marks := ttsscript.NewSSMLFormatter().FormatWithBookmarks(segments, "en")
+//	_ = marks.Save("narration_en.bookmarks.json")
+func (f *SSMLFormatter) FormatWithBookmarks(segments []CompiledSegment, language string) (string, *SSMLBookmarkMap) {
+	formatter := *f
+	if formatter.Bookmarks == BookmarkNone {
+		formatter.Bookmarks = BookmarkAzure
+	}
+	return formatter.Format(segments, language), NewSSMLBookmarkMap(segments, language, formatter.Bookmarks)
+}
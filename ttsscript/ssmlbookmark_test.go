@@ -0,0 +1,63 @@
+package ttsscript
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func bookmarkTestSegments() []CompiledSegment {
+	return []CompiledSegment{
+		{SlideIndex: 0, SegmentIndex: -1, IsTitleSegment: true, SlideTitle: "Intro", Text: "Intro", OriginalText: "Intro"},
+		{SlideIndex: 0, SegmentIndex: 0, ID: "welcome", SlideTitle: "Intro", Text: "Welcome.", OriginalText: "Welcome.", PauseBeforeMs: 500},
+		{SlideIndex: 1, SegmentIndex: 1, Text: "Next.", OriginalText: "Next."},
+	}
+}
+
+func TestSSMLFormatterBookmarks(t *testing.T) {
+	segments := bookmarkTestSegments()
+
+	ssml, marks := NewSSMLFormatter().FormatWithBookmarks(segments, "en")
+	for _, want := range []string{
+		`<bookmark mark="slide01_title"/>`,
+		`<break time="500ms"/>` + "\n  " + `<bookmark mark="slide01_seg01"/>`,
+		`<bookmark mark="slide02_seg02"/>`,
+	} {
+		if !strings.Contains(ssml, want) {
+			t.Errorf("SSML missing %q:\n%s", want, ssml)
+		}
+	}
+	if marks.Style != BookmarkAzure || len(marks.Bookmarks) != 3 {
+		t.Fatalf("marks = %+v", marks)
+	}
+	if b, ok := marks.Lookup("slide01_seg01"); !ok || b.ID != "welcome" || b.Text != "Welcome." {
+		t.Errorf("Lookup() = %+v, %v", b, ok)
+	}
+	if _, ok := marks.Lookup("slide09_seg01"); ok {
+		t.Error("Lookup() found an unknown mark")
+	}
+
+	formatter := NewSSMLFormatter()
+	formatter.Bookmarks = BookmarkMark
+	if ssml := formatter.Format(segments, "en"); !strings.Contains(ssml, `<mark name="slide01_title"/>`) {
+		t.Errorf("W3C marks missing:\n%s", ssml)
+	}
+	if ssml := NewSSMLFormatter().Format(segments, "en"); strings.Contains(ssml, "mark") {
+		t.Errorf("marks added without Bookmarks:\n%s", ssml)
+	}
+}
+
+func TestSSMLBookmarkMapSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bookmarks.json")
+	marks := NewSSMLBookmarkMap(bookmarkTestSegments(), "en", BookmarkAzure)
+	if err := marks.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	loaded, err := LoadSSMLBookmarkMap(path)
+	if err != nil {
+		t.Fatalf("LoadSSMLBookmarkMap() error = %v", err)
+	}
+	if loaded.Language != "en" || len(loaded.Bookmarks) != 3 || !loaded.Bookmarks[0].IsTitleSegment {
+		t.Errorf("loaded = %+v", loaded)
+	}
+}
@@ -0,0 +1,200 @@
+package ttsscript
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// SSML islands are raw SSML snippets embedded in segment text, for control
+// the structured format doesn't offer:
+//
+//	"We store it in {{ssml:<say-as interpret-as=\"characters\">SQL</say-as>}}."
+//
+// SSMLFormatter writes an island verbatim. ElevenLabsFormatter converts it
+// with SSMLIslandText. Cleaning and pronunciation rules don't apply inside
+// islands.
+
+// ssmlIslandPattern matches an SSML island and captures its markup.
+var ssmlIslandPattern = regexp.MustCompile(`(?s)\{\{ssml:(.*?)\}\}`)
+
+// protectedIslandPattern matches the islands that cleaning and
+// pronunciation rules skip: SSML islands and audio tags.
+var protectedIslandPattern = regexp.MustCompile(`(?s)\{\{(?:ssml:.*?|tag:[^{}]*)\}\}`)
+
+// ssmlIslandPlaceholder is the first rune used to stand in for islands while
+// text is cleaned and pronunciations are applied. Private use runes are not
+// word characters and are never cleaned.
+const ssmlIslandPlaceholder = '\uE000'
+
+// HasSSMLIslands reports whether text contains an SSML island.
+func HasSSMLIslands(text string) bool {
+	return ssmlIslandPattern.MatchString(text)
+}
+
+// ValidateSSMLIslands returns an error if an island in text is not
+// well-formed SSML or is not closed with "}}".
+func ValidateSSMLIslands(text string) error {
+	for _, m := range ssmlIslandPattern.FindAllStringSubmatch(text, -1) {
+		if _, err := parseSSMLIsland(m[1]); err != nil {
+			return fmt.Errorf("invalid SSML island %q: %w", m[0], err)
+		}
+	}
+	if strings.Contains(ssmlIslandPattern.ReplaceAllString(text, ""), "{{ssml:") {
+		return fmt.Errorf("unterminated SSML island")
+	}
+	return nil
+}
+
+// protectSSMLIslands replaces each island, including audio tags, with a
+// placeholder rune and returns the islands.
+func protectSSMLIslands(text string) (string, []string) {
+	var islands []string
+	protected := protectedIslandPattern.ReplaceAllStringFunc(text, func(island string) string {
+		islands = append(islands, island)
+		return string(ssmlIslandPlaceholder + rune(len(islands)-1))
+	})
+	return protected, islands
+}
+
+// withSSMLIslandsProtected applies fn to text with islands protected.
+func withSSMLIslandsProtected(text string, fn func(string) string) string {
+	protected, islands := protectSSMLIslands(text)
+	result := fn(protected)
+	if len(islands) == 0 {
+		return result
+	}
+	var sb strings.Builder
+	for _, r := range result {
+		if i := int(r - ssmlIslandPlaceholder); i >= 0 && i < len(islands) {
+			sb.WriteString(islands[i])
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// escapeSSMLWithIslands escapes text for SSML, writing islands verbatim.
+func escapeSSMLWithIslands(text string) string {
+	var sb strings.Builder
+	last := 0
+	for _, loc := range ssmlIslandPattern.FindAllStringSubmatchIndex(text, -1) {
+		sb.WriteString(EscapeSSML(text[last:loc[0]]))
+		sb.WriteString(text[loc[2]:loc[3]])
+		last = loc[1]
+	}
+	sb.WriteString(EscapeSSML(text[last:]))
+	return sb.String()
+}
+
+// SSMLIslandText converts the SSML islands in text for ElevenLabs, which
+// reads most SSML tags aloud. <break> and <phoneme> tags are kept, since
+// ElevenLabs supports them; <sub> becomes its alias; <say-as
+// interpret-as="characters"> spells out its text; other tags are removed
+// and their text kept. Islands that are not well-formed have their tags
+// removed.
+func SSMLIslandText(text string) string {
+	return ssmlIslandPattern.ReplaceAllStringFunc(text, func(island string) string {
+		markup := ssmlIslandPattern.FindStringSubmatch(island)[1]
+		converted, err := parseSSMLIsland(markup)
+		if err != nil {
+			return xmlTagPattern.ReplaceAllString(markup, "")
+		}
+		return converted
+	})
+}
+
+// xmlTagPattern matches an XML tag.
+var xmlTagPattern = regexp.MustCompile(`<[^>]*>`)
+
+// parseSSMLIsland converts island markup to ElevenLabs text, returning an
+// error if it is not well-formed.
+func parseSSMLIsland(markup string) (string, error) {
+	dec := xml.NewDecoder(strings.NewReader("<island>" + markup + "</island>"))
+	var sb strings.Builder
+	// Text inside <sub> is replaced by its alias, and inside
+	// <say-as interpret-as="characters"> is spelled out
+	var subDepth, spellDepth, depth int
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			switch t.Name.Local {
+			case "break":
+				sb.WriteString(ssmlStartTag(t) + " />")
+			case "phoneme":
+				sb.WriteString(ssmlStartTag(t) + ">")
+			case "sub":
+				sb.WriteString(xmlAttr(t, "alias"))
+				subDepth = depth
+			case "say-as":
+				if xmlAttr(t, "interpret-as") == "characters" || xmlAttr(t, "interpret-as") == "spell-out" {
+					spellDepth = depth
+				}
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "phoneme":
+				sb.WriteString("</phoneme>")
+			}
+			if depth == subDepth {
+				subDepth = 0
+			}
+			if depth == spellDepth {
+				spellDepth = 0
+			}
+			depth--
+		case xml.CharData:
+			switch {
+			case subDepth > 0:
+			case spellDepth > 0:
+				sb.WriteString(spellCharacters(string(t)))
+			default:
+				sb.WriteString(string(t))
+			}
+		}
+	}
+	return sb.String(), nil
+}
+
+// ssmlStartTag returns an element's start tag without the closing ">".
+func ssmlStartTag(el xml.StartElement) string {
+	var sb strings.Builder
+	sb.WriteString("<" + el.Name.Local)
+	for _, a := range el.Attr {
+		sb.WriteString(fmt.Sprintf(` %s="%s"`, a.Name.Local, EscapeSSML(a.Value)))
+	}
+	return sb.String()
+}
+
+// xmlAttr returns the value of an element's attribute.
+func xmlAttr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// spellCharacters separates every character with a space, so "SQL" is
+// read "S Q L".
+func spellCharacters(s string) string {
+	var chars []string
+	for _, r := range s {
+		if r != ' ' && r != '\t' && r != '\n' {
+			chars = append(chars, string(r))
+		}
+	}
+	return strings.Join(chars, " ")
+}
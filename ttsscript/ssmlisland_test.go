@@ -0,0 +1,82 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSSMLIslandText(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"No islands here.", "No islands here."},
+		{`We use {{ssml:<say-as interpret-as="characters">SQL</say-as>}} daily.`, "We use S Q L daily."},
+		{`Call {{ssml:<sub alias="World Wide Web">WWW</sub>}} now.`, "Call World Wide Web now."},
+		{`Wait{{ssml:<break time="500ms"/>}} then go.`, `Wait<break time="500ms" /> then go.`},
+		{`Say {{ssml:<phoneme alphabet="ipa" ph="təˈmɑːtoʊ">tomato</phoneme>}}.`, `Say <phoneme alphabet="ipa" ph="təˈmɑːtoʊ">tomato</phoneme>.`},
+		{`A {{ssml:<emphasis level="strong">big</emphasis>}} deal.`, "A big deal."},
+		{`Broken {{ssml:<say-as>oops}} island.`, "Broken oops island."},
+	}
+	for _, tt := range tests {
+		if got := SSMLIslandText(tt.text); got != tt.want {
+			t.Errorf("SSMLIslandText(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestValidateSSMLIslands(t *testing.T) {
+	if err := ValidateSSMLIslands(`Fine {{ssml:<break time="1s"/>}}.`); err != nil {
+		t.Errorf("valid island: %v", err)
+	}
+	if err := ValidateSSMLIslands(`Bad {{ssml:<say-as>SQL}}.`); err == nil {
+		t.Error("expected error for unclosed tag")
+	}
+	if err := ValidateSSMLIslands(`Open {{ssml:<break/> forever.`); err == nil {
+		t.Error("expected error for unterminated island")
+	}
+}
+
+func TestSSMLIslandFormatters(t *testing.T) {
+	script := &Script{
+		Pronunciations: map[string]map[string]string{"SQL": {"en": "sequel"}},
+		Slides: []Slide{{
+			DefaultVoice: map[string]string{"en": "voice1"},
+			Segments: []Segment{{Text: map[string]string{
+				"en": `SQL & {{ssml:<say-as interpret-as="characters">SQL</say-as>}} **now**.`,
+			}}},
+		}},
+	}
+	compiler := NewCompiler()
+	compiler.StripInaudible = true
+	segments, err := compiler.Compile(script, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Cleaning and pronunciations don't apply inside the island
+	want := `sequel & {{ssml:<say-as interpret-as="characters">SQL</say-as>}} now.`
+	if segments[0].Text != want {
+		t.Fatalf("compiled text = %q, want %q", segments[0].Text, want)
+	}
+
+	ssml := NewSSMLFormatter().Format(segments, "en")
+	if !strings.Contains(ssml, `sequel &amp; <say-as interpret-as="characters">SQL</say-as> now.`) {
+		t.Errorf("SSML should pass the island through verbatim:\n%s", ssml)
+	}
+
+	el := NewElevenLabsFormatter().Format(segments)
+	if el[0].Text != "sequel & S Q L now." {
+		t.Errorf("ElevenLabs text = %q", el[0].Text)
+	}
+}
+
+func TestScriptValidateSSMLIslands(t *testing.T) {
+	script := &Script{Slides: []Slide{{Segments: []Segment{{
+		Text: map[string]string{"en": "Bad {{ssml:<say-as>SQL}}."},
+	}}}}}
+	issues := script.Validate()
+	if len(issues) != 1 || !strings.Contains(issues[0], "invalid SSML island") {
+		t.Errorf("Validate() = %v", issues)
+	}
+}
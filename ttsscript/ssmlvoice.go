@@ -0,0 +1,169 @@
+package ttsscript
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SSMLEngine is the engine SSMLFormatter output is checked against by
+// SSMLFormatter.Lint. Engines accept different prosody values and
+// elements; generic SSML is not checked.
+type SSMLEngine string
+
+// SSML engines.
+const (
+	// SSMLEngineGeneric is W3C SSML with no engine-specific checks.
+	SSMLEngineGeneric SSMLEngine = ""
+
+	// SSMLEnginePollyStandard is Amazon Polly with standard voices.
+	SSMLEnginePollyStandard SSMLEngine = "polly-standard"
+
+	// SSMLEnginePollyNeural is Amazon Polly with neural voices, which
+	// don't support prosody pitch or emphasis.
+	SSMLEnginePollyNeural SSMLEngine = "polly-neural"
+
+	// SSMLEngineAzure is Azure TTS, which needs every segment in a
+	// <voice> element.
+	SSMLEngineAzure SSMLEngine = "azure"
+)
+
+// isPolly reports whether the engine is Amazon Polly.
+func (e SSMLEngine) isPolly() bool {
+	return e == SSMLEnginePollyStandard || e == SSMLEnginePollyNeural
+}
+
+// voiceName returns the engine voice for a segment: its voice in
+// SpeakerVoices, then the voice for its language in Voices, then for the
+// language's base ("en" for "en-US"). Empty if none is mapped.
+func (f *SSMLFormatter) voiceName(seg CompiledSegment) string {
+	if name := f.SpeakerVoices[seg.VoiceID]; name != "" {
+		return name
+	}
+	lang := firstNonEmpty(seg.FallbackLanguage, seg.Language)
+	if name := f.Voices[lang]; name != "" {
+		return name
+	}
+	base, _, _ := strings.Cut(lang, "-")
+	return f.Voices[base]
+}
+
+// Lint warns about SSML the formatter's Engine may reject or ignore:
+// prosody values outside the engine's ranges, features its voices don't
+// support, and segments missing a voice the engine needs.
+func (f *SSMLFormatter) Lint(segments []CompiledSegment) []string {
+	var warnings []string
+	engine := f.Engine
+	if engine.isPolly() && (len(f.Voices) > 0 || len(f.SpeakerVoices) > 0) {
+		warnings = append(warnings, "Amazon Polly doesn't support <voice>, so voices are left out; generate each voice in a separate request")
+	}
+	for _, seg := range segments {
+		if seg.IsEarcon {
+			continue
+		}
+		where := fmt.Sprintf("slide %d, %s", seg.SlideIndex+1, segmentLabel(seg.SegmentIndex))
+		if engine == SSMLEngineAzure && f.voiceName(seg) == "" {
+			warnings = append(warnings, fmt.Sprintf("%s: no voice for language %q; Azure needs one for every segment", where, firstNonEmpty(seg.FallbackLanguage, seg.Language)))
+		}
+		for _, p := range []struct{ attr, value string }{{"rate", seg.Rate}, {"pitch", seg.Pitch}, {"volume", seg.Volume}} {
+			if p.value == "" {
+				continue
+			}
+			if problem := checkProsody(engine, p.attr, p.value); problem != "" {
+				warnings = append(warnings, fmt.Sprintf("%s: %s %q %s", where, p.attr, p.value, problem))
+			}
+		}
+		if engine == SSMLEnginePollyNeural && seg.Emphasis != "" {
+			warnings = append(warnings, where+": emphasis isn't supported by Polly neural voices")
+		}
+	}
+	return warnings
+}
+
+// prosodyValue matches a numeric prosody value: an optional sign, a
+// number, and an optional unit.
+var prosodyValue = regexp.MustCompile(`^([+-]?)(\d+(?:\.\d+)?)(%|dB|Hz|st)?$`)
+
+// prosodyKeywords are the keyword values of each prosody attribute.
+var prosodyKeywords = map[string][]string{
+	"rate":   {"x-slow", "slow", "medium", "fast", "x-fast", "default"},
+	"pitch":  {"x-low", "low", "medium", "high", "x-high", "default"},
+	"volume": {"silent", "x-soft", "soft", "medium", "loud", "x-loud", "default"},
+}
+
+// checkProsody returns why an engine doesn't accept a prosody value, or an
+// empty string if it does or the engine isn't checked. The ranges are
+// from the Amazon Polly and Azure SSML references.
+func checkProsody(engine SSMLEngine, attr, value string) string {
+	if engine == SSMLEngineGeneric {
+		return ""
+	}
+	if engine == SSMLEnginePollyNeural && attr == "pitch" {
+		return "isn't supported by Polly neural voices"
+	}
+	for _, keyword := range prosodyKeywords[attr] {
+		if value == keyword {
+			return ""
+		}
+	}
+	m := prosodyValue.FindStringSubmatch(value)
+	if m == nil {
+		return "is not a valid value"
+	}
+	sign, unit := m[1], m[3]
+	n, _ := strconv.ParseFloat(m[2], 64)
+	if sign == "-" {
+		n = -n
+	}
+	inRange := func(lo, hi float64) string {
+		if n < lo || n > hi {
+			return fmt.Sprintf("is outside the allowed range %s to %s", formatProsody(lo, unit, sign != ""), formatProsody(hi, unit, sign != ""))
+		}
+		return ""
+	}
+
+	if engine.isPolly() {
+		switch {
+		case attr == "rate" && unit == "%" && sign == "":
+			return inRange(20, 200)
+		case attr == "pitch" && unit == "%" && sign != "":
+			return inRange(-33.3, 50)
+		case attr == "volume" && unit == "dB" && sign != "":
+			if n > 6 {
+				return "is above Polly's maximum of +6dB"
+			}
+			return ""
+		}
+		return "is not a form Polly accepts"
+	}
+
+	// Azure
+	switch {
+	case attr == "rate" && unit == "%" && sign == "":
+		return fmt.Sprintf("is relative in Azure; use %s", formatProsody(n-100, "%", true))
+	case attr == "rate" && unit == "%":
+		return inRange(-50, 100)
+	case attr == "rate" && unit == "" && sign == "":
+		return inRange(0.5, 2)
+	case attr == "pitch" && unit == "%" && sign != "":
+		return inRange(-50, 50)
+	case attr == "pitch" && (unit == "Hz" || unit == "st"):
+		return ""
+	case attr == "volume" && unit == "" && sign == "":
+		return inRange(0, 100)
+	case attr == "volume" && (unit == "%" || unit == "") && sign != "":
+		return ""
+	}
+	return "is not a form Azure accepts"
+}
+
+// formatProsody formats a prosody number with its unit, signed if signed
+// is set.
+func formatProsody(n float64, unit string, signed bool) string {
+	s := strconv.FormatFloat(n, 'f', -1, 64)
+	if signed && n >= 0 {
+		s = "+" + s
+	}
+	return s + unit
+}
@@ -0,0 +1,88 @@
+package ttsscript
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSSMLFormatterVoices(t *testing.T) {
+	segments := []CompiledSegment{
+		{SlideIndex: 0, SegmentIndex: 0, Text: "Hello.", Language: "en-US", VoiceID: "narrator", PauseAfterMs: 300},
+		{SlideIndex: 0, SegmentIndex: 1, Text: "Hi!", Language: "en-US", VoiceID: "guest"},
+		{SlideIndex: 1, SegmentIndex: 0, Text: "Hola.", Language: "es", FallbackLanguage: "es"},
+		{SlideIndex: 1, SegmentIndex: 1, Text: "Bonjour.", Language: "fr"},
+	}
+	formatter := NewSSMLFormatter()
+	formatter.Voices = map[string]string{"en": "en-US-JennyNeural", "es": "es-ES-ElviraNeural"}
+	formatter.SpeakerVoices = map[string]string{"guest": "en-US-GuyNeural"}
+
+	ssml := formatter.Format(segments, "en-US")
+	for _, want := range []string{
+		"  <voice name=\"en-US-JennyNeural\">\n    Hello.\n    <break time=\"300ms\"/>\n  </voice>",
+		"<voice name=\"en-US-GuyNeural\">\n    Hi!\n",
+		"<voice name=\"es-ES-ElviraNeural\">\n    Hola.\n",
+		"  Bonjour.\n",
+	} {
+		if !strings.Contains(ssml, want) {
+			t.Errorf("SSML missing %q:\n%s", want, ssml)
+		}
+	}
+
+	formatter.Engine = SSMLEngineAzure
+	warnings := formatter.Lint(segments)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], `slide 2, segment 2: no voice for language "fr"`) {
+		t.Errorf("Lint() = %v", warnings)
+	}
+
+	formatter.Engine = SSMLEnginePollyNeural
+	if ssml := formatter.Format(segments, "en-US"); strings.Contains(ssml, "<voice") {
+		t.Errorf("Polly SSML has voice elements:\n%s", ssml)
+	}
+	if warnings := formatter.Lint(segments); len(warnings) != 1 || !strings.Contains(warnings[0], "doesn't support <voice>") {
+		t.Errorf("Lint() = %v", warnings)
+	}
+}
+
+func TestSSMLFormatterLintProsody(t *testing.T) {
+	tests := []struct {
+		engine SSMLEngine
+		seg    CompiledSegment
+		want   []string
+	}{
+		{SSMLEngineGeneric, CompiledSegment{Rate: "500%", Pitch: "wobbly"}, nil},
+		{SSMLEnginePollyStandard, CompiledSegment{Rate: "95%", Pitch: "+10%", Volume: "+3dB"}, nil},
+		{SSMLEnginePollyStandard, CompiledSegment{Rate: "250%", Pitch: "-40%", Volume: "+10dB"}, []string{
+			`rate "250%" is outside the allowed range 20% to 200%`,
+			`pitch "-40%" is outside the allowed range -33.3% to +50%`,
+			`volume "+10dB" is above Polly's maximum of +6dB`,
+		}},
+		{SSMLEnginePollyNeural, CompiledSegment{Rate: "slow", Pitch: "high", Emphasis: "strong"}, []string{
+			`pitch "high" isn't supported by Polly neural voices`,
+			"emphasis isn't supported by Polly neural voices",
+		}},
+		{SSMLEngineAzure, CompiledSegment{Rate: "-5%", Pitch: "+2st", Volume: "80"}, nil},
+		{SSMLEngineAzure, CompiledSegment{Rate: "95%", Pitch: "+60%", Volume: "+3dB"}, []string{
+			`rate "95%" is relative in Azure; use -5%`,
+			`pitch "+60%" is outside the allowed range -50% to +50%`,
+			`volume "+3dB" is not a form Azure accepts`,
+		}},
+	}
+	for _, tt := range tests {
+		formatter := NewSSMLFormatter()
+		formatter.Engine = tt.engine
+		if tt.engine == SSMLEngineAzure {
+			formatter.Voices = map[string]string{"en": "en-US-JennyNeural"}
+		}
+		tt.seg.Language = "en"
+		warnings := formatter.Lint([]CompiledSegment{tt.seg})
+		if len(warnings) != len(tt.want) {
+			t.Errorf("%s %+v: Lint() = %v, want %d warnings", tt.engine, tt.seg, warnings, len(tt.want))
+			continue
+		}
+		for i, want := range tt.want {
+			if !strings.HasSuffix(warnings[i], want) {
+				t.Errorf("%s: warning %q, want suffix %q", tt.engine, warnings[i], want)
+			}
+		}
+	}
+}
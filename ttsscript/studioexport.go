@@ -0,0 +1,183 @@
+package ttsscript
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// StudioExportMetadataFile is the name of the metadata file in a Studio
+// export.
+const StudioExportMetadataFile = "metadata.json"
+
+// StudioExportOptions configures StudioExport.
+type StudioExportOptions struct {
+	// Title is the project title.
+	Title string
+
+	// Language is the language the chapters were compiled for.
+	Language string
+}
+
+// StudioExportFile is a file in a Studio export.
+type StudioExportFile struct {
+	// Name is the slash-separated path within the export.
+	Name string
+
+	// Data is the file content.
+	Data []byte
+}
+
+// StudioExportMetadata describes a Studio export. It is written to
+// StudioExportMetadataFile so voices can be assigned after import.
+type StudioExportMetadata struct {
+	Title    string                `json:"title,omitempty"`
+	Language string                `json:"language,omitempty"`
+	Voices   []string              `json:"voices"`
+	Chapters []StudioExportChapter `json:"chapters"`
+}
+
+// StudioExportChapter describes one chapter document in a Studio export.
+type StudioExportChapter struct {
+	Index  int                     `json:"index"`
+	ID     string                  `json:"id"`
+	Title  string                  `json:"title"`
+	Kind   ChapterKind             `json:"kind,omitempty"`
+	Part   string                  `json:"part,omitempty"`
+	File   string                  `json:"file"`
+	Blocks []StudioExportBlockHint `json:"blocks"`
+}
+
+// StudioExportBlockHint is the voice for one heading or paragraph of a
+// chapter document, in document order.
+type StudioExportBlockHint struct {
+	SubType string `json:"sub_type"`
+	VoiceID string `json:"voice_id"`
+}
+
+// StudioExport builds a folder layout that can be imported into ElevenLabs
+// Studio in the UI: one HTML document per chapter under chapters/, with
+// headings as <h1>/<h2> and each segment as a paragraph, plus
+// StudioExportMetadataFile listing chapters and the voice of each block.
+// Each block also carries its voice in a data-voice-id attribute. It is an
+// alternative to creating the project through the API with
+// StudioContentJSON, for teams that finalize audio in the Studio editor.
+func StudioExport(chapters []CompiledChapter, opts StudioExportOptions) ([]StudioExportFile, error) {
+	meta := StudioExportMetadata{
+		Title:    opts.Title,
+		Language: opts.Language,
+		Voices:   []string{},
+		Chapters: make([]StudioExportChapter, 0, len(chapters)),
+	}
+	seenVoices := make(map[string]bool)
+	files := make([]StudioExportFile, 0, len(chapters)+1)
+
+	for i, sc := range StudioContent(chapters) {
+		ch := chapters[i]
+		name := fmt.Sprintf("chapters/%02d-%s.html", ch.Index+1, studioExportSlug(firstNonEmpty(ch.ID, sc.Name)))
+		mc := StudioExportChapter{
+			Index:  ch.Index,
+			ID:     ch.ID,
+			Title:  sc.Name,
+			Kind:   ch.Kind,
+			Part:   ch.Part,
+			File:   name,
+			Blocks: make([]StudioExportBlockHint, 0, len(sc.Blocks)),
+		}
+
+		var sb strings.Builder
+		sb.WriteString("<!DOCTYPE html>\n<html")
+		if opts.Language != "" {
+			fmt.Fprintf(&sb, ` lang="%s"`, html.EscapeString(opts.Language))
+		}
+		fmt.Fprintf(&sb, ">\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n", html.EscapeString(sc.Name))
+		for _, block := range sc.Blocks {
+			for _, node := range block.Nodes {
+				fmt.Fprintf(&sb, "<%s data-voice-id=\"%s\">%s</%s>\n",
+					block.SubType, html.EscapeString(node.VoiceID), html.EscapeString(node.Text), block.SubType)
+				mc.Blocks = append(mc.Blocks, StudioExportBlockHint{SubType: block.SubType, VoiceID: node.VoiceID})
+				if node.VoiceID != "" && !seenVoices[node.VoiceID] {
+					seenVoices[node.VoiceID] = true
+					meta.Voices = append(meta.Voices, node.VoiceID)
+				}
+			}
+		}
+		sb.WriteString("</body>\n</html>\n")
+
+		files = append(files, StudioExportFile{Name: name, Data: []byte(sb.String())})
+		meta.Chapters = append(meta.Chapters, mc)
+	}
+
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling studio export metadata: %w", err)
+	}
+	files = append([]StudioExportFile{{Name: StudioExportMetadataFile, Data: append(data, '\n')}}, files...)
+	return files, nil
+}
+
+// WriteStudioExportZip writes the StudioExport files as a zip archive.
+func WriteStudioExportZip(w io.Writer, chapters []CompiledChapter, opts StudioExportOptions) error {
+	files, err := StudioExport(chapters, opts)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(f.Name)
+		if err != nil {
+			return fmt.Errorf("adding %s to zip: %w", f.Name, err)
+		}
+		if _, err := fw.Write(f.Data); err != nil {
+			return fmt.Errorf("writing %s to zip: %w", f.Name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("closing zip: %w", err)
+	}
+	return nil
+}
+
+// WriteStudioExportDir writes the StudioExport files under dir.
+func WriteStudioExportDir(dir string, chapters []CompiledChapter, opts StudioExportOptions) error {
+	files, err := StudioExport(chapters, opts)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return fmt.Errorf("creating directory: %w", err)
+		}
+		if err := os.WriteFile(path, f.Data, 0600); err != nil {
+			return fmt.Errorf("writing %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// studioExportSlug makes a chapter ID safe for use in a file name.
+func studioExportSlug(s string) string {
+	var sb strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			sb.WriteRune(r)
+			dash = false
+		} else if !dash && sb.Len() > 0 {
+			sb.WriteByte('-')
+			dash = true
+		}
+	}
+	slug := strings.TrimSuffix(sb.String(), "-")
+	if slug == "" {
+		return "chapter"
+	}
+	return slug
+}
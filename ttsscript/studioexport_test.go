@@ -0,0 +1,105 @@
+package ttsscript
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func compileTestBook(t *testing.T) []CompiledChapter {
+	t.Helper()
+	book, err := ParseBook([]byte(testBookJSON))
+	if err != nil {
+		t.Fatalf("ParseBook failed: %v", err)
+	}
+	chapters, err := NewCompiler().CompileBook(book, "en")
+	if err != nil {
+		t.Fatalf("CompileBook failed: %v", err)
+	}
+	return chapters
+}
+
+func TestStudioExport(t *testing.T) {
+	files, err := StudioExport(compileTestBook(t), StudioExportOptions{Title: "The Book", Language: "en"})
+	if err != nil {
+		t.Fatalf("StudioExport failed: %v", err)
+	}
+	if len(files) != 5 || files[0].Name != StudioExportMetadataFile || files[2].Name != "chapters/02-ch02.html" {
+		t.Fatalf("unexpected files: %v", files)
+	}
+
+	doc := string(files[2].Data)
+	for _, want := range []string{
+		`<html lang="en">`,
+		`<h1 data-voice-id="announcer">Beginnings</h1>`,
+		`<p data-voice-id="narrator">It began.</p>`,
+		`<h2 data-voice-id="narrator">Later</h2>`,
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("chapter document missing %q:\n%s", want, doc)
+		}
+	}
+
+	var meta StudioExportMetadata
+	if err := json.Unmarshal(files[0].Data, &meta); err != nil {
+		t.Fatalf("invalid metadata: %v", err)
+	}
+	if meta.Title != "The Book" || len(meta.Chapters) != 4 || meta.Chapters[1].File != files[2].Name {
+		t.Errorf("unexpected metadata: %+v", meta)
+	}
+	if len(meta.Chapters[1].Blocks) != 6 || meta.Chapters[1].Blocks[1].VoiceID != "announcer" {
+		t.Errorf("unexpected block hints: %+v", meta.Chapters[1].Blocks)
+	}
+	if strings.Join(meta.Voices, ",") != "narrator,announcer" {
+		t.Errorf("voices = %v", meta.Voices)
+	}
+}
+
+func TestWriteStudioExport(t *testing.T) {
+	chapters := compileTestBook(t)
+
+	var buf bytes.Buffer
+	if err := WriteStudioExportZip(&buf, chapters, StudioExportOptions{}); err != nil {
+		t.Fatalf("WriteStudioExportZip failed: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("invalid zip: %v", err)
+	}
+	if len(zr.File) != 5 || zr.File[4].Name != "chapters/04-credits.html" {
+		t.Fatalf("unexpected zip entries: %d", len(zr.File))
+	}
+	rc, _ := zr.File[4].Open()
+	data, _ := io.ReadAll(rc)
+	_ = rc.Close()
+	if !strings.Contains(string(data), "Thanks.") {
+		t.Errorf("unexpected credits document:\n%s", data)
+	}
+
+	dir := t.TempDir()
+	if err := WriteStudioExportDir(dir, chapters, StudioExportOptions{}); err != nil {
+		t.Fatalf("WriteStudioExportDir failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "chapters", "01-preface.html")); err != nil {
+		t.Errorf("chapter file not written: %v", err)
+	}
+}
+
+func TestStudioExportSlug(t *testing.T) {
+	tests := map[string]string{
+		"ch01":          "ch01",
+		"The End!":      "the-end",
+		"  --Épilogue ": "épilogue",
+		"???":           "chapter",
+	}
+	for in, want := range tests {
+		if got := studioExportSlug(in); got != want {
+			t.Errorf("studioExportSlug(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
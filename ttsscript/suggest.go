@@ -0,0 +1,309 @@
+package ttsscript
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Kinds of pronunciation suggestions, from most to least likely to be
+// mispronounced.
+const (
+	// SuggestionInitialism is an all-caps term read letter by letter
+	// (e.g., "API", "HTTP2").
+	SuggestionInitialism = "initialism"
+
+	// SuggestionAcronym is an all-caps term that may be read as a word
+	// (e.g., "NASA"). TTS engines guess either way.
+	SuggestionAcronym = "acronym"
+
+	// SuggestionMixedCase is a brand or identifier with internal capitals
+	// (e.g., "GitHub", "iOS").
+	SuggestionMixedCase = "mixed_case"
+
+	// SuggestionProperNoun is a capitalized word used mid-sentence that never
+	// appears in lower case, likely a name.
+	SuggestionProperNoun = "proper_noun"
+)
+
+// suggestionWeights rank kinds by how often they are mispronounced.
+var suggestionWeights = map[string]int{
+	SuggestionInitialism: 4,
+	SuggestionAcronym:    3,
+	SuggestionMixedCase:  2,
+	SuggestionProperNoun: 1,
+}
+
+// maxSuggestionLocations limits the locations recorded per suggestion.
+const maxSuggestionLocations = 5
+
+// PronunciationSuggestion is a term that is not covered by a pronunciation
+// rule and may be mispronounced.
+type PronunciationSuggestion struct {
+	// Term is the text as it appears in the script.
+	Term string `json:"term"`
+
+	// Kind is SuggestionInitialism, SuggestionAcronym, SuggestionMixedCase,
+	// or SuggestionProperNoun.
+	Kind string `json:"kind"`
+
+	// Count is the number of occurrences.
+	Count int `json:"count"`
+
+	// Score ranks suggestions: the kind's weight times the count.
+	Score int `json:"score"`
+
+	// Suggestion is a suggested replacement (e.g., spelled-out letters), or
+	// empty when only the author can know (proper nouns).
+	Suggestion string `json:"suggestion,omitempty"`
+
+	// Locations are the first occurrences of the term.
+	Locations []SuggestionLocation `json:"locations"`
+}
+
+// SuggestionLocation identifies a segment containing a suggested term.
+type SuggestionLocation struct {
+	SlideIndex int `json:"slide_index"`
+
+	// SegmentIndex is -1 for slide titles.
+	SegmentIndex int `json:"segment_index"`
+}
+
+var (
+	suggestWordPattern  = regexp.MustCompile(`[\p{L}\p{N}]+`)
+	sentenceEndPattern  = regexp.MustCompile(`[.!?:;]["'”’)\]]*\s*$`)
+	leadingQuotePattern = regexp.MustCompile(`^\s*["'“‘(\[]*\s*$`)
+)
+
+// SuggestPronunciations scans the script text for a language and returns
+// likely acronyms, initialisms, brand names, and proper nouns that no
+// pronunciation rule covers, highest score first. Terms covered by a script
+// or segment pronunciation (case-insensitive, as the compiler matches) are
+// skipped.
+func SuggestPronunciations(script *Script, language string) []PronunciationSuggestion {
+	type occurrence struct {
+		text string
+		loc  SuggestionLocation
+	}
+	var texts []occurrence
+	covered := make(map[string]bool)
+	addCovered := func(prons map[string]map[string]string) {
+		for term, langMap := range prons {
+			if _, ok := langMap[language]; ok {
+				covered[strings.ToLower(term)] = true
+			}
+		}
+	}
+	addCovered(script.Pronunciations)
+
+	for slideIdx, slide := range script.Slides {
+		if slide.ShouldSpeakTitle() {
+			if title := slide.SpokenTitle(language); title != "" {
+				texts = append(texts, occurrence{title, SuggestionLocation{slideIdx, -1}})
+			}
+		}
+		for segIdx, seg := range slide.Segments {
+			addCovered(seg.Pronunciations)
+			if text := seg.Text[language]; text != "" {
+				texts = append(texts, occurrence{text, SuggestionLocation{slideIdx, segIdx}})
+			}
+		}
+	}
+
+	// Words that appear in lower case anywhere are ordinary words, not names.
+	lowercase := make(map[string]bool)
+	for _, occ := range texts {
+		for _, word := range suggestWordPattern.FindAllString(occ.text, -1) {
+			if word == strings.ToLower(word) {
+				lowercase[word] = true
+			}
+		}
+	}
+
+	byTerm := make(map[string]*PronunciationSuggestion)
+	var order []string
+	for _, occ := range texts {
+		for _, idx := range suggestWordPattern.FindAllStringIndex(occ.text, -1) {
+			word := occ.text[idx[0]:idx[1]]
+			if covered[strings.ToLower(word)] {
+				continue
+			}
+			kind := classifyTerm(word)
+			if kind == SuggestionProperNoun && (startsSentence(occ.text[:idx[0]]) || lowercase[strings.ToLower(word)]) {
+				continue
+			}
+			if kind == "" {
+				continue
+			}
+			s, ok := byTerm[word]
+			if !ok {
+				s = &PronunciationSuggestion{Term: word, Kind: kind, Suggestion: suggestReplacement(word, kind)}
+				byTerm[word] = s
+				order = append(order, word)
+			}
+			s.Count++
+			if len(s.Locations) < maxSuggestionLocations && !containsLocation(s.Locations, occ.loc) {
+				s.Locations = append(s.Locations, occ.loc)
+			}
+		}
+	}
+
+	suggestions := make([]PronunciationSuggestion, 0, len(order))
+	for _, term := range order {
+		s := byTerm[term]
+		s.Score = suggestionWeights[s.Kind] * s.Count
+		suggestions = append(suggestions, *s)
+	}
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].Term < suggestions[j].Term
+	})
+	return suggestions
+}
+
+// SuggestedPronunciations converts suggestions with a replacement into
+// pronunciation entries (term → language → replacement) that can be merged
+// into Script.Pronunciations.
+func SuggestedPronunciations(suggestions []PronunciationSuggestion, language string) map[string]map[string]string {
+	prons := make(map[string]map[string]string)
+	for _, s := range suggestions {
+		if s.Suggestion != "" {
+			prons[s.Term] = map[string]string{language: s.Suggestion}
+		}
+	}
+	return prons
+}
+
+// classifyTerm returns the suggestion kind for a word, or "" for ordinary
+// words and numbers.
+func classifyTerm(word string) string {
+	runes := []rune(word)
+	var upper, lower, letters, digits int
+	internalUpper := false
+	for i, r := range runes {
+		switch {
+		case unicode.IsUpper(r):
+			upper++
+			letters++
+			if i > 0 && unicode.IsLower(runes[i-1]) {
+				internalUpper = true
+			}
+		case unicode.IsLower(r):
+			lower++
+			letters++
+		case unicode.IsDigit(r):
+			digits++
+		}
+	}
+
+	switch {
+	case letters == 0:
+		return ""
+	case lower == 0 && (upper >= 2 || (upper == 1 && digits > 0)):
+		if upper >= 4 && digits == 0 && pronounceable(word) {
+			return SuggestionAcronym
+		}
+		return SuggestionInitialism
+	case upper > 0 && lower > 0 && (internalUpper || !unicode.IsUpper(runes[0]) || upper >= 2):
+		return SuggestionMixedCase
+	case unicode.IsUpper(runes[0]) && upper == 1 && len(runes) >= 3 && digits == 0:
+		return SuggestionProperNoun
+	default:
+		return ""
+	}
+}
+
+// pronounceable reports whether an all-caps term alternates vowels and
+// consonants enough to be read as a word (e.g., "NASA", "SCUBA").
+func pronounceable(word string) bool {
+	vowels, run, maxRun := 0, 0, 0
+	for _, r := range strings.ToUpper(word) {
+		if strings.ContainsRune("AEIOUY", r) {
+			vowels++
+			run = 0
+			continue
+		}
+		run++
+		if run > maxRun {
+			maxRun = run
+		}
+	}
+	return vowels > 0 && maxRun <= 2
+}
+
+// suggestReplacement suggests a spoken form for a term.
+func suggestReplacement(word, kind string) string {
+	switch kind {
+	case SuggestionInitialism, SuggestionAcronym:
+		return spellOut(word)
+	case SuggestionMixedCase:
+		return splitMixedCase(word)
+	default:
+		return ""
+	}
+}
+
+// spellOut separates letters and digit groups with spaces ("HTTP2" → "H T T P 2").
+// A trailing lower-case plural "s" stays attached ("APIs" → "A P Is").
+func spellOut(word string) string {
+	var parts []string
+	runes := []rune(word)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if unicode.IsDigit(r) {
+			j := i
+			for j < len(runes) && unicode.IsDigit(runes[j]) {
+				j++
+			}
+			parts = append(parts, string(runes[i:j]))
+			i = j - 1
+			continue
+		}
+		if unicode.IsLower(r) && len(parts) > 0 {
+			parts[len(parts)-1] += string(r)
+			continue
+		}
+		parts = append(parts, string(r))
+	}
+	return strings.Join(parts, " ")
+}
+
+// splitMixedCase splits a mixed-case term into words, spelling out
+// all-caps runs ("GitHub" → "Git Hub", "iOS" → "i O S").
+func splitMixedCase(word string) string {
+	runes := []rune(word)
+	var parts []string
+	start := 0
+	for i := 1; i <= len(runes); i++ {
+		boundary := i == len(runes) ||
+			(unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])) ||
+			(i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i+1]))
+		if !boundary {
+			continue
+		}
+		part := string(runes[start:i])
+		if part == strings.ToUpper(part) && len([]rune(part)) > 1 {
+			part = spellOut(part)
+		}
+		parts = append(parts, part)
+		start = i
+	}
+	return strings.Join(parts, " ")
+}
+
+// startsSentence reports whether a word following prefix starts a sentence.
+func startsSentence(prefix string) bool {
+	return leadingQuotePattern.MatchString(prefix) || sentenceEndPattern.MatchString(prefix)
+}
+
+func containsLocation(locs []SuggestionLocation, loc SuggestionLocation) bool {
+	for _, l := range locs {
+		if l == loc {
+			return true
+		}
+	}
+	return false
+}
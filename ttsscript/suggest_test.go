@@ -0,0 +1,96 @@
+package ttsscript
+
+import (
+	"testing"
+)
+
+func TestSuggestPronunciations(t *testing.T) {
+	script := &Script{
+		Pronunciations: map[string]map[string]string{"SDK": {"en": "S D K"}},
+		Slides: []Slide{
+			{
+				Title:           "Using the API",
+				IsSectionHeader: true,
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Call the API over HTTP2. The sdk wraps the API."}},
+					{Text: map[string]string{"en": "Upload to GitHub from iOS, then ask NASA."}},
+				},
+			},
+			{
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Talk to Priya about the Release. The release ships soon."}},
+					{
+						Text:           map[string]string{"en": "Use gRPC here."},
+						Pronunciations: map[string]map[string]string{"grpc": {"en": "G R P C"}},
+					},
+				},
+			},
+		},
+	}
+
+	suggestions := SuggestPronunciations(script, "en")
+	byTerm := make(map[string]PronunciationSuggestion)
+	for _, s := range suggestions {
+		byTerm[s.Term] = s
+	}
+
+	api, ok := byTerm["API"]
+	if !ok || api.Kind != SuggestionInitialism || api.Count != 3 || api.Suggestion != "A P I" {
+		t.Errorf("unexpected API suggestion: %+v", api)
+	}
+	if len(api.Locations) != 2 || api.Locations[0] != (SuggestionLocation{0, -1}) {
+		t.Errorf("unexpected API locations: %+v", api.Locations)
+	}
+	if suggestions[0].Term != "API" {
+		t.Errorf("expected API ranked first, got %+v", suggestions[0])
+	}
+
+	checks := map[string]struct{ kind, suggestion string }{
+		"HTTP2":  {SuggestionInitialism, "H T T P 2"},
+		"NASA":   {SuggestionAcronym, "N A S A"},
+		"GitHub": {SuggestionMixedCase, "Git Hub"},
+		"iOS":    {SuggestionMixedCase, "i O S"},
+		"Priya":  {SuggestionProperNoun, ""},
+	}
+	for term, want := range checks {
+		got, ok := byTerm[term]
+		if !ok {
+			t.Errorf("missing suggestion for %s", term)
+			continue
+		}
+		if got.Kind != want.kind || got.Suggestion != want.suggestion {
+			t.Errorf("%s: got kind %q suggestion %q, want %q %q", term, got.Kind, got.Suggestion, want.kind, want.suggestion)
+		}
+	}
+
+	// Covered terms (script or segment, any case), sentence-initial words,
+	// and capitalized words that also appear in lower case are skipped.
+	for _, term := range []string{"SDK", "gRPC", "Call", "Upload", "Talk", "Release", "Using"} {
+		if _, ok := byTerm[term]; ok {
+			t.Errorf("unexpected suggestion for %s", term)
+		}
+	}
+
+	prons := SuggestedPronunciations(suggestions, "en")
+	if prons["API"]["en"] != "A P I" {
+		t.Errorf("unexpected pronunciations: %v", prons)
+	}
+	if _, ok := prons["Priya"]; ok {
+		t.Error("suggestions without a replacement should not become pronunciations")
+	}
+}
+
+func TestSpellOut(t *testing.T) {
+	tests := map[string]string{
+		"API":   "A P I",
+		"APIs":  "A P Is",
+		"S3":    "S 3",
+		"HTTP2": "H T T P 2",
+		"MP3s":  "M P 3s",
+	}
+	for in, want := range tests {
+		if got := spellOut(in); got != want {
+			t.Errorf("spellOut(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
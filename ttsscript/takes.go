@@ -0,0 +1,146 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// TakeSelection records the takes generated for segments and which take is
+// active. The active take is copied to the entry's output file, so
+// concatenation and the manifest use it. Like a Review, a selection applies
+// to audio with a specific content hash: once a segment changes, its takes
+// are stale and are generated again.
+type TakeSelection struct {
+	// Language is the language of the takes.
+	Language string `json:"language"`
+
+	// Segments are sorted by output file.
+	Segments []SegmentTakes `json:"segments"`
+}
+
+// SegmentTakes are the takes of one segment.
+type SegmentTakes struct {
+	// OutputFile is the manifest output file the takes are for.
+	OutputFile string `json:"output_file"`
+
+	// Hash is the manifest hash of the segment when the takes were
+	// generated.
+	Hash string `json:"hash"`
+
+	// Takes is the number of takes generated, numbered from 1.
+	Takes int `json:"takes"`
+
+	// Active is the selected take.
+	Active int `json:"active"`
+}
+
+// Files returns the take files, in take order.
+func (t SegmentTakes) Files() []string {
+	files := make([]string, t.Takes)
+	for i := range files {
+		files[i] = TakeFile(t.OutputFile, i+1)
+	}
+	return files
+}
+
+// TakeFile returns the file for a take of an output file, e.g.
+// "slide01_seg01_en.take2.mp3" for take 2 of "slide01_seg01_en.mp3".
+func TakeFile(outputFile string, take int) string {
+	ext := filepath.Ext(outputFile)
+	return fmt.Sprintf("%s.take%d%s", strings.TrimSuffix(outputFile, ext), take, ext)
+}
+
+// TakeSeed returns the generation seed for a take, so that each take is a
+// different delivery and regenerating a take reproduces it. Take 1 uses the
+// segment's seed, or 1 if it has none.
+func TakeSeed(seed, take int) int {
+	if seed <= 0 {
+		seed = 1
+	}
+	return seed + take - 1
+}
+
+// NewTakeSelection creates an empty selection for a language.
+func NewTakeSelection(language string) *TakeSelection {
+	return &TakeSelection{Language: language, Segments: []SegmentTakes{}}
+}
+
+// LoadTakeSelection loads a selection from a JSON file.
+func LoadTakeSelection(filePath string) (*TakeSelection, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading takes file: %w", err)
+	}
+	var s TakeSelection
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("parsing takes JSON: %w", err)
+	}
+	return &s, nil
+}
+
+// WriteJSON writes the selection as indented JSON to a file.
+func (s *TakeSelection) WriteJSON(filePath string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling takes: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("writing takes file: %w", err)
+	}
+	return nil
+}
+
+// Get returns the takes for an entry, or nil if none were generated or the
+// entry has changed since.
+func (s *TakeSelection) Get(entry ManifestEntry) *SegmentTakes {
+	i := s.index(entry.OutputFile)
+	if i < 0 || s.Segments[i].Hash != entry.Hash {
+		return nil
+	}
+	return &s.Segments[i]
+}
+
+// Record records that takes were generated for an entry. The active take
+// is kept if it still applies, and is otherwise take 1.
+func (s *TakeSelection) Record(entry ManifestEntry, takes int) *SegmentTakes {
+	active := 1
+	if prev := s.Get(entry); prev != nil && prev.Active <= takes {
+		active = prev.Active
+	}
+	t := SegmentTakes{OutputFile: entry.OutputFile, Hash: entry.Hash, Takes: takes, Active: active}
+	if i := s.index(entry.OutputFile); i >= 0 {
+		s.Segments[i] = t
+		return &s.Segments[i]
+	}
+	s.Segments = append(s.Segments, t)
+	sort.Slice(s.Segments, func(i, j int) bool {
+		return s.Segments[i].OutputFile < s.Segments[j].OutputFile
+	})
+	return &s.Segments[s.index(entry.OutputFile)]
+}
+
+// Select makes a take active for an output file.
+func (s *TakeSelection) Select(outputFile string, take int) error {
+	i := s.index(outputFile)
+	if i < 0 {
+		return fmt.Errorf("no takes recorded for %s", outputFile)
+	}
+	if take < 1 || take > s.Segments[i].Takes {
+		return fmt.Errorf("take %d out of range for %s (1-%d)", take, outputFile, s.Segments[i].Takes)
+	}
+	s.Segments[i].Active = take
+	return nil
+}
+
+func (s *TakeSelection) index(outputFile string) int {
+	for i, t := range s.Segments {
+		if t.OutputFile == outputFile {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,58 @@
+package ttsscript
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestTakeFileAndSeed(t *testing.T) {
+	if got := TakeFile("out/slide01_seg01_en.mp3", 2); got != "out/slide01_seg01_en.take2.mp3" {
+		t.Errorf("TakeFile() = %q", got)
+	}
+	if TakeSeed(0, 1) != 1 || TakeSeed(0, 3) != 3 || TakeSeed(42, 1) != 42 || TakeSeed(42, 2) != 43 {
+		t.Error("TakeSeed() should start at the segment seed and differ per take")
+	}
+}
+
+func TestTakeSelection(t *testing.T) {
+	s := NewTakeSelection("en")
+	entry := ManifestEntry{OutputFile: "out/b.mp3", Hash: "h1"}
+	s.Record(ManifestEntry{OutputFile: "out/a.mp3", Hash: "x"}, 2)
+
+	if got := s.Record(entry, 3); got.Active != 1 || got.Takes != 3 {
+		t.Errorf("Record() = %+v", got)
+	}
+	if err := s.Select("out/b.mp3", 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Select("out/b.mp3", 4); err == nil {
+		t.Error("Select() of a take out of range should fail")
+	}
+	if err := s.Select("out/c.mp3", 1); err == nil {
+		t.Error("Select() without takes should fail")
+	}
+
+	// Regenerating the same segment keeps the choice
+	if got := s.Record(entry, 3); got.Active != 3 {
+		t.Errorf("active take after re-record = %d, want 3", got.Active)
+	}
+	// A changed segment has no current takes
+	if s.Get(ManifestEntry{OutputFile: "out/b.mp3", Hash: "h2"}) != nil {
+		t.Error("Get() should ignore takes of a changed segment")
+	}
+
+	path := filepath.Join(t.TempDir(), "takes_en.json")
+	if err := s.WriteJSON(path); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadTakeSelection(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Segments) != 2 || loaded.Segments[0].OutputFile != "out/a.mp3" || loaded.Get(entry).Active != 3 {
+		t.Errorf("loaded = %+v", loaded)
+	}
+	if files := loaded.Get(entry).Files(); len(files) != 3 || files[2] != "out/b.take3.mp3" {
+		t.Errorf("Files() = %v", files)
+	}
+}
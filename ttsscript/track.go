@@ -0,0 +1,147 @@
+package ttsscript
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TrackPart is one piece of a single-file track: either a generated audio
+// file or a stretch of silence.
+type TrackPart struct {
+	// Entry is the manifest entry whose audio is played. Nil for silence.
+	Entry *ManifestEntry
+
+	// SilenceMs is the length of silence in milliseconds. Zero for audio.
+	SilenceMs int
+
+	// CrossfadeMs is how much the part's audio overlaps the audio before
+	// it, from the entry's CrossfadeMs. Zero to play it after.
+	CrossfadeMs int
+}
+
+// ChapterMarker marks where a slide starts and ends in a single-file track.
+type ChapterMarker struct {
+	SlideIndex int    `json:"slide_index"`
+	Title      string `json:"title"`
+	StartMs    int    `json:"start_ms"`
+	EndMs      int    `json:"end_ms"`
+}
+
+// Track lays out every slide of a language as one continuous audio file,
+// for podcast-style output.
+type Track struct {
+	// Language is the language of the track.
+	Language string `json:"language"`
+
+	// Parts are the audio files and silences to concatenate, in order.
+	Parts []TrackPart `json:"-"`
+
+	// Chapters has one entry per slide, in order.
+	Chapters []ChapterMarker `json:"chapters"`
+
+	// DurationMs is the total length of the track.
+	DurationMs int `json:"duration_ms"`
+}
+
+// BuildTrack lays out manifest entries as a single track. Segments keep their
+// pauses and crossfades, and slideGapMs of extra silence is inserted between
+// slides.
+//
+// Entries must have DurationMs measured so chapter offsets are exact.
+func BuildTrack(entries []ManifestEntry, language string, slideGapMs int) (*Track, error) {
+	if slideGapMs < 0 {
+		return nil, errors.New("slide gap cannot be negative")
+	}
+
+	sorted := make([]ManifestEntry, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].SlideIndex != sorted[j].SlideIndex {
+			return sorted[i].SlideIndex < sorted[j].SlideIndex
+		}
+		if sorted[i].SegmentIndex != sorted[j].SegmentIndex {
+			return sorted[i].SegmentIndex < sorted[j].SegmentIndex
+		}
+		return sorted[i].IsEarcon && !sorted[j].IsEarcon
+	})
+
+	track := &Track{Language: language}
+	silence := func(ms int) {
+		if ms > 0 {
+			track.Parts = append(track.Parts, TrackPart{SilenceMs: ms})
+			track.DurationMs += ms
+		}
+	}
+
+	for i := range sorted {
+		e := &sorted[i]
+		if e.DurationMs <= 0 {
+			return nil, fmt.Errorf("%s has no measured duration", e.OutputFile)
+		}
+
+		if i == 0 || e.SlideIndex != sorted[i-1].SlideIndex {
+			if i > 0 {
+				track.Chapters[len(track.Chapters)-1].EndMs = track.DurationMs
+				silence(slideGapMs)
+			}
+			title := e.SlideTitle
+			if title == "" {
+				title = fmt.Sprintf("Slide %d", e.SlideIndex+1)
+			}
+			track.Chapters = append(track.Chapters, ChapterMarker{
+				SlideIndex: e.SlideIndex,
+				Title:      title,
+				StartMs:    track.DurationMs,
+			})
+		}
+
+		if i > 0 {
+			silence(e.PauseBeforeMs)
+		}
+		part := TrackPart{Entry: e}
+		if n := len(track.Parts); n > 0 && track.Parts[n-1].Entry != nil {
+			// A crossfade cannot be longer than either side
+			if prev := track.Parts[n-1].Entry; e.CrossfadeMs < prev.DurationMs && e.CrossfadeMs < e.DurationMs {
+				part.CrossfadeMs = e.CrossfadeMs
+			}
+		}
+		track.Parts = append(track.Parts, part)
+		track.DurationMs += e.DurationMs - part.CrossfadeMs
+		silence(e.PauseAfterMs)
+	}
+	if n := len(track.Chapters); n > 0 {
+		track.Chapters[n-1].EndMs = track.DurationMs
+	}
+	return track, nil
+}
+
+// ChapterList returns the chapters as podcast-style timestamps, one per line:
+//
+//	00:00:00 Introduction
+//	00:01:42 Architecture
+func (t *Track) ChapterList() string {
+	var b strings.Builder
+	for _, c := range t.Chapters {
+		d := time.Duration(c.StartMs) * time.Millisecond
+		fmt.Fprintf(&b, "%02d:%02d:%02d %s\n",
+			int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60, c.Title)
+	}
+	return b.String()
+}
+
+// WriteJSON writes the track's chapter markers to a JSON file.
+func (t *Track) WriteJSON(filePath string) error {
+	data, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling chapters: %w", err)
+	}
+	if err := os.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("writing chapters file: %w", err)
+	}
+	return nil
+}
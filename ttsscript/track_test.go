@@ -0,0 +1,79 @@
+package ttsscript
+
+import "testing"
+
+func TestBuildTrack(t *testing.T) {
+	entries := []ManifestEntry{
+		{SlideIndex: 1, SegmentIndex: 0, OutputFile: "s2.mp3", DurationMs: 2000},
+		{SlideIndex: 0, SegmentIndex: 1, OutputFile: "s1b.mp3", DurationMs: 1500, PauseBeforeMs: 200, PauseAfterMs: 800},
+		{SlideIndex: 0, SegmentIndex: -1, SlideTitle: "Intro", IsTitleSegment: true, OutputFile: "s1t.mp3", DurationMs: 1000, PauseAfterMs: 300},
+	}
+
+	track, err := BuildTrack(entries, "en", 1000)
+	if err != nil {
+		t.Fatalf("BuildTrack() error = %v", err)
+	}
+
+	// 1000 + 300 + 200 + 1500 + 800 | gap 1000 | 2000
+	if track.DurationMs != 6800 {
+		t.Errorf("DurationMs = %d, want 6800", track.DurationMs)
+	}
+	want := []ChapterMarker{
+		{SlideIndex: 0, Title: "Intro", StartMs: 0, EndMs: 3800},
+		{SlideIndex: 1, Title: "Slide 2", StartMs: 4800, EndMs: 6800},
+	}
+	if len(track.Chapters) != len(want) {
+		t.Fatalf("Chapters = %+v", track.Chapters)
+	}
+	for i, c := range want {
+		if track.Chapters[i] != c {
+			t.Errorf("Chapters[%d] = %+v, want %+v", i, track.Chapters[i], c)
+		}
+	}
+
+	var files []string
+	for _, p := range track.Parts {
+		if p.Entry != nil {
+			files = append(files, p.Entry.OutputFile)
+		}
+	}
+	if len(files) != 3 || files[0] != "s1t.mp3" || files[2] != "s2.mp3" {
+		t.Errorf("audio order = %v", files)
+	}
+	if len(track.Parts) != 7 {
+		t.Errorf("len(Parts) = %d, want 7", len(track.Parts))
+	}
+
+	if got, want := track.ChapterList(), "00:00:00 Intro\n00:00:04 Slide 2\n"; got != want {
+		t.Errorf("ChapterList() = %q, want %q", got, want)
+	}
+}
+
+func TestBuildTrackUnmeasured(t *testing.T) {
+	if _, err := BuildTrack([]ManifestEntry{{OutputFile: "a.mp3"}}, "en", 0); err == nil {
+		t.Error("BuildTrack() without durations should fail")
+	}
+	if _, err := BuildTrack(nil, "en", -1); err == nil {
+		t.Error("BuildTrack() with negative gap should fail")
+	}
+}
+
+func TestBuildTrackCrossfade(t *testing.T) {
+	entries := []ManifestEntry{
+		{SlideIndex: 0, SegmentIndex: 0, OutputFile: "a.mp3", DurationMs: 1000},
+		{SlideIndex: 0, SegmentIndex: 1, OutputFile: "b.mp3", DurationMs: 1000, CrossfadeMs: 50},
+		{SlideIndex: 0, SegmentIndex: 2, OutputFile: "c.mp3", DurationMs: 1000, CrossfadeMs: 50, PauseBeforeMs: 200},
+	}
+	track, err := BuildTrack(entries, "en", 0)
+	if err != nil {
+		t.Fatalf("BuildTrack() error = %v", err)
+	}
+
+	// The crossfade after a pause has nothing to overlap
+	if track.DurationMs != 3150 {
+		t.Errorf("DurationMs = %d, want 3150", track.DurationMs)
+	}
+	if track.Parts[1].CrossfadeMs != 50 || track.Parts[3].CrossfadeMs != 0 {
+		t.Errorf("Parts = %+v", track.Parts)
+	}
+}
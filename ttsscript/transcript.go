@@ -0,0 +1,329 @@
+package ttsscript
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Transcript bundle defaults.
+const (
+	// TranscriptBundleManifestFile is the name of the manifest in a
+	// transcript bundle.
+	TranscriptBundleManifestFile = "manifest.json"
+
+	// DefaultCaptionCueChars is the maximum characters per caption cue:
+	// two lines of 42, the common broadcast subtitle line length.
+	DefaultCaptionCueChars = 84
+)
+
+// TranscriptBundleOptions configures TranscriptBundle.
+type TranscriptBundleOptions struct {
+	// Title is the course title, written at the top of the full
+	// transcript.
+	Title string
+
+	// Language is the language the segments were compiled for.
+	Language string
+
+	// SlideGapMs is the extra silence between slides in the single-file
+	// track the full captions are timed against. It must match the gap
+	// the track was built with.
+	SlideGapMs int
+
+	// MaxCueChars is the maximum characters per caption cue. Defaults to
+	// DefaultCaptionCueChars.
+	MaxCueChars int
+}
+
+// TranscriptBundleFile is a file in a transcript bundle.
+type TranscriptBundleFile struct {
+	// Name is the slash-separated path within the bundle.
+	Name string
+
+	// Data is the file content.
+	Data []byte
+}
+
+// TranscriptBundleManifest describes a transcript bundle. It is written to
+// TranscriptBundleManifestFile.
+type TranscriptBundleManifest struct {
+	Title      string                  `json:"title,omitempty"`
+	Language   string                  `json:"language,omitempty"`
+	DurationMs int                     `json:"duration_ms"`
+	Transcript string                  `json:"transcript"`
+	Captions   []string                `json:"captions"`
+	Slides     []TranscriptBundleSlide `json:"slides"`
+}
+
+// TranscriptBundleSlide describes the files for one slide in a transcript
+// bundle. StartMs and EndMs are the slide's position in the single-file
+// track; the slide's captions are timed from the start of its own audio.
+type TranscriptBundleSlide struct {
+	SlideIndex int      `json:"slide_index"`
+	Title      string   `json:"title"`
+	StartMs    int      `json:"start_ms"`
+	EndMs      int      `json:"end_ms"`
+	Transcript string   `json:"transcript"`
+	Captions   []string `json:"captions"`
+}
+
+// transcriptCue is a caption cue in milliseconds.
+type transcriptCue struct {
+	startMs, endMs int
+	text           string
+}
+
+// TranscriptBundle builds the accessibility files for one language from
+// the compiled segments and the manifest of the generated audio: a plain
+// text transcript of the whole course (transcript.txt), one per slide
+// (slides/slide-NN.txt), and WebVTT and SRT captions for the single-file
+// track (captions.vtt, captions.srt) and for each slide's audio, plus
+// TranscriptBundleManifestFile listing them.
+//
+// Transcripts use each segment's OriginalText, so they read as written
+// rather than as respelled for pronunciation, with audio tags, SSML
+// markup, and Markdown removed. Entries with no matching segment fall back
+// to the manifest text. Caption times come from the measured DurationMs of
+// each entry, so every entry must have one; each segment's time is shared
+// among its cues by length.
+func TranscriptBundle(segments []CompiledSegment, entries []ManifestEntry, opts TranscriptBundleOptions) ([]TranscriptBundleFile, error) {
+	track, err := BuildTrack(entries, opts.Language, opts.SlideGapMs)
+	if err != nil {
+		return nil, err
+	}
+	maxChars := opts.MaxCueChars
+	if maxChars <= 0 {
+		maxChars = DefaultCaptionCueChars
+	}
+
+	type segmentKey struct{ slide, segment int }
+	texts := make(map[segmentKey]string)
+	for _, seg := range segments {
+		if !seg.IsEarcon {
+			texts[segmentKey{seg.SlideIndex, seg.SegmentIndex}] = transcriptText(seg.OriginalText)
+		}
+	}
+
+	meta := TranscriptBundleManifest{
+		Title:      opts.Title,
+		Language:   opts.Language,
+		DurationMs: track.DurationMs,
+		Transcript: "transcript.txt",
+		Captions:   []string{"captions.vtt", "captions.srt"},
+		Slides:     make([]TranscriptBundleSlide, 0, len(track.Chapters)),
+	}
+	var full strings.Builder
+	if opts.Title != "" {
+		fmt.Fprintf(&full, "%s\n\n", opts.Title)
+	}
+	var fullCues []transcriptCue
+	slideTexts := make([][]string, len(track.Chapters))
+	slideCues := make([][]transcriptCue, len(track.Chapters))
+
+	// Walk the track as BuildTrack laid it out, to time each segment
+	chapter := -1
+	elapsed := 0
+	for _, part := range track.Parts {
+		if part.Entry == nil {
+			elapsed += part.SilenceMs
+			continue
+		}
+		e := part.Entry
+		start := elapsed - part.CrossfadeMs
+		elapsed = start + e.DurationMs
+		for chapter+1 < len(track.Chapters) && track.Chapters[chapter+1].SlideIndex <= e.SlideIndex {
+			chapter++
+		}
+		if e.IsEarcon {
+			continue
+		}
+		text, ok := texts[segmentKey{e.SlideIndex, e.SegmentIndex}]
+		if !ok {
+			text = transcriptText(e.Text)
+		}
+		if text == "" {
+			continue
+		}
+		slideTexts[chapter] = append(slideTexts[chapter], text)
+		cues := splitCues(text, start, elapsed, maxChars)
+		fullCues = append(fullCues, cues...)
+		offset := track.Chapters[chapter].StartMs
+		for _, c := range cues {
+			slideCues[chapter] = append(slideCues[chapter], transcriptCue{c.startMs - offset, c.endMs - offset, c.text})
+		}
+	}
+
+	var files []TranscriptBundleFile
+	for i, c := range track.Chapters {
+		base := fmt.Sprintf("slides/slide-%02d", c.SlideIndex+1)
+		body := strings.Join(slideTexts[i], "\n\n")
+		fmt.Fprintf(&full, "%s\n\n", c.Title)
+		if body != "" {
+			fmt.Fprintf(&full, "%s\n\n", body)
+		}
+		files = append(files,
+			TranscriptBundleFile{Name: base + ".txt", Data: []byte(c.Title + "\n\n" + body + "\n")},
+			TranscriptBundleFile{Name: base + ".vtt", Data: []byte(formatVTT(slideCues[i]))},
+			TranscriptBundleFile{Name: base + ".srt", Data: []byte(formatSRT(slideCues[i]))},
+		)
+		meta.Slides = append(meta.Slides, TranscriptBundleSlide{
+			SlideIndex: c.SlideIndex,
+			Title:      c.Title,
+			StartMs:    c.StartMs,
+			EndMs:      c.EndMs,
+			Transcript: base + ".txt",
+			Captions:   []string{base + ".vtt", base + ".srt"},
+		})
+	}
+
+	data, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling transcript bundle manifest: %w", err)
+	}
+	return append([]TranscriptBundleFile{
+		{Name: TranscriptBundleManifestFile, Data: append(data, '\n')},
+		{Name: "transcript.txt", Data: []byte(strings.TrimRight(full.String(), "\n") + "\n")},
+		{Name: "captions.vtt", Data: []byte(formatVTT(fullCues))},
+		{Name: "captions.srt", Data: []byte(formatSRT(fullCues))},
+	}, files...), nil
+}
+
+// WriteTranscriptBundleZip writes the TranscriptBundle files as a zip
+// archive.
+func WriteTranscriptBundleZip(w io.Writer, segments []CompiledSegment, entries []ManifestEntry, opts TranscriptBundleOptions) error {
+	files, err := TranscriptBundle(segments, entries, opts)
+	if err != nil {
+		return err
+	}
+	zw := zip.NewWriter(w)
+	for _, f := range files {
+		fw, err := zw.Create(f.Name)
+		if err != nil {
+			return fmt.Errorf("adding %s to zip: %w", f.Name, err)
+		}
+		if _, err := fw.Write(f.Data); err != nil {
+			return fmt.Errorf("writing %s to zip: %w", f.Name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("closing zip: %w", err)
+	}
+	return nil
+}
+
+// WriteTranscriptBundleDir writes the TranscriptBundle files under dir.
+func WriteTranscriptBundleDir(dir string, segments []CompiledSegment, entries []ManifestEntry, opts TranscriptBundleOptions) error {
+	files, err := TranscriptBundle(segments, entries, opts)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		path := filepath.Join(dir, filepath.FromSlash(f.Name))
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return fmt.Errorf("creating directory: %w", err)
+		}
+		if err := os.WriteFile(path, f.Data, 0600); err != nil {
+			return fmt.Errorf("writing %s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// transcriptText converts segment text to plain text for a transcript:
+// audio tags are removed, SSML islands keep only their text, and Markdown
+// is removed. URLs are kept, since readers can follow them.
+func transcriptText(text string) string {
+	text = AudioTagText(text, false)
+	text = ssmlIslandPattern.ReplaceAllStringFunc(text, func(island string) string {
+		return xmlTagPattern.ReplaceAllString(ssmlIslandPattern.FindStringSubmatch(island)[1], "")
+	})
+	text = xmlTagPattern.ReplaceAllString(text, "")
+	return CleanText(text, CleanURLs)
+}
+
+// cueBreak matches the end of a sentence and the space after it.
+var cueBreak = regexp.MustCompile(`[.!?]["')\]\x{201D}\x{2019}]*\s+`)
+
+// splitCues splits text into cues of at most maxChars, breaking at
+// sentence ends and then between words, and shares startMs to endMs among
+// them by length.
+func splitCues(text string, startMs, endMs, maxChars int) []transcriptCue {
+	var parts []string
+	last := 0
+	for _, loc := range cueBreak.FindAllStringIndex(text, -1) {
+		parts = append(parts, wrapCue(strings.TrimSpace(text[last:loc[1]]), maxChars)...)
+		last = loc[1]
+	}
+	if rest := strings.TrimSpace(text[last:]); rest != "" {
+		parts = append(parts, wrapCue(rest, maxChars)...)
+	}
+
+	total := 0
+	for _, p := range parts {
+		total += utf8.RuneCountInString(p)
+	}
+	cues := make([]transcriptCue, 0, len(parts))
+	done := 0
+	for _, p := range parts {
+		cueStart := startMs + (endMs-startMs)*done/total
+		done += utf8.RuneCountInString(p)
+		cues = append(cues, transcriptCue{cueStart, startMs + (endMs-startMs)*done/total, p})
+	}
+	return cues
+}
+
+// wrapCue splits a sentence between words into pieces of at most maxChars.
+// A single word longer than maxChars is its own piece.
+func wrapCue(sentence string, maxChars int) []string {
+	var pieces []string
+	var cur string
+	for _, word := range strings.Fields(sentence) {
+		if cur != "" && utf8.RuneCountInString(cur)+1+utf8.RuneCountInString(word) > maxChars {
+			pieces = append(pieces, cur)
+			cur = ""
+		}
+		if cur != "" {
+			cur += " "
+		}
+		cur += word
+	}
+	if cur != "" {
+		pieces = append(pieces, cur)
+	}
+	return pieces
+}
+
+// formatVTT renders cues as a WebVTT file.
+func formatVTT(cues []transcriptCue) string {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for i, c := range cues {
+		fmt.Fprintf(&b, "\n%d\n%s --> %s\n%s\n", i+1, cueTimestamp(c.startMs, "."), cueTimestamp(c.endMs, "."), c.text)
+	}
+	return b.String()
+}
+
+// formatSRT renders cues as a SubRip file.
+func formatSRT(cues []transcriptCue) string {
+	var b strings.Builder
+	for i, c := range cues {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n", i+1, cueTimestamp(c.startMs, ","), cueTimestamp(c.endMs, ","), c.text)
+	}
+	return b.String()
+}
+
+// cueTimestamp formats milliseconds as HH:MM:SS followed by sep and the
+// milliseconds: "." for WebVTT, "," for SRT.
+func cueTimestamp(ms int, sep string) string {
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
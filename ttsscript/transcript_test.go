@@ -0,0 +1,91 @@
+package ttsscript
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTranscriptBundle(t *testing.T) {
+	segments := []CompiledSegment{
+		{SlideIndex: 0, SegmentIndex: -1, IsTitleSegment: true, OriginalText: "Welcome"},
+		{SlideIndex: 0, SegmentIndex: 0, Text: "Learn S Q L today.", OriginalText: "{{tag:excited}} Learn **SQL** today. It is fun!"},
+		{SlideIndex: 1, SegmentIndex: 0, OriginalText: "See {{ssml:<sub alias=\"the docs\">docs</sub>}} at https://example.com."},
+	}
+	entries := []ManifestEntry{
+		{SlideIndex: 0, SegmentIndex: -1, IsTitleSegment: true, SlideTitle: "Welcome", OutputFile: "t.mp3", DurationMs: 1000, PauseAfterMs: 500},
+		{SlideIndex: 0, SegmentIndex: 0, SlideTitle: "Welcome", OutputFile: "a.mp3", DurationMs: 2800},
+		{SlideIndex: 1, SegmentIndex: 0, SlideTitle: "Docs", OutputFile: "b.mp3", DurationMs: 2000},
+		{SlideIndex: 1, SegmentIndex: 1, SlideTitle: "Docs", IsEarcon: true, OutputFile: "chime.mp3", DurationMs: 400},
+		{SlideIndex: 1, SegmentIndex: 1, SlideTitle: "Docs", Text: "Questions <break time=\"1s\"/> welcome.", OutputFile: "c.mp3", DurationMs: 1000},
+	}
+
+	files, err := TranscriptBundle(segments, entries, TranscriptBundleOptions{Title: "SQL Basics", Language: "en", SlideGapMs: 1000})
+	if err != nil {
+		t.Fatalf("TranscriptBundle() error: %v", err)
+	}
+	byName := make(map[string]string)
+	for _, f := range files {
+		byName[f.Name] = string(f.Data)
+	}
+
+	wantTranscript := "SQL Basics\n\nWelcome\n\nWelcome\n\nLearn SQL today. It is fun!\n\nDocs\n\n" +
+		"See docs at https://example.com.\n\nQuestions welcome.\n"
+	if got := byName["transcript.txt"]; got != wantTranscript {
+		t.Errorf("transcript.txt = %q, want %q", got, wantTranscript)
+	}
+	if got := byName["slides/slide-02.txt"]; got != "Docs\n\nSee docs at https://example.com.\n\nQuestions welcome.\n" {
+		t.Errorf("slides/slide-02.txt = %q", got)
+	}
+
+	// The segment's 2800ms is shared 16:10 between its sentences. Slide 2
+	// starts at 1000 + 500 + 2800 + 1000 = 5300, and the earcon before its
+	// second segment is left out of the captions.
+	for _, want := range []string{
+		"WEBVTT\n\n1\n00:00:00.000 --> 00:00:01.000\nWelcome\n",
+		"\n2\n00:00:01.500 --> 00:00:03.223\nLearn SQL today.\n",
+		"\n3\n00:00:03.223 --> 00:00:04.300\nIt is fun!\n",
+		"\n4\n00:00:05.300 --> 00:00:07.300\nSee docs at https://example.com.\n",
+	} {
+		if !strings.Contains(byName["captions.vtt"], want) {
+			t.Errorf("captions.vtt missing %q:\n%s", want, byName["captions.vtt"])
+		}
+	}
+	if want := "1\n00:00:00,000 --> 00:00:02,000\nSee docs at https://example.com.\n\n2\n00:00:02,400 --> 00:00:03,400\nQuestions welcome.\n"; byName["slides/slide-02.srt"] != want {
+		t.Errorf("slides/slide-02.srt = %q, want %q", byName["slides/slide-02.srt"], want)
+	}
+
+	var meta TranscriptBundleManifest
+	if err := json.Unmarshal([]byte(byName[TranscriptBundleManifestFile]), &meta); err != nil {
+		t.Fatalf("manifest: %v", err)
+	}
+	if meta.DurationMs != 8700 || len(meta.Slides) != 2 || meta.Slides[1].StartMs != 5300 || meta.Slides[1].Captions[0] != "slides/slide-02.vtt" {
+		t.Errorf("manifest = %+v", meta)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTranscriptBundleZip(&buf, segments, entries, TranscriptBundleOptions{}); err != nil {
+		t.Fatalf("WriteTranscriptBundleZip() error: %v", err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("reading zip: %v", err)
+	}
+	if len(zr.File) != len(files) {
+		t.Errorf("zip has %d files, want %d", len(zr.File), len(files))
+	}
+
+	entries[0].DurationMs = 0
+	if _, err := TranscriptBundle(segments, entries, TranscriptBundleOptions{}); err == nil {
+		t.Error("expected an error for an entry without a duration")
+	}
+}
+
+func TestSplitCues(t *testing.T) {
+	cues := splitCues("One two three four five six.", 0, 2800, 12)
+	if len(cues) != 3 || cues[0].text != "One two" || cues[2].text != "five six." || cues[2].endMs != 2800 {
+		t.Errorf("splitCues() = %+v", cues)
+	}
+}
@@ -1,6 +1,9 @@
 package ttsscript
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -157,6 +160,34 @@ func TestElevenLabsFormatter(t *testing.T) {
 	}
 }
 
+func TestGenerateTTSRequestsModelAndSeed(t *testing.T) {
+	script := &Script{
+		DefaultModel:  "eleven_multilingual_v2",
+		DefaultVoices: map[string]string{"en": "voice-1"},
+		Slides: []Slide{{Segments: []Segment{
+			{Text: map[string]string{"en": "Default model."}},
+			{Text: map[string]string{"en": "Fast model."}, Model: "eleven_turbo_v2_5", Seed: 7},
+		}}},
+	}
+	segments, err := NewElevenLabsFormatter().FormatScript(script, "en")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := GenerateTTSRequests(segments, "fallback_model", "en")
+	if requests[0].ModelID != "eleven_multilingual_v2" || requests[0].Seed != 0 {
+		t.Errorf("requests[0] = %+v", requests[0])
+	}
+	if requests[1].ModelID != "eleven_turbo_v2_5" || requests[1].Seed != 7 || requests[1].LanguageCode != "en" {
+		t.Errorf("requests[1] = %+v", requests[1])
+	}
+
+	// The segment's model and seed change its hash
+	if SegmentHash(segments[1], "") == SegmentHash(ElevenLabsSegment{Text: "Fast model.", VoiceID: "voice-1"}, "eleven_turbo_v2_5") {
+		t.Error("seed should change the segment hash")
+	}
+}
+
 func TestParseDuration(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -642,3 +673,640 @@ func TestBatchConfigGenerateFilename(t *testing.T) {
 		})
 	}
 }
+
+func TestCompilerPronunciationLongestMatch(t *testing.T) {
+	script := &Script{
+		Pronunciations: map[string]map[string]string{
+			"Go":     {"en": "go lang"},
+			"Golang": {"en": "go language"},
+			"lang":   {"en": "LANG"},
+		},
+		Slides: []Slide{
+			{Segments: []Segment{{Text: map[string]string{"en": "Golang and Go"}}}},
+		},
+	}
+
+	compiler := NewCompiler()
+	compiler.Trace = true
+
+	for i := 0; i < 10; i++ {
+		segments, err := compiler.Compile(script, "en")
+		if err != nil {
+			t.Fatalf("Compile failed: %v", err)
+		}
+		// "lang" must not be applied inside the "go lang" replacement.
+		if segments[0].Text != "go language and go lang" {
+			t.Fatalf("expected 'go language and go lang', got '%s'", segments[0].Text)
+		}
+
+		trace := segments[0].PronunciationTrace
+		if len(trace) != 2 {
+			t.Fatalf("expected 2 trace hits, got %d", len(trace))
+		}
+		if trace[0].Term != "Golang" || trace[1].Term != "Go" {
+			t.Errorf("unexpected trace order: %+v", trace)
+		}
+		if trace[0].Source != "script" {
+			t.Errorf("expected source 'script', got '%s'", trace[0].Source)
+		}
+	}
+}
+
+func TestCompilerPronunciationPriority(t *testing.T) {
+	script := &Script{
+		Pronunciations: map[string]map[string]string{"API": {"en": "script"}},
+		Slides: []Slide{
+			{Segments: []Segment{{
+				Text:           map[string]string{"en": "API"},
+				Pronunciations: map[string]map[string]string{"API": {"en": "segment"}},
+			}}},
+		},
+	}
+
+	compiler := NewCompiler()
+	segments, _ := compiler.Compile(script, "en")
+	if segments[0].Text != "segment" {
+		t.Errorf("expected segment override, got '%s'", segments[0].Text)
+	}
+	if segments[0].PronunciationTrace != nil {
+		t.Error("expected no trace when Trace is disabled")
+	}
+
+	compiler.AddPronunciation("API", "en", "compiler")
+	segments, _ = compiler.Compile(script, "en")
+	if segments[0].Text != "compiler" {
+		t.Errorf("expected compiler override, got '%s'", segments[0].Text)
+	}
+}
+
+func TestCompilerSkippedSegments(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "voice-en", "es": "voice-es"},
+		Slides: []Slide{
+			{Segments: []Segment{
+				{Text: map[string]string{"en": "Hello", "es": "Hola"}},
+				{Text: map[string]string{"en": "Only English"}},
+			}},
+		},
+	}
+
+	compiler := NewCompiler()
+	result, err := compiler.CompileWithResult(script, "es")
+	if err != nil {
+		t.Fatalf("CompileWithResult failed: %v", err)
+	}
+	if len(result.Segments) != 1 {
+		t.Errorf("expected 1 segment, got %d", len(result.Segments))
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].SegmentIndex != 1 {
+		t.Errorf("expected segment 1 to be skipped, got %+v", result.Skipped)
+	}
+
+	compiler.ErrOnMissingLanguage = true
+	if _, err := compiler.Compile(script, "es"); !errors.Is(err, ErrMissingLanguage) {
+		t.Errorf("expected ErrMissingLanguage, got %v", err)
+	}
+}
+
+func TestCompilerWithFallback(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "voice-en", "es": "voice-es"},
+		Slides: []Slide{
+			{Segments: []Segment{
+				{Text: map[string]string{"en": "Hello", "es": "Hola"}},
+				{Text: map[string]string{"en": "Only English"}},
+			}},
+		},
+	}
+
+	compiler := NewCompiler()
+	compiler.ErrOnMissingLanguage = true
+	segments, err := compiler.Compile(script, "es", WithFallback("fr", "en"))
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	if len(segments) != 2 {
+		t.Fatalf("expected 2 segments, got %d", len(segments))
+	}
+	if segments[0].FallbackLanguage != "" {
+		t.Errorf("expected no fallback for first segment, got '%s'", segments[0].FallbackLanguage)
+	}
+	if segments[1].Text != "Only English" || segments[1].FallbackLanguage != "en" {
+		t.Errorf("expected English fallback, got %+v", segments[1])
+	}
+	if segments[1].VoiceID != "voice-en" {
+		t.Errorf("expected fallback voice 'voice-en', got '%s'", segments[1].VoiceID)
+	}
+}
+
+func TestCompilerIncludeSlideTitles(t *testing.T) {
+	speakFalse := false
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "voice-1", "es": "voice-2"},
+		Slides: []Slide{
+			{
+				Title:     "Overview",
+				TitleText: map[string]string{"es": "Resumen"},
+				Segments:  []Segment{{Text: map[string]string{"en": "Content", "es": "Contenido"}}},
+			},
+			{
+				Title:      "Hidden",
+				SpeakTitle: &speakFalse,
+				Segments:   []Segment{{Text: map[string]string{"en": "More", "es": "Más"}}},
+			},
+		},
+	}
+
+	compiler := NewCompiler()
+	segments, _ := compiler.Compile(script, "en")
+	if len(segments) != 2 {
+		t.Fatalf("expected 2 segments without IncludeSlideTitles, got %d", len(segments))
+	}
+
+	compiler.IncludeSlideTitles = true
+	segments, _ = compiler.Compile(script, "es")
+	if len(segments) != 3 {
+		t.Fatalf("expected 3 segments, got %d", len(segments))
+	}
+	if !segments[0].IsTitleSegment || segments[0].SegmentIndex != -1 {
+		t.Errorf("expected first segment to be a title segment, got %+v", segments[0])
+	}
+	if segments[0].Text != "Resumen" {
+		t.Errorf("expected localized title 'Resumen', got '%s'", segments[0].Text)
+	}
+	if segments[2].IsTitleSegment {
+		t.Error("expected explicit SpeakTitle=false to suppress the title")
+	}
+}
+
+func TestCompilerSlideDefaults(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "narrator"},
+		Slides: []Slide{
+			{
+				DefaultVoice:      map[string]string{"en": "guest"},
+				DefaultRate:       "slow",
+				DefaultPitch:      "low",
+				DefaultPauseAfter: "400ms",
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Inherits"}},
+					{
+						Text:       map[string]string{"en": "Overrides"},
+						Voice:      map[string]string{"en": "host"},
+						Rate:       "fast",
+						PauseAfter: "100ms",
+					},
+					{Text: map[string]string{"en": "Last"}},
+				},
+			},
+			{Segments: []Segment{{Text: map[string]string{"en": "Next slide"}}}},
+		},
+	}
+
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	first := segments[0]
+	if first.VoiceID != "guest" || first.Rate != "slow" || first.Pitch != "low" || first.PauseAfterMs != 400 {
+		t.Errorf("expected slide defaults, got %+v", first)
+	}
+
+	second := segments[1]
+	if second.VoiceID != "host" || second.Rate != "fast" || second.Pitch != "low" || second.PauseAfterMs != 100 {
+		t.Errorf("expected segment overrides, got %+v", second)
+	}
+
+	if segments[3].VoiceID != "narrator" || segments[3].Rate != "" {
+		t.Errorf("expected script defaults on next slide, got %+v", segments[3])
+	}
+}
+
+func TestCompilerProsodyProfiles(t *testing.T) {
+	stability := 0.6
+	style := 0.4
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "voice-1"},
+		Profiles: map[string]ProsodyProfile{
+			"narration": {Rate: "95%", Stability: &stability, Volume: "soft"},
+			"energetic": {Style: &style, Pitch: "high"},
+		},
+		Slides: []Slide{
+			{
+				DefaultProfile: "narration",
+				Segments: []Segment{
+					{Text: map[string]string{"en": "Calm"}},
+					{Text: map[string]string{"en": "Loud"}, Profile: "energetic", Pitch: "low"},
+				},
+			},
+		},
+	}
+
+	if issues := script.Validate(); len(issues) != 0 {
+		t.Fatalf("unexpected validation issues: %v", issues)
+	}
+
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	if segments[0].Profile != "narration" || segments[0].Rate != "95%" || segments[0].Volume != "soft" {
+		t.Errorf("expected narration profile, got %+v", segments[0])
+	}
+	if segments[1].Profile != "energetic" || segments[1].Pitch != "low" {
+		t.Errorf("expected energetic profile with pitch override, got %+v", segments[1])
+	}
+
+	jobs := NewElevenLabsFormatter().Format(segments)
+	vs := jobs[0].VoiceSettings
+	if vs == nil || vs.Stability == nil || *vs.Stability != 0.6 {
+		t.Fatalf("expected stability 0.6, got %+v", vs)
+	}
+	if vs.Speed == nil || *vs.Speed != 0.95 {
+		t.Errorf("expected speed 0.95 derived from rate, got %v", vs.Speed)
+	}
+	if jobs[1].VoiceSettings == nil || *jobs[1].VoiceSettings.Style != 0.4 {
+		t.Errorf("expected style 0.4, got %+v", jobs[1].VoiceSettings)
+	}
+
+	ssml := NewSSMLFormatter().Format(segments, "en")
+	if !strings.Contains(ssml, `<prosody rate="95%" volume="soft">`) {
+		t.Errorf("expected profile prosody in SSML, got:\n%s", ssml)
+	}
+}
+
+func TestProfilePreset(t *testing.T) {
+	speed := 0.9
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "v1"},
+		Profiles: map[string]ProsodyProfile{
+			"calm":   {Preset: "stable", Speed: &speed},
+			"preset": {Preset: "narration"},
+		},
+		Slides: []Slide{{
+			Segments: []Segment{
+				{Text: map[string]string{"en": "One"}, Profile: "calm"},
+				{Text: map[string]string{"en": "Two"}, Profile: "preset"},
+				{Text: map[string]string{"en": "Three"}},
+			},
+		}},
+	}
+
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	jobs := NewElevenLabsFormatter().Format(segments)
+	if vs := jobs[0].VoiceSettings; vs == nil || vs.Preset != "stable" || *vs.Speed != 0.9 {
+		t.Errorf("expected stable preset with speed override, got %+v", vs)
+	}
+	if vs := jobs[1].VoiceSettings; vs == nil || vs.Preset != "narration" || vs.Stability != nil {
+		t.Errorf("expected preset-only settings, got %+v", vs)
+	}
+	if jobs[2].VoiceSettings != nil {
+		t.Errorf("expected no settings without a profile, got %+v", jobs[2].VoiceSettings)
+	}
+
+	// A preset changes the generated audio, so it changes the hash.
+	a := ElevenLabsSegment{Text: "x", VoiceID: "v1", VoiceSettings: &VoiceSettings{Speed: &speed}}
+	b := a
+	b.VoiceSettings = &VoiceSettings{Preset: "stable", Speed: &speed}
+	if SegmentHash(a, "m") == SegmentHash(b, "m") {
+		t.Error("preset should change the segment hash")
+	}
+}
+
+func TestCompilerUnknownProfile(t *testing.T) {
+	script := &Script{
+		Slides: []Slide{
+			{Segments: []Segment{{Text: map[string]string{"en": "Hi"}, Profile: "missing"}}},
+		},
+	}
+	if issues := script.Validate(); len(issues) != 1 {
+		t.Errorf("expected 1 validation issue, got %v", issues)
+	}
+	if _, err := NewCompiler().Compile(script, "en"); !errors.Is(err, ErrUnknownProfile) {
+		t.Errorf("expected ErrUnknownProfile, got %v", err)
+	}
+}
+
+func TestScriptVoiceIDs(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "v1", "es": "v2"},
+		Slides: []Slide{
+			{
+				TitleVoice:   map[string]string{"en": "v3"},
+				DefaultVoice: map[string]string{"en": "v1"},
+				Segments: []Segment{
+					{Voice: map[string]string{"en": "v4", "es": ""}},
+				},
+			},
+		},
+	}
+
+	ids := script.VoiceIDs()
+	want := []string{"v1", "v2", "v3", "v4"}
+	if strings.Join(ids, ",") != strings.Join(want, ",") {
+		t.Errorf("VoiceIDs() = %v, want %v", ids, want)
+	}
+
+	entries := []ManifestEntry{{VoiceID: "b"}, {VoiceID: "a"}, {VoiceID: "b"}, {VoiceID: ""}}
+	if got := ManifestVoiceIDs(entries); strings.Join(got, ",") != "a,b" {
+		t.Errorf("ManifestVoiceIDs() = %v", got)
+	}
+}
+
+func TestSlideAssets(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "v1", "es": "v2"},
+		Slides: []Slide{{
+			Title: "Dashboard",
+			Assets: []Asset{{
+				ID:             "screenshot",
+				Type:           "image",
+				Path:           "img/dashboard.png",
+				LocalizedPaths: map[string]string{"es": "img/dashboard_es.png"},
+				AltText:        map[string]string{"en": "The dashboard", "es": "El panel"},
+			}},
+			Segments: []Segment{{Text: map[string]string{"en": "Hello", "es": "Hola"}}},
+		}},
+	}
+	if issues := script.Validate(); len(issues) > 0 {
+		t.Fatalf("unexpected issues: %v", issues)
+	}
+
+	segments, err := NewCompiler().Compile(script, "es")
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	want := LocalizedAsset{ID: "screenshot", Type: "image", Path: "img/dashboard_es.png", AltText: "El panel"}
+	if len(segments[0].Assets) != 1 || segments[0].Assets[0] != want {
+		t.Fatalf("unexpected assets: %+v", segments[0].Assets)
+	}
+
+	jobs := NewElevenLabsFormatter().Format(segments)
+	entries := GenerateManifest(jobs, NewBatchConfig("out"), "es")
+	if len(entries[0].Assets) != 1 || entries[0].Assets[0] != want {
+		t.Errorf("assets not carried into manifest: %+v", entries[0].Assets)
+	}
+
+	if img, ok := entries[0].Image(); !ok || img.Path != "img/dashboard_es.png" {
+		t.Errorf("Image() = %+v, %v", img, ok)
+	}
+	if _, ok := (ManifestEntry{Assets: []LocalizedAsset{{Type: "video", Path: "a.mp4"}}}).Image(); ok {
+		t.Error("Image() should ignore non-image assets")
+	}
+
+	en := script.Slides[0].LocalizedAssets("en")
+	if en[0].Path != "img/dashboard.png" || en[0].AltText != "The dashboard" {
+		t.Errorf("unexpected English asset: %+v", en[0])
+	}
+}
+
+func TestValidateAssets(t *testing.T) {
+	script := &Script{
+		Slides: []Slide{{
+			Assets: []Asset{
+				{Type: "image"},
+				{Path: "a.png", AltText: map[string]string{"en": "A"}},
+			},
+			Segments: []Segment{{Text: map[string]string{"en": "Hello", "es": "Hola"}}},
+		}},
+	}
+	issues := script.Validate()
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues, got %v", issues)
+	}
+	if !strings.Contains(issues[0], "no path") || !strings.Contains(issues[1], `"es"`) {
+		t.Errorf("unexpected issues: %v", issues)
+	}
+}
+
+func TestSegmentEffects(t *testing.T) {
+	script := &Script{
+		DefaultVoices: map[string]string{"en": "v1"},
+		Slides: []Slide{{
+			Segments: []Segment{
+				{Text: map[string]string{"en": "Outro"}, FadeIn: "200ms", FadeOut: "1.5s", GainDB: -3},
+				{Text: map[string]string{"en": "Plain"}},
+			},
+		}},
+	}
+	if issues := script.Validate(); len(issues) > 0 {
+		t.Fatalf("unexpected issues: %v", issues)
+	}
+
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	entries := GenerateManifest(NewElevenLabsFormatter().Format(segments), NewBatchConfig("out"), "en")
+
+	e := entries[0]
+	if e.FadeInMs != 200 || e.FadeOutMs != 1500 || e.GainDB != -3 || !e.HasEffects() {
+		t.Errorf("unexpected effects: %+v", e)
+	}
+	if entries[1].HasEffects() {
+		t.Error("expected no effects on plain segment")
+	}
+
+	script.Slides[0].Segments[1].FadeOut = "soon"
+	if issues := script.Validate(); len(issues) != 1 || !strings.Contains(issues[0], "fade_out") {
+		t.Errorf("expected invalid fade_out issue, got %v", issues)
+	}
+}
+
+func TestCompilerPrerecordedAudio(t *testing.T) {
+	script, err := ParseScript([]byte(`{
+		"default_voices": {"en": "narrator", "es": "narrador"},
+		"slides": [{
+			"segments": [
+				{"id": "intro", "text": {"en": "Welcome from our CEO."}, "audio_file": {"en": "assets/ceo_intro_en.WAV"}},
+				{"audio_file": {"en": "assets/jingle.mp3", "es": "assets/jingle.mp3"}},
+				{"text": {"en": "Narration.", "es": "Narración."}}
+			]
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseScript() error = %v", err)
+	}
+	if issues := script.Validate(); len(issues) != 0 {
+		t.Errorf("Validate() = %v", issues)
+	}
+
+	compiler := NewCompiler()
+	result, err := compiler.CompileWithResult(script, "en")
+	if err != nil {
+		t.Fatalf("CompileWithResult() error = %v", err)
+	}
+	if len(result.Segments) != 3 || len(result.Skipped) != 0 {
+		t.Fatalf("expected 3 segments and none skipped, got %+v", result)
+	}
+	if result.Segments[0].AudioFile != "assets/ceo_intro_en.WAV" || result.Segments[0].Text != "Welcome from our CEO." {
+		t.Errorf("segment 0 = %+v", result.Segments[0])
+	}
+	if result.Segments[1].AudioFile != "assets/jingle.mp3" || result.Segments[1].Text != "" {
+		t.Errorf("segment 1 = %+v", result.Segments[1])
+	}
+
+	jobs := NewElevenLabsFormatter().Format(result.Segments)
+	if !jobs[0].IsPrerecorded() || jobs[2].IsPrerecorded() {
+		t.Errorf("IsPrerecorded() mismatch: %+v", jobs)
+	}
+	if jobs[0].SuggestedFilename != "slide01_seg01.wav" {
+		t.Errorf("SuggestedFilename = %q", jobs[0].SuggestedFilename)
+	}
+
+	config := NewBatchConfig("out")
+	entries := GenerateManifest(jobs, config, "en")
+	if entries[0].OutputFile != "out/slide01_seg01_en.wav" || entries[0].AudioFile != "assets/ceo_intro_en.WAV" {
+		t.Errorf("manifest entry = %+v", entries[0])
+	}
+	if entries[1].OutputFile != "out/slide01_seg02_en.mp3" {
+		t.Errorf("OutputFile = %q", entries[1].OutputFile)
+	}
+
+	// Replacing the recording changes the hash
+	moved := jobs[1]
+	moved.AudioFile = "assets/jingle_v2.mp3"
+	if SegmentHash(moved, "") == SegmentHash(jobs[1], "") {
+		t.Error("SegmentHash() should change with AudioFile")
+	}
+
+	// Voices of pre-recorded segments need not exist
+	if ids := ManifestVoiceIDs(entries); len(ids) != 1 || ids[0] != "narrator" {
+		t.Errorf("ManifestVoiceIDs() = %v", ids)
+	}
+
+	// The intro has no Spanish text or recording
+	es, err := compiler.CompileWithResult(script, "es")
+	if err != nil {
+		t.Fatalf("CompileWithResult(es) error = %v", err)
+	}
+	if len(es.Segments) != 2 || len(es.Skipped) != 1 || es.Segments[0].AudioFile != "assets/jingle.mp3" {
+		t.Errorf("es result = %+v", es)
+	}
+}
+
+func TestCompilerSpeakerChangeEarcon(t *testing.T) {
+	script, err := ParseScript([]byte(`{
+		"default_voices": {"en": "narrator"},
+		"speaker_change_earcon": {"audio_file": "assets/chime.mp3", "prompt": "soft chime", "duration": "600ms", "pause_after": "200ms", "gain_db": -6},
+		"slides": [{
+			"segments": [
+				{"text": {"en": "Welcome."}},
+				{"text": {"en": "Thanks."}},
+				{"text": {"en": "Hi, I'm the guest."}, "voice": {"en": "guest"}},
+				{"text": {"en": "Back to you."}}
+			]
+		}, {
+			"segments": [{"text": {"en": "Guest again."}, "voice": {"en": "guest"}}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseScript() error = %v", err)
+	}
+	if issues := script.Validate(); len(issues) != 0 {
+		t.Errorf("Validate() = %v", issues)
+	}
+
+	segments, err := NewCompiler().Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	// Earcons precede segments 3 and 4 but not the next slide
+	var got []string
+	for _, seg := range segments {
+		ref := fmt.Sprintf("%d:%d", seg.SlideIndex+1, seg.SegmentIndex+1)
+		if seg.IsEarcon {
+			ref += "e"
+		}
+		got = append(got, ref)
+	}
+	if want := "1:1 1:2 1:3e 1:3 1:4e 1:4 2:1"; strings.Join(got, " ") != want {
+		t.Fatalf("segments = %v, want %s", got, want)
+	}
+	earcon := segments[2]
+	if earcon.AudioFile != "assets/chime.mp3" || earcon.Text != "" || earcon.PauseAfterMs != 200 || earcon.GainDB != -6 {
+		t.Errorf("earcon = %+v", earcon)
+	}
+
+	entries := GenerateManifest(NewElevenLabsFormatter().Format(segments), NewBatchConfig("out"), "en")
+	if entries[2].OutputFile != "out/slide01_seg03_earcon_en.mp3" || !entries[2].IsEarcon {
+		t.Errorf("earcon entry = %+v", entries[2])
+	}
+	if entries[2].SegmentRef() == entries[3].SegmentRef() {
+		t.Errorf("SegmentRef() = %q for both earcon and segment", entries[2].SegmentRef())
+	}
+
+	// Sorting keeps each earcon before its segment
+	reversed := slices.Clone(entries)
+	slices.Reverse(reversed)
+	SortManifest(reversed)
+	for i := range entries {
+		if reversed[i].OutputFile != entries[i].OutputFile {
+			t.Errorf("SortManifest()[%d] = %s, want %s", i, reversed[i].OutputFile, entries[i].OutputFile)
+		}
+	}
+
+	// Earcons are not speech
+	if ssml := NewSSMLFormatter().Format(segments, "en"); strings.Contains(ssml, "chime") {
+		t.Errorf("SSML contains earcon: %s", ssml)
+	}
+
+	script.SpeakerChangeEarcon = &Earcon{Duration: "50ms"}
+	if issues := script.Validate(); len(issues) != 2 {
+		t.Errorf("Validate() = %v, want missing audio file and invalid duration", issues)
+	}
+}
+
+func TestCompilerCrossfade(t *testing.T) {
+	script, err := ParseScript([]byte(`{
+		"default_voices": {"en": "narrator"},
+		"default_crossfade": "50ms",
+		"slides": [{
+			"segments": [
+				{"text": {"en": "One."}},
+				{"text": {"en": "Two."}},
+				{"text": {"en": "Guest."}, "voice": {"en": "guest"}},
+				{"text": {"en": "Three."}, "pause_after": "300ms"},
+				{"text": {"en": "Four."}}
+			]
+		}, {
+			"crossfade": "0ms",
+			"segments": [{"text": {"en": "Five."}}, {"text": {"en": "Six."}}]
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("ParseScript() error = %v", err)
+	}
+	if issues := script.Validate(); len(issues) != 0 {
+		t.Errorf("Validate() = %v", issues)
+	}
+	c := NewCompiler()
+	c.DefaultPauseAfterSlide = ""
+	segments, err := c.Compile(script, "en")
+	if err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+
+	// Only same-voice segments with no pause between them crossfade
+	var got []int
+	for _, seg := range segments {
+		got = append(got, seg.CrossfadeMs)
+	}
+	if want := []int{0, 50, 0, 0, 0, 0, 0}; !slices.Equal(got, want) {
+		t.Errorf("CrossfadeMs = %v, want %v", got, want)
+	}
+	entries := GenerateManifest(NewElevenLabsFormatter().Format(segments), NewBatchConfig("out"), "en")
+	if entries[1].CrossfadeMs != 50 {
+		t.Errorf("manifest CrossfadeMs = %d, want 50", entries[1].CrossfadeMs)
+	}
+
+	script.Slides[1].Crossfade = "2s"
+	if issues := script.Validate(); len(issues) != 1 || !strings.Contains(issues[0], "crossfade") {
+		t.Errorf("Validate() = %v, want crossfade issue", issues)
+	}
+}
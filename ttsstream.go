@@ -0,0 +1,179 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// GenerateStream generates speech from text and returns the audio as it is
+// generated, for playback before generation finishes. The caller must
+// close the returned reader.
+//
+// If the connection fails partway, reading returns a StreamInterruptedError
+// with the number of bytes already delivered. With WithStreamRetries and a
+// request Seed, the stream is instead resumed: the request is repeated and
+// the bytes already delivered are skipped.
+func (s *TextToSpeechService) GenerateStream(ctx context.Context, req *TTSRequest) (io.ReadCloser, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.checkModel(ctx, req); err != nil {
+		return nil, err
+	}
+	if err := s.client.checkOutputFormat(ctx, EndpointTextToSpeech, req.OutputFormat); err != nil {
+		return nil, err
+	}
+	resp, err := s.openStream(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &resumingStream{ctx: ctx, service: s, req: req, body: resp.Body, attempts: 1}, nil
+}
+
+// ttsStreamBody is the JSON body of a streaming TTS request.
+type ttsStreamBody struct {
+	Text          string                 `json:"text"`
+	ModelID       string                 `json:"model_id"`
+	VoiceSettings *OptionalVoiceSettings `json:"voice_settings,omitempty"`
+	LanguageCode  string                 `json:"language_code,omitempty"`
+	Seed          int                    `json:"seed,omitempty"`
+
+	PreviousText       string   `json:"previous_text,omitempty"`
+	NextText           string   `json:"next_text,omitempty"`
+	PreviousRequestIDs []string `json:"previous_request_ids,omitempty"`
+}
+
+// openStream sends a streaming TTS request. The generated client buffers
+// the whole response, so the request is made directly to read the audio
+// as it arrives.
+func (s *TextToSpeechService) openStream(ctx context.Context, req *TTSRequest) (*http.Response, error) {
+	body := ttsStreamBody{
+		Text:         req.Text,
+		ModelID:      req.ModelID,
+		LanguageCode: req.LanguageCode,
+		Seed:         req.Seed,
+
+		PreviousText:       req.PreviousText,
+		NextText:           req.NextText,
+		PreviousRequestIDs: req.PreviousRequestIDs,
+	}
+	if body.ModelID == "" {
+		body.ModelID = DefaultModelID
+	}
+	if vs := req.VoiceSettings; vs != nil {
+		settings := vs.Optional()
+		body.VoiceSettings = &settings
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	path := "/v1/text-to-speech/" + url.PathEscape(req.VoiceID) + "/stream"
+	q := url.Values{}
+	if req.OutputFormat != "" {
+		q.Set("output_format", req.OutputFormat)
+	}
+	if req.OptimizeStreamingLatency > 0 {
+		q.Set("optimize_streaming_latency", strconv.Itoa(int(req.OptimizeStreamingLatency)))
+	}
+	if len(q) > 0 {
+		path += "?" + q.Encode()
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", s.client.baseURL+path, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	s.client.headers.apply(httpReq.Header)
+
+	resp, err := s.client.streamHTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(respBody),
+		}
+	}
+	return resp, nil
+}
+
+// resumingStream reads a streaming TTS response, resuming it after a
+// connection failure when the request is deterministic.
+type resumingStream struct {
+	ctx      context.Context
+	service  *TextToSpeechService
+	req      *TTSRequest
+	body     io.ReadCloser
+	attempts int
+
+	// delivered is the number of bytes returned by Read.
+	delivered int64
+}
+
+// Read implements io.Reader.
+func (r *resumingStream) Read(p []byte) (int, error) {
+	for {
+		n, err := r.body.Read(p)
+		r.delivered += int64(n)
+		if err == nil || err == io.EOF || !isStreamInterruption(err) {
+			return n, err
+		}
+		if n > 0 {
+			// Deliver what arrived; the next Read sees the failure again
+			r.body.Close()
+			r.body = failedBody{err: err}
+			return n, nil
+		}
+		if rerr := r.resume(err); rerr != nil {
+			return 0, rerr
+		}
+	}
+}
+
+// resume reopens the stream after err and skips the bytes already
+// delivered. Without a seed the audio would differ, so it gives up.
+func (r *resumingStream) resume(err error) error {
+	r.body.Close()
+	r.body = failedBody{err: err}
+	if r.req.Seed <= 0 || r.attempts > r.service.client.streamRetries || r.ctx.Err() != nil {
+		return &StreamInterruptedError{Delivered: r.delivered, Attempts: r.attempts, Err: err}
+	}
+
+	r.attempts++
+	resp, openErr := r.service.openStream(r.ctx, r.req)
+	if openErr != nil {
+		return &StreamInterruptedError{Delivered: r.delivered, Attempts: r.attempts, Err: openErr}
+	}
+	r.body = resp.Body
+	if _, skipErr := io.CopyN(io.Discard, r.body, r.delivered); skipErr != nil {
+		if isStreamInterruption(skipErr) {
+			return r.resume(skipErr)
+		}
+		return &StreamInterruptedError{Delivered: r.delivered, Attempts: r.attempts, Err: skipErr}
+	}
+	return nil
+}
+
+// Close implements io.Closer.
+func (r *resumingStream) Close() error {
+	return r.body.Close()
+}
+
+// failedBody stands in for a response body after a connection failure.
+type failedBody struct {
+	err error
+}
+
+func (b failedBody) Read([]byte) (int, error) { return 0, b.err }
+func (b failedBody) Close() error             { return nil }
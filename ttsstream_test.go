@@ -0,0 +1,121 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newInterruptingTTSServer serves "hello world" as audio, but cuts off the
+// first `failures` responses after "hello".
+func newInterruptingTTSServer(t *testing.T, failures int) (*httptest.Server, *int) {
+	t.Helper()
+	const audio = "hello world"
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if seed, ok := body["seed"]; ok && seed != float64(42) {
+			t.Errorf("seed = %v, want 42", seed)
+		}
+
+		w.Header().Set("Content-Type", "audio/mpeg")
+		w.Header().Set("Content-Length", strconv.Itoa(len(audio)))
+		if requests <= failures {
+			// The connection closes short of Content-Length
+			_, _ = w.Write([]byte(audio[:5]))
+			return
+		}
+		_, _ = w.Write([]byte(audio))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestGenerateStreamResumes(t *testing.T) {
+	server, requests := newInterruptingTTSServer(t, 1)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithStreamRetries(2))
+
+	stream, err := client.TextToSpeech().GenerateStream(context.Background(), &TTSRequest{
+		VoiceID: "voice1",
+		Text:    "Hello world",
+		Seed:    42,
+	})
+	if err != nil {
+		t.Fatalf("GenerateStream() error = %v", err)
+	}
+	defer stream.Close()
+
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		t.Fatalf("reading stream: %v", err)
+	}
+	if string(data) != "hello world" {
+		t.Errorf("audio = %q, want the resumed audio without repeats", data)
+	}
+	if *requests != 2 {
+		t.Errorf("requests = %d, want 2", *requests)
+	}
+}
+
+func TestGenerateStreamInterruptedWithoutSeed(t *testing.T) {
+	server, requests := newInterruptingTTSServer(t, 1)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithStreamRetries(2))
+
+	stream, err := client.TextToSpeech().GenerateStream(context.Background(), &TTSRequest{
+		VoiceID: "voice1",
+		Text:    "Hello world",
+	})
+	if err != nil {
+		t.Fatalf("GenerateStream() error = %v", err)
+	}
+	defer stream.Close()
+
+	data, err := io.ReadAll(stream)
+	if !errors.Is(err, ErrStreamInterrupted) {
+		t.Fatalf("reading stream error = %v, want ErrStreamInterrupted", err)
+	}
+	var interrupted *StreamInterruptedError
+	if !errors.As(err, &interrupted) || interrupted.Delivered != 5 {
+		t.Errorf("error = %#v, want 5 bytes delivered", err)
+	}
+	if string(data) != "hello" || *requests != 1 {
+		t.Errorf("audio = %q after %d requests, want the first part and no retry", data, *requests)
+	}
+}
+
+func TestGenerateRetriesInterruptedResponse(t *testing.T) {
+	server, requests := newInterruptingTTSServer(t, 1)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), WithStreamRetries(1))
+
+	resp, err := client.TextToSpeech().Generate(context.Background(), &TTSRequest{
+		VoiceID: "voice1",
+		Text:    "Hello world",
+	})
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	data, _ := io.ReadAll(resp.Audio)
+	if string(data) != "hello world" || *requests != 2 {
+		t.Errorf("audio = %q after %d requests, want the full audio after a retry", data, *requests)
+	}
+}
+
+func TestGenerateInterruptedResponse(t *testing.T) {
+	server, _ := newInterruptingTTSServer(t, 1)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	_, err := client.TextToSpeech().Generate(context.Background(), &TTSRequest{
+		VoiceID: "voice1",
+		Text:    "Hello world",
+	})
+	if !errors.Is(err, ErrStreamInterrupted) || !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Generate() error = %v, want ErrStreamInterrupted wrapping io.ErrUnexpectedEOF", err)
+	}
+}
@@ -14,44 +14,6 @@ type TwilioService struct {
 	client *Client
 }
 
-// postJSON is a helper for making JSON POST requests.
-func (s *TwilioService) postJSON(ctx context.Context, path string, req any, result any) error {
-	body, err := json.Marshal(req)
-	if err != nil {
-		return fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	httpReq, err := http.NewRequestWithContext(ctx, "POST",
-		s.client.baseURL+path,
-		bytes.NewReader(body))
-	if err != nil {
-		return err
-	}
-
-	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
-
-	resp, err := http.DefaultClient.Do(httpReq)
-	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		respBody, _ := io.ReadAll(resp.Body)
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    string(respBody),
-		}
-	}
-
-	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
-		return fmt.Errorf("failed to decode response: %w", err)
-	}
-
-	return nil
-}
-
 // TwilioRegisterCallRequest is the request to register an incoming Twilio call.
 type TwilioRegisterCallRequest struct {
 	// AgentID is the ElevenLabs agent ID to handle the call.
@@ -60,6 +22,15 @@ type TwilioRegisterCallRequest struct {
 	// AgentPhoneNumberID is the ElevenLabs phone number ID (if using imported number).
 	AgentPhoneNumberID string `json:"agent_phone_number_id,omitempty"`
 
+	// FromNumber is the caller's number, from the webhook's From parameter.
+	FromNumber string `json:"from_number,omitempty"`
+
+	// ToNumber is the called number, from the webhook's To parameter.
+	ToNumber string `json:"to_number,omitempty"`
+
+	// Direction is "inbound" or "outbound".
+	Direction string `json:"direction,omitempty"`
+
 	// CustomLLMExtraBody is additional data to pass to the LLM.
 	CustomLLMExtraBody map[string]any `json:"custom_llm_extra_body,omitempty"`
 
@@ -161,10 +132,21 @@ func (s *TwilioService) RegisterCall(ctx context.Context, req *TwilioRegisterCal
 		return nil, &APIError{Message: "agent_id is required"}
 	}
 
-	var result TwilioRegisterCallResponse
-	if err := s.postJSON(ctx, "/v1/convai/twilio/register-call", req, &result); err != nil {
+	body, err := s.client.postForBytes(ctx, "/v1/convai/twilio/register-call", req)
+	if err != nil {
 		return nil, err
 	}
+
+	// The API returns the TwiML document itself; older responses wrap it
+	// in JSON with the conversation ID
+	var result TwilioRegisterCallResponse
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '{' {
+		if err := json.Unmarshal(trimmed, &result); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		return &result, nil
+	}
+	result.TwiML = string(body)
 	return &result, nil
 }
 
@@ -181,7 +163,7 @@ func (s *TwilioService) OutboundCall(ctx context.Context, req *TwilioOutboundCal
 	}
 
 	var result TwilioOutboundCallResponse
-	if err := s.postJSON(ctx, "/v1/convai/twilio/outbound-call", req, &result); err != nil {
+	if err := s.client.postJSON(ctx, "/v1/convai/twilio/outbound-call", req, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -200,7 +182,7 @@ func (s *TwilioService) SIPOutboundCall(ctx context.Context, req *SIPOutboundCal
 	}
 
 	var result SIPOutboundCallResponse
-	if err := s.postJSON(ctx, "/v1/convai/sip-trunk/outbound-call", req, &result); err != nil {
+	if err := s.client.postJSON(ctx, "/v1/convai/sip-trunk/outbound-call", req, &result); err != nil {
 		return nil, err
 	}
 	return &result, nil
@@ -236,9 +218,9 @@ func (s *PhoneNumberService) List(ctx context.Context) ([]PhoneNumber, error) {
 		return nil, err
 	}
 
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
+	s.client.headers.apply(httpReq.Header)
 
-	resp, err := http.DefaultClient.Do(httpReq)
+	resp, err := s.client.rawHTTPClient.Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -273,9 +255,9 @@ func (s *PhoneNumberService) Get(ctx context.Context, phoneNumberID string) (*Ph
 		return nil, err
 	}
 
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
+	s.client.headers.apply(httpReq.Header)
 
-	resp, err := http.DefaultClient.Do(httpReq)
+	resp, err := s.client.rawHTTPClient.Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -325,9 +307,9 @@ func (s *PhoneNumberService) Update(ctx context.Context, phoneNumberID string, r
 	}
 
 	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
+	s.client.headers.apply(httpReq.Header)
 
-	resp, err := http.DefaultClient.Do(httpReq)
+	resp, err := s.client.rawHTTPClient.Do(httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
@@ -362,9 +344,9 @@ func (s *PhoneNumberService) Delete(ctx context.Context, phoneNumberID string) e
 		return err
 	}
 
-	httpReq.Header.Set("xi-api-key", s.client.apiKey)
+	s.client.headers.apply(httpReq.Header)
 
-	resp, err := http.DefaultClient.Do(httpReq)
+	resp, err := s.client.rawHTTPClient.Do(httpReq)
 	if err != nil {
 		return fmt.Errorf("request failed: %w", err)
 	}
@@ -0,0 +1,230 @@
+package elevenlabs
+
+import (
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // Twilio signs requests with HMAC-SHA1
+	"encoding/base64"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// TwilioSignatureHeader is the header carrying Twilio's request signature.
+const TwilioSignatureHeader = "X-Twilio-Signature"
+
+// DefaultTwilioErrorMessage is spoken to callers when their call can't be
+// connected to the agent.
+const DefaultTwilioErrorMessage = "Sorry, we couldn't connect your call. Please try again later."
+
+// TwilioCall is the call described by a Twilio voice webhook.
+type TwilioCall struct {
+	// CallSID is the Twilio call SID.
+	CallSID string
+
+	// AccountSID is the Twilio account SID.
+	AccountSID string
+
+	// From is the caller's number.
+	From string
+
+	// To is the called number.
+	To string
+
+	// Direction is the Twilio call direction, such as "inbound".
+	Direction string
+
+	// CallerName is the caller ID name, if Twilio looked it up.
+	CallerName string
+}
+
+// ParseTwilioCall reads the call parameters from a Twilio webhook request.
+func ParseTwilioCall(r *http.Request) (*TwilioCall, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, err
+	}
+	return &TwilioCall{
+		CallSID:    r.PostFormValue("CallSid"),
+		AccountSID: r.PostFormValue("AccountSid"),
+		From:       r.PostFormValue("From"),
+		To:         r.PostFormValue("To"),
+		Direction:  r.PostFormValue("Direction"),
+		CallerName: r.PostFormValue("CallerName"),
+	}, nil
+}
+
+// TwilioSignature computes the signature Twilio sends in
+// TwilioSignatureHeader: the base64 HMAC-SHA1, keyed by the account's auth
+// token, of the full request URL followed by each POST parameter's name
+// and value, sorted by name.
+func TwilioSignature(authToken, requestURL string, params url.Values) string {
+	var sb strings.Builder
+	sb.WriteString(requestURL)
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		values := append([]string(nil), params[name]...)
+		sort.Strings(values)
+		for _, v := range values {
+			sb.WriteString(name)
+			sb.WriteString(v)
+		}
+	}
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(sb.String()))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateTwilioRequest reports whether a webhook request carries a valid
+// Twilio signature. requestURL is the URL configured in Twilio; if empty,
+// it is rebuilt from the request, which is only correct when the server
+// sees the same scheme, host, and path Twilio called. Behind a proxy or
+// load balancer, such as one that terminates TLS, pass requestURL.
+func ValidateTwilioRequest(r *http.Request, authToken, requestURL string) bool {
+	return validateTwilioRequest(r, authToken, requestURL, false)
+}
+
+// validateTwilioRequest is ValidateTwilioRequest, optionally taking the
+// scheme of a rebuilt URL from X-Forwarded-Proto.
+func validateTwilioRequest(r *http.Request, authToken, requestURL string, trustForwardedProto bool) bool {
+	signature := r.Header.Get(TwilioSignatureHeader)
+	if signature == "" || authToken == "" {
+		return false
+	}
+	if err := r.ParseForm(); err != nil {
+		return false
+	}
+	if requestURL == "" {
+		requestURL = twilioRequestURL(r, trustForwardedProto)
+	}
+	expected := TwilioSignature(authToken, requestURL, r.PostForm)
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// twilioRequestURL rebuilds the URL a request was sent to. Any client can
+// set X-Forwarded-Proto, so it is only honored if trustForwardedProto.
+func twilioRequestURL(r *http.Request, trustForwardedProto bool) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" && trustForwardedProto {
+		scheme = strings.TrimSpace(strings.Split(proto, ",")[0])
+	}
+	return scheme + "://" + r.Host + r.URL.RequestURI()
+}
+
+// TwilioWebhookOptions configures TwilioService.WebhookHandler.
+type TwilioWebhookOptions struct {
+	// AgentID is the agent that answers calls. Required unless Request
+	// sets it.
+	AgentID string
+
+	// AuthToken is the Twilio auth token. Requests without a valid
+	// signature are rejected with 403 Forbidden. Required unless
+	// InsecureSkipSignature is set; without it, every request is rejected.
+	AuthToken string
+
+	// InsecureSkipSignature accepts requests without checking their
+	// signature, for local testing. Anyone who finds the URL can then
+	// register calls with the agent, billed to the account.
+	InsecureSkipSignature bool
+
+	// URL is the webhook URL configured in Twilio, used to check
+	// signatures. Set it behind a proxy or load balancer that changes the
+	// scheme, host, or path. Defaults to the URL rebuilt from the request.
+	URL string
+
+	// TrustForwardedProto takes the scheme of the rebuilt URL from the
+	// X-Forwarded-Proto header, for a proxy that terminates TLS and sets
+	// it. Only set it if the proxy overwrites the header, since any client
+	// can send one. Ignored if URL is set.
+	TrustForwardedProto bool
+
+	// Request, if set, builds the register call request for a call, for
+	// example to choose the agent or add dynamic variables. By default,
+	// the call's numbers are passed with AgentID and the caller_number
+	// and call_sid dynamic variables.
+	Request func(r *http.Request, call *TwilioCall) (*TwilioRegisterCallRequest, error)
+
+	// ErrorMessage is spoken to the caller if the call can't be registered.
+	// Defaults to DefaultTwilioErrorMessage.
+	ErrorMessage string
+
+	// OnError, if set, is called with errors registering calls.
+	OnError func(r *http.Request, err error)
+}
+
+// WebhookHandler returns an http.Handler for Twilio's incoming call
+// webhook. It checks the request signature, rejecting every request if
+// AuthToken is not set, registers the call with RegisterCall, and responds
+// with the returned TwiML, which connects the caller to the agent. If
+// registration fails, the caller hears ErrorMessage and the call ends.
+//
+// Example:
+//
+//	http.Handle("/twilio/voice", client.Twilio().WebhookHandler(&elevenlabs.TwilioWebhookOptions{
+//	    AgentID:   os.Getenv("ELEVENLABS_AGENT_ID"),
+//	    AuthToken: os.Getenv("TWILIO_AUTH_TOKEN"),
+//	}))
+func (s *TwilioService) WebhookHandler(opts *TwilioWebhookOptions) http.Handler {
+	if opts == nil {
+		opts = &TwilioWebhookOptions{}
+	}
+	errorMessage := opts.ErrorMessage
+	if errorMessage == "" {
+		errorMessage = DefaultTwilioErrorMessage
+	}
+	fail := func(w http.ResponseWriter, r *http.Request, err error) {
+		if opts.OnError != nil {
+			opts.OnError(r, err)
+		}
+		_ = WriteTwiML(w, TwiMLSayHangup(errorMessage))
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if !opts.InsecureSkipSignature && !validateTwilioRequest(r, opts.AuthToken, opts.URL, opts.TrustForwardedProto) {
+			http.Error(w, "invalid Twilio signature", http.StatusForbidden)
+			return
+		}
+		call, err := ParseTwilioCall(r)
+		if err != nil {
+			http.Error(w, "invalid form data", http.StatusBadRequest)
+			return
+		}
+
+		var req *TwilioRegisterCallRequest
+		if opts.Request != nil {
+			req, err = opts.Request(r, call)
+			if err != nil {
+				fail(w, r, err)
+				return
+			}
+		} else {
+			req = &TwilioRegisterCallRequest{
+				AgentID:    opts.AgentID,
+				FromNumber: call.From,
+				ToNumber:   call.To,
+				Direction:  "inbound",
+				DynamicVariables: map[string]string{
+					"caller_number": call.From,
+					"call_sid":      call.CallSID,
+				},
+			}
+		}
+
+		resp, err := s.RegisterCall(r.Context(), req)
+		if err != nil {
+			fail(w, r, err)
+			return
+		}
+		_ = WriteTwiML(w, resp.TwiML)
+	})
+}
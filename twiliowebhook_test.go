@@ -0,0 +1,126 @@
+package elevenlabs
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+var twilioTestParams = url.Values{
+	"CallSid": {"CA123"},
+	"From":    {"+15551230000"},
+	"To":      {"+15559870000"},
+}
+
+func TestTwilioSignature(t *testing.T) {
+	got := TwilioSignature("secret", "https://example.com/twilio/voice", twilioTestParams)
+	if want := "6jPtZxaUttfSrH89WQhW/NATl6M="; got != want {
+		t.Errorf("TwilioSignature() = %q, want %q", got, want)
+	}
+}
+
+func newTwilioWebhookRequest(signature string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "https://example.com/twilio/voice", strings.NewReader(twilioTestParams.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if signature != "" {
+		r.Header.Set(TwilioSignatureHeader, signature)
+	}
+	return r
+}
+
+func TestTwilioWebhookHandler(t *testing.T) {
+	var registered map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&registered)
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(`<Response><Connect><Stream url="wss://example"/></Connect></Response>`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	handler := client.Twilio().WebhookHandler(&TwilioWebhookOptions{AgentID: "agent1", AuthToken: "secret"})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTwilioWebhookRequest("6jPtZxaUttfSrH89WQhW/NATl6M="))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "<Connect>") {
+		t.Fatalf("response = %d %s", w.Code, w.Body.String())
+	}
+	if ct := w.Header().Get("Content-Type"); ct != TwiMLContentType {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if registered["agent_id"] != "agent1" || registered["from_number"] != "+15551230000" || registered["direction"] != "inbound" {
+		t.Errorf("register call request = %v", registered)
+	}
+
+	for _, signature := range []string{"", "bad"} {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newTwilioWebhookRequest(signature))
+		if w.Code != http.StatusForbidden {
+			t.Errorf("signature %q: status = %d, want 403", signature, w.Code)
+		}
+	}
+}
+
+func TestTwilioWebhookHandlerRegisterError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"detail": "agent not found"}`, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	var handlerErr error
+	handler := client.Twilio().WebhookHandler(&TwilioWebhookOptions{
+		AgentID:               "agent1",
+		InsecureSkipSignature: true,
+		ErrorMessage:          "Lines are busy & closed.",
+		OnError:               func(r *http.Request, err error) { handlerErr = err },
+	})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTwilioWebhookRequest(""))
+	if !strings.Contains(w.Body.String(), "<Say>Lines are busy &amp; closed.</Say>") || !strings.Contains(w.Body.String(), "<Hangup></Hangup>") {
+		t.Errorf("TwiML = %s", w.Body.String())
+	}
+	if handlerErr == nil {
+		t.Error("OnError was not called")
+	}
+}
+
+func TestTwilioWebhookHandlerFailsClosed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	handler := client.Twilio().WebhookHandler(&TwilioWebhookOptions{AgentID: "agent1"})
+
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, newTwilioWebhookRequest("6jPtZxaUttfSrH89WQhW/NATl6M="))
+	if w.Code != http.StatusForbidden {
+		t.Errorf("status without AuthToken = %d, want 403", w.Code)
+	}
+}
+
+func TestValidateTwilioRequestForwardedProto(t *testing.T) {
+	// Behind a TLS-terminating proxy, the server sees plain HTTP
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "http://example.com/twilio/voice", strings.NewReader(twilioTestParams.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.Header.Set(TwilioSignatureHeader, "6jPtZxaUttfSrH89WQhW/NATl6M=")
+		r.Header.Set("X-Forwarded-Proto", "https")
+		return r
+	}
+	if ValidateTwilioRequest(newRequest(), "secret", "") {
+		t.Error("X-Forwarded-Proto honored without TrustForwardedProto")
+	}
+	if !validateTwilioRequest(newRequest(), "secret", "", true) {
+		t.Error("X-Forwarded-Proto ignored with TrustForwardedProto")
+	}
+	if !ValidateTwilioRequest(newRequest(), "secret", "https://example.com/twilio/voice") {
+		t.Error("configured URL rejected")
+	}
+}
@@ -0,0 +1,166 @@
+package elevenlabs
+
+import (
+	"encoding/xml"
+	"io"
+	"net/http"
+)
+
+// TwiMLContentType is the content type of TwiML responses.
+const TwiMLContentType = "text/xml; charset=utf-8"
+
+// TwiML is a TwiML response document: the verbs Twilio executes, in
+// order. Build one with NewTwiML and write it with WriteTwiMLResponse:
+//
+//	twiml := elevenlabs.NewTwiML(
+//	    &elevenlabs.TwiMLSay{Text: "Please hold."},
+//	    &elevenlabs.TwiMLRedirect{URL: "https://example.com/twilio/queue"},
+//	)
+type TwiML struct {
+	XMLName xml.Name    `xml:"Response"`
+	Verbs   []TwiMLVerb `xml:",any"`
+}
+
+// TwiMLVerb is a TwiML verb, such as *TwiMLSay or *TwiMLHangup.
+type TwiMLVerb interface {
+	twimlVerb()
+}
+
+// NewTwiML returns a TwiML response with the given verbs.
+func NewTwiML(verbs ...TwiMLVerb) *TwiML {
+	return &TwiML{Verbs: verbs}
+}
+
+// Marshal returns the TwiML document, with an XML declaration.
+func (t *TwiML) Marshal() ([]byte, error) {
+	data, err := xml.MarshalIndent(t, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
+
+// String returns the TwiML document, or an empty string if it can't be
+// marshaled.
+func (t *TwiML) String() string {
+	data, err := t.Marshal()
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// TwiMLSay speaks text to the caller.
+type TwiMLSay struct {
+	XMLName xml.Name `xml:"Say"`
+
+	// Text is the text to speak.
+	Text string `xml:",chardata"`
+
+	// Voice is the Twilio voice, such as "Polly.Joanna". Optional.
+	Voice string `xml:"voice,attr,omitempty"`
+
+	// Language is the language of Text, such as "en-US". Optional.
+	Language string `xml:"language,attr,omitempty"`
+
+	// Loop is how many times to repeat Text. Optional.
+	Loop int `xml:"loop,attr,omitempty"`
+}
+
+// TwiMLPlay plays an audio file to the caller.
+type TwiMLPlay struct {
+	XMLName xml.Name `xml:"Play"`
+
+	// URL is the audio to play.
+	URL string `xml:",chardata"`
+
+	// Loop is how many times to play the audio. Optional.
+	Loop int `xml:"loop,attr,omitempty"`
+}
+
+// TwiMLPause waits silently.
+type TwiMLPause struct {
+	XMLName xml.Name `xml:"Pause"`
+
+	// Length is the pause in seconds. Defaults to 1.
+	Length int `xml:"length,attr,omitempty"`
+}
+
+// TwiMLRedirect continues the call with the TwiML at another URL.
+type TwiMLRedirect struct {
+	XMLName xml.Name `xml:"Redirect"`
+
+	// URL is the TwiML to continue with.
+	URL string `xml:",chardata"`
+
+	// Method is the HTTP method used to fetch URL. Defaults to POST.
+	Method string `xml:"method,attr,omitempty"`
+}
+
+// TwiMLConnectStream connects the call's audio to a WebSocket stream, as
+// ElevenLabs agents are connected.
+type TwiMLConnectStream struct {
+	XMLName xml.Name `xml:"Connect"`
+
+	// Stream is the stream to connect to.
+	Stream TwiMLStream `xml:"Stream"`
+}
+
+// TwiMLStream is the WebSocket stream of a TwiMLConnectStream.
+type TwiMLStream struct {
+	// URL is the wss:// URL of the stream.
+	URL string `xml:"url,attr"`
+
+	// Parameters are sent to the stream when it starts.
+	Parameters []TwiMLParameter `xml:"Parameter,omitempty"`
+}
+
+// TwiMLParameter is a custom parameter of a TwiMLStream.
+type TwiMLParameter struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+// TwiMLHangup ends the call.
+type TwiMLHangup struct {
+	XMLName xml.Name `xml:"Hangup"`
+}
+
+// TwiMLReject rejects an incoming call without answering it, so it is
+// not billed.
+type TwiMLReject struct {
+	XMLName xml.Name `xml:"Reject"`
+
+	// Reason is "rejected" or "busy". Optional.
+	Reason string `xml:"reason,attr,omitempty"`
+}
+
+func (*TwiMLSay) twimlVerb()           {}
+func (*TwiMLPlay) twimlVerb()          {}
+func (*TwiMLPause) twimlVerb()         {}
+func (*TwiMLRedirect) twimlVerb()      {}
+func (*TwiMLConnectStream) twimlVerb() {}
+func (*TwiMLHangup) twimlVerb()        {}
+func (*TwiMLReject) twimlVerb()        {}
+
+// TwiMLSayHangup returns TwiML that speaks a message and hangs up.
+func TwiMLSayHangup(message string) string {
+	return NewTwiML(&TwiMLSay{Text: message}, &TwiMLHangup{}).String()
+}
+
+// WriteTwiML writes a TwiML document as an HTTP response.
+func WriteTwiML(w http.ResponseWriter, twiml string) error {
+	w.Header().Set("Content-Type", TwiMLContentType)
+	_, err := io.WriteString(w, twiml)
+	return err
+}
+
+// WriteTwiMLResponse marshals a TwiML response and writes it as an HTTP
+// response.
+func WriteTwiMLResponse(w http.ResponseWriter, twiml *TwiML) error {
+	data, err := twiml.Marshal()
+	if err != nil {
+		return err
+	}
+	return WriteTwiML(w, string(data))
+}
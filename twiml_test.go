@@ -0,0 +1,43 @@
+package elevenlabs
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestTwiML(t *testing.T) {
+	twiml := NewTwiML(
+		&TwiMLSay{Text: "Hold & wait", Voice: "Polly.Joanna"},
+		&TwiMLPause{Length: 2},
+		&TwiMLConnectStream{Stream: TwiMLStream{
+			URL:        "wss://example.com/stream",
+			Parameters: []TwiMLParameter{{Name: "caller", Value: "+15551230000"}},
+		}},
+		&TwiMLHangup{},
+	)
+	got := twiml.String()
+	for _, want := range []string{
+		`<?xml version="1.0" encoding="UTF-8"?>`,
+		`<Say voice="Polly.Joanna">Hold &amp; wait</Say>`,
+		`<Pause length="2"></Pause>`,
+		`<Stream url="wss://example.com/stream">`,
+		`<Parameter name="caller" value="+15551230000"></Parameter>`,
+		`<Hangup></Hangup>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("TwiML missing %s:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "<Say") > strings.Index(got, "<Connect>") {
+		t.Errorf("verbs out of order:\n%s", got)
+	}
+
+	w := httptest.NewRecorder()
+	if err := WriteTwiMLResponse(w, NewTwiML(&TwiMLReject{Reason: "busy"})); err != nil {
+		t.Fatalf("WriteTwiMLResponse() error = %v", err)
+	}
+	if w.Header().Get("Content-Type") != TwiMLContentType || !strings.Contains(w.Body.String(), `<Reject reason="busy"></Reject>`) {
+		t.Errorf("response = %q %s", w.Header().Get("Content-Type"), w.Body.String())
+	}
+}
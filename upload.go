@@ -0,0 +1,124 @@
+package elevenlabs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+)
+
+// UploadProgressFunc is called as an upload file is sent. sent is the number
+// of file bytes sent so far; total is the file size, or -1 if unknown.
+//
+// The callback runs on the goroutine streaming the request body and should
+// return quickly.
+type UploadProgressFunc func(sent, total int64)
+
+// progressReader reports read progress to an UploadProgressFunc.
+type progressReader struct {
+	r     io.Reader
+	sent  int64
+	total int64
+	fn    UploadProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.sent += int64(n)
+		p.fn(p.sent, p.total)
+	}
+	return n, err
+}
+
+// withUploadProgress wraps r to report progress to fn. It returns r unchanged
+// if fn is nil.
+func withUploadProgress(r io.Reader, fn UploadProgressFunc) io.Reader {
+	if fn == nil {
+		return r
+	}
+	return &progressReader{r: r, total: readerSize(r), fn: fn}
+}
+
+// readerSize returns the number of bytes remaining in r, or -1 if unknown.
+func readerSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		return info.Size() - offset
+	case interface{ Size() int64 }:
+		return v.Size()
+	default:
+		return -1
+	}
+}
+
+// streamMultipart returns a request body that streams the multipart form
+// written by build through an io.Pipe, so files are never buffered in memory.
+// Writes block until the HTTP transport reads them, providing backpressure.
+// Errors from build are surfaced to the transport as read errors.
+func streamMultipart(build func(w *multipart.Writer) error) (io.ReadCloser, string) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	go func() {
+		err := build(mw)
+		if err == nil {
+			err = mw.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr, mw.FormDataContentType()
+}
+
+// writeFormFile copies a file into a multipart form field.
+func writeFormFile(w *multipart.Writer, field, filename string, r io.Reader) error {
+	part, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		return fmt.Errorf("failed to create %s form field: %w", field, err)
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return fmt.Errorf("failed to write %s: %w", field, err)
+	}
+	return nil
+}
+
+// postMultipart streams a multipart form to path and returns the response.
+// Non-200 responses are returned as an *APIError with the body closed.
+func (c *Client) postMultipart(ctx context.Context, path string, build func(w *multipart.Writer) error) (*http.Response, error) {
+	body, contentType := streamMultipart(build)
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, body)
+	if err != nil {
+		body.Close()
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	c.headers.apply(httpReq.Header)
+
+	resp, err := c.streamHTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &APIError{
+			StatusCode: resp.StatusCode,
+			Message:    string(respBody),
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,128 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithUploadProgress(t *testing.T) {
+	data := strings.Repeat("x", 100)
+
+	var calls int
+	var lastSent, lastTotal int64
+	r := withUploadProgress(strings.NewReader(data), func(sent, total int64) {
+		calls++
+		lastSent, lastTotal = sent, total
+	})
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		t.Fatalf("Copy() error = %v", err)
+	}
+	if calls == 0 || lastSent != 100 || lastTotal != 100 {
+		t.Errorf("progress calls=%d sent=%d total=%d, want sent=total=100", calls, lastSent, lastTotal)
+	}
+
+	plain := strings.NewReader(data)
+	if withUploadProgress(plain, nil) != io.Reader(plain) {
+		t.Error("expected reader to be returned unchanged without callback")
+	}
+
+	if readerSize(io.MultiReader(plain)) != -1 {
+		t.Error("expected unknown size for opaque reader")
+	}
+}
+
+func TestStreamMultipart(t *testing.T) {
+	body, contentType := streamMultipart(func(w *multipart.Writer) error {
+		if err := writeFormFile(w, "file", "a.mp3", bytes.NewReader([]byte("audio"))); err != nil {
+			return err
+		}
+		return w.WriteField("model_id", "m1")
+	})
+	defer body.Close()
+
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		t.Fatalf("ParseMediaType() error = %v", err)
+	}
+	form, err := multipart.NewReader(body, params["boundary"]).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("ReadForm() error = %v", err)
+	}
+	if got := form.Value["model_id"]; len(got) != 1 || got[0] != "m1" {
+		t.Errorf("model_id = %v, want [m1]", got)
+	}
+	if files := form.File["file"]; len(files) != 1 || files[0].Filename != "a.mp3" || files[0].Size != 5 {
+		t.Errorf("unexpected file parts: %+v", files)
+	}
+}
+
+func TestStreamMultipartError(t *testing.T) {
+	wantErr := errors.New("boom")
+	body, _ := streamMultipart(func(w *multipart.Writer) error {
+		return wantErr
+	})
+	defer body.Close()
+
+	if _, err := io.ReadAll(body); !errors.Is(err, wantErr) {
+		t.Errorf("ReadAll() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestDubbingCreateFromFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/dubbing" {
+			t.Errorf("path = %s, want /v1/dubbing", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm() error = %v", err)
+		}
+		if got := r.FormValue("target_lang"); got != "es" {
+			t.Errorf("target_lang = %q, want es", got)
+		}
+		f, fh, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile() error = %v", err)
+		}
+		defer f.Close()
+		if data, _ := io.ReadAll(f); fh.Filename != "clip.mp4" || string(data) != "video-bytes" {
+			t.Errorf("file = %s %q, want clip.mp4 %q", fh.Filename, data, "video-bytes")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"dubbing_id":"dub1","expected_duration_sec":12.5}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-api-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	var sent int64
+	resp, err := client.Dubbing().CreateFromFile(context.Background(), &DubbingRequest{
+		File:           bytes.NewReader([]byte("video-bytes")),
+		Filename:       "clip.mp4",
+		TargetLanguage: "es",
+		OnProgress:     func(s, _ int64) { sent = s },
+	})
+	if err != nil {
+		t.Fatalf("CreateFromFile() error = %v", err)
+	}
+	if resp.DubbingID != "dub1" || resp.ExpectedDurationSeconds != 12.5 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+	if sent != int64(len("video-bytes")) {
+		t.Errorf("progress sent = %d, want %d", sent, len("video-bytes"))
+	}
+
+	if _, err := client.Dubbing().CreateFromFile(context.Background(), &DubbingRequest{TargetLanguage: "es"}); !isValidationError(err, nil) {
+		t.Errorf("expected validation error for nil file, got %v", err)
+	}
+}
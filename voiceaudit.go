@@ -0,0 +1,207 @@
+package elevenlabs
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// VoiceAuditStatus is the result of auditing a single voice reference.
+type VoiceAuditStatus string
+
+// Voice audit statuses.
+const (
+	// VoiceAuditOK means the voice exists and matches the expected name (if any).
+	VoiceAuditOK VoiceAuditStatus = "ok"
+
+	// VoiceAuditMissing means the voice ID is not available to the account.
+	// The voice was deleted, or belongs to another account.
+	VoiceAuditMissing VoiceAuditStatus = "missing"
+
+	// VoiceAuditRenamed means the voice exists but its name differs from
+	// the expected name.
+	VoiceAuditRenamed VoiceAuditStatus = "renamed"
+)
+
+// VoiceReference is a voice used by a script, manifest, or configuration.
+type VoiceReference struct {
+	// VoiceID is the referenced voice ID.
+	VoiceID string
+
+	// Name is the expected voice name (optional). Used to detect renames
+	// and to suggest replacements.
+	Name string
+
+	// Category is the expected voice category (optional), used for suggestions.
+	Category string
+
+	// Labels are expected voice labels (optional), such as gender, accent,
+	// or age, used for suggestions.
+	Labels map[string]string
+
+	// Source describes where the reference came from (e.g., a file path).
+	Source string
+}
+
+// VoiceAuditResult is the audit result for a single voice reference.
+type VoiceAuditResult struct {
+	// Reference is the audited reference.
+	Reference VoiceReference
+
+	// Status is the audit status.
+	Status VoiceAuditStatus
+
+	// Voice is the matching available voice, if found.
+	Voice *Voice
+
+	// Suggestions are candidate replacement voices for missing voices,
+	// ordered best match first.
+	Suggestions []*Voice
+}
+
+// VoiceAuditReport is the result of auditing a set of voice references.
+type VoiceAuditReport struct {
+	// Results contains one result per unique voice ID, sorted by voice ID.
+	Results []VoiceAuditResult
+}
+
+// OK returns true if no referenced voices are missing.
+func (r *VoiceAuditReport) OK() bool {
+	return len(r.Missing()) == 0
+}
+
+// Missing returns the results for missing voices.
+func (r *VoiceAuditReport) Missing() []VoiceAuditResult {
+	return r.withStatus(VoiceAuditMissing)
+}
+
+// Renamed returns the results for renamed voices.
+func (r *VoiceAuditReport) Renamed() []VoiceAuditResult {
+	return r.withStatus(VoiceAuditRenamed)
+}
+
+func (r *VoiceAuditReport) withStatus(status VoiceAuditStatus) []VoiceAuditResult {
+	var results []VoiceAuditResult
+	for _, res := range r.Results {
+		if res.Status == status {
+			results = append(results, res)
+		}
+	}
+	return results
+}
+
+// maxVoiceSuggestions is the number of replacement suggestions per missing voice.
+const maxVoiceSuggestions = 3
+
+// AuditVoices cross-references voice references against the available voices.
+// References with the same voice ID are merged, keeping the first non-empty
+// hints. Empty voice IDs are ignored.
+func AuditVoices(available []*Voice, refs []VoiceReference) *VoiceAuditReport {
+	byID := make(map[string]*Voice, len(available))
+	for _, v := range available {
+		byID[v.VoiceID] = v
+	}
+
+	merged := make(map[string]VoiceReference)
+	for _, ref := range refs {
+		if ref.VoiceID == "" {
+			continue
+		}
+		existing, ok := merged[ref.VoiceID]
+		if !ok {
+			merged[ref.VoiceID] = ref
+			continue
+		}
+		if existing.Name == "" {
+			existing.Name = ref.Name
+		}
+		if existing.Category == "" {
+			existing.Category = ref.Category
+		}
+		if existing.Labels == nil {
+			existing.Labels = ref.Labels
+		}
+		if existing.Source == "" {
+			existing.Source = ref.Source
+		}
+		merged[ref.VoiceID] = existing
+	}
+
+	report := &VoiceAuditReport{}
+	for _, ref := range merged {
+		res := VoiceAuditResult{Reference: ref}
+		if v, ok := byID[ref.VoiceID]; ok {
+			res.Voice = v
+			res.Status = VoiceAuditOK
+			if ref.Name != "" && !strings.EqualFold(ref.Name, v.Name) {
+				res.Status = VoiceAuditRenamed
+			}
+		} else {
+			res.Status = VoiceAuditMissing
+			res.Suggestions = suggestVoices(available, ref)
+		}
+		report.Results = append(report.Results, res)
+	}
+	sort.Slice(report.Results, func(i, j int) bool {
+		return report.Results[i].Reference.VoiceID < report.Results[j].Reference.VoiceID
+	})
+	return report
+}
+
+// suggestVoices ranks available voices by similarity to a missing reference:
+// matching name, category, and labels each add to the score.
+func suggestVoices(available []*Voice, ref VoiceReference) []*Voice {
+	type scored struct {
+		voice *Voice
+		score int
+	}
+	var candidates []scored
+	for _, v := range available {
+		score := 0
+		if ref.Name != "" && strings.EqualFold(ref.Name, v.Name) {
+			score += 10
+		}
+		if ref.Category != "" && strings.EqualFold(ref.Category, string(v.Category)) {
+			score += 2
+		}
+		for k, want := range ref.Labels {
+			if got, ok := v.Labels[k]; ok && strings.EqualFold(got, want) {
+				score += 3
+			}
+		}
+		if score > 0 {
+			candidates = append(candidates, scored{v, score})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score > candidates[j].score
+		}
+		return candidates[i].voice.Name < candidates[j].voice.Name
+	})
+
+	var suggestions []*Voice
+	for i := 0; i < len(candidates) && i < maxVoiceSuggestions; i++ {
+		suggestions = append(suggestions, candidates[i].voice)
+	}
+	return suggestions
+}
+
+// Audit lists the account's voices and audits the given references against
+// them. Use it before a long generation run to catch deleted or renamed voices.
+func (s *VoicesService) Audit(ctx context.Context, refs []VoiceReference) (*VoiceAuditReport, error) {
+	available, err := s.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return AuditVoices(available, refs), nil
+}
+
+// VoiceReferencesFromIDs creates references from plain voice IDs.
+func VoiceReferencesFromIDs(source string, voiceIDs ...string) []VoiceReference {
+	refs := make([]VoiceReference, 0, len(voiceIDs))
+	for _, id := range voiceIDs {
+		refs = append(refs, VoiceReference{VoiceID: id, Source: source})
+	}
+	return refs
+}
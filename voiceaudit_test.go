@@ -0,0 +1,96 @@
+package elevenlabs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuditVoices(t *testing.T) {
+	available := []*Voice{
+		{VoiceID: "v1", Name: "Rachel", Category: "premade", Labels: map[string]string{"gender": "female", "accent": "american"}},
+		{VoiceID: "v2", Name: "Josh", Category: "premade", Labels: map[string]string{"gender": "male", "accent": "american"}},
+		{VoiceID: "v3", Name: "Bella", Category: "premade", Labels: map[string]string{"gender": "female", "accent": "british"}},
+	}
+	refs := []VoiceReference{
+		{VoiceID: "v1", Name: "Rachel"},
+		{VoiceID: "v2", Name: "Joshua"},
+		{VoiceID: "gone", Name: "My Clone", Labels: map[string]string{"gender": "female", "accent": "american"}},
+		{VoiceID: "v1", Source: "other.json"},
+		{VoiceID: ""},
+	}
+
+	report := AuditVoices(available, refs)
+	if len(report.Results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(report.Results))
+	}
+	if report.OK() {
+		t.Error("expected report to flag missing voice")
+	}
+
+	missing := report.Missing()
+	if len(missing) != 1 || missing[0].Reference.VoiceID != "gone" {
+		t.Fatalf("unexpected missing results: %+v", missing)
+	}
+	if len(missing[0].Suggestions) == 0 || missing[0].Suggestions[0].VoiceID != "v1" {
+		t.Errorf("expected Rachel as best suggestion, got %+v", missing[0].Suggestions)
+	}
+
+	renamed := report.Renamed()
+	if len(renamed) != 1 || renamed[0].Voice.Name != "Josh" {
+		t.Errorf("unexpected renamed results: %+v", renamed)
+	}
+}
+
+func TestAuditVoicesAllPresent(t *testing.T) {
+	available := []*Voice{{VoiceID: "v1", Name: "Rachel"}}
+	report := AuditVoices(available, VoiceReferencesFromIDs("script.json", "v1"))
+	if !report.OK() || report.Results[0].Status != VoiceAuditOK {
+		t.Errorf("expected all voices OK, got %+v", report.Results)
+	}
+}
+
+func TestAuditVoicesWithRecordedInfo(t *testing.T) {
+	// The account after the last run: "gone" was deleted and v2 renamed
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/voices" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"voices": [
+			{"voice_id": "v1", "name": "Rachel", "category": "premade", "labels": {"gender": "female", "accent": "american"}, "available_for_tiers": [], "high_quality_base_model_ids": []},
+			{"voice_id": "v2", "name": "Josh", "category": "premade", "labels": {"gender": "male", "accent": "american"}, "available_for_tiers": [], "high_quality_base_model_ids": []},
+			{"voice_id": "v3", "name": "Bella", "category": "premade", "labels": {"gender": "female", "accent": "british"}, "available_for_tiers": [], "high_quality_base_model_ids": []}
+		], "has_more": false}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+
+	// The voices as recorded by the last run
+	refs := []VoiceReference{
+		{VoiceID: "gone", Name: "My Clone", Category: "cloned", Labels: map[string]string{"gender": "female", "accent": "american"}},
+		{VoiceID: "v2", Name: "Joshua", Category: "premade"},
+		{VoiceID: "v3"},
+	}
+	report, err := client.Voices().Audit(context.Background(), refs)
+	if err != nil {
+		t.Fatalf("Audit() error = %v", err)
+	}
+
+	missing := report.Missing()
+	if len(missing) != 1 || missing[0].Reference.Name != "My Clone" {
+		t.Fatalf("missing = %+v", missing)
+	}
+	if s := missing[0].Suggestions; len(s) != 3 || s[0].VoiceID != "v1" {
+		t.Errorf("suggestions = %+v, want Rachel first", s)
+	}
+	renamed := report.Renamed()
+	if len(renamed) != 1 || renamed[0].Reference.Name != "Joshua" || renamed[0].Voice.Name != "Josh" {
+		t.Errorf("renamed = %+v", renamed)
+	}
+}
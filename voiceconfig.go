@@ -0,0 +1,356 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// VoiceConfig is the declared configuration of a voice. Voice libraries
+// can be kept as YAML or JSON files in version control, dumped with
+// DumpVoiceConfig and reconciled with ApplyVoiceConfig.
+type VoiceConfig struct {
+	// VoiceID is the voice the configuration applies to.
+	VoiceID string `json:"voice_id"`
+
+	// Name is the voice's display name. Empty leaves it unchanged.
+	Name string `json:"name,omitempty"`
+
+	// Description is the voice's description. Empty leaves it unchanged.
+	Description string `json:"description,omitempty"`
+
+	// Labels are the voice's labels, such as accent or use case. Nil
+	// leaves them unchanged; an empty map removes all labels.
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Settings are the voice's stored settings. Nil leaves them unchanged.
+	Settings *VoiceConfigSettings `json:"settings,omitempty"`
+
+	// Sharing is the voice's workspace sharing. Nil leaves it unchanged.
+	Sharing *VoiceConfigSharing `json:"sharing,omitempty"`
+}
+
+// VoiceConfigSettings are the settings in a VoiceConfig.
+type VoiceConfigSettings struct {
+	Stability       float64 `json:"stability"`
+	SimilarityBoost float64 `json:"similarity_boost"`
+	Style           float64 `json:"style"`
+	Speed           float64 `json:"speed,omitempty"`
+	UseSpeakerBoost bool    `json:"use_speaker_boost"`
+}
+
+// VoiceSettings returns the settings as VoiceSettings.
+func (s *VoiceConfigSettings) VoiceSettings() *VoiceSettings {
+	return &VoiceSettings{
+		Stability:       s.Stability,
+		SimilarityBoost: s.SimilarityBoost,
+		Style:           s.Style,
+		Speed:           s.Speed,
+		UseSpeakerBoost: s.UseSpeakerBoost,
+	}
+}
+
+// voiceConfigSettings converts VoiceSettings for a VoiceConfig.
+func voiceConfigSettings(s *VoiceSettings) *VoiceConfigSettings {
+	return &VoiceConfigSettings{
+		Stability:       s.Stability,
+		SimilarityBoost: s.SimilarityBoost,
+		Style:           s.Style,
+		Speed:           s.Speed,
+		UseSpeakerBoost: s.UseSpeakerBoost,
+	}
+}
+
+// VoiceConfigSharing is the workspace sharing in a VoiceConfig.
+type VoiceConfigSharing struct {
+	// Groups maps group IDs, GroupDefault, or user IDs as reported by
+	// GetSharing to their role. Principals not listed lose access, except
+	// the voice's creator.
+	Groups map[string]WorkspaceRole `json:"groups"`
+}
+
+// VoiceConfigChange is a difference between a voice and its declared
+// configuration.
+type VoiceConfigChange struct {
+	// Field is the changed field: "name", "description", "labels",
+	// "settings", or "sharing.<group ID>".
+	Field string
+
+	// From is the current value.
+	From string
+
+	// To is the declared value.
+	To string
+}
+
+// String returns the change as "field: from -> to".
+func (c VoiceConfigChange) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Field, c.From, c.To)
+}
+
+// ApplyVoiceConfigOptions configures ApplyVoiceConfig.
+type ApplyVoiceConfigOptions struct {
+	// DryRun returns the changes without making them.
+	DryRun bool
+}
+
+// DumpVoiceConfig returns a voice's current configuration: name,
+// description, labels, settings, and workspace sharing. Sharing is nil if
+// the account can't read it, such as outside a workspace.
+func (s *VoicesService) DumpVoiceConfig(ctx context.Context, voiceID string) (*VoiceConfig, error) {
+	voice, err := s.Get(ctx, voiceID)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &VoiceConfig{
+		VoiceID:     voice.VoiceID,
+		Name:        voice.Name,
+		Description: voice.Description,
+		Labels:      voice.Labels,
+	}
+
+	settings := voice.Settings
+	if settings == nil {
+		if settings, err = s.GetSettings(ctx, voiceID); err != nil {
+			return nil, fmt.Errorf("getting settings: %w", err)
+		}
+	}
+	cfg.Settings = voiceConfigSettings(settings)
+
+	sharing, err := s.client.Workspace().GetSharing(ctx, voiceID, ResourceTypeVoice)
+	switch apiErr := ParseAPIError(err); {
+	case err == nil:
+		cfg.Sharing = &VoiceConfigSharing{Groups: make(map[string]WorkspaceRole)}
+		for _, groups := range sharing.RoleGroups {
+			for _, id := range groups {
+				if id != sharing.CreatorUserID {
+					cfg.Sharing.Groups[id] = sharing.HasAccess(id)
+				}
+			}
+		}
+	case apiErr != nil && (apiErr.StatusCode == 403 || apiErr.StatusCode == 404):
+		// Sharing is a workspace feature; leave it unmanaged
+	default:
+		return nil, fmt.Errorf("getting sharing: %w", err)
+	}
+	return cfg, nil
+}
+
+// ApplyVoiceConfig reconciles a voice toward its declared configuration
+// and returns the changes made. Only the fields set in cfg are managed.
+//
+// Example:
+//
+//	configs, _ := elevenlabs.LoadVoiceConfigs("voices.yaml")
+//	for _, cfg := range configs {
+//	    changes, err := client.Voices().ApplyVoiceConfig(ctx, cfg, &elevenlabs.ApplyVoiceConfigOptions{DryRun: plan})
+//	    for _, c := range changes {
+//	        fmt.Println(cfg.VoiceID, c)
+//	    }
+//	}
+func (s *VoicesService) ApplyVoiceConfig(ctx context.Context, cfg *VoiceConfig, opts *ApplyVoiceConfigOptions) ([]VoiceConfigChange, error) {
+	if cfg == nil {
+		return nil, &ValidationError{Field: "config", Message: "cannot be nil"}
+	}
+	if cfg.VoiceID == "" {
+		return nil, ErrEmptyVoiceID
+	}
+	if cfg.Settings != nil {
+		if err := cfg.Settings.VoiceSettings().Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.Sharing != nil {
+		for group, role := range cfg.Sharing.Groups {
+			req := &ShareRequest{ResourceID: cfg.VoiceID, ResourceType: ResourceTypeVoice, Role: role, GroupID: group}
+			if err := req.validate(true); err != nil {
+				return nil, fmt.Errorf("sharing %s: %w", group, err)
+			}
+		}
+	}
+	if opts == nil {
+		opts = &ApplyVoiceConfigOptions{}
+	}
+
+	current, err := s.DumpVoiceConfig(ctx, cfg.VoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Sharing != nil && current.Sharing == nil {
+		return nil, &ValidationError{Field: "sharing", Message: "workspace sharing is not available for this voice"}
+	}
+
+	var changes []VoiceConfigChange
+	edit := false
+	if cfg.Name != "" && cfg.Name != current.Name {
+		changes = append(changes, VoiceConfigChange{Field: "name", From: current.Name, To: cfg.Name})
+		edit = true
+	}
+	if cfg.Description != "" && cfg.Description != current.Description {
+		changes = append(changes, VoiceConfigChange{Field: "description", From: current.Description, To: cfg.Description})
+		edit = true
+	}
+	if cfg.Labels != nil && formatLabels(cfg.Labels) != formatLabels(current.Labels) {
+		changes = append(changes, VoiceConfigChange{Field: "labels", From: formatLabels(current.Labels), To: formatLabels(cfg.Labels)})
+		edit = true
+	}
+	settingsChanged := false
+	if cfg.Settings != nil {
+		want := *cfg.Settings
+		if want.Speed == 0 {
+			// Unset speed keeps the current speed, as in UpdateSettings
+			want.Speed = current.Settings.Speed
+		}
+		if settingsChanged = want != *current.Settings; settingsChanged {
+			changes = append(changes, VoiceConfigChange{Field: "settings", From: formatVoiceConfigSettings(current.Settings), To: formatVoiceConfigSettings(&want)})
+		}
+	}
+	var shares, unshares []string
+	if cfg.Sharing != nil {
+		for group, role := range cfg.Sharing.Groups {
+			if current.Sharing.Groups[group] != role {
+				changes = append(changes, VoiceConfigChange{Field: "sharing." + group, From: string(current.Sharing.Groups[group]), To: string(role)})
+				shares = append(shares, group)
+			}
+		}
+		for group, role := range current.Sharing.Groups {
+			if _, ok := cfg.Sharing.Groups[group]; !ok {
+				changes = append(changes, VoiceConfigChange{Field: "sharing." + group, From: string(role)})
+				unshares = append(unshares, group)
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Field < changes[j].Field })
+	if opts.DryRun {
+		return changes, nil
+	}
+
+	if edit {
+		desired := *current
+		if cfg.Name != "" {
+			desired.Name = cfg.Name
+		}
+		if cfg.Description != "" {
+			desired.Description = cfg.Description
+		}
+		if cfg.Labels != nil {
+			desired.Labels = cfg.Labels
+		}
+		if err := s.edit(ctx, &desired); err != nil {
+			return nil, fmt.Errorf("editing voice: %w", err)
+		}
+	}
+	if settingsChanged {
+		if err := s.UpdateSettings(ctx, cfg.VoiceID, cfg.Settings.VoiceSettings()); err != nil {
+			return nil, fmt.Errorf("updating settings: %w", err)
+		}
+	}
+	sort.Strings(shares)
+	for _, group := range shares {
+		req := &ShareRequest{ResourceID: cfg.VoiceID, ResourceType: ResourceTypeVoice, Role: cfg.Sharing.Groups[group], GroupID: group}
+		if err := s.client.Workspace().Share(ctx, req); err != nil {
+			return nil, fmt.Errorf("sharing with %s: %w", group, err)
+		}
+	}
+	sort.Strings(unshares)
+	for _, group := range unshares {
+		req := &ShareRequest{ResourceID: cfg.VoiceID, ResourceType: ResourceTypeVoice, GroupID: group}
+		if err := s.client.Workspace().Unshare(ctx, req); err != nil {
+			return nil, fmt.Errorf("unsharing from %s: %w", group, err)
+		}
+	}
+	return changes, nil
+}
+
+// edit sets a voice's name, description, and labels.
+func (s *VoicesService) edit(ctx context.Context, cfg *VoiceConfig) error {
+	labels, err := json.Marshal(cfg.Labels)
+	if err != nil {
+		return err
+	}
+	body := &api.BodyEditVoiceV1VoicesVoiceIDEditPostMultipart{
+		Name:        cfg.Name,
+		Description: api.NewOptNilString(cfg.Description),
+		Labels:      api.NewOptNilString(string(labels)),
+	}
+	resp, err := s.client.apiClient.EditVoice(ctx, body, api.EditVoiceParams{VoiceID: cfg.VoiceID})
+	if err != nil {
+		return err
+	}
+
+	switch resp.(type) {
+	case *api.EditVoiceResponseModel:
+		return nil
+	default:
+		return &APIError{Message: "unexpected response type"}
+	}
+}
+
+// formatLabels returns labels as sorted "key=value" pairs.
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// formatVoiceConfigSettings returns settings for a change description.
+func formatVoiceConfigSettings(s *VoiceConfigSettings) string {
+	return fmt.Sprintf("stability=%g similarity_boost=%g style=%g speed=%g use_speaker_boost=%t",
+		s.Stability, s.SimilarityBoost, s.Style, s.Speed, s.UseSpeakerBoost)
+}
+
+// LoadVoiceConfigs reads voice configurations from a YAML or JSON file
+// holding a single configuration or a list.
+func LoadVoiceConfigs(path string) ([]*VoiceConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading voice config: %w", err)
+	}
+	data, err = yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("parsing voice config: %w", err)
+	}
+	var configs []*VoiceConfig
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		err = json.Unmarshal(data, &configs)
+	} else {
+		var cfg VoiceConfig
+		err = json.Unmarshal(data, &cfg)
+		configs = []*VoiceConfig{&cfg}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing voice config: %w", err)
+	}
+	return configs, nil
+}
+
+// SaveVoiceConfigs writes voice configurations as a list, in JSON if path
+// ends in ".json" and YAML otherwise.
+func SaveVoiceConfigs(path string, configs []*VoiceConfig) error {
+	data, err := json.MarshalIndent(configs, "", "  ")
+	if err == nil && !strings.EqualFold(filepath.Ext(path), ".json") {
+		data, err = yaml.JSONToYAML(data)
+	}
+	if err != nil {
+		return fmt.Errorf("encoding voice config: %w", err)
+	}
+	if filepath.Ext(path) == ".json" {
+		data = append(data, '\n')
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("writing voice config: %w", err)
+	}
+	return nil
+}
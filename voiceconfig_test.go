@@ -0,0 +1,141 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func newVoiceConfigServer(t *testing.T) (*httptest.Server, *[]string) {
+	t.Helper()
+	var mu sync.Mutex
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		call := r.Method + " " + r.URL.Path
+		switch call {
+		case "GET /v1/voices/v1":
+			_, _ = w.Write([]byte(`{
+				"voice_id": "v1",
+				"name": "Narrator",
+				"category": "professional",
+				"description": "Warm narrator",
+				"available_for_tiers": [],
+				"high_quality_base_model_ids": [],
+				"labels": {"accent": "british"},
+				"settings": {"stability": 0.5, "similarity_boost": 0.75, "style": 0, "use_speaker_boost": true}
+			}`))
+			return
+		case "GET /v1/workspace/resources/v1":
+			_, _ = w.Write([]byte(`{"resource_id": "v1", "resource_type": "voice", "creator_user_id": "u1",
+				"anonymous_access_level_override": null,
+				"role_to_group_ids": {"admin": ["u1"], "viewer": ["default", "g2"]}, "share_options": []}`))
+			return
+		case "POST /v1/voices/v1/edit":
+			if err := r.ParseMultipartForm(1 << 20); err == nil {
+				call += " name=" + r.FormValue("name") + " labels=" + r.FormValue("labels")
+			}
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		case "POST /v1/voices/v1/settings/edit":
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		case "POST /v1/workspace/resources/v1/share", "POST /v1/workspace/resources/v1/unshare":
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			call += " " + body["group_id"].(string)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		calls = append(calls, call)
+		mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestDumpVoiceConfig(t *testing.T) {
+	server, _ := newVoiceConfigServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	cfg, err := client.Voices().DumpVoiceConfig(context.Background(), "v1")
+	if err != nil {
+		t.Fatalf("DumpVoiceConfig() error = %v", err)
+	}
+	if cfg.Name != "Narrator" || cfg.Labels["accent"] != "british" || cfg.Settings == nil || !cfg.Settings.UseSpeakerBoost {
+		t.Errorf("config = %+v", cfg)
+	}
+	if cfg.Sharing == nil || len(cfg.Sharing.Groups) != 2 || cfg.Sharing.Groups["g2"] != RoleViewer {
+		t.Errorf("sharing = %+v", cfg.Sharing)
+	}
+
+	path := filepath.Join(t.TempDir(), "voices.yaml")
+	if err := SaveVoiceConfigs(path, []*VoiceConfig{cfg}); err != nil {
+		t.Fatalf("SaveVoiceConfigs() error = %v", err)
+	}
+	loaded, err := LoadVoiceConfigs(path)
+	if err != nil {
+		t.Fatalf("LoadVoiceConfigs() error = %v", err)
+	}
+	if len(loaded) != 1 || loaded[0].VoiceID != "v1" || *loaded[0].Settings != *cfg.Settings {
+		t.Errorf("loaded = %+v", loaded)
+	}
+}
+
+func TestApplyVoiceConfig(t *testing.T) {
+	server, calls := newVoiceConfigServer(t)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	ctx := context.Background()
+
+	cfg := &VoiceConfig{
+		VoiceID:  "v1",
+		Name:     "Lead Narrator",
+		Labels:   map[string]string{"accent": "british", "use_case": "audiobook"},
+		Settings: &VoiceConfigSettings{Stability: 0.5, SimilarityBoost: 0.75, UseSpeakerBoost: true},
+		Sharing:  &VoiceConfigSharing{Groups: map[string]WorkspaceRole{GroupDefault: RoleViewer, "g3": RoleEditor}},
+	}
+
+	changes, err := client.Voices().ApplyVoiceConfig(ctx, cfg, &ApplyVoiceConfigOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("ApplyVoiceConfig() error = %v", err)
+	}
+	var fields []string
+	for _, c := range changes {
+		fields = append(fields, c.Field)
+	}
+	if got := strings.Join(fields, ","); got != "labels,name,sharing.g2,sharing.g3" {
+		t.Errorf("changes = %s", got)
+	}
+	if len(*calls) != 0 {
+		t.Errorf("dry run made changes: %v", *calls)
+	}
+
+	if _, err := client.Voices().ApplyVoiceConfig(ctx, cfg, nil); err != nil {
+		t.Fatalf("ApplyVoiceConfig() error = %v", err)
+	}
+	got := append([]string(nil), *calls...)
+	sort.Strings(got)
+	want := []string{
+		`POST /v1/voices/v1/edit name=Lead Narrator labels={"accent":"british","use_case":"audiobook"}`,
+		"POST /v1/workspace/resources/v1/share g3",
+		"POST /v1/workspace/resources/v1/unshare g2",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("calls =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if _, err := client.Voices().ApplyVoiceConfig(ctx, &VoiceConfig{}, nil); err != ErrEmptyVoiceID {
+		t.Errorf("ApplyVoiceConfig(empty) error = %v", err)
+	}
+	bad := &VoiceConfig{VoiceID: "v1", Sharing: &VoiceConfigSharing{Groups: map[string]WorkspaceRole{"g1": "owner"}}}
+	if _, err := client.Voices().ApplyVoiceConfig(ctx, bad, nil); err == nil {
+		t.Error("ApplyVoiceConfig() with invalid role expected error")
+	}
+}
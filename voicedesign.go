@@ -161,12 +161,7 @@ func (s *VoiceDesignService) SaveVoice(ctx context.Context, req *SaveVoiceReques
 
 	switch r := resp.(type) {
 	case *api.VoiceResponseModel:
-		return &Voice{
-			VoiceID:     r.VoiceID,
-			Name:        r.Name,
-			Description: r.Description.Value,
-			Category:    string(r.Category),
-		}, nil
+		return voiceFromAPI(r), nil
 	default:
 		return nil, &APIError{Message: "unexpected response type"}
 	}
@@ -2,6 +2,7 @@ package elevenlabs
 
 import (
 	"context"
+	"time"
 
 	"github.com/agentplexus/go-elevenlabs/internal/api"
 )
@@ -11,6 +12,19 @@ type VoicesService struct {
 	client *Client
 }
 
+// VoiceCategory is the category of a voice.
+type VoiceCategory string
+
+// Voice categories.
+const (
+	VoiceCategoryPremade      VoiceCategory = "premade"
+	VoiceCategoryCloned       VoiceCategory = "cloned"
+	VoiceCategoryGenerated    VoiceCategory = "generated"
+	VoiceCategoryProfessional VoiceCategory = "professional"
+	VoiceCategoryFamous       VoiceCategory = "famous"
+	VoiceCategoryHighQuality  VoiceCategory = "high_quality"
+)
+
 // Voice represents an ElevenLabs voice.
 type Voice struct {
 	// VoiceID is the unique identifier for the voice.
@@ -19,8 +33,8 @@ type Voice struct {
 	// Name is the display name of the voice.
 	Name string
 
-	// Category is the category of the voice (e.g., "premade", "cloned").
-	Category string
+	// Category is the category of the voice (e.g., VoiceCategoryPremade).
+	Category VoiceCategory
 
 	// Description is the description of the voice.
 	Description string
@@ -30,6 +44,252 @@ type Voice struct {
 
 	// Labels contains additional metadata about the voice.
 	Labels map[string]string
+
+	// IsOwner reports whether the voice is owned by the user.
+	IsOwner bool
+
+	// IsLegacy reports whether the voice is a legacy voice.
+	IsLegacy bool
+
+	// CreatedAt is when the voice was created, if known.
+	CreatedAt time.Time
+
+	// AvailableForTiers lists the subscription tiers the voice is
+	// available for.
+	AvailableForTiers []string
+
+	// HighQualityBaseModelIDs lists the base models for high quality
+	// voices.
+	HighQualityBaseModelIDs []string
+
+	// Settings are the voice's settings, or nil if not returned.
+	Settings *VoiceSettings
+
+	// FineTuning is the voice's fine-tuning state, or nil if not returned.
+	FineTuning *VoiceFineTuning
+
+	// Sharing is the voice's library sharing information, or nil if the
+	// voice is not shared.
+	Sharing *VoiceSharing
+
+	// Samples are the audio samples a cloned voice was created from.
+	Samples []VoiceSample
+
+	// VerifiedLanguages are the languages the voice is verified for.
+	VerifiedLanguages []VerifiedLanguage
+}
+
+// FineTuningState is the fine-tuning state of a voice for a model.
+type FineTuningState string
+
+// Fine-tuning states.
+const (
+	FineTuningStateNotStarted FineTuningState = "not_started"
+	FineTuningStateQueued     FineTuningState = "queued"
+	FineTuningStateFineTuning FineTuningState = "fine_tuning"
+	FineTuningStateFineTuned  FineTuningState = "fine_tuned"
+	FineTuningStateFailed     FineTuningState = "failed"
+	FineTuningStateDelayed    FineTuningState = "delayed"
+)
+
+// VoiceFineTuning is the fine-tuning information of a voice.
+type VoiceFineTuning struct {
+	// IsAllowedToFineTune reports whether the user may fine-tune the voice.
+	IsAllowedToFineTune bool
+
+	// State is the fine-tuning state for each model ID.
+	State map[string]FineTuningState
+
+	// Language is the language of the fine-tuning dataset.
+	Language string
+
+	// DatasetDurationSeconds is the duration of the fine-tuning dataset.
+	DatasetDurationSeconds float64
+
+	// ManualVerificationRequested reports whether manual verification was
+	// requested.
+	ManualVerificationRequested bool
+
+	// VerificationAttemptsCount is the number of verification attempts.
+	VerificationAttemptsCount int
+
+	// VerificationFailures lists the reasons verification failed.
+	VerificationFailures []string
+}
+
+// VoiceSharingStatus is the sharing status of a voice in the voice library.
+type VoiceSharingStatus string
+
+// Voice sharing statuses.
+const (
+	VoiceSharingStatusEnabled  VoiceSharingStatus = "enabled"
+	VoiceSharingStatusDisabled VoiceSharingStatus = "disabled"
+	VoiceSharingStatusCopied   VoiceSharingStatus = "copied"
+)
+
+// VoiceSharing is the voice library sharing information of a voice.
+type VoiceSharing struct {
+	// Status is the sharing status.
+	Status VoiceSharingStatus
+
+	// ReviewStatus is the library review status (e.g., "allowed").
+	ReviewStatus string
+
+	// PublicOwnerID is the public ID of the voice's owner.
+	PublicOwnerID string
+
+	// OriginalVoiceID is the ID of the voice that was shared.
+	OriginalVoiceID string
+
+	// EnabledInLibrary reports whether the voice is listed in the library.
+	EnabledInLibrary bool
+
+	// Featured reports whether the voice is featured.
+	Featured bool
+
+	// FreeUsersAllowed reports whether free users may use the voice.
+	FreeUsersAllowed bool
+
+	// ClonedByCount is the number of times the voice was added by others.
+	ClonedByCount int
+
+	// LikedByCount is the number of likes.
+	LikedByCount int
+
+	// SharedAt is when the voice was shared.
+	SharedAt time.Time
+}
+
+// VoiceSample is an audio sample of a voice.
+type VoiceSample struct {
+	// SampleID is the unique identifier for the sample.
+	SampleID string
+
+	// FileName is the name of the sample file.
+	FileName string
+
+	// MimeType is the MIME type of the sample file.
+	MimeType string
+
+	// SizeBytes is the size of the sample file.
+	SizeBytes int
+
+	// Hash is the hash of the sample file.
+	Hash string
+
+	// DurationSeconds is the duration of the sample, if known.
+	DurationSeconds float64
+}
+
+// VerifiedLanguage is a language a voice is verified for.
+type VerifiedLanguage struct {
+	// Language is the language code (e.g., "en").
+	Language string
+
+	// ModelID is the model the voice is verified with.
+	ModelID string
+
+	// Accent is the voice's accent in the language, if any.
+	Accent string
+
+	// Locale is the voice's locale (e.g., "en-US"), if any.
+	Locale string
+
+	// PreviewURL is a preview of the voice in the language, if any.
+	PreviewURL string
+}
+
+// voiceFromAPI converts an API voice response.
+func voiceFromAPI(v *api.VoiceResponseModel) *Voice {
+	voice := &Voice{
+		VoiceID:                 v.VoiceID,
+		Name:                    v.Name,
+		Category:                VoiceCategory(v.Category),
+		Labels:                  make(map[string]string),
+		IsOwner:                 v.IsOwner.Value,
+		IsLegacy:                v.IsLegacy.Value,
+		AvailableForTiers:       v.AvailableForTiers,
+		HighQualityBaseModelIDs: v.HighQualityBaseModelIds,
+	}
+	if v.Description.Set && !v.Description.Null {
+		voice.Description = v.Description.Value
+	}
+	if v.PreviewURL.Set && !v.PreviewURL.Null {
+		voice.PreviewURL = v.PreviewURL.Value
+	}
+	if v.CreatedAtUnix.Set && !v.CreatedAtUnix.Null {
+		voice.CreatedAt = time.Unix(int64(v.CreatedAtUnix.Value), 0)
+	}
+	// Convert labels
+	for k, val := range v.Labels {
+		voice.Labels[k] = val
+	}
+
+	if s, ok := v.Settings.Get(); ok {
+		voice.Settings = &VoiceSettings{
+			Stability:       s.Stability.Value,
+			SimilarityBoost: s.SimilarityBoost.Value,
+			Style:           s.Style.Value,
+			Speed:           s.Speed.Value,
+			UseSpeakerBoost: s.UseSpeakerBoost.Value,
+		}
+	}
+
+	if ft, ok := v.FineTuning.Get(); ok {
+		fineTuning := &VoiceFineTuning{
+			IsAllowedToFineTune:         ft.IsAllowedToFineTune,
+			State:                       make(map[string]FineTuningState, len(ft.State)),
+			Language:                    ft.Language.Value,
+			DatasetDurationSeconds:      ft.DatasetDurationSeconds.Value,
+			ManualVerificationRequested: ft.ManualVerificationRequested,
+			VerificationAttemptsCount:   ft.VerificationAttemptsCount,
+			VerificationFailures:        ft.VerificationFailures,
+		}
+		for model, state := range ft.State {
+			fineTuning.State[model] = FineTuningState(state)
+		}
+		voice.FineTuning = fineTuning
+	}
+
+	if sh, ok := v.Sharing.Get(); ok {
+		voice.Sharing = &VoiceSharing{
+			Status:           VoiceSharingStatus(sh.Status),
+			ReviewStatus:     string(sh.ReviewStatus),
+			PublicOwnerID:    sh.PublicOwnerID,
+			OriginalVoiceID:  sh.OriginalVoiceID,
+			EnabledInLibrary: sh.EnabledInLibrary,
+			Featured:         sh.Featured,
+			FreeUsersAllowed: sh.FreeUsersAllowed,
+			ClonedByCount:    sh.ClonedByCount,
+			LikedByCount:     sh.LikedByCount,
+		}
+		if sh.DateUnix > 0 {
+			voice.Sharing.SharedAt = time.Unix(int64(sh.DateUnix), 0)
+		}
+	}
+
+	for _, s := range v.Samples.Value {
+		voice.Samples = append(voice.Samples, VoiceSample{
+			SampleID:        s.SampleID,
+			FileName:        s.FileName,
+			MimeType:        s.MimeType,
+			SizeBytes:       s.SizeBytes,
+			Hash:            s.Hash,
+			DurationSeconds: s.DurationSecs.Value,
+		})
+	}
+
+	for _, l := range v.VerifiedLanguages.Value {
+		voice.VerifiedLanguages = append(voice.VerifiedLanguages, VerifiedLanguage{
+			Language:   l.Language,
+			ModelID:    l.ModelID,
+			Accent:     l.Accent.Value,
+			Locale:     l.Locale.Value,
+			PreviewURL: l.PreviewURL.Value,
+		})
+	}
+
+	return voice
 }
 
 // List returns all available voices.
@@ -43,24 +303,8 @@ func (s *VoicesService) List(ctx context.Context) ([]*Voice, error) {
 	switch r := resp.(type) {
 	case *api.GetVoicesResponseModel:
 		voices := make([]*Voice, 0, len(r.Voices))
-		for _, v := range r.Voices {
-			voice := &Voice{
-				VoiceID:  v.VoiceID,
-				Name:     v.Name,
-				Category: string(v.Category),
-				Labels:   make(map[string]string),
-			}
-			if v.Description.Set && !v.Description.Null {
-				voice.Description = v.Description.Value
-			}
-			if v.PreviewURL.Set && !v.PreviewURL.Null {
-				voice.PreviewURL = v.PreviewURL.Value
-			}
-			// Convert labels
-			for k, val := range v.Labels {
-				voice.Labels[k] = val
-			}
-			voices = append(voices, voice)
+		for i := range r.Voices {
+			voices = append(voices, voiceFromAPI(&r.Voices[i]))
 		}
 		return voices, nil
 	default:
@@ -84,23 +328,7 @@ func (s *VoicesService) Get(ctx context.Context, voiceID string) (*Voice, error)
 	// Handle response type
 	switch r := resp.(type) {
 	case *api.VoiceResponseModel:
-		voice := &Voice{
-			VoiceID:  r.VoiceID,
-			Name:     r.Name,
-			Category: string(r.Category),
-			Labels:   make(map[string]string),
-		}
-		if r.Description.Set && !r.Description.Null {
-			voice.Description = r.Description.Value
-		}
-		if r.PreviewURL.Set && !r.PreviewURL.Null {
-			voice.PreviewURL = r.PreviewURL.Value
-		}
-		// Convert labels
-		for k, val := range r.Labels {
-			voice.Labels[k] = val
-		}
-		return voice, nil
+		return voiceFromAPI(r), nil
 	default:
 		return nil, &APIError{Message: "unexpected response type"}
 	}
@@ -122,20 +350,7 @@ func (s *VoicesService) GetSettings(ctx context.Context, voiceID string) (*Voice
 	// Handle response type
 	switch r := resp.(type) {
 	case *api.VoiceSettingsResponseModel:
-		settings := &VoiceSettings{}
-		if r.Stability.Set && !r.Stability.Null {
-			settings.Stability = r.Stability.Value
-		}
-		if r.SimilarityBoost.Set && !r.SimilarityBoost.Null {
-			settings.SimilarityBoost = r.SimilarityBoost.Value
-		}
-		if r.Style.Set && !r.Style.Null {
-			settings.Style = r.Style.Value
-		}
-		if r.Speed.Set && !r.Speed.Null {
-			settings.Speed = r.Speed.Value
-		}
-		return settings, nil
+		return voiceSettingsFromAPI(r), nil
 	default:
 		return nil, &APIError{Message: "unexpected response type"}
 	}
@@ -147,21 +362,7 @@ func (s *VoicesService) GetDefaultSettings(ctx context.Context) (*VoiceSettings,
 	if err != nil {
 		return nil, err
 	}
-
-	settings := &VoiceSettings{}
-	if resp.Stability.Set && !resp.Stability.Null {
-		settings.Stability = resp.Stability.Value
-	}
-	if resp.SimilarityBoost.Set && !resp.SimilarityBoost.Null {
-		settings.SimilarityBoost = resp.SimilarityBoost.Value
-	}
-	if resp.Style.Set && !resp.Style.Null {
-		settings.Style = resp.Style.Value
-	}
-	if resp.Speed.Set && !resp.Speed.Null {
-		settings.Speed = resp.Speed.Value
-	}
-	return settings, nil
+	return voiceSettingsFromAPI(resp), nil
 }
 
 // Delete deletes a voice by ID.
@@ -175,3 +376,33 @@ func (s *VoicesService) Delete(ctx context.Context, voiceID string) error {
 	})
 	return err
 }
+
+// UpdateSettings replaces the stored settings for a voice. The settings
+// are used when a request doesn't set its own.
+func (s *VoicesService) UpdateSettings(ctx context.Context, voiceID string, settings *VoiceSettings) error {
+	if voiceID == "" {
+		return ErrEmptyVoiceID
+	}
+	if settings == nil {
+		return &ValidationError{Field: "settings", Message: "cannot be nil"}
+	}
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	body := apiVoiceSettings(settings, legacyDefault)
+
+	resp, err := s.client.apiClient.EditVoiceSettings(ctx, &body, api.EditVoiceSettingsParams{
+		VoiceID: voiceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch resp.(type) {
+	case *api.EditVoiceSettingsResponseModel:
+		return nil
+	default:
+		return &APIError{Message: "unexpected response type"}
+	}
+}
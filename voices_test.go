@@ -2,6 +2,8 @@ package elevenlabs
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -121,3 +123,64 @@ func TestVoicesGetValidation(t *testing.T) {
 		t.Errorf("GetSettings('') error = %v, want %v", err, ErrEmptyVoiceID)
 	}
 }
+
+func TestVoicesGetDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"voice_id": "v1",
+			"name": "Narrator",
+			"category": "professional",
+			"labels": {"accent": "british"},
+			"is_owner": true,
+			"created_at_unix": 1700000000,
+			"available_for_tiers": ["creator", "pro"],
+			"high_quality_base_model_ids": [],
+			"settings": {"stability": 0.4, "similarity_boost": 0.8, "use_speaker_boost": true},
+			"fine_tuning": {
+				"is_allowed_to_fine_tune": true,
+				"state": {"eleven_multilingual_v2": "fine_tuned", "eleven_turbo_v2": "queued"},
+				"verification_failures": [],
+				"verification_attempts_count": 1,
+				"manual_verification_requested": false,
+				"language": "en"
+			},
+			"samples": [{"sample_id": "s1", "file_name": "take1.mp3", "mime_type": "audio/mpeg", "size_bytes": 1024, "hash": "abc", "duration_secs": 12.5}],
+			"verified_languages": [{"language": "en", "model_id": "eleven_multilingual_v2", "accent": "british", "locale": "en-GB"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	voice, err := client.Voices().Get(context.Background(), "v1")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	if !voice.IsOwner || voice.Category != VoiceCategoryProfessional || voice.CreatedAt.Unix() != 1700000000 {
+		t.Errorf("unexpected voice: %+v", voice)
+	}
+	if len(voice.AvailableForTiers) != 2 {
+		t.Errorf("AvailableForTiers = %v", voice.AvailableForTiers)
+	}
+	if voice.Settings == nil || voice.Settings.Stability != 0.4 || !voice.Settings.UseSpeakerBoost {
+		t.Errorf("Settings = %+v", voice.Settings)
+	}
+	if voice.FineTuning == nil || !voice.FineTuning.IsAllowedToFineTune ||
+		voice.FineTuning.State["eleven_multilingual_v2"] != FineTuningStateFineTuned ||
+		voice.FineTuning.State["eleven_turbo_v2"] != FineTuningStateQueued {
+		t.Errorf("FineTuning = %+v", voice.FineTuning)
+	}
+	if voice.Sharing != nil {
+		t.Errorf("Sharing = %+v, want nil", voice.Sharing)
+	}
+	if len(voice.Samples) != 1 || voice.Samples[0].FileName != "take1.mp3" || voice.Samples[0].DurationSeconds != 12.5 {
+		t.Errorf("Samples = %+v", voice.Samples)
+	}
+	if len(voice.VerifiedLanguages) != 1 || voice.VerifiedLanguages[0].Locale != "en-GB" {
+		t.Errorf("VerifiedLanguages = %+v", voice.VerifiedLanguages)
+	}
+}
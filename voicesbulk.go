@@ -0,0 +1,151 @@
+package elevenlabs
+
+import (
+	"context"
+	"sync"
+)
+
+// BulkVoiceOptions configures VoicesService.DeleteMany and
+// VoicesService.UpdateSettingsMany.
+type BulkVoiceOptions struct {
+	// Concurrency is the number of concurrent requests.
+	// Defaults to DefaultBatchConcurrency.
+	Concurrency int
+
+	// MaxRetries is the number of retries for rate limited (429) and
+	// server (5xx) errors. Defaults to DefaultBatchMaxRetries; use a
+	// negative value to disable retries.
+	MaxRetries int
+
+	// DryRun returns the voices that would be changed without changing
+	// them.
+	DryRun bool
+
+	// OnResult, if set, is called as each voice finishes. It may be called
+	// concurrently.
+	OnResult func(BulkVoiceResult)
+}
+
+// BulkVoiceResult is the outcome of a bulk operation on one voice.
+type BulkVoiceResult struct {
+	// VoiceID is the voice.
+	VoiceID string
+
+	// Attempts is the number of requests made, including retries. It is 0
+	// in a dry run.
+	Attempts int
+
+	// Err is the error if the operation failed for this voice.
+	Err error
+}
+
+// DeleteMany deletes voices concurrently, retrying rate limited requests.
+// A failure for one voice doesn't stop the others; check each result's
+// Err. Results are in the order of voiceIDs.
+//
+// Example:
+//
+//	results := client.Voices().DeleteMany(ctx, ids, &elevenlabs.BulkVoiceOptions{DryRun: true})
+//	for _, r := range results {
+//	    fmt.Println("would delete", r.VoiceID)
+//	}
+func (s *VoicesService) DeleteMany(ctx context.Context, voiceIDs []string, opts *BulkVoiceOptions) []BulkVoiceResult {
+	return s.runBulk(ctx, voiceIDs, opts, s.Delete)
+}
+
+// UpdateSettingsMany applies the same settings to voices concurrently, for
+// example a preset from VoiceSettingsPreset. Invalid settings fail every
+// voice without making requests. Results are in the order of voiceIDs.
+func (s *VoicesService) UpdateSettingsMany(ctx context.Context, voiceIDs []string, settings *VoiceSettings, opts *BulkVoiceOptions) []BulkVoiceResult {
+	var invalid error
+	if settings == nil {
+		invalid = &ValidationError{Field: "settings", Message: "cannot be nil"}
+	} else {
+		invalid = settings.Validate()
+	}
+	if invalid != nil {
+		results := make([]BulkVoiceResult, len(voiceIDs))
+		for i, id := range voiceIDs {
+			results[i] = BulkVoiceResult{VoiceID: id, Err: invalid}
+		}
+		return results
+	}
+	return s.runBulk(ctx, voiceIDs, opts, func(ctx context.Context, voiceID string) error {
+		return s.UpdateSettings(ctx, voiceID, settings)
+	})
+}
+
+// runBulk calls op for each voice with bounded concurrency and retries.
+func (s *VoicesService) runBulk(ctx context.Context, voiceIDs []string, opts *BulkVoiceOptions, op func(context.Context, string) error) []BulkVoiceResult {
+	if opts == nil {
+		opts = &BulkVoiceOptions{}
+	}
+	results := make([]BulkVoiceResult, len(voiceIDs))
+	if opts.DryRun {
+		for i, id := range voiceIDs {
+			results[i] = BulkVoiceResult{VoiceID: id}
+			if opts.OnResult != nil {
+				opts.OnResult(results[i])
+			}
+		}
+		return results
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultBatchMaxRetries
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, id := range voiceIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				results[i] = BulkVoiceResult{VoiceID: id, Err: ctx.Err()}
+				return
+			}
+
+			res := s.runBulkOp(ctx, id, maxRetries, op)
+			results[i] = res
+			if opts.OnResult != nil {
+				opts.OnResult(res)
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+// runBulkOp calls op for one voice, retrying retryable errors.
+func (s *VoicesService) runBulkOp(ctx context.Context, voiceID string, maxRetries int, op func(context.Context, string) error) BulkVoiceResult {
+	res := BulkVoiceResult{VoiceID: voiceID}
+	for {
+		res.Attempts++
+		res.Err = op(ctx, voiceID)
+		if res.Err == nil || res.Attempts > maxRetries || !isRetryableError(res.Err) {
+			return res
+		}
+
+		wait := DefaultBatchRetryBackoff << (res.Attempts - 1)
+		if IsRateLimitError(res.Err) {
+			if after := s.client.RateLimitState().RetryAfter; after > wait {
+				wait = after
+			}
+		}
+		select {
+		case <-s.client.clock.After(wait):
+		case <-ctx.Done():
+			res.Err = ctx.Err()
+			return res
+		}
+	}
+}
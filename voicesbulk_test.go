@@ -0,0 +1,93 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestVoicesDeleteMany(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimPrefix(r.URL.Path, "/v1/voices/")
+		if r.Method != http.MethodDelete || id == "missing" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":{"status":"voice_not_found"}}`))
+			return
+		}
+		mu.Lock()
+		deleted = append(deleted, id)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	ids := []string{"v1", "missing", "v2"}
+
+	results := client.Voices().DeleteMany(context.Background(), ids, &BulkVoiceOptions{DryRun: true})
+	if len(results) != 3 || len(deleted) != 0 {
+		t.Fatalf("dry run: results = %+v, deleted = %v", results, deleted)
+	}
+
+	results = client.Voices().DeleteMany(context.Background(), ids, &BulkVoiceOptions{MaxRetries: -1})
+	for i, r := range results {
+		if r.VoiceID != ids[i] {
+			t.Errorf("results[%d].VoiceID = %s, want %s", i, r.VoiceID, ids[i])
+		}
+		if (r.Err != nil) != (ids[i] == "missing") {
+			t.Errorf("results[%d].Err = %v", i, r.Err)
+		}
+	}
+	if len(deleted) != 2 {
+		t.Errorf("deleted = %v, want v1 and v2", deleted)
+	}
+}
+
+func TestVoicesUpdateSettingsMany(t *testing.T) {
+	var mu sync.Mutex
+	got := make(map[string]map[string]any)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/v1/voices/"), "/settings/edit")
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		mu.Lock()
+		got[id] = body
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	ids := []string{"v1", "v2"}
+
+	results := client.Voices().UpdateSettingsMany(context.Background(), ids, VoiceSettingsForPodcast(), nil)
+	for _, r := range results {
+		if r.Err != nil {
+			t.Errorf("%s: %v", r.VoiceID, r.Err)
+		}
+	}
+	if len(got) != 2 || got["v2"]["stability"] != VoiceSettingsForPodcast().Stability {
+		t.Errorf("requests = %v", got)
+	}
+
+	// Invalid settings fail every voice without requests
+	clear(got)
+	results = client.Voices().UpdateSettingsMany(context.Background(), ids, &VoiceSettings{Stability: 2}, nil)
+	for _, r := range results {
+		if r.Err != ErrInvalidStability {
+			t.Errorf("%s: err = %v, want ErrInvalidStability", r.VoiceID, r.Err)
+		}
+	}
+	if len(got) != 0 {
+		t.Errorf("invalid settings made requests: %v", got)
+	}
+}
@@ -1,5 +1,11 @@
 package elevenlabs
 
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
 // Voice settings presets for different platforms and use cases.
 //
 // These presets are tuned for specific content types and platforms.
@@ -100,3 +106,98 @@ func VoiceSettingsForAudiobook() *VoiceSettings {
 		UseSpeakerBoost: true,
 	}
 }
+
+// Named voice settings presets for general use. Each trades consistency
+// (higher stability, lower style) against expressiveness (lower stability,
+// higher style); expressive settings vary more between generations and are
+// more likely to produce artifacts on long text.
+const (
+	// PresetNarration is for long-form narration: consistent delivery across
+	// many segments, with a little style so it does not sound flat.
+	PresetNarration = "narration"
+
+	// PresetConversational is for dialogue and informal content: more natural
+	// variation at the cost of some consistency between takes.
+	PresetConversational = "conversational"
+
+	// PresetExpressive is for short, emotive content such as trailers and
+	// social clips. Takes vary noticeably; regenerate and pick the best.
+	PresetExpressive = "expressive"
+
+	// PresetStable is for maximum reproducibility, e.g. UI prompts or
+	// segments that are regenerated individually and must match their
+	// neighbors. Delivery can sound monotone.
+	PresetStable = "stable"
+)
+
+var (
+	presetsMu sync.RWMutex
+	presets   = map[string]func() *VoiceSettings{
+		PresetNarration: func() *VoiceSettings {
+			return &VoiceSettings{Stability: 0.6, SimilarityBoost: 0.8, Style: 0.05, Speed: 1.0, UseSpeakerBoost: true}
+		},
+		PresetConversational: func() *VoiceSettings {
+			return &VoiceSettings{Stability: 0.4, SimilarityBoost: 0.75, Style: 0.2, Speed: 1.0, UseSpeakerBoost: true}
+		},
+		PresetExpressive: func() *VoiceSettings {
+			return &VoiceSettings{Stability: 0.3, SimilarityBoost: 0.8, Style: 0.5, Speed: 1.0, UseSpeakerBoost: true}
+		},
+		PresetStable: func() *VoiceSettings {
+			return &VoiceSettings{Stability: 0.85, SimilarityBoost: 0.9, Style: 0.0, Speed: 1.0, UseSpeakerBoost: true}
+		},
+		"udemy":     VoiceSettingsForUdemy,
+		"coursera":  VoiceSettingsForCoursera,
+		"edx":       VoiceSettingsForEdX,
+		"instagram": VoiceSettingsForInstagram,
+		"tiktok":    VoiceSettingsForTikTok,
+		"youtube":   VoiceSettingsForYouTube,
+		"podcast":   VoiceSettingsForPodcast,
+		"audiobook": VoiceSettingsForAudiobook,
+	}
+)
+
+// VoiceSettingsPreset returns a copy of the named preset. Names are
+// case-insensitive. The platform presets above are registered under
+// lowercase platform names (e.g., "udemy", "podcast").
+func VoiceSettingsPreset(name string) (*VoiceSettings, bool) {
+	presetsMu.RLock()
+	fn, ok := presets[strings.ToLower(name)]
+	presetsMu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return fn(), true
+}
+
+// RegisterVoiceSettingsPreset adds or replaces a named preset.
+func RegisterVoiceSettingsPreset(name string, settings *VoiceSettings) error {
+	if name == "" {
+		return &ValidationError{Field: "name", Message: "cannot be empty"}
+	}
+	if settings == nil {
+		return &ValidationError{Field: "settings", Message: "cannot be nil"}
+	}
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+	snapshot := *settings
+	presetsMu.Lock()
+	presets[strings.ToLower(name)] = func() *VoiceSettings {
+		vs := snapshot
+		return &vs
+	}
+	presetsMu.Unlock()
+	return nil
+}
+
+// VoiceSettingsPresetNames returns the registered preset names, sorted.
+func VoiceSettingsPresetNames() []string {
+	presetsMu.RLock()
+	defer presetsMu.RUnlock()
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,224 @@
+package elevenlabs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestVoiceSettingsPresets(t *testing.T) {
+	for _, name := range []string{PresetNarration, PresetConversational, PresetExpressive, PresetStable, "udemy", "Podcast"} {
+		vs, ok := VoiceSettingsPreset(name)
+		if !ok {
+			t.Errorf("VoiceSettingsPreset(%q) not found", name)
+			continue
+		}
+		if err := vs.Validate(); err != nil {
+			t.Errorf("preset %q is invalid: %v", name, err)
+		}
+	}
+
+	if _, ok := VoiceSettingsPreset("missing"); ok {
+		t.Error("VoiceSettingsPreset(missing) should not be found")
+	}
+
+	// Callers get a copy they can modify.
+	vs, _ := VoiceSettingsPreset(PresetStable)
+	vs.Stability = 0
+	if again, _ := VoiceSettingsPreset(PresetStable); again.Stability != 0.85 {
+		t.Errorf("preset was modified through a returned copy: %+v", again)
+	}
+}
+
+func TestRegisterVoiceSettingsPreset(t *testing.T) {
+	settings := &VoiceSettings{Stability: 0.7, SimilarityBoost: 0.8, Speed: 0.9}
+	if err := RegisterVoiceSettingsPreset("Lecture", settings); err != nil {
+		t.Fatalf("RegisterVoiceSettingsPreset() error = %v", err)
+	}
+	settings.Speed = 2 // later changes do not affect the registered preset
+
+	got, ok := VoiceSettingsPreset("lecture")
+	if !ok || got.Speed != 0.9 || got.Stability != 0.7 {
+		t.Errorf("VoiceSettingsPreset(lecture) = %+v, %v", got, ok)
+	}
+	if !slices.Contains(VoiceSettingsPresetNames(), "lecture") {
+		t.Errorf("VoiceSettingsPresetNames() = %v, missing lecture", VoiceSettingsPresetNames())
+	}
+
+	if err := RegisterVoiceSettingsPreset("bad", &VoiceSettings{Stability: 2}); !errors.Is(err, ErrInvalidStability) {
+		t.Errorf("invalid preset error = %v, want ErrInvalidStability", err)
+	}
+	if err := RegisterVoiceSettingsPreset("", settings); !isValidationError(err, nil) {
+		t.Errorf("empty name error = %v, want ValidationError", err)
+	}
+}
+
+func TestNewVoiceSettingsSendsExplicitZeros(t *testing.T) {
+	o := NewVoiceSettings().WithStyle(0).WithSpeakerBoost(false).Optional()
+	if o.Style == nil || *o.Style != 0 || o.UseSpeakerBoost == nil || *o.UseSpeakerBoost {
+		t.Errorf("explicit zeros not sent: %+v", o)
+	}
+	if o.Stability != nil || o.SimilarityBoost != nil || o.Speed != nil {
+		t.Errorf("unset fields sent: %+v", o)
+	}
+
+	m := apiVoiceSettings(NewVoiceSettings().WithStyle(0), legacyTTS)
+	if !m.Style.Set || m.Style.Value != 0 || m.Stability.Set {
+		t.Errorf("apiVoiceSettings() = %+v", m)
+	}
+
+	if err := NewVoiceSettings().WithSpeed(0).Validate(); !errors.Is(err, ErrInvalidSpeed) {
+		t.Errorf("explicit zero speed error = %v, want ErrInvalidSpeed", err)
+	}
+	if err := (&VoiceSettings{Stability: 0.5}).Validate(); err != nil {
+		t.Errorf("zero speed literal error = %v, want nil", err)
+	}
+}
+
+func TestVoiceSettingsLiteralsSendAsBefore(t *testing.T) {
+	o := (&VoiceSettings{Stability: 0.5, SimilarityBoost: 0.75}).Optional()
+	if o.Style == nil || o.UseSpeakerBoost == nil || o.Stability == nil || o.SimilarityBoost == nil {
+		t.Errorf("literal fields not sent: %+v", o)
+	}
+	if o.Speed != nil {
+		t.Errorf("zero speed sent: %v", *o.Speed)
+	}
+
+	// Setting a field keeps the fields a literal already sends
+	o = DefaultVoiceSettings().WithStyle(0.3).Optional()
+	if o.Stability == nil || *o.Stability != 0.5 || o.Speed == nil || *o.Speed != 1.0 || *o.Style != 0.3 {
+		t.Errorf("With on a literal dropped fields: %+v", o)
+	}
+}
+
+func TestOptionalVoiceSettings(t *testing.T) {
+	style, boost := 0.0, false
+	vs := OptionalVoiceSettings{Style: &style, UseSpeakerBoost: &boost}.VoiceSettings()
+	o := vs.Optional()
+	if o.Style == nil || o.UseSpeakerBoost == nil || o.Stability != nil || o.Speed != nil {
+		t.Errorf("round trip = %+v", o)
+	}
+
+	// The copy doesn't follow later changes
+	vs.Style = 0.4
+	if *o.Style != 0 {
+		t.Errorf("Optional() aliases the settings: %v", *o.Style)
+	}
+}
+
+// literalVoiceSettings is settings written as a struct literal, with a zero
+// Style and a nonzero Speed.
+func literalVoiceSettings(boost bool) *VoiceSettings {
+	return &VoiceSettings{Stability: 0.5, SimilarityBoost: 0.75, Speed: 1.1, UseSpeakerBoost: boost}
+}
+
+func TestVoiceSettingsLiteralWireTTS(t *testing.T) {
+	bodies := make(chan map[string]any, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			VoiceSettings map[string]any `json:"voice_settings"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		bodies <- body.VoiceSettings
+		w.Header().Set("Content-Type", "audio/mpeg")
+		_, _ = w.Write([]byte("audio"))
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	// Generate never sent use_speaker_boost
+	want := map[string]any{"stability": 0.5, "similarity_boost": 0.75, "style": 0.0, "speed": 1.1}
+	for _, boost := range []bool{true, false} {
+		req := &TTSRequest{VoiceID: "v1", Text: "Hello.", VoiceSettings: literalVoiceSettings(boost)}
+		if _, err := client.TextToSpeech().Generate(context.Background(), req); err != nil {
+			t.Fatalf("Generate() error = %v", err)
+		}
+		if got := <-bodies; !reflect.DeepEqual(got, want) {
+			t.Errorf("boost=%v voice_settings = %v, want %v", boost, got, want)
+		}
+	}
+}
+
+func TestVoiceSettingsLiteralWireWebSocket(t *testing.T) {
+	inits := make(chan map[string]any, 2)
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		var msg struct {
+			VoiceSettings map[string]any `json:"voice_settings"`
+		}
+		if err := conn.ReadJSON(&msg); err == nil {
+			inits <- msg.VoiceSettings
+		}
+	}))
+	defer server.Close()
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	// A zero style and a false speaker boost were dropped; speed never sent
+	for boost, want := range map[bool]map[string]any{
+		true:  {"stability": 0.5, "similarity_boost": 0.75, "use_speaker_boost": true},
+		false: {"stability": 0.5, "similarity_boost": 0.75},
+	} {
+		conn, err := client.WebSocketTTS().Connect(context.Background(), "v1", &WebSocketTTSOptions{VoiceSettings: literalVoiceSettings(boost)})
+		if err != nil {
+			t.Fatalf("Connect() error = %v", err)
+		}
+		select {
+		case got := <-inits:
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("boost=%v voice_settings = %v, want %v", boost, got, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("no initial message")
+		}
+		_ = conn.Close()
+	}
+}
+
+func TestVoiceSettingsLiteralWireSpeechToSpeech(t *testing.T) {
+	// The fields the separate form fields sent, now as one JSON field
+	for boost, want := range map[bool]map[string]any{
+		true:  {"stability": 0.5, "similarity_boost": 0.75, "use_speaker_boost": true},
+		false: {"stability": 0.5, "similarity_boost": 0.75},
+	} {
+		var buf bytes.Buffer
+		writer := multipart.NewWriter(&buf)
+		req := &SpeechToSpeechRequest{Audio: strings.NewReader("audio"), VoiceSettings: literalVoiceSettings(boost)}
+		if err := req.writeForm(writer, false); err != nil {
+			t.Fatalf("writeForm() error = %v", err)
+		}
+		_ = writer.Close()
+
+		form, err := multipart.NewReader(&buf, writer.Boundary()).ReadForm(1 << 20)
+		if err != nil {
+			t.Fatalf("ReadForm() error = %v", err)
+		}
+		for _, name := range []string{"stability", "similarity_boost", "style", "use_speaker_boost"} {
+			if _, ok := form.Value[name]; ok {
+				t.Errorf("separate form field %q sent", name)
+			}
+		}
+		var got map[string]any
+		if err := json.Unmarshal([]byte(form.Value["voice_settings"][0]), &got); err != nil {
+			t.Fatalf("voice_settings = %q: %v", form.Value["voice_settings"], err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("boost=%v voice_settings = %v, want %v", boost, got, want)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package elevenlabs
+
+import "github.com/agentplexus/go-elevenlabs/internal/api"
+
+// voiceSettingsField marks a VoiceSettings field as set explicitly, so it
+// is sent even when zero.
+type voiceSettingsField uint8
+
+const (
+	fieldStability voiceSettingsField = 1 << iota
+	fieldSimilarityBoost
+	fieldStyle
+	fieldSpeed
+	fieldSpeakerBoost
+
+	// fieldsBuilt marks settings started with NewVoiceSettings, which send
+	// only the fields set on them.
+	fieldsBuilt voiceSettingsField = 1 << 7
+)
+
+// legacyFields is the way an endpoint sent settings written as struct
+// literals before NewVoiceSettings, which those settings keep.
+type legacyFields struct {
+	// always are sent even when zero.
+	always voiceSettingsField
+	// never are not sent even when nonzero.
+	never voiceSettingsField
+}
+
+var (
+	// legacyDefault sends every field but a zero Speed, as streaming TTS
+	// and Voices().UpdateSettings did.
+	legacyDefault = legacyFields{
+		always: fieldStability | fieldSimilarityBoost | fieldStyle | fieldSpeakerBoost,
+	}
+
+	// legacyTTS is legacyDefault without speaker boost, which
+	// TextToSpeech().Generate never sent.
+	legacyTTS = legacyFields{
+		always: fieldStability | fieldSimilarityBoost | fieldStyle,
+		never:  fieldSpeakerBoost,
+	}
+
+	// legacyWebSocket drops a zero Style and a false speaker boost and
+	// never sends Speed.
+	legacyWebSocket = legacyFields{
+		always: fieldStability | fieldSimilarityBoost,
+		never:  fieldSpeed,
+	}
+
+	// legacySpeechToSpeech matches the form fields speech-to-speech sent
+	// before voice settings were sent as one JSON field.
+	legacySpeechToSpeech = legacyWebSocket
+)
+
+// NewVoiceSettings returns empty voice settings to fill in with the With
+// methods. Only the fields set are sent, zero or not; the others keep the
+// voice's stored settings. For example, to turn style off for one request
+// without touching the rest:
+//
+//	settings := elevenlabs.NewVoiceSettings().WithStyle(0)
+//
+// Settings written as struct literals, such as DefaultVoiceSettings and
+// the presets, send the fields each endpoint sent before: text-to-speech
+// never sends UseSpeakerBoost, WebSocket TTS and speech-to-speech drop a
+// zero Style, a false UseSpeakerBoost, and Speed, and streaming TTS and
+// UpdateSettings send everything but a zero Speed. Calling a With method
+// on them keeps that and additionally sends the field set.
+func NewVoiceSettings() *VoiceSettings {
+	return &VoiceSettings{set: fieldsBuilt}
+}
+
+// WithStability sets Stability and returns vs.
+func (vs *VoiceSettings) WithStability(stability float64) *VoiceSettings {
+	vs.Stability = stability
+	vs.mark(fieldStability)
+	return vs
+}
+
+// WithSimilarityBoost sets SimilarityBoost and returns vs.
+func (vs *VoiceSettings) WithSimilarityBoost(similarityBoost float64) *VoiceSettings {
+	vs.SimilarityBoost = similarityBoost
+	vs.mark(fieldSimilarityBoost)
+	return vs
+}
+
+// WithStyle sets Style and returns vs.
+func (vs *VoiceSettings) WithStyle(style float64) *VoiceSettings {
+	vs.Style = style
+	vs.mark(fieldStyle)
+	return vs
+}
+
+// WithSpeed sets Speed and returns vs. Unlike a zero Speed field, an
+// explicit zero fails Validate, since speeds start at 0.25.
+func (vs *VoiceSettings) WithSpeed(speed float64) *VoiceSettings {
+	vs.Speed = speed
+	vs.mark(fieldSpeed)
+	return vs
+}
+
+// WithSpeakerBoost sets UseSpeakerBoost and returns vs.
+func (vs *VoiceSettings) WithSpeakerBoost(useSpeakerBoost bool) *VoiceSettings {
+	vs.UseSpeakerBoost = useSpeakerBoost
+	vs.mark(fieldSpeakerBoost)
+	return vs
+}
+
+// mark records that a field was set explicitly.
+func (vs *VoiceSettings) mark(field voiceSettingsField) {
+	vs.set |= field
+	vs.cleared &^= field
+}
+
+// unset clears a field, so it is no longer sent unless it is set again.
+func (vs *VoiceSettings) unset(field voiceSettingsField) {
+	vs.set &^= field
+	vs.cleared |= field
+}
+
+// sends reports whether a field with the given zero-ness is sent to an
+// endpoint that sent struct literals as legacy does.
+func (vs *VoiceSettings) sends(field voiceSettingsField, nonzero bool, legacy legacyFields) bool {
+	switch {
+	case vs.cleared&field != 0:
+		return false
+	case vs.set&field != 0:
+		return true
+	case vs.set&fieldsBuilt != 0:
+		return nonzero
+	case legacy.never&field != 0:
+		return false
+	}
+	return nonzero || legacy.always&field != 0
+}
+
+// OptionalVoiceSettings is VoiceSettings with a pointer per field, nil
+// meaning unset, for settings decoded from JSON or configuration. It is
+// also the JSON form voice settings are sent in.
+type OptionalVoiceSettings struct {
+	Stability       *float64 `json:"stability,omitempty"`
+	SimilarityBoost *float64 `json:"similarity_boost,omitempty"`
+	Style           *float64 `json:"style,omitempty"`
+	Speed           *float64 `json:"speed,omitempty"`
+	UseSpeakerBoost *bool    `json:"use_speaker_boost,omitempty"`
+}
+
+// VoiceSettings converts the settings; only the non-nil fields are sent.
+func (o OptionalVoiceSettings) VoiceSettings() *VoiceSettings {
+	vs := NewVoiceSettings()
+	if o.Stability != nil {
+		vs.WithStability(*o.Stability)
+	}
+	if o.SimilarityBoost != nil {
+		vs.WithSimilarityBoost(*o.SimilarityBoost)
+	}
+	if o.Style != nil {
+		vs.WithStyle(*o.Style)
+	}
+	if o.Speed != nil {
+		vs.WithSpeed(*o.Speed)
+	}
+	if o.UseSpeakerBoost != nil {
+		vs.WithSpeakerBoost(*o.UseSpeakerBoost)
+	}
+	return vs
+}
+
+// Optional returns a copy of the settings as they are sent: fields that
+// are not sent are nil. Settings written as struct literals send every
+// field but a zero Speed, as streaming TTS and UpdateSettings do.
+func (vs *VoiceSettings) Optional() OptionalVoiceSettings {
+	return vs.optional(legacyDefault)
+}
+
+// optional is Optional for an endpoint that sent struct literals as
+// legacy does.
+func (vs *VoiceSettings) optional(legacy legacyFields) OptionalVoiceSettings {
+	var o OptionalVoiceSettings
+	copied := *vs
+	vs = &copied
+	if vs.sends(fieldStability, vs.Stability != 0, legacy) {
+		o.Stability = &vs.Stability
+	}
+	if vs.sends(fieldSimilarityBoost, vs.SimilarityBoost != 0, legacy) {
+		o.SimilarityBoost = &vs.SimilarityBoost
+	}
+	if vs.sends(fieldStyle, vs.Style != 0, legacy) {
+		o.Style = &vs.Style
+	}
+	if vs.sends(fieldSpeed, vs.Speed != 0, legacy) {
+		o.Speed = &vs.Speed
+	}
+	if vs.sends(fieldSpeakerBoost, vs.UseSpeakerBoost, legacy) {
+		o.UseSpeakerBoost = &vs.UseSpeakerBoost
+	}
+	return o
+}
+
+// apiVoiceSettings converts voice settings for the generated client,
+// sending struct literals as legacy does.
+func apiVoiceSettings(vs *VoiceSettings, legacy legacyFields) api.VoiceSettingsResponseModel {
+	o := vs.optional(legacy)
+	var m api.VoiceSettingsResponseModel
+	if o.Stability != nil {
+		m.Stability = api.NewOptNilFloat64(*o.Stability)
+	}
+	if o.SimilarityBoost != nil {
+		m.SimilarityBoost = api.NewOptNilFloat64(*o.SimilarityBoost)
+	}
+	if o.Style != nil {
+		m.Style = api.NewOptNilFloat64(*o.Style)
+	}
+	if o.Speed != nil {
+		m.Speed = api.NewOptNilFloat64(*o.Speed)
+	}
+	if o.UseSpeakerBoost != nil {
+		m.UseSpeakerBoost = api.NewOptNilBool(*o.UseSpeakerBoost)
+	}
+	return m
+}
+
+// voiceSettingsFromAPI converts voice settings from the generated client.
+// The fields the API returned are marked set, so the settings can be sent
+// back unchanged.
+func voiceSettingsFromAPI(m *api.VoiceSettingsResponseModel) *VoiceSettings {
+	var o OptionalVoiceSettings
+	if m.Stability.Set && !m.Stability.Null {
+		o.Stability = &m.Stability.Value
+	}
+	if m.SimilarityBoost.Set && !m.SimilarityBoost.Null {
+		o.SimilarityBoost = &m.SimilarityBoost.Value
+	}
+	if m.Style.Set && !m.Style.Null {
+		o.Style = &m.Style.Value
+	}
+	if m.Speed.Set && !m.Speed.Null {
+		o.Speed = &m.Speed.Value
+	}
+	if m.UseSpeakerBoost.Set && !m.UseSpeakerBoost.Null {
+		o.UseSpeakerBoost = &m.UseSpeakerBoost.Value
+	}
+	return o.VoiceSettings()
+}
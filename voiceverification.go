@@ -0,0 +1,189 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// VerificationCaptcha is the captcha for verifying a professional voice
+// clone (PVC). The voice owner reads it aloud and the recording is sent
+// with SubmitVerificationRecording.
+type VerificationCaptcha struct {
+	// Text is the text to read, when the captcha is text.
+	Text string
+
+	// Image is the captcha image, when the captcha is an image of the text.
+	Image []byte
+
+	// ContentType is the media type of the captcha response.
+	ContentType string
+}
+
+// VerificationDocument is a file sent for manual verification, such as a
+// signed consent form.
+type VerificationDocument struct {
+	// Filename is the document's file name.
+	Filename string
+
+	// Data is the document content.
+	Data io.Reader
+}
+
+// ManualVerificationRequest asks ElevenLabs to verify a professional voice
+// clone manually, for voice owners who can't complete the captcha.
+type ManualVerificationRequest struct {
+	// Documents are the verification documents.
+	Documents []VerificationDocument
+
+	// ExtraText is additional context for the reviewer (optional).
+	ExtraText string
+}
+
+// verificationStatus is the response to verification requests.
+type verificationStatus struct {
+	Status string `json:"status"`
+}
+
+// GetVerificationCaptcha returns the captcha for verifying a professional
+// voice clone. Show it to the voice owner, record them reading it, and send
+// the recording with SubmitVerificationRecording.
+func (s *VoicesService) GetVerificationCaptcha(ctx context.Context, voiceID string) (*VerificationCaptcha, error) {
+	if voiceID == "" {
+		return nil, ErrEmptyVoiceID
+	}
+
+	// The generated client discards the captcha body, so it is read directly
+	path := fmt.Sprintf("/v1/voices/pvc/%s/captcha", url.PathEscape(voiceID))
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", s.client.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.client.headers.apply(httpReq.Header)
+
+	resp, err := s.client.rawHTTPClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &APIError{StatusCode: resp.StatusCode, Message: string(data)}
+	}
+
+	captcha := &VerificationCaptcha{ContentType: resp.Header.Get("Content-Type")}
+	mediaType, _, _ := mime.ParseMediaType(captcha.ContentType)
+	switch {
+	case strings.HasPrefix(mediaType, "image/"):
+		captcha.Image = data
+	case mediaType == "application/json":
+		var text string
+		if json.Unmarshal(data, &text) == nil {
+			captcha.Text = text
+			break
+		}
+		var obj struct {
+			Text    string `json:"text"`
+			Captcha string `json:"captcha"`
+		}
+		if err := json.Unmarshal(data, &obj); err != nil {
+			return nil, fmt.Errorf("failed to decode captcha: %w", err)
+		}
+		captcha.Text = obj.Text
+		if captcha.Text == "" {
+			captcha.Text = obj.Captcha
+		}
+	default:
+		captcha.Text = strings.TrimSpace(string(data))
+	}
+	return captcha, nil
+}
+
+// SubmitVerificationRecording sends a recording of the voice owner reading
+// the captcha from GetVerificationCaptcha. filename is used for format
+// detection and defaults to "recording.mp3". It returns an error if the
+// recording is not accepted.
+func (s *VoicesService) SubmitVerificationRecording(ctx context.Context, voiceID string, recording io.Reader, filename string) error {
+	if voiceID == "" {
+		return ErrEmptyVoiceID
+	}
+	if recording == nil {
+		return &ValidationError{Field: "recording", Message: "cannot be nil"}
+	}
+	if filename == "" {
+		filename = "recording.mp3"
+	}
+
+	path := fmt.Sprintf("/v1/voices/pvc/%s/captcha", url.PathEscape(voiceID))
+	resp, err := s.client.postMultipart(ctx, path, func(w *multipart.Writer) error {
+		return writeFormFile(w, "recording", filename, recording)
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeVerificationStatus(resp.Body)
+}
+
+// RequestManualVerification asks ElevenLabs to verify a professional voice
+// clone manually from documents, instead of with the captcha.
+func (s *VoicesService) RequestManualVerification(ctx context.Context, voiceID string, req *ManualVerificationRequest) error {
+	if voiceID == "" {
+		return ErrEmptyVoiceID
+	}
+	if req == nil || len(req.Documents) == 0 {
+		return &ValidationError{Field: "documents", Message: "cannot be empty"}
+	}
+	for i, doc := range req.Documents {
+		if doc.Data == nil {
+			return &ValidationError{Field: "documents", Message: fmt.Sprintf("document %d has no data", i+1)}
+		}
+	}
+
+	path := fmt.Sprintf("/v1/voices/pvc/%s/verification", url.PathEscape(voiceID))
+	resp, err := s.client.postMultipart(ctx, path, func(w *multipart.Writer) error {
+		for i, doc := range req.Documents {
+			filename := doc.Filename
+			if filename == "" {
+				filename = fmt.Sprintf("document%d", i+1)
+			}
+			if err := writeFormFile(w, "files", filename, doc.Data); err != nil {
+				return err
+			}
+		}
+		if req.ExtraText != "" {
+			if err := w.WriteField("extra_text", req.ExtraText); err != nil {
+				return fmt.Errorf("failed to write extra_text: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return decodeVerificationStatus(resp.Body)
+}
+
+// decodeVerificationStatus returns an error unless a verification response
+// has status "ok".
+func decodeVerificationStatus(r io.Reader) error {
+	var result verificationStatus
+	if err := json.NewDecoder(r).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	if result.Status != "ok" {
+		return &APIError{StatusCode: http.StatusOK, Message: "verification not accepted", Detail: result.Status}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package elevenlabs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVoicesVerificationFlow(t *testing.T) {
+	var recording, extraText string
+	var documents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/voices/pvc/voice1/captcha":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`"the quick brown fox"`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/voices/pvc/voice1/captcha":
+			f, _, err := r.FormFile("recording")
+			if err != nil {
+				t.Errorf("FormFile(recording) error = %v", err)
+				return
+			}
+			data, _ := io.ReadAll(f)
+			recording = string(data)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/voices/pvc/voice1/verification":
+			_ = r.ParseMultipartForm(1 << 20)
+			for _, fh := range r.MultipartForm.File["files"] {
+				documents = append(documents, fh.Filename)
+			}
+			extraText = r.FormValue("extra_text")
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status": "ok"}`))
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"status": "recording does not match"}`))
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+	voices := client.Voices()
+	ctx := context.Background()
+
+	captcha, err := voices.GetVerificationCaptcha(ctx, "voice1")
+	if err != nil {
+		t.Fatalf("GetVerificationCaptcha() error = %v", err)
+	}
+	if captcha.Text != "the quick brown fox" {
+		t.Errorf("captcha text = %q", captcha.Text)
+	}
+
+	if err := voices.SubmitVerificationRecording(ctx, "voice1", strings.NewReader("audio"), ""); err != nil {
+		t.Fatalf("SubmitVerificationRecording() error = %v", err)
+	}
+	if recording != "audio" {
+		t.Errorf("recording = %q", recording)
+	}
+	if err := voices.SubmitVerificationRecording(ctx, "voice2", strings.NewReader("audio"), ""); err == nil {
+		t.Error("SubmitVerificationRecording() accepted a rejected recording")
+	}
+
+	err = voices.RequestManualVerification(ctx, "voice1", &ManualVerificationRequest{
+		Documents: []VerificationDocument{{Filename: "consent.pdf", Data: strings.NewReader("pdf")}},
+		ExtraText: "Owner is unavailable to record",
+	})
+	if err != nil {
+		t.Fatalf("RequestManualVerification() error = %v", err)
+	}
+	if len(documents) != 1 || documents[0] != "consent.pdf" || extraText == "" {
+		t.Errorf("documents = %v, extra text = %q", documents, extraText)
+	}
+	if err := voices.RequestManualVerification(ctx, "voice1", &ManualVerificationRequest{}); !isValidationError(err, nil) {
+		t.Errorf("RequestManualVerification() without documents = %v", err)
+	}
+}
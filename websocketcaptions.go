@@ -0,0 +1,258 @@
+package elevenlabs
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Caption defaults.
+const (
+	// DefaultCaptionMaxChars is the maximum characters per cue, the common
+	// broadcast subtitle line length.
+	DefaultCaptionMaxChars = 42
+
+	// DefaultCaptionMaxDuration is the maximum time span of a cue's words.
+	DefaultCaptionMaxDuration = 6 * time.Second
+
+	// DefaultCaptionMinDuration is the minimum display time of a cue.
+	DefaultCaptionMinDuration = time.Second
+
+	// DefaultCaptionFlushAfter is how long StreamCaptions waits for more
+	// alignments before emitting a partial cue.
+	DefaultCaptionFlushAfter = time.Second
+)
+
+// CaptionOptions configures caption cues built from TTS alignments.
+type CaptionOptions struct {
+	// MaxChars is the maximum characters per cue.
+	// Defaults to DefaultCaptionMaxChars.
+	MaxChars int
+
+	// MaxDuration is the maximum time from a cue's first word to its last.
+	// Defaults to DefaultCaptionMaxDuration.
+	MaxDuration time.Duration
+
+	// MinDuration is the minimum display time; shorter cues are extended.
+	// Defaults to DefaultCaptionMinDuration.
+	MinDuration time.Duration
+
+	// FlushAfter is how long StreamCaptions waits for more alignments
+	// before emitting the words received so far.
+	// Defaults to DefaultCaptionFlushAfter.
+	FlushAfter time.Duration
+}
+
+// CaptionCue is a caption to display for a span of the audio stream.
+type CaptionCue struct {
+	// Text is the caption text.
+	Text string
+
+	// Start is when the first word starts, from the start of the stream.
+	Start time.Duration
+
+	// End is when to stop displaying the cue. It is at least MinDuration
+	// after Start, so it may overlap the next cue.
+	End time.Duration
+}
+
+// Duration returns how long the cue is displayed.
+func (c CaptionCue) Duration() time.Duration {
+	return c.End - c.Start
+}
+
+type captionWord struct {
+	text       string
+	start, end float64
+}
+
+// CaptionBuilder turns TTS alignment chunks into caption cues. Words split
+// across chunks are joined, and chunk times relative to the chunk's audio
+// are offset to the stream timeline. Cues break at sentence ends and when
+// MaxChars or MaxDuration would be exceeded.
+//
+// A CaptionBuilder is not safe for concurrent use.
+type CaptionBuilder struct {
+	opts CaptionOptions
+
+	// offset is added to chunk times; lastEnd is the latest stream time seen
+	offset, lastEnd float64
+	started         bool
+
+	word               strings.Builder
+	wordStart, wordEnd float64
+	inWord             bool
+
+	words []captionWord
+}
+
+// NewCaptionBuilder creates a caption builder. opts may be nil for defaults.
+func NewCaptionBuilder(opts *CaptionOptions) *CaptionBuilder {
+	b := &CaptionBuilder{}
+	if opts != nil {
+		b.opts = *opts
+	}
+	if b.opts.MaxChars <= 0 {
+		b.opts.MaxChars = DefaultCaptionMaxChars
+	}
+	if b.opts.MaxDuration <= 0 {
+		b.opts.MaxDuration = DefaultCaptionMaxDuration
+	}
+	if b.opts.MinDuration <= 0 {
+		b.opts.MinDuration = DefaultCaptionMinDuration
+	}
+	if b.opts.FlushAfter <= 0 {
+		b.opts.FlushAfter = DefaultCaptionFlushAfter
+	}
+	return b
+}
+
+// Add adds an alignment chunk and returns the cues it completes. A word at
+// the end of the chunk is held until the next chunk or Flush, since it may
+// continue.
+//
+// The API reports times relative to each chunk's audio. A chunk that starts
+// before the end of the previous one is taken as relative and offset by the
+// stream time so far; chunks with stream times are used as-is.
+func (b *CaptionBuilder) Add(a *TTSAlignment) []CaptionCue {
+	if a == nil {
+		return nil
+	}
+	n := min(len(a.Characters), len(a.CharacterStart), len(a.CharacterEnd))
+	if n == 0 {
+		return nil
+	}
+	if b.started && a.CharacterStart[0]+b.offset < b.lastEnd {
+		b.offset = b.lastEnd
+	}
+	b.started = true
+
+	var cues []CaptionCue
+	for i := 0; i < n; i++ {
+		start := a.CharacterStart[i] + b.offset
+		end := a.CharacterEnd[i] + b.offset
+		if end > b.lastEnd {
+			b.lastEnd = end
+		}
+
+		ch := a.Characters[i]
+		if strings.TrimSpace(ch) == "" {
+			cues = append(cues, b.endWord()...)
+			continue
+		}
+		if !b.inWord {
+			b.inWord = true
+			b.wordStart = start
+		}
+		b.word.WriteString(ch)
+		b.wordEnd = end
+	}
+	return cues
+}
+
+// Flush returns cues for all remaining words, such as at the end of the
+// stream.
+func (b *CaptionBuilder) Flush() []CaptionCue {
+	cues := b.endWord()
+	if len(b.words) > 0 {
+		cues = append(cues, b.emit())
+	}
+	return cues
+}
+
+// endWord completes the current word and returns the cues it completes.
+func (b *CaptionBuilder) endWord() []CaptionCue {
+	if !b.inWord {
+		return nil
+	}
+	w := captionWord{text: b.word.String(), start: b.wordStart, end: b.wordEnd}
+	b.word.Reset()
+	b.inWord = false
+
+	var cues []CaptionCue
+	if len(b.words) > 0 {
+		chars := utf8.RuneCountInString(w.text)
+		for _, prev := range b.words {
+			chars += utf8.RuneCountInString(prev.text) + 1
+		}
+		span := seconds(w.end - b.words[0].start)
+		if chars > b.opts.MaxChars || span > b.opts.MaxDuration {
+			cues = append(cues, b.emit())
+		}
+	}
+	b.words = append(b.words, w)
+	if strings.ContainsAny(w.text[len(w.text)-1:], ".!?") {
+		cues = append(cues, b.emit())
+	}
+	return cues
+}
+
+// emit returns the pending words as a cue.
+func (b *CaptionBuilder) emit() CaptionCue {
+	texts := make([]string, len(b.words))
+	for i, w := range b.words {
+		texts[i] = w.text
+	}
+	cue := CaptionCue{
+		Text:  strings.Join(texts, " "),
+		Start: seconds(b.words[0].start),
+		End:   seconds(b.words[len(b.words)-1].end),
+	}
+	if cue.Duration() < b.opts.MinDuration {
+		cue.End = cue.Start + b.opts.MinDuration
+	}
+	b.words = b.words[:0]
+	return cue
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// StreamCaptions consumes alignments and sends caption cues as they
+// complete. If no alignment arrives for FlushAfter, the words received so
+// far are sent as a cue. The returned channel is closed after alignments is
+// closed and the remaining words are sent; it must be drained.
+func StreamCaptions(alignments <-chan *TTSAlignment, opts *CaptionOptions) <-chan CaptionCue {
+	b := NewCaptionBuilder(opts)
+	out := make(chan CaptionCue, 100)
+	go func() {
+		defer close(out)
+		idle := time.NewTimer(b.opts.FlushAfter)
+		defer idle.Stop()
+		for {
+			select {
+			case a, ok := <-alignments:
+				if !ok {
+					for _, cue := range b.Flush() {
+						out <- cue
+					}
+					return
+				}
+				for _, cue := range b.Add(a) {
+					out <- cue
+				}
+				idle.Reset(b.opts.FlushAfter)
+			case <-idle.C:
+				for _, cue := range b.Flush() {
+					out <- cue
+				}
+				idle.Reset(b.opts.FlushAfter)
+			}
+		}
+	}()
+	return out
+}
+
+// Captions returns caption cues for live subtitles, built from the
+// connection's alignments (see StreamCaptions). It consumes Alignments, so
+// do not also read that channel.
+//
+// Example:
+//
+//	for cue := range conn.Captions(nil) {
+//	    fmt.Printf("[%s - %s] %s\n", cue.Start, cue.End, cue.Text)
+//	}
+func (wsc *WebSocketTTSConnection) Captions(opts *CaptionOptions) <-chan CaptionCue {
+	return StreamCaptions(wsc.Alignments(), opts)
+}
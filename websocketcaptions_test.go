@@ -0,0 +1,96 @@
+package elevenlabs
+
+import (
+	"testing"
+	"time"
+)
+
+// alignment builds a chunk with one character every step seconds from start.
+func alignment(text string, start, step float64) *TTSAlignment {
+	a := &TTSAlignment{}
+	for i, r := range []rune(text) {
+		a.Characters = append(a.Characters, string(r))
+		a.CharacterStart = append(a.CharacterStart, start+float64(i)*step)
+		a.CharacterEnd = append(a.CharacterEnd, start+float64(i+1)*step)
+	}
+	return a
+}
+
+func TestCaptionBuilder(t *testing.T) {
+	b := NewCaptionBuilder(&CaptionOptions{MaxChars: 20})
+
+	// Chunk times are relative to each chunk, and "world" is split
+	var cues []CaptionCue
+	cues = append(cues, b.Add(alignment("Hello wor", 0, 0.1))...)
+	cues = append(cues, b.Add(alignment("ld. This is a longer ", 0, 0.1))...)
+	cues = append(cues, b.Add(alignment("sentence here", 0, 0.1))...)
+	if len(cues) != 2 {
+		t.Fatalf("Add() cues = %+v", cues)
+	}
+	cues = append(cues, b.Flush()...)
+
+	want := []CaptionCue{
+		{Text: "Hello world.", Start: 0, End: 1200 * time.Millisecond},
+		{Text: "This is a longer", Start: 1300 * time.Millisecond, End: 2900 * time.Millisecond},
+		{Text: "sentence here", Start: 3000 * time.Millisecond, End: 4300 * time.Millisecond},
+	}
+	if len(cues) != len(want) {
+		t.Fatalf("cues = %+v", cues)
+	}
+	for i := range want {
+		got := cues[i]
+		if got.Text != want[i].Text || (got.Start-want[i].Start).Abs() > time.Millisecond || (got.End-want[i].End).Abs() > time.Millisecond {
+			t.Errorf("cue %d = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func TestCaptionBuilderStreamTimes(t *testing.T) {
+	b := NewCaptionBuilder(nil)
+	b.Add(alignment("Go ", 0, 0.1))
+	// Times continuing the stream are not offset again
+	if cues := b.Add(alignment("now.", 0.3, 0.1)); len(cues) != 0 {
+		t.Fatalf("Add() = %+v, want last word held", cues)
+	}
+	cues := b.Flush()
+	if len(cues) != 1 || cues[0].Text != "Go now." {
+		t.Fatalf("Flush() = %+v", cues)
+	}
+	if cues[0].Start != 0 || cues[0].End != DefaultCaptionMinDuration {
+		t.Errorf("cue = %+v, want extended to MinDuration", cues[0])
+	}
+}
+
+func TestCaptionBuilderMaxDuration(t *testing.T) {
+	b := NewCaptionBuilder(&CaptionOptions{MaxDuration: time.Second})
+	cues := b.Add(alignment("slow words keep coming ", 0, 0.1))
+	cues = append(cues, b.Flush()...)
+	if len(cues) != 3 || cues[0].Text != "slow words" || cues[1].Text != "keep" {
+		t.Errorf("cues = %+v", cues)
+	}
+}
+
+func TestStreamCaptions(t *testing.T) {
+	alignments := make(chan *TTSAlignment, 2)
+	cues := StreamCaptions(alignments, &CaptionOptions{FlushAfter: 20 * time.Millisecond})
+
+	alignments <- alignment("Partial words", 0, 0.1)
+	select {
+	case cue := <-cues:
+		if cue.Text != "Partial words" {
+			t.Errorf("idle cue = %+v", cue)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no cue after idle timeout")
+	}
+
+	alignments <- alignment("Done.", 0, 0.1)
+	close(alignments)
+	var got []CaptionCue
+	for cue := range cues {
+		got = append(got, cue)
+	}
+	if len(got) != 1 || got[0].Text != "Done." || got[0].Start != 1300*time.Millisecond {
+		t.Errorf("cues = %+v", got)
+	}
+}
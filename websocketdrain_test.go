@@ -0,0 +1,157 @@
+package elevenlabs
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// newReplyingWebSocketServer starts a server that reads the initial message
+// and then answers each message with the responses from reply.
+func newReplyingWebSocketServer(t *testing.T, reply func(msg map[string]any) []any) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		for {
+			_, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var msg map[string]any
+			if err := json.Unmarshal(data, &msg); err != nil {
+				return
+			}
+			for _, resp := range reply(msg) {
+				if err := conn.WriteJSON(resp); err != nil {
+					return
+				}
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestWebSocketTTSDrainAndClose(t *testing.T) {
+	audio := base64.StdEncoding.EncodeToString([]byte("last sentence"))
+	server := newReplyingWebSocketServer(t, func(msg map[string]any) []any {
+		// The final audio only arrives once the input is ended
+		if text, ok := msg["text"]; ok && text == "" {
+			return []any{
+				map[string]any{"audio": audio},
+				map[string]any{"isFinal": true},
+			}
+		}
+		return nil
+	})
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	conn, err := client.WebSocketTTS().Connect(context.Background(), "voice1", nil)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if err := conn.SendText("The last sentence."); err != nil {
+		t.Fatalf("SendText() error = %v", err)
+	}
+
+	received := make(chan []byte, 1)
+	go func() {
+		for chunk := range conn.Audio() {
+			received <- chunk
+		}
+		close(received)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := conn.DrainAndClose(ctx); err != nil {
+		t.Fatalf("DrainAndClose() error = %v", err)
+	}
+	if chunk := <-received; string(chunk) != "last sentence" {
+		t.Errorf("audio = %q, want the final chunk", chunk)
+	}
+	if err := conn.SendText("more"); err == nil {
+		t.Error("SendText() after DrainAndClose should fail")
+	}
+}
+
+func TestWebSocketTTSDrainAndCloseTimeout(t *testing.T) {
+	server := newReplyingWebSocketServer(t, func(map[string]any) []any { return nil })
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	conn, err := client.WebSocketTTS().Connect(context.Background(), "voice1", nil)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := conn.DrainAndClose(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("DrainAndClose() error = %v, want context.DeadlineExceeded", err)
+	}
+	for range conn.Audio() {
+	}
+}
+
+func TestWebSocketSTTDrainAndClose(t *testing.T) {
+	server := newReplyingWebSocketServer(t, func(msg map[string]any) []any {
+		switch msg["type"] {
+		case "audio":
+			return []any{map[string]any{"type": "transcript", "text": "hello", "is_final": true}}
+		case "end_of_stream":
+			return []any{
+				map[string]any{"type": "transcript", "text": "hello wor"},
+				map[string]any{"type": "transcript", "text": "hello world", "is_final": true},
+			}
+		}
+		return nil
+	})
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	conn, err := client.WebSocketSTT().Connect(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	var finals []string
+	done := make(chan struct{})
+	go func() {
+		for tr := range conn.Transcripts() {
+			if tr.IsFinal {
+				finals = append(finals, tr.Text)
+			}
+		}
+		close(done)
+	}()
+
+	if err := conn.SendAudio([]byte{0, 1}); err != nil {
+		t.Fatalf("SendAudio() error = %v", err)
+	}
+	// Let the earlier final transcript arrive first
+	time.Sleep(50 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := conn.DrainAndClose(ctx); err != nil {
+		t.Fatalf("DrainAndClose() error = %v", err)
+	}
+	<-done
+	if len(finals) != 2 || finals[1] != "hello world" {
+		t.Errorf("final transcripts = %q, want the one after the end of the stream", finals)
+	}
+}
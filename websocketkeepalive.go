@@ -0,0 +1,169 @@
+package elevenlabs
+
+import (
+	"errors"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+// WebSocket keepalive defaults.
+const (
+	DefaultWebSocketPingInterval = 15 * time.Second
+	DefaultWebSocketPongTimeout  = 10 * time.Second
+	DefaultWebSocketWriteTimeout = 10 * time.Second
+)
+
+// WebSocketKeepalive configures health checks for a WebSocket connection,
+// so a half-open connection is detected and its channels closed instead of
+// blocking consumers forever. Zero values disable each check.
+type WebSocketKeepalive struct {
+	// PingInterval is how often to ping the server.
+	PingInterval time.Duration
+
+	// PongTimeout is how long to wait past a ping interval for a pong (or
+	// any message) before the connection is considered dead and closed
+	// with ErrWebSocketTimeout. Defaults to PingInterval when pings are
+	// enabled.
+	PongTimeout time.Duration
+
+	// WriteTimeout is the deadline for each write.
+	WriteTimeout time.Duration
+
+	// IdleTimeout closes the connection cleanly, with ErrWebSocketIdle,
+	// when no message has been sent or received for this long.
+	IdleTimeout time.Duration
+}
+
+// DefaultWebSocketKeepalive returns keepalive settings that detect dead
+// connections within about 25 seconds. Idle connections are not closed.
+func DefaultWebSocketKeepalive() *WebSocketKeepalive {
+	return &WebSocketKeepalive{
+		PingInterval: DefaultWebSocketPingInterval,
+		PongTimeout:  DefaultWebSocketPongTimeout,
+		WriteTimeout: DefaultWebSocketWriteTimeout,
+	}
+}
+
+// wsKeepalive applies WebSocketKeepalive to a connection. Writes must be
+// serialized by the caller; pings use WriteControl, which gorilla allows
+// concurrently with other writes. Deadlines are set from clock, so a fake
+// clock must start at the real time.
+type wsKeepalive struct {
+	conn  *websocket.Conn
+	cfg   WebSocketKeepalive
+	clock clock.Clock
+
+	// lastActivity is the time of the last data message, in Unix nanoseconds
+	lastActivity atomic.Int64
+	idle         atomic.Bool
+}
+
+func newWSKeepalive(conn *websocket.Conn, cfg *WebSocketKeepalive, clk clock.Clock) *wsKeepalive {
+	k := &wsKeepalive{conn: conn, clock: clk}
+	if cfg != nil {
+		k.cfg = *cfg
+	}
+	if k.cfg.PingInterval > 0 && k.cfg.PongTimeout <= 0 {
+		k.cfg.PongTimeout = k.cfg.PingInterval
+	}
+	k.touch()
+	if k.cfg.PingInterval > 0 {
+		conn.SetPongHandler(func(string) error {
+			k.extendRead()
+			return nil
+		})
+		k.extendRead()
+	}
+	return k
+}
+
+// touch records activity for the idle timeout.
+func (k *wsKeepalive) touch() {
+	k.lastActivity.Store(k.clock.Now().UnixNano())
+}
+
+// received records a received message.
+func (k *wsKeepalive) received() {
+	k.touch()
+	k.extendRead()
+}
+
+// extendRead pushes the read deadline past the next expected pong.
+func (k *wsKeepalive) extendRead() {
+	if k.cfg.PingInterval > 0 {
+		_ = k.conn.SetReadDeadline(k.clock.Now().Add(k.cfg.PingInterval + k.cfg.PongTimeout))
+	}
+}
+
+// beforeWrite sets the write deadline and records activity.
+func (k *wsKeepalive) beforeWrite() {
+	k.touch()
+	if k.cfg.WriteTimeout > 0 {
+		_ = k.conn.SetWriteDeadline(k.clock.Now().Add(k.cfg.WriteTimeout))
+	}
+}
+
+// run pings the server and closes the connection when idle, until done is
+// closed. onIdle is called before an idle connection is closed.
+func (k *wsKeepalive) run(done <-chan struct{}, onIdle func()) {
+	var ping <-chan time.Time
+	if k.cfg.PingInterval > 0 {
+		ping = k.clock.After(k.cfg.PingInterval)
+	}
+	var idle <-chan time.Time
+	if k.cfg.IdleTimeout > 0 {
+		idle = k.clock.After(k.cfg.IdleTimeout)
+	}
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ping:
+			ping = k.clock.After(k.cfg.PingInterval)
+			// A failed ping surfaces as a read timeout
+			_ = k.conn.WriteControl(websocket.PingMessage, nil, k.clock.Now().Add(k.controlTimeout()))
+		case <-idle:
+			since := k.clock.Now().Sub(time.Unix(0, k.lastActivity.Load()))
+			if since < k.cfg.IdleTimeout {
+				idle = k.clock.After(k.cfg.IdleTimeout - since)
+				continue
+			}
+			k.idle.Store(true)
+			onIdle()
+			msg := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "idle timeout")
+			_ = k.conn.WriteControl(websocket.CloseMessage, msg, k.clock.Now().Add(k.controlTimeout()))
+			// Closing unblocks the read loop, which closes the channels
+			_ = k.conn.Close()
+			return
+		}
+	}
+}
+
+func (k *wsKeepalive) controlTimeout() time.Duration {
+	if k.cfg.WriteTimeout > 0 {
+		return k.cfg.WriteTimeout
+	}
+	return DefaultWebSocketWriteTimeout
+}
+
+// readError maps a read loop error to the error to report, or nil for a
+// normal close.
+func (k *wsKeepalive) readError(err error) error {
+	if k.idle.Load() {
+		return ErrWebSocketIdle
+	}
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+		return nil
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ErrWebSocketTimeout
+	}
+	return err
+}
@@ -0,0 +1,152 @@
+package elevenlabs
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/agentplexus/go-elevenlabs/internal/clock"
+)
+
+// newWebSocketServer starts a server that reads messages (answering pings)
+// while read is true, and otherwise only reads the initial message.
+func newWebSocketServer(t *testing.T, read bool) *httptest.Server {
+	t.Helper()
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if _, _, err := conn.ReadMessage(); err != nil {
+			return
+		}
+		if !read {
+			<-r.Context().Done()
+			return
+		}
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func waitForError(t *testing.T, errs <-chan error) error {
+	t.Helper()
+	select {
+	case err := <-errs:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for connection error")
+		return nil
+	}
+}
+
+func TestWebSocketTTSIdleTimeout(t *testing.T) {
+	server := newWebSocketServer(t, true)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	conn, err := client.WebSocketTTS().Connect(context.Background(), "voice1", &WebSocketTTSOptions{
+		Keepalive: &WebSocketKeepalive{IdleTimeout: 100 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer conn.Close()
+
+	if err := waitForError(t, conn.Errors()); !errors.Is(err, ErrWebSocketIdle) {
+		t.Errorf("error = %v, want ErrWebSocketIdle", err)
+	}
+	// Consumers are released
+	for range conn.Audio() {
+	}
+	if err := conn.SendText("hello"); err == nil {
+		t.Error("SendText() after idle close should fail")
+	}
+}
+
+func TestWebSocketTTSPingTimeout(t *testing.T) {
+	server := newWebSocketServer(t, false)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	conn, err := client.WebSocketTTS().Connect(context.Background(), "voice1", &WebSocketTTSOptions{
+		Keepalive: &WebSocketKeepalive{PingInterval: 50 * time.Millisecond, PongTimeout: 50 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer conn.Close()
+
+	if err := waitForError(t, conn.Errors()); !errors.Is(err, ErrWebSocketTimeout) {
+		t.Errorf("error = %v, want ErrWebSocketTimeout", err)
+	}
+	for range conn.Alignments() {
+	}
+}
+
+func TestWebSocketTTSPingKeepsAlive(t *testing.T) {
+	server := newWebSocketServer(t, true)
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL))
+
+	conn, err := client.WebSocketTTS().Connect(context.Background(), "voice1", &WebSocketTTSOptions{
+		Keepalive: &WebSocketKeepalive{PingInterval: 20 * time.Millisecond, PongTimeout: 200 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer conn.Close()
+
+	select {
+	case err := <-conn.Errors():
+		t.Fatalf("unexpected error: %v", err)
+	case <-time.After(300 * time.Millisecond):
+	}
+	if err := conn.SendText("still here"); err != nil {
+		t.Errorf("SendText() error = %v", err)
+	}
+}
+
+func TestWebSocketSTTIdleTimeout(t *testing.T) {
+	server := newWebSocketServer(t, true)
+	fake := clock.NewFake(time.Now())
+	client, _ := NewClient(WithAPIKey("test-key"), WithBaseURL(server.URL), withClock(fake))
+
+	conn, err := client.WebSocketSTT().Connect(context.Background(), &WebSocketSTTOptions{
+		Keepalive: &WebSocketKeepalive{IdleTimeout: time.Minute},
+	})
+	if err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	defer conn.Close()
+
+	// Sending audio counts as activity, so the first timeout is rescheduled
+	fake.BlockUntil(1)
+	fake.Advance(40 * time.Second)
+	if err := conn.SendAudio([]byte{0, 1}); err != nil {
+		t.Fatalf("SendAudio() error = %v", err)
+	}
+	fake.Advance(20 * time.Second)
+	select {
+	case err := <-conn.Errors():
+		t.Fatalf("closed %v after activity", err)
+	default:
+	}
+	fake.BlockUntil(1)
+	fake.Advance(40 * time.Second)
+
+	if err := waitForError(t, conn.Errors()); !errors.Is(err, ErrWebSocketIdle) {
+		t.Errorf("error = %v, want ErrWebSocketIdle", err)
+	}
+	for range conn.Transcripts() {
+	}
+}
@@ -43,6 +43,10 @@ type WebSocketSTTOptions struct {
 
 	// MaxAlternatives is the maximum number of transcription alternatives.
 	MaxAlternatives int
+
+	// Keepalive configures pings, write deadlines, and the idle timeout.
+	// Nil disables them; DefaultWebSocketSTTOptions enables pings.
+	Keepalive *WebSocketKeepalive
 }
 
 // DefaultWebSocketSTTOptions returns default options for real-time STT.
@@ -53,18 +57,21 @@ func DefaultWebSocketSTTOptions() *WebSocketSTTOptions {
 		Encoding:             "pcm_s16le",
 		EnablePartials:       true,
 		EnableWordTimestamps: true,
+		Keepalive:            DefaultWebSocketKeepalive(),
 	}
 }
 
 // WebSocketSTTConnection represents an active WebSocket STT connection.
 type WebSocketSTTConnection struct {
-	conn    *websocket.Conn
-	options *WebSocketSTTOptions
-	mu      sync.Mutex
-	closed  bool
+	conn      *websocket.Conn
+	options   *WebSocketSTTOptions
+	keepalive *wsKeepalive
+	mu        sync.Mutex
+	closed    bool
 
 	// Channels for async operation
 	transcriptOut chan *STTTranscript
+	finalOut      chan struct{}
 	errChan       chan error
 	closeChan     chan struct{}
 	closeOnce     sync.Once
@@ -157,7 +164,7 @@ func (s *WebSocketSTTService) Connect(ctx context.Context, opts *WebSocketSTTOpt
 
 	// Add headers
 	headers := http.Header{}
-	headers.Set("xi-api-key", s.client.apiKey)
+	s.client.headers.apply(headers)
 
 	// Connect
 	conn, _, err := dialer.DialContext(ctx, wsURL, headers)
@@ -169,8 +176,10 @@ func (s *WebSocketSTTService) Connect(ctx context.Context, opts *WebSocketSTTOpt
 		conn:          conn,
 		options:       opts,
 		transcriptOut: make(chan *STTTranscript, 100),
+		finalOut:      make(chan struct{}, 1),
 		errChan:       make(chan error, 1),
 		closeChan:     make(chan struct{}),
+		keepalive:     newWSKeepalive(conn, opts.Keepalive, s.client.clock),
 	}
 
 	// Send initial configuration
@@ -181,6 +190,7 @@ func (s *WebSocketSTTService) Connect(ctx context.Context, opts *WebSocketSTTOpt
 
 	// Start reading responses
 	go wsc.readLoop()
+	go wsc.keepalive.run(wsc.closeChan, wsc.markClosed)
 
 	return wsc, nil
 }
@@ -243,6 +253,7 @@ func (wsc *WebSocketSTTConnection) sendJSON(msg any) error {
 		return fmt.Errorf("connection closed")
 	}
 
+	wsc.keepalive.beforeWrite()
 	return wsc.conn.WriteJSON(msg)
 }
 
@@ -258,7 +269,7 @@ func (wsc *WebSocketSTTConnection) readLoop() {
 
 		_, message, err := wsc.conn.ReadMessage()
 		if err != nil {
-			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			if err := wsc.keepalive.readError(err); err != nil {
 				select {
 				case wsc.errChan <- err:
 				default:
@@ -266,6 +277,7 @@ func (wsc *WebSocketSTTConnection) readLoop() {
 			}
 			return
 		}
+		wsc.keepalive.received()
 
 		var resp sttWSResponse
 		if err := json.Unmarshal(message, &resp); err != nil {
@@ -305,10 +317,23 @@ func (wsc *WebSocketSTTConnection) readLoop() {
 			case <-wsc.closeChan:
 				return
 			}
+			if transcript.IsFinal {
+				select {
+				case wsc.finalOut <- struct{}{}:
+				default:
+				}
+			}
 		}
 	}
 }
 
+// markClosed rejects further sends, for a connection closed by its keepalive.
+func (wsc *WebSocketSTTConnection) markClosed() {
+	wsc.mu.Lock()
+	wsc.closed = true
+	wsc.mu.Unlock()
+}
+
 func (wsc *WebSocketSTTConnection) closeChannels() {
 	wsc.closeOnce.Do(func() {
 		close(wsc.closeChan)
@@ -368,6 +393,32 @@ func (wsc *WebSocketSTTConnection) Close() error {
 	return wsc.conn.Close()
 }
 
+// DrainAndClose ends the audio stream, waits for the final transcript of
+// the remaining audio (or for the server to close the connection), and
+// then closes, so the last words are not lost as with Close. Transcripts
+// must still be consumed while it waits. If ctx ends first, the connection
+// is closed anyway and ctx's error is returned.
+func (wsc *WebSocketSTTConnection) DrainAndClose(ctx context.Context) error {
+	// Only a final transcript after the end of the stream counts
+	select {
+	case <-wsc.finalOut:
+	default:
+	}
+	err := wsc.EndStream()
+	if err == nil {
+		select {
+		case <-wsc.finalOut:
+		case <-wsc.closeChan:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	if cerr := wsc.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
 // StreamAudio is a convenience method that streams audio from a channel.
 // It handles ending the stream automatically when the input channel closes.
 func (wsc *WebSocketSTTConnection) StreamAudio(ctx context.Context, audioStream <-chan []byte) (<-chan *STTTranscript, <-chan error) {
@@ -30,9 +30,9 @@ type WebSocketTTSOptions struct {
 	// VoiceSettings configures the voice parameters.
 	VoiceSettings *VoiceSettings
 
-	// OptimizeStreamingLatency reduces latency at the cost of quality (0-4).
-	// 0 = no optimization, 4 = maximum optimization.
-	OptimizeStreamingLatency int
+	// OptimizeStreamingLatency reduces latency at the cost of quality, from
+	// LatencyDefault to LatencyMaxNoNormalizer.
+	OptimizeStreamingLatency StreamingLatency
 
 	// EnableSSMLParsing enables SSML parsing for the input text.
 	EnableSSMLParsing bool
@@ -49,6 +49,10 @@ type WebSocketTTSOptions struct {
 
 	// PronunciationDictionaryIDs is a list of pronunciation dictionary IDs to use.
 	PronunciationDictionaryIDs []string
+
+	// Keepalive configures pings, write deadlines, and the idle timeout.
+	// Nil disables them; DefaultWebSocketTTSOptions enables pings.
+	Keepalive *WebSocketKeepalive
 }
 
 // DefaultWebSocketTTSOptions returns default options optimized for low latency.
@@ -56,21 +60,24 @@ func DefaultWebSocketTTSOptions() *WebSocketTTSOptions {
 	return &WebSocketTTSOptions{
 		ModelID:                  "eleven_turbo_v2_5",
 		OutputFormat:             "pcm_16000",
-		OptimizeStreamingLatency: 3,
+		OptimizeStreamingLatency: LatencyMax,
+		Keepalive:                DefaultWebSocketKeepalive(),
 	}
 }
 
 // WebSocketTTSConnection represents an active WebSocket TTS connection.
 type WebSocketTTSConnection struct {
-	conn    *websocket.Conn
-	voiceID string
-	options *WebSocketTTSOptions
-	mu      sync.Mutex
-	closed  bool
+	conn      *websocket.Conn
+	voiceID   string
+	options   *WebSocketTTSOptions
+	keepalive *wsKeepalive
+	mu        sync.Mutex
+	closed    bool
 
 	// Channels for async operation
 	audioOut  chan []byte
 	alignOut  chan *TTSAlignment
+	finalOut  chan struct{}
 	errChan   chan error
 	closeChan chan struct{}
 	closeOnce sync.Once
@@ -85,22 +92,21 @@ type TTSAlignment struct {
 
 // ttsWSMessage is the WebSocket message format for TTS.
 type ttsWSMessage struct {
-	Text                       string           `json:"text,omitempty"`
-	VoiceSettings              *wsVoiceSettings `json:"voice_settings,omitempty"`
-	GenerationConfig           *wsGenConfig     `json:"generation_config,omitempty"`
-	XIAPIKey                   string           `json:"xi_api_key,omitempty"`
-	TryTriggerGeneration       bool             `json:"try_trigger_generation,omitempty"`
-	Flush                      bool             `json:"flush,omitempty"`
-	CloseConnection            bool             `json:"close_connection,omitempty"`
-	ContextID                  string           `json:"context_id,omitempty"`
-	PronunciationDictionaryIDs []string         `json:"pronunciation_dictionary_locators,omitempty"`
+	Text                       string                 `json:"text,omitempty"`
+	VoiceSettings              *OptionalVoiceSettings `json:"voice_settings,omitempty"`
+	GenerationConfig           *wsGenConfig           `json:"generation_config,omitempty"`
+	XIAPIKey                   string                 `json:"xi_api_key,omitempty"`
+	TryTriggerGeneration       bool                   `json:"try_trigger_generation,omitempty"`
+	Flush                      bool                   `json:"flush,omitempty"`
+	CloseConnection            bool                   `json:"close_connection,omitempty"`
+	ContextID                  string                 `json:"context_id,omitempty"`
+	PronunciationDictionaryIDs []string               `json:"pronunciation_dictionary_locators,omitempty"`
 }
 
-type wsVoiceSettings struct {
-	Stability       float64 `json:"stability"`
-	SimilarityBoost float64 `json:"similarity_boost"`
-	Style           float64 `json:"style,omitempty"`
-	UseSpeakerBoost bool    `json:"use_speaker_boost,omitempty"`
+// ttsWSEndMessage ends the input stream. Unlike ttsWSMessage, its empty
+// text is sent.
+type ttsWSEndMessage struct {
+	Text string `json:"text"`
 }
 
 type wsGenConfig struct {
@@ -127,6 +133,9 @@ func (s *WebSocketTTSService) Connect(ctx context.Context, voiceID string, opts
 	if opts == nil {
 		opts = DefaultWebSocketTTSOptions()
 	}
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
 
 	// Build WebSocket URL
 	wsURL, err := s.buildWebSocketURL(voiceID, opts)
@@ -141,7 +150,7 @@ func (s *WebSocketTTSService) Connect(ctx context.Context, voiceID string, opts
 
 	// Add headers
 	headers := http.Header{}
-	headers.Set("xi-api-key", s.client.apiKey)
+	s.client.headers.apply(headers)
 
 	// Connect
 	conn, _, err := dialer.DialContext(ctx, wsURL, headers)
@@ -155,8 +164,10 @@ func (s *WebSocketTTSService) Connect(ctx context.Context, voiceID string, opts
 		options:   opts,
 		audioOut:  make(chan []byte, 100),
 		alignOut:  make(chan *TTSAlignment, 100),
+		finalOut:  make(chan struct{}, 1),
 		errChan:   make(chan error, 1),
 		closeChan: make(chan struct{}),
+		keepalive: newWSKeepalive(conn, opts.Keepalive, s.client.clock),
 	}
 
 	// Send initial configuration
@@ -167,6 +178,7 @@ func (s *WebSocketTTSService) Connect(ctx context.Context, voiceID string, opts
 
 	// Start reading responses
 	go wsc.readLoop()
+	go wsc.keepalive.run(wsc.closeChan, wsc.markClosed)
 
 	return wsc, nil
 }
@@ -222,12 +234,8 @@ func (wsc *WebSocketTTSConnection) sendInit() error {
 	}
 
 	if wsc.options.VoiceSettings != nil {
-		msg.VoiceSettings = &wsVoiceSettings{
-			Stability:       wsc.options.VoiceSettings.Stability,
-			SimilarityBoost: wsc.options.VoiceSettings.SimilarityBoost,
-			Style:           wsc.options.VoiceSettings.Style,
-			UseSpeakerBoost: wsc.options.VoiceSettings.UseSpeakerBoost,
-		}
+		settings := wsc.options.VoiceSettings.optional(legacyWebSocket)
+		msg.VoiceSettings = &settings
 	}
 
 	if len(wsc.options.ChunkLengthSchedule) > 0 {
@@ -251,6 +259,7 @@ func (wsc *WebSocketTTSConnection) sendJSON(msg any) error {
 		return fmt.Errorf("connection closed")
 	}
 
+	wsc.keepalive.beforeWrite()
 	return wsc.conn.WriteJSON(msg)
 }
 
@@ -266,7 +275,7 @@ func (wsc *WebSocketTTSConnection) readLoop() {
 
 		_, message, err := wsc.conn.ReadMessage()
 		if err != nil {
-			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+			if err := wsc.keepalive.readError(err); err != nil {
 				select {
 				case wsc.errChan <- err:
 				default:
@@ -274,6 +283,7 @@ func (wsc *WebSocketTTSConnection) readLoop() {
 			}
 			return
 		}
+		wsc.keepalive.received()
 
 		var resp ttsWSResponse
 		if err := json.Unmarshal(message, &resp); err != nil {
@@ -328,9 +338,23 @@ func (wsc *WebSocketTTSConnection) readLoop() {
 			default:
 			}
 		}
+
+		if resp.IsFinal {
+			select {
+			case wsc.finalOut <- struct{}{}:
+			default:
+			}
+		}
 	}
 }
 
+// markClosed rejects further sends, for a connection closed by its keepalive.
+func (wsc *WebSocketTTSConnection) markClosed() {
+	wsc.mu.Lock()
+	wsc.closed = true
+	wsc.mu.Unlock()
+}
+
 func (wsc *WebSocketTTSConnection) closeChannels() {
 	wsc.closeOnce.Do(func() {
 		close(wsc.closeChan)
@@ -422,6 +446,27 @@ func (wsc *WebSocketTTSConnection) Close() error {
 	return wsc.conn.Close()
 }
 
+// DrainAndClose ends the input, waits until the server has generated all
+// pending text (or closed the connection), and then closes, so the last
+// sentence is not cut off as with Close. Audio and alignments must still
+// be consumed while it waits. If ctx ends first, the connection is closed
+// anyway and ctx's error is returned.
+func (wsc *WebSocketTTSConnection) DrainAndClose(ctx context.Context) error {
+	err := wsc.sendJSON(ttsWSEndMessage{})
+	if err == nil {
+		select {
+		case <-wsc.finalOut:
+		case <-wsc.closeChan:
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+	}
+	if cerr := wsc.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
 // StreamText is a convenience method that sends all text from a channel and returns audio.
 // It handles flushing automatically when the input channel closes.
 func (wsc *WebSocketTTSConnection) StreamText(ctx context.Context, textStream <-chan string) (<-chan []byte, <-chan error) {
@@ -0,0 +1,254 @@
+package elevenlabs
+
+import (
+	"context"
+	"sort"
+
+	"github.com/agentplexus/go-elevenlabs/internal/api"
+)
+
+// WorkspaceService handles workspace resource sharing.
+type WorkspaceService struct {
+	client *Client
+}
+
+// WorkspaceResourceType identifies the kind of a shareable workspace resource.
+type WorkspaceResourceType string
+
+// Common workspace resource types. Other types supported by the API can be
+// used by converting the string, e.g. WorkspaceResourceType("convai_tools").
+const (
+	ResourceTypeVoice                   WorkspaceResourceType = "voice"
+	ResourceTypeVoiceCollection         WorkspaceResourceType = "voice_collection"
+	ResourceTypePronunciationDictionary WorkspaceResourceType = "pronunciation_dictionary"
+	ResourceTypeDubbing                 WorkspaceResourceType = "dubbing"
+	ResourceTypeProject                 WorkspaceResourceType = "project"
+	ResourceTypeAgent                   WorkspaceResourceType = "convai_agents"
+	ResourceTypeKnowledgeBaseDocument   WorkspaceResourceType = "convai_knowledge_base_documents"
+)
+
+// WorkspaceRole is the access level granted on a shared resource.
+type WorkspaceRole string
+
+// Workspace roles, from most to least privileged.
+const (
+	RoleAdmin     WorkspaceRole = "admin"
+	RoleEditor    WorkspaceRole = "editor"
+	RoleCommenter WorkspaceRole = "commenter"
+	RoleViewer    WorkspaceRole = "viewer"
+)
+
+// GroupDefault targets the permissions every workspace member has on a
+// resource by default.
+const GroupDefault = "default"
+
+// ShareRequest shares a resource with, or unshares it from, one principal.
+// Exactly one of GroupID, UserEmail, or WorkspaceAPIKeyID must be set.
+type ShareRequest struct {
+	// ResourceID is the ID of the resource (e.g., a voice or dictionary ID).
+	ResourceID string
+
+	// ResourceType is the type of the resource.
+	ResourceType WorkspaceResourceType
+
+	// Role is the access level to grant. Ignored by Unshare.
+	Role WorkspaceRole
+
+	// GroupID is a workspace group ID, or GroupDefault.
+	GroupID string
+
+	// UserEmail is the email of a user or service account.
+	UserEmail string
+
+	// WorkspaceAPIKeyID is the ID (not the value) of a workspace API key.
+	WorkspaceAPIKeyID string
+}
+
+// validate checks the request; role is required when sharing.
+func (r *ShareRequest) validate(requireRole bool) error {
+	if r.ResourceID == "" {
+		return &ValidationError{Field: "resource_id", Message: "cannot be empty"}
+	}
+	if r.ResourceType == "" {
+		return &ValidationError{Field: "resource_type", Message: "cannot be empty"}
+	}
+	if requireRole {
+		switch r.Role {
+		case RoleAdmin, RoleEditor, RoleCommenter, RoleViewer:
+		default:
+			return &ValidationError{Field: "role", Message: "must be admin, editor, commenter, or viewer"}
+		}
+	}
+	principals := 0
+	for _, v := range []string{r.GroupID, r.UserEmail, r.WorkspaceAPIKeyID} {
+		if v != "" {
+			principals++
+		}
+	}
+	if principals != 1 {
+		return &ValidationError{Field: "principal", Message: "exactly one of group_id, user_email, or workspace_api_key_id is required"}
+	}
+	return nil
+}
+
+// optString returns an unset OptNilString for empty values.
+func optString(v string) api.OptNilString {
+	if v == "" {
+		return api.OptNilString{}
+	}
+	return api.NewOptNilString(v)
+}
+
+// Share grants a principal a role on a resource. Sharing again with a
+// different role updates the principal's role.
+func (s *WorkspaceService) Share(ctx context.Context, req *ShareRequest) error {
+	if req == nil {
+		return &ValidationError{Field: "request", Message: "cannot be nil"}
+	}
+	if err := req.validate(true); err != nil {
+		return err
+	}
+
+	resp, err := s.client.apiClient.ShareResourceEndpoint(ctx, &api.BodyShareWorkspaceResourceV1WorkspaceResourcesResourceIDSharePost{
+		ResourceType:      api.WorkspaceResourceType(req.ResourceType),
+		Role:              api.BodyShareWorkspaceResourceV1WorkspaceResourcesResourceIDSharePostRole(req.Role),
+		GroupID:           optString(req.GroupID),
+		UserEmail:         optString(req.UserEmail),
+		WorkspaceAPIKeyID: optString(req.WorkspaceAPIKeyID),
+	}, api.ShareResourceEndpointParams{ResourceID: req.ResourceID})
+	if err != nil {
+		return err
+	}
+
+	switch resp.(type) {
+	case *api.ShareResourceEndpointOKApplicationJSON:
+		return nil
+	default:
+		return &APIError{Message: "unexpected response type"}
+	}
+}
+
+// Unshare removes a principal's access to a resource.
+func (s *WorkspaceService) Unshare(ctx context.Context, req *ShareRequest) error {
+	if req == nil {
+		return &ValidationError{Field: "request", Message: "cannot be nil"}
+	}
+	if err := req.validate(false); err != nil {
+		return err
+	}
+
+	resp, err := s.client.apiClient.UnshareResourceEndpoint(ctx, &api.BodyUnshareWorkspaceResourceV1WorkspaceResourcesResourceIDUnsharePost{
+		ResourceType:      api.WorkspaceResourceType(req.ResourceType),
+		GroupID:           optString(req.GroupID),
+		UserEmail:         optString(req.UserEmail),
+		WorkspaceAPIKeyID: optString(req.WorkspaceAPIKeyID),
+	}, api.UnshareResourceEndpointParams{ResourceID: req.ResourceID})
+	if err != nil {
+		return err
+	}
+
+	switch resp.(type) {
+	case *api.UnshareResourceEndpointOKApplicationJSON:
+		return nil
+	default:
+		return &APIError{Message: "unexpected response type"}
+	}
+}
+
+// ResourceSharing describes who can access a workspace resource.
+type ResourceSharing struct {
+	// ResourceID is the ID of the resource.
+	ResourceID string
+
+	// ResourceType is the type of the resource.
+	ResourceType WorkspaceResourceType
+
+	// CreatorUserID is the ID of the user who created the resource.
+	CreatorUserID string
+
+	// AnonymousAccess is the role granted to anonymous users, or empty if the
+	// resource is not shared publicly.
+	AnonymousAccess WorkspaceRole
+
+	// RoleGroups maps roles to group IDs. For resources shared with a user,
+	// the group ID is the user's ID.
+	RoleGroups map[WorkspaceRole][]string
+
+	// ShareOptions are principals the resource can still be shared with.
+	ShareOptions []ShareOption
+}
+
+// ShareOption is a principal that does not yet have access to a resource.
+type ShareOption struct {
+	ID   string
+	Name string
+
+	// Type is "user", "group", or "key" (service account).
+	Type string
+}
+
+// HasAccess returns the role granted to a group or user ID, or "" if none.
+func (r *ResourceSharing) HasAccess(groupID string) WorkspaceRole {
+	for _, role := range []WorkspaceRole{RoleAdmin, RoleEditor, RoleCommenter, RoleViewer} {
+		for _, id := range r.RoleGroups[role] {
+			if id == groupID {
+				return role
+			}
+		}
+	}
+	return ""
+}
+
+// GetSharing returns a resource's sharing metadata.
+func (s *WorkspaceService) GetSharing(ctx context.Context, resourceID string, resourceType WorkspaceResourceType) (*ResourceSharing, error) {
+	if resourceID == "" {
+		return nil, &ValidationError{Field: "resource_id", Message: "cannot be empty"}
+	}
+	if resourceType == "" {
+		return nil, &ValidationError{Field: "resource_type", Message: "cannot be empty"}
+	}
+
+	resp, err := s.client.apiClient.GetResourceMetadata(ctx, api.GetResourceMetadataParams{
+		ResourceID:   resourceID,
+		ResourceType: api.WorkspaceResourceType(resourceType),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := resp.(type) {
+	case *api.ResourceMetadataResponseModel:
+		return resourceSharingFromAPI(r), nil
+	default:
+		return nil, &APIError{Message: "unexpected response type"}
+	}
+}
+
+// resourceSharingFromAPI converts resource metadata.
+func resourceSharingFromAPI(r *api.ResourceMetadataResponseModel) *ResourceSharing {
+	sharing := &ResourceSharing{
+		ResourceID:   r.ResourceID,
+		ResourceType: WorkspaceResourceType(r.ResourceType),
+		RoleGroups:   make(map[WorkspaceRole][]string, len(r.RoleToGroupIds)),
+		ShareOptions: make([]ShareOption, 0, len(r.ShareOptions)),
+	}
+	if !r.CreatorUserID.Null {
+		sharing.CreatorUserID = r.CreatorUserID.Value
+	}
+	if !r.AnonymousAccessLevelOverride.Null {
+		sharing.AnonymousAccess = WorkspaceRole(r.AnonymousAccessLevelOverride.Value)
+	}
+	for role, ids := range r.RoleToGroupIds {
+		groups := append([]string(nil), ids...)
+		sort.Strings(groups)
+		sharing.RoleGroups[WorkspaceRole(role)] = groups
+	}
+	for _, opt := range r.ShareOptions {
+		sharing.ShareOptions = append(sharing.ShareOptions, ShareOption{
+			ID:   opt.ID,
+			Name: opt.Name,
+			Type: string(opt.Type),
+		})
+	}
+	return sharing
+}
@@ -0,0 +1,77 @@
+package elevenlabs
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWorkspaceShareValidation(t *testing.T) {
+	client, err := NewClient(WithAPIKey("test-key"))
+	if err != nil {
+		t.Fatalf("NewClient() error = %v", err)
+	}
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  *ShareRequest
+	}{
+		{"nil request", nil},
+		{"missing resource ID", &ShareRequest{ResourceType: ResourceTypeVoice, Role: RoleViewer, GroupID: GroupDefault}},
+		{"missing resource type", &ShareRequest{ResourceID: "v1", Role: RoleViewer, GroupID: GroupDefault}},
+		{"invalid role", &ShareRequest{ResourceID: "v1", ResourceType: ResourceTypeVoice, Role: "owner", GroupID: GroupDefault}},
+		{"no principal", &ShareRequest{ResourceID: "v1", ResourceType: ResourceTypeVoice, Role: RoleViewer}},
+		{"two principals", &ShareRequest{ResourceID: "v1", ResourceType: ResourceTypeVoice, Role: RoleViewer, GroupID: "g1", UserEmail: "a@example.com"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := client.Workspace().Share(ctx, tt.req); !isValidationError(err, nil) {
+				t.Errorf("Share() error = %v, want ValidationError", err)
+			}
+		})
+	}
+
+	// Unshare does not require a role.
+	err = client.Workspace().Unshare(ctx, &ShareRequest{ResourceID: "v1", ResourceType: ResourceTypeVoice})
+	if !isValidationError(err, nil) {
+		t.Errorf("Unshare() without principal error = %v, want ValidationError", err)
+	}
+	if _, err := client.Workspace().GetSharing(ctx, "", ResourceTypeVoice); !isValidationError(err, nil) {
+		t.Errorf("GetSharing() error = %v, want ValidationError", err)
+	}
+}
+
+func TestWorkspaceSharing_Replay(t *testing.T) {
+	client := newReplayClient(t, "workspace_sharing.json")
+	ctx := context.Background()
+
+	err := client.Workspace().Share(ctx, &ShareRequest{
+		ResourceID:   "pd1",
+		ResourceType: ResourceTypePronunciationDictionary,
+		Role:         RoleEditor,
+		GroupID:      "g2",
+	})
+	if err != nil {
+		t.Fatalf("Share() error = %v", err)
+	}
+
+	sharing, err := client.Workspace().GetSharing(ctx, "pd1", ResourceTypePronunciationDictionary)
+	if err != nil {
+		t.Fatalf("GetSharing() error = %v", err)
+	}
+	if sharing.CreatorUserID != "u1" || sharing.AnonymousAccess != "" {
+		t.Errorf("unexpected sharing: %+v", sharing)
+	}
+	if got := sharing.HasAccess("g2"); got != RoleEditor {
+		t.Errorf("HasAccess(g2) = %q, want editor", got)
+	}
+	if got := sharing.HasAccess("u1"); got != RoleAdmin {
+		t.Errorf("HasAccess(u1) = %q, want admin", got)
+	}
+	if got := sharing.HasAccess("g3"); got != "" {
+		t.Errorf("HasAccess(g3) = %q, want none", got)
+	}
+	if len(sharing.ShareOptions) != 1 || sharing.ShareOptions[0].Type != "group" {
+		t.Errorf("unexpected share options: %+v", sharing.ShareOptions)
+	}
+}